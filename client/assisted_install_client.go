@@ -15,12 +15,18 @@ import (
 
 	"github.com/openshift/assisted-service/client/assisted_service_iso"
 	"github.com/openshift/assisted-service/client/bootfiles"
+	"github.com/openshift/assisted-service/client/cluster_templates"
 	"github.com/openshift/assisted-service/client/events"
+	"github.com/openshift/assisted-service/client/history"
 	"github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/client/logs"
 	"github.com/openshift/assisted-service/client/managed_domains"
 	"github.com/openshift/assisted-service/client/manifests"
 	"github.com/openshift/assisted-service/client/operators"
+	"github.com/openshift/assisted-service/client/timeline"
+	"github.com/openshift/assisted-service/client/validations"
 	"github.com/openshift/assisted-service/client/versions"
+	"github.com/openshift/assisted-service/client/webhooks"
 )
 
 const (
@@ -67,12 +73,18 @@ func New(c Config) *AssistedInstall {
 	cli.Transport = transport
 	cli.AssistedServiceIso = assisted_service_iso.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Bootfiles = bootfiles.New(transport, strfmt.Default, c.AuthInfo)
+	cli.ClusterTemplates = cluster_templates.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Events = events.New(transport, strfmt.Default, c.AuthInfo)
+	cli.History = history.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Installer = installer.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Logs = logs.New(transport, strfmt.Default, c.AuthInfo)
 	cli.ManagedDomains = managed_domains.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Manifests = manifests.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Operators = operators.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Timeline = timeline.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Validations = validations.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Versions = versions.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Webhooks = webhooks.New(transport, strfmt.Default, c.AuthInfo)
 	return cli
 }
 
@@ -80,11 +92,17 @@ func New(c Config) *AssistedInstall {
 type AssistedInstall struct {
 	AssistedServiceIso *assisted_service_iso.Client
 	Bootfiles          *bootfiles.Client
+	ClusterTemplates   *cluster_templates.Client
 	Events             *events.Client
+	History            *history.Client
 	Installer          *installer.Client
+	Logs               *logs.Client
 	ManagedDomains     *managed_domains.Client
 	Manifests          *manifests.Client
 	Operators          *operators.Client
+	Timeline           *timeline.Client
+	Validations        *validations.Client
 	Versions           *versions.Client
+	Webhooks           *webhooks.Client
 	Transport          runtime.ClientTransport
 }
@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the cluster templates client
+type API interface {
+	/*
+	   CreateClusterTemplate Creates a cluster template that clusters can be registered from.*/
+	CreateClusterTemplate(ctx context.Context, params *CreateClusterTemplateParams) (*CreateClusterTemplateCreated, error)
+	/*
+	   DeleteClusterTemplate Deletes a cluster template. Clusters that were registered from the template are not affected.*/
+	DeleteClusterTemplate(ctx context.Context, params *DeleteClusterTemplateParams) (*DeleteClusterTemplateNoContent, error)
+	/*
+	   GetClusterTemplate Retrieves the details of a cluster template.*/
+	GetClusterTemplate(ctx context.Context, params *GetClusterTemplateParams) (*GetClusterTemplateOK, error)
+	/*
+	   ListClusterTemplates Retrieves the list of cluster templates.*/
+	ListClusterTemplates(ctx context.Context, params *ListClusterTemplatesParams) (*ListClusterTemplatesOK, error)
+}
+
+// New creates a new cluster templates API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for cluster templates API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+CreateClusterTemplate Creates a cluster template that clusters can be registered from.
+*/
+func (a *Client) CreateClusterTemplate(ctx context.Context, params *CreateClusterTemplateParams) (*CreateClusterTemplateCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateClusterTemplate",
+		Method:             "POST",
+		PathPattern:        "/cluster-templates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateClusterTemplateCreated), nil
+
+}
+
+/*
+DeleteClusterTemplate Deletes a cluster template. Clusters that were registered from the template are not affected.
+*/
+func (a *Client) DeleteClusterTemplate(ctx context.Context, params *DeleteClusterTemplateParams) (*DeleteClusterTemplateNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteClusterTemplate",
+		Method:             "DELETE",
+		PathPattern:        "/cluster-templates/{template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteClusterTemplateNoContent), nil
+
+}
+
+/*
+GetClusterTemplate Retrieves the details of a cluster template.
+*/
+func (a *Client) GetClusterTemplate(ctx context.Context, params *GetClusterTemplateParams) (*GetClusterTemplateOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterTemplate",
+		Method:             "GET",
+		PathPattern:        "/cluster-templates/{template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterTemplateOK), nil
+
+}
+
+/*
+ListClusterTemplates Retrieves the list of cluster templates.
+*/
+func (a *Client) ListClusterTemplates(ctx context.Context, params *ListClusterTemplatesParams) (*ListClusterTemplatesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterTemplates",
+		Method:             "GET",
+		PathPattern:        "/cluster-templates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListClusterTemplatesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterTemplatesOK), nil
+
+}
@@ -0,0 +1,138 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewCreateClusterTemplateParams creates a new CreateClusterTemplateParams object
+// with the default values initialized.
+func NewCreateClusterTemplateParams() *CreateClusterTemplateParams {
+	var ()
+	return &CreateClusterTemplateParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateClusterTemplateParamsWithTimeout creates a new CreateClusterTemplateParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCreateClusterTemplateParamsWithTimeout(timeout time.Duration) *CreateClusterTemplateParams {
+	var ()
+	return &CreateClusterTemplateParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCreateClusterTemplateParamsWithContext creates a new CreateClusterTemplateParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCreateClusterTemplateParamsWithContext(ctx context.Context) *CreateClusterTemplateParams {
+	var ()
+	return &CreateClusterTemplateParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCreateClusterTemplateParamsWithHTTPClient creates a new CreateClusterTemplateParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCreateClusterTemplateParamsWithHTTPClient(client *http.Client) *CreateClusterTemplateParams {
+	var ()
+	return &CreateClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*CreateClusterTemplateParams contains all the parameters to send to the API endpoint
+for the create cluster template operation typically these are written to a http.Request
+*/
+type CreateClusterTemplateParams struct {
+
+	/*NewClusterTemplateParams
+	  The settings of the new cluster template.
+
+	*/
+	NewClusterTemplateParams *models.ClusterTemplateCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the create cluster template params
+func (o *CreateClusterTemplateParams) WithTimeout(timeout time.Duration) *CreateClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create cluster template params
+func (o *CreateClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create cluster template params
+func (o *CreateClusterTemplateParams) WithContext(ctx context.Context) *CreateClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create cluster template params
+func (o *CreateClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create cluster template params
+func (o *CreateClusterTemplateParams) WithHTTPClient(client *http.Client) *CreateClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create cluster template params
+func (o *CreateClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNewClusterTemplateParams adds the newClusterTemplateParams to the create cluster template params
+func (o *CreateClusterTemplateParams) WithNewClusterTemplateParams(newClusterTemplateParams *models.ClusterTemplateCreateParams) *CreateClusterTemplateParams {
+	o.SetNewClusterTemplateParams(newClusterTemplateParams)
+	return o
+}
+
+// SetNewClusterTemplateParams adds the newClusterTemplateParams to the create cluster template params
+func (o *CreateClusterTemplateParams) SetNewClusterTemplateParams(newClusterTemplateParams *models.ClusterTemplateCreateParams) {
+	o.NewClusterTemplateParams = newClusterTemplateParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.NewClusterTemplateParams != nil {
+		if err := r.SetBodyParam(o.NewClusterTemplateParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
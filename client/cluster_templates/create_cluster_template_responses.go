@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// CreateClusterTemplateReader is a Reader for the CreateClusterTemplate structure.
+type CreateClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateClusterTemplateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateClusterTemplateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewCreateClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewCreateClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewCreateClusterTemplateMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewCreateClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewCreateClusterTemplateCreated creates a CreateClusterTemplateCreated with default headers values
+func NewCreateClusterTemplateCreated() *CreateClusterTemplateCreated {
+	return &CreateClusterTemplateCreated{}
+}
+
+/*CreateClusterTemplateCreated handles this case with default header values.
+
+Success.
+*/
+type CreateClusterTemplateCreated struct {
+	Payload *models.ClusterTemplate
+}
+
+func (o *CreateClusterTemplateCreated) Error() string {
+	return fmt.Sprintf("[POST /cluster-templates][%d] createClusterTemplateCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateClusterTemplateCreated) GetPayload() *models.ClusterTemplate {
+	return o.Payload
+}
+
+func (o *CreateClusterTemplateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterTemplate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateClusterTemplateBadRequest creates a CreateClusterTemplateBadRequest with default headers values
+func NewCreateClusterTemplateBadRequest() *CreateClusterTemplateBadRequest {
+	return &CreateClusterTemplateBadRequest{}
+}
+
+/*CreateClusterTemplateBadRequest handles this case with default header values.
+
+Error.
+*/
+type CreateClusterTemplateBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *CreateClusterTemplateBadRequest) Error() string {
+	return fmt.Sprintf("[POST /cluster-templates][%d] createClusterTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateClusterTemplateBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateClusterTemplateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateClusterTemplateUnauthorized creates a CreateClusterTemplateUnauthorized with default headers values
+func NewCreateClusterTemplateUnauthorized() *CreateClusterTemplateUnauthorized {
+	return &CreateClusterTemplateUnauthorized{}
+}
+
+/*CreateClusterTemplateUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type CreateClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *CreateClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /cluster-templates][%d] createClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *CreateClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *CreateClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateClusterTemplateForbidden creates a CreateClusterTemplateForbidden with default headers values
+func NewCreateClusterTemplateForbidden() *CreateClusterTemplateForbidden {
+	return &CreateClusterTemplateForbidden{}
+}
+
+/*CreateClusterTemplateForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type CreateClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *CreateClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[POST /cluster-templates][%d] createClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *CreateClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *CreateClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateClusterTemplateMethodNotAllowed creates a CreateClusterTemplateMethodNotAllowed with default headers values
+func NewCreateClusterTemplateMethodNotAllowed() *CreateClusterTemplateMethodNotAllowed {
+	return &CreateClusterTemplateMethodNotAllowed{}
+}
+
+/*CreateClusterTemplateMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type CreateClusterTemplateMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *CreateClusterTemplateMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /cluster-templates][%d] createClusterTemplateMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *CreateClusterTemplateMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateClusterTemplateMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateClusterTemplateInternalServerError creates a CreateClusterTemplateInternalServerError with default headers values
+func NewCreateClusterTemplateInternalServerError() *CreateClusterTemplateInternalServerError {
+	return &CreateClusterTemplateInternalServerError{}
+}
+
+/*CreateClusterTemplateInternalServerError handles this case with default header values.
+
+Error.
+*/
+type CreateClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *CreateClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /cluster-templates][%d] createClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CreateClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteClusterTemplateParams creates a new DeleteClusterTemplateParams object
+// with the default values initialized.
+func NewDeleteClusterTemplateParams() *DeleteClusterTemplateParams {
+	var ()
+	return &DeleteClusterTemplateParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteClusterTemplateParamsWithTimeout creates a new DeleteClusterTemplateParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeleteClusterTemplateParamsWithTimeout(timeout time.Duration) *DeleteClusterTemplateParams {
+	var ()
+	return &DeleteClusterTemplateParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeleteClusterTemplateParamsWithContext creates a new DeleteClusterTemplateParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeleteClusterTemplateParamsWithContext(ctx context.Context) *DeleteClusterTemplateParams {
+	var ()
+	return &DeleteClusterTemplateParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDeleteClusterTemplateParamsWithHTTPClient creates a new DeleteClusterTemplateParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeleteClusterTemplateParamsWithHTTPClient(client *http.Client) *DeleteClusterTemplateParams {
+	var ()
+	return &DeleteClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*DeleteClusterTemplateParams contains all the parameters to send to the API endpoint
+for the delete cluster template operation typically these are written to a http.Request
+*/
+type DeleteClusterTemplateParams struct {
+
+	/*TemplateID
+	  The cluster template to be deleted.
+
+	*/
+	TemplateID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete cluster template params
+func (o *DeleteClusterTemplateParams) WithTimeout(timeout time.Duration) *DeleteClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete cluster template params
+func (o *DeleteClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete cluster template params
+func (o *DeleteClusterTemplateParams) WithContext(ctx context.Context) *DeleteClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete cluster template params
+func (o *DeleteClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete cluster template params
+func (o *DeleteClusterTemplateParams) WithHTTPClient(client *http.Client) *DeleteClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete cluster template params
+func (o *DeleteClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithTemplateID adds the templateID to the delete cluster template params
+func (o *DeleteClusterTemplateParams) WithTemplateID(templateID strfmt.UUID) *DeleteClusterTemplateParams {
+	o.SetTemplateID(templateID)
+	return o
+}
+
+// SetTemplateID adds the templateId to the delete cluster template params
+func (o *DeleteClusterTemplateParams) SetTemplateID(templateID strfmt.UUID) {
+	o.TemplateID = templateID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param template_id
+	if err := r.SetPathParam("template_id", o.TemplateID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// DeleteClusterTemplateReader is a Reader for the DeleteClusterTemplate structure.
+type DeleteClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteClusterTemplateNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewDeleteClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewDeleteClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDeleteClusterTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewDeleteClusterTemplateMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDeleteClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDeleteClusterTemplateNoContent creates a DeleteClusterTemplateNoContent with default headers values
+func NewDeleteClusterTemplateNoContent() *DeleteClusterTemplateNoContent {
+	return &DeleteClusterTemplateNoContent{}
+}
+
+/*DeleteClusterTemplateNoContent handles this case with default header values.
+
+Success.
+*/
+type DeleteClusterTemplateNoContent struct {
+}
+
+func (o *DeleteClusterTemplateNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /cluster-templates/{template_id}][%d] deleteClusterTemplateNoContent ", 204)
+}
+
+func (o *DeleteClusterTemplateNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteClusterTemplateUnauthorized creates a DeleteClusterTemplateUnauthorized with default headers values
+func NewDeleteClusterTemplateUnauthorized() *DeleteClusterTemplateUnauthorized {
+	return &DeleteClusterTemplateUnauthorized{}
+}
+
+/*DeleteClusterTemplateUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type DeleteClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *DeleteClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /cluster-templates/{template_id}][%d] deleteClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DeleteClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *DeleteClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteClusterTemplateForbidden creates a DeleteClusterTemplateForbidden with default headers values
+func NewDeleteClusterTemplateForbidden() *DeleteClusterTemplateForbidden {
+	return &DeleteClusterTemplateForbidden{}
+}
+
+/*DeleteClusterTemplateForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type DeleteClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *DeleteClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /cluster-templates/{template_id}][%d] deleteClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeleteClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *DeleteClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteClusterTemplateNotFound creates a DeleteClusterTemplateNotFound with default headers values
+func NewDeleteClusterTemplateNotFound() *DeleteClusterTemplateNotFound {
+	return &DeleteClusterTemplateNotFound{}
+}
+
+/*DeleteClusterTemplateNotFound handles this case with default header values.
+
+Error.
+*/
+type DeleteClusterTemplateNotFound struct {
+	Payload *models.Error
+}
+
+func (o *DeleteClusterTemplateNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /cluster-templates/{template_id}][%d] deleteClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteClusterTemplateNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteClusterTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteClusterTemplateMethodNotAllowed creates a DeleteClusterTemplateMethodNotAllowed with default headers values
+func NewDeleteClusterTemplateMethodNotAllowed() *DeleteClusterTemplateMethodNotAllowed {
+	return &DeleteClusterTemplateMethodNotAllowed{}
+}
+
+/*DeleteClusterTemplateMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type DeleteClusterTemplateMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *DeleteClusterTemplateMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[DELETE /cluster-templates/{template_id}][%d] deleteClusterTemplateMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *DeleteClusterTemplateMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteClusterTemplateMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteClusterTemplateInternalServerError creates a DeleteClusterTemplateInternalServerError with default headers values
+func NewDeleteClusterTemplateInternalServerError() *DeleteClusterTemplateInternalServerError {
+	return &DeleteClusterTemplateInternalServerError{}
+}
+
+/*DeleteClusterTemplateInternalServerError handles this case with default header values.
+
+Error.
+*/
+type DeleteClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *DeleteClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /cluster-templates/{template_id}][%d] deleteClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeleteClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetClusterTemplateParams creates a new GetClusterTemplateParams object
+// with the default values initialized.
+func NewGetClusterTemplateParams() *GetClusterTemplateParams {
+	var ()
+	return &GetClusterTemplateParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetClusterTemplateParamsWithTimeout creates a new GetClusterTemplateParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetClusterTemplateParamsWithTimeout(timeout time.Duration) *GetClusterTemplateParams {
+	var ()
+	return &GetClusterTemplateParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetClusterTemplateParamsWithContext creates a new GetClusterTemplateParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetClusterTemplateParamsWithContext(ctx context.Context) *GetClusterTemplateParams {
+	var ()
+	return &GetClusterTemplateParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetClusterTemplateParamsWithHTTPClient creates a new GetClusterTemplateParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetClusterTemplateParamsWithHTTPClient(client *http.Client) *GetClusterTemplateParams {
+	var ()
+	return &GetClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetClusterTemplateParams contains all the parameters to send to the API endpoint
+for the get cluster template operation typically these are written to a http.Request
+*/
+type GetClusterTemplateParams struct {
+
+	/*TemplateID
+	  The cluster template to be retrieved.
+
+	*/
+	TemplateID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get cluster template params
+func (o *GetClusterTemplateParams) WithTimeout(timeout time.Duration) *GetClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get cluster template params
+func (o *GetClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get cluster template params
+func (o *GetClusterTemplateParams) WithContext(ctx context.Context) *GetClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get cluster template params
+func (o *GetClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get cluster template params
+func (o *GetClusterTemplateParams) WithHTTPClient(client *http.Client) *GetClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get cluster template params
+func (o *GetClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithTemplateID adds the templateID to the get cluster template params
+func (o *GetClusterTemplateParams) WithTemplateID(templateID strfmt.UUID) *GetClusterTemplateParams {
+	o.SetTemplateID(templateID)
+	return o
+}
+
+// SetTemplateID adds the templateId to the get cluster template params
+func (o *GetClusterTemplateParams) SetTemplateID(templateID strfmt.UUID) {
+	o.TemplateID = templateID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param template_id
+	if err := r.SetPathParam("template_id", o.TemplateID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
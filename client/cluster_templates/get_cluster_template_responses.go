@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetClusterTemplateReader is a Reader for the GetClusterTemplate structure.
+type GetClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetClusterTemplateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetClusterTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewGetClusterTemplateMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetClusterTemplateOK creates a GetClusterTemplateOK with default headers values
+func NewGetClusterTemplateOK() *GetClusterTemplateOK {
+	return &GetClusterTemplateOK{}
+}
+
+/*GetClusterTemplateOK handles this case with default header values.
+
+Success.
+*/
+type GetClusterTemplateOK struct {
+	Payload *models.ClusterTemplate
+}
+
+func (o *GetClusterTemplateOK) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates/{template_id}][%d] getClusterTemplateOK  %+v", 200, o.Payload)
+}
+
+func (o *GetClusterTemplateOK) GetPayload() *models.ClusterTemplate {
+	return o.Payload
+}
+
+func (o *GetClusterTemplateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterTemplate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterTemplateUnauthorized creates a GetClusterTemplateUnauthorized with default headers values
+func NewGetClusterTemplateUnauthorized() *GetClusterTemplateUnauthorized {
+	return &GetClusterTemplateUnauthorized{}
+}
+
+/*GetClusterTemplateUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type GetClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *GetClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates/{template_id}][%d] getClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterTemplateForbidden creates a GetClusterTemplateForbidden with default headers values
+func NewGetClusterTemplateForbidden() *GetClusterTemplateForbidden {
+	return &GetClusterTemplateForbidden{}
+}
+
+/*GetClusterTemplateForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type GetClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *GetClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates/{template_id}][%d] getClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterTemplateNotFound creates a GetClusterTemplateNotFound with default headers values
+func NewGetClusterTemplateNotFound() *GetClusterTemplateNotFound {
+	return &GetClusterTemplateNotFound{}
+}
+
+/*GetClusterTemplateNotFound handles this case with default header values.
+
+Error.
+*/
+type GetClusterTemplateNotFound struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterTemplateNotFound) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates/{template_id}][%d] getClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetClusterTemplateNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterTemplateMethodNotAllowed creates a GetClusterTemplateMethodNotAllowed with default headers values
+func NewGetClusterTemplateMethodNotAllowed() *GetClusterTemplateMethodNotAllowed {
+	return &GetClusterTemplateMethodNotAllowed{}
+}
+
+/*GetClusterTemplateMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type GetClusterTemplateMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterTemplateMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates/{template_id}][%d] getClusterTemplateMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *GetClusterTemplateMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterTemplateMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterTemplateInternalServerError creates a GetClusterTemplateInternalServerError with default headers values
+func NewGetClusterTemplateInternalServerError() *GetClusterTemplateInternalServerError {
+	return &GetClusterTemplateInternalServerError{}
+}
+
+/*GetClusterTemplateInternalServerError handles this case with default header values.
+
+Error.
+*/
+type GetClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates/{template_id}][%d] getClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
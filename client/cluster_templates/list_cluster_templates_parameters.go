@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListClusterTemplatesParams creates a new ListClusterTemplatesParams object
+// with the default values initialized.
+func NewListClusterTemplatesParams() *ListClusterTemplatesParams {
+
+	return &ListClusterTemplatesParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListClusterTemplatesParamsWithTimeout creates a new ListClusterTemplatesParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListClusterTemplatesParamsWithTimeout(timeout time.Duration) *ListClusterTemplatesParams {
+
+	return &ListClusterTemplatesParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListClusterTemplatesParamsWithContext creates a new ListClusterTemplatesParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListClusterTemplatesParamsWithContext(ctx context.Context) *ListClusterTemplatesParams {
+
+	return &ListClusterTemplatesParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListClusterTemplatesParamsWithHTTPClient creates a new ListClusterTemplatesParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListClusterTemplatesParamsWithHTTPClient(client *http.Client) *ListClusterTemplatesParams {
+
+	return &ListClusterTemplatesParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListClusterTemplatesParams contains all the parameters to send to the API endpoint
+for the list cluster templates operation typically these are written to a http.Request
+*/
+type ListClusterTemplatesParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list cluster templates params
+func (o *ListClusterTemplatesParams) WithTimeout(timeout time.Duration) *ListClusterTemplatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list cluster templates params
+func (o *ListClusterTemplatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list cluster templates params
+func (o *ListClusterTemplatesParams) WithContext(ctx context.Context) *ListClusterTemplatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list cluster templates params
+func (o *ListClusterTemplatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list cluster templates params
+func (o *ListClusterTemplatesParams) WithHTTPClient(client *http.Client) *ListClusterTemplatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list cluster templates params
+func (o *ListClusterTemplatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListClusterTemplatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
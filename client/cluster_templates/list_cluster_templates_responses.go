@@ -0,0 +1,223 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListClusterTemplatesReader is a Reader for the ListClusterTemplates structure.
+type ListClusterTemplatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListClusterTemplatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListClusterTemplatesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListClusterTemplatesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListClusterTemplatesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewListClusterTemplatesMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListClusterTemplatesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListClusterTemplatesOK creates a ListClusterTemplatesOK with default headers values
+func NewListClusterTemplatesOK() *ListClusterTemplatesOK {
+	return &ListClusterTemplatesOK{}
+}
+
+/*ListClusterTemplatesOK handles this case with default header values.
+
+Success.
+*/
+type ListClusterTemplatesOK struct {
+	Payload models.ClusterTemplateList
+}
+
+func (o *ListClusterTemplatesOK) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates][%d] listClusterTemplatesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListClusterTemplatesOK) GetPayload() models.ClusterTemplateList {
+	return o.Payload
+}
+
+func (o *ListClusterTemplatesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterTemplatesUnauthorized creates a ListClusterTemplatesUnauthorized with default headers values
+func NewListClusterTemplatesUnauthorized() *ListClusterTemplatesUnauthorized {
+	return &ListClusterTemplatesUnauthorized{}
+}
+
+/*ListClusterTemplatesUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ListClusterTemplatesUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ListClusterTemplatesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates][%d] listClusterTemplatesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListClusterTemplatesUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListClusterTemplatesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterTemplatesForbidden creates a ListClusterTemplatesForbidden with default headers values
+func NewListClusterTemplatesForbidden() *ListClusterTemplatesForbidden {
+	return &ListClusterTemplatesForbidden{}
+}
+
+/*ListClusterTemplatesForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ListClusterTemplatesForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ListClusterTemplatesForbidden) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates][%d] listClusterTemplatesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListClusterTemplatesForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListClusterTemplatesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterTemplatesMethodNotAllowed creates a ListClusterTemplatesMethodNotAllowed with default headers values
+func NewListClusterTemplatesMethodNotAllowed() *ListClusterTemplatesMethodNotAllowed {
+	return &ListClusterTemplatesMethodNotAllowed{}
+}
+
+/*ListClusterTemplatesMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ListClusterTemplatesMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ListClusterTemplatesMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates][%d] listClusterTemplatesMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ListClusterTemplatesMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListClusterTemplatesMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterTemplatesInternalServerError creates a ListClusterTemplatesInternalServerError with default headers values
+func NewListClusterTemplatesInternalServerError() *ListClusterTemplatesInternalServerError {
+	return &ListClusterTemplatesInternalServerError{}
+}
+
+/*ListClusterTemplatesInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ListClusterTemplatesInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ListClusterTemplatesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /cluster-templates][%d] listClusterTemplatesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListClusterTemplatesInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListClusterTemplatesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
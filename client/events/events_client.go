@@ -20,6 +20,9 @@ type API interface {
 	/*
 	   ListEvents Lists events for a cluster.*/
 	ListEvents(ctx context.Context, params *ListEventsParams) (*ListEventsOK, error)
+	/*
+	   StreamEvents Streams the events of a cluster as Server-Sent Events. The stream starts with the events that were added after the last seen event, if specified, and then pushes new events as they are added.*/
+	StreamEvents(ctx context.Context, params *StreamEventsParams) (*StreamEventsOK, error)
 }
 
 // New creates a new events API client.
@@ -64,3 +67,28 @@ func (a *Client) ListEvents(ctx context.Context, params *ListEventsParams) (*Lis
 	return result.(*ListEventsOK), nil
 
 }
+
+/*
+StreamEvents Streams the events of a cluster as Server-Sent Events. The stream starts with the events that were added after the last seen event, if specified, and then pushes new events as they are added.
+*/
+func (a *Client) StreamEvents(ctx context.Context, params *StreamEventsParams) (*StreamEventsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "StreamEvents",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/events/stream",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &StreamEventsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*StreamEventsOK), nil
+
+}
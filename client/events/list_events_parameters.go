@@ -20,8 +20,11 @@ import (
 // NewListEventsParams creates a new ListEventsParams object
 // with the default values initialized.
 func NewListEventsParams() *ListEventsParams {
-	var ()
+	var (
+		offsetDefault = int64(0)
+	)
 	return &ListEventsParams{
+		Offset: &offsetDefault,
 
 		timeout: cr.DefaultTimeout,
 	}
@@ -30,8 +33,11 @@ func NewListEventsParams() *ListEventsParams {
 // NewListEventsParamsWithTimeout creates a new ListEventsParams object
 // with the default values initialized, and the ability to set a timeout on a request
 func NewListEventsParamsWithTimeout(timeout time.Duration) *ListEventsParams {
-	var ()
+	var (
+		offsetDefault = int64(0)
+	)
 	return &ListEventsParams{
+		Offset: &offsetDefault,
 
 		timeout: timeout,
 	}
@@ -40,8 +46,11 @@ func NewListEventsParamsWithTimeout(timeout time.Duration) *ListEventsParams {
 // NewListEventsParamsWithContext creates a new ListEventsParams object
 // with the default values initialized, and the ability to set a context for a request
 func NewListEventsParamsWithContext(ctx context.Context) *ListEventsParams {
-	var ()
+	var (
+		offsetDefault = int64(0)
+	)
 	return &ListEventsParams{
+		Offset: &offsetDefault,
 
 		Context: ctx,
 	}
@@ -50,8 +59,11 @@ func NewListEventsParamsWithContext(ctx context.Context) *ListEventsParams {
 // NewListEventsParamsWithHTTPClient creates a new ListEventsParams object
 // with the default values initialized, and the ability to set a custom HTTPClient for a request
 func NewListEventsParamsWithHTTPClient(client *http.Client) *ListEventsParams {
-	var ()
+	var (
+		offsetDefault = int64(0)
+	)
 	return &ListEventsParams{
+		Offset:     &offsetDefault,
 		HTTPClient: client,
 	}
 }
@@ -76,6 +88,36 @@ type ListEventsParams struct {
 
 	*/
 	HostID *strfmt.UUID
+	/*Limit
+	  The maximum number of events to return. All the matching events are returned if not specified.
+
+	*/
+	Limit *int64
+	/*Message
+	  Return only events whose message contains this text (case insensitive).
+
+	*/
+	Message *string
+	/*Offset
+	  The number of matching events to skip before starting to return events.
+
+	*/
+	Offset *int64
+	/*Severities
+	  A comma-separated list of event severities. Events of all severities are returned if not specified.
+
+	*/
+	Severities []string
+	/*Since
+	  Return only events that occurred at or after this time.
+
+	*/
+	Since *strfmt.DateTime
+	/*Until
+	  Return only events that occurred before this time.
+
+	*/
+	Until *strfmt.DateTime
 
 	timeout    time.Duration
 	Context    context.Context
@@ -148,6 +190,72 @@ func (o *ListEventsParams) SetHostID(hostID *strfmt.UUID) {
 	o.HostID = hostID
 }
 
+// WithLimit adds the limit to the list events params
+func (o *ListEventsParams) WithLimit(limit *int64) *ListEventsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list events params
+func (o *ListEventsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMessage adds the message to the list events params
+func (o *ListEventsParams) WithMessage(message *string) *ListEventsParams {
+	o.SetMessage(message)
+	return o
+}
+
+// SetMessage adds the message to the list events params
+func (o *ListEventsParams) SetMessage(message *string) {
+	o.Message = message
+}
+
+// WithOffset adds the offset to the list events params
+func (o *ListEventsParams) WithOffset(offset *int64) *ListEventsParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the list events params
+func (o *ListEventsParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithSeverities adds the severities to the list events params
+func (o *ListEventsParams) WithSeverities(severities []string) *ListEventsParams {
+	o.SetSeverities(severities)
+	return o
+}
+
+// SetSeverities adds the severities to the list events params
+func (o *ListEventsParams) SetSeverities(severities []string) {
+	o.Severities = severities
+}
+
+// WithSince adds the since to the list events params
+func (o *ListEventsParams) WithSince(since *strfmt.DateTime) *ListEventsParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the list events params
+func (o *ListEventsParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithUntil adds the until to the list events params
+func (o *ListEventsParams) WithUntil(until *strfmt.DateTime) *ListEventsParams {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the list events params
+func (o *ListEventsParams) SetUntil(until *strfmt.DateTime) {
+	o.Until = until
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -185,6 +293,94 @@ func (o *ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Message != nil {
+
+		// query param message
+		var qrMessage string
+		if o.Message != nil {
+			qrMessage = *o.Message
+		}
+		qMessage := qrMessage
+		if qMessage != "" {
+			if err := r.SetQueryParam("message", qMessage); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	valuesSeverities := o.Severities
+
+	joinedSeverities := swag.JoinByFormat(valuesSeverities, "")
+	// query array param severities
+	if err := r.SetQueryParam("severities", joinedSeverities...); err != nil {
+		return err
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil strfmt.DateTime
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil.String()
+		if qUntil != "" {
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
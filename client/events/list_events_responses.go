@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -75,6 +77,10 @@ func NewListEventsOK() *ListEventsOK {
 Success.
 */
 type ListEventsOK struct {
+	/*The total number of events that match the filters, regardless of the limit and offset.
+	 */
+	XTotalCount int64
+
 	Payload models.EventList
 }
 
@@ -88,6 +94,13 @@ func (o *ListEventsOK) GetPayload() models.EventList {
 
 func (o *ListEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header X-Total-Count
+	xTotalCount, err := swag.ConvertInt64(response.GetHeader("X-Total-Count"))
+	if err != nil {
+		return errors.InvalidType("X-Total-Count", "header", "int64", response.GetHeader("X-Total-Count"))
+	}
+	o.XTotalCount = xTotalCount
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
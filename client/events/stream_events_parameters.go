@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewStreamEventsParams creates a new StreamEventsParams object
+// with the default values initialized.
+func NewStreamEventsParams() *StreamEventsParams {
+	var ()
+	return &StreamEventsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStreamEventsParamsWithTimeout creates a new StreamEventsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewStreamEventsParamsWithTimeout(timeout time.Duration) *StreamEventsParams {
+	var ()
+	return &StreamEventsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewStreamEventsParamsWithContext creates a new StreamEventsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewStreamEventsParamsWithContext(ctx context.Context) *StreamEventsParams {
+	var ()
+	return &StreamEventsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewStreamEventsParamsWithHTTPClient creates a new StreamEventsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewStreamEventsParamsWithHTTPClient(client *http.Client) *StreamEventsParams {
+	var ()
+	return &StreamEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/*StreamEventsParams contains all the parameters to send to the API endpoint
+for the stream events operation typically these are written to a http.Request
+*/
+type StreamEventsParams struct {
+
+	/*LastEventID
+	  The ID of the last event that the client received, sent by the browser when it reconnects to the stream.
+
+	*/
+	LastEventID *int64
+	/*AfterEventID
+	  Stream only the events that were added after the event with this ID. Used when the Last-Event-ID header can't be set, the header takes precedence.
+
+	*/
+	AfterEventID *int64
+	/*Categories
+	  A comma-separated list of event categories.
+
+	*/
+	Categories []string
+	/*ClusterID
+	  The cluster to stream events for.
+
+	*/
+	ClusterID strfmt.UUID
+	/*HostID
+	  A host in the specified cluster to stream events for.
+
+	*/
+	HostID *strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the stream events params
+func (o *StreamEventsParams) WithTimeout(timeout time.Duration) *StreamEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the stream events params
+func (o *StreamEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the stream events params
+func (o *StreamEventsParams) WithContext(ctx context.Context) *StreamEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the stream events params
+func (o *StreamEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the stream events params
+func (o *StreamEventsParams) WithHTTPClient(client *http.Client) *StreamEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the stream events params
+func (o *StreamEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLastEventID adds the lastEventID to the stream events params
+func (o *StreamEventsParams) WithLastEventID(lastEventID *int64) *StreamEventsParams {
+	o.SetLastEventID(lastEventID)
+	return o
+}
+
+// SetLastEventID adds the lastEventId to the stream events params
+func (o *StreamEventsParams) SetLastEventID(lastEventID *int64) {
+	o.LastEventID = lastEventID
+}
+
+// WithAfterEventID adds the afterEventID to the stream events params
+func (o *StreamEventsParams) WithAfterEventID(afterEventID *int64) *StreamEventsParams {
+	o.SetAfterEventID(afterEventID)
+	return o
+}
+
+// SetAfterEventID adds the afterEventId to the stream events params
+func (o *StreamEventsParams) SetAfterEventID(afterEventID *int64) {
+	o.AfterEventID = afterEventID
+}
+
+// WithCategories adds the categories to the stream events params
+func (o *StreamEventsParams) WithCategories(categories []string) *StreamEventsParams {
+	o.SetCategories(categories)
+	return o
+}
+
+// SetCategories adds the categories to the stream events params
+func (o *StreamEventsParams) SetCategories(categories []string) {
+	o.Categories = categories
+}
+
+// WithClusterID adds the clusterID to the stream events params
+func (o *StreamEventsParams) WithClusterID(clusterID strfmt.UUID) *StreamEventsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the stream events params
+func (o *StreamEventsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithHostID adds the hostID to the stream events params
+func (o *StreamEventsParams) WithHostID(hostID *strfmt.UUID) *StreamEventsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the stream events params
+func (o *StreamEventsParams) SetHostID(hostID *strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StreamEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.LastEventID != nil {
+
+		// header param Last-Event-ID
+		if err := r.SetHeaderParam("Last-Event-ID", swag.FormatInt64(*o.LastEventID)); err != nil {
+			return err
+		}
+
+	}
+
+	if o.AfterEventID != nil {
+
+		// query param after_event_id
+		var qrAfterEventID int64
+		if o.AfterEventID != nil {
+			qrAfterEventID = *o.AfterEventID
+		}
+		qAfterEventID := swag.FormatInt64(qrAfterEventID)
+		if qAfterEventID != "" {
+			if err := r.SetQueryParam("after_event_id", qAfterEventID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	valuesCategories := o.Categories
+
+	joinedCategories := swag.JoinByFormat(valuesCategories, "")
+	// query array param categories
+	if err := r.SetQueryParam("categories", joinedCategories...); err != nil {
+		return err
+	}
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.HostID != nil {
+
+		// query param host_id
+		var qrHostID strfmt.UUID
+		if o.HostID != nil {
+			qrHostID = *o.HostID
+		}
+		qHostID := qrHostID.String()
+		if qHostID != "" {
+			if err := r.SetQueryParam("host_id", qHostID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
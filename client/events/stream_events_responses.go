@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// StreamEventsReader is a Reader for the StreamEvents structure.
+type StreamEventsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StreamEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStreamEventsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewStreamEventsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewStreamEventsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewStreamEventsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewStreamEventsMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewStreamEventsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewStreamEventsOK creates a StreamEventsOK with default headers values
+func NewStreamEventsOK() *StreamEventsOK {
+	return &StreamEventsOK{}
+}
+
+/*StreamEventsOK handles this case with default header values.
+
+Success.
+*/
+type StreamEventsOK struct {
+	Payload string
+}
+
+func (o *StreamEventsOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/events/stream][%d] streamEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *StreamEventsOK) GetPayload() string {
+	return o.Payload
+}
+
+func (o *StreamEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamEventsUnauthorized creates a StreamEventsUnauthorized with default headers values
+func NewStreamEventsUnauthorized() *StreamEventsUnauthorized {
+	return &StreamEventsUnauthorized{}
+}
+
+/*StreamEventsUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type StreamEventsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *StreamEventsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/events/stream][%d] streamEventsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *StreamEventsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *StreamEventsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamEventsForbidden creates a StreamEventsForbidden with default headers values
+func NewStreamEventsForbidden() *StreamEventsForbidden {
+	return &StreamEventsForbidden{}
+}
+
+/*StreamEventsForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type StreamEventsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *StreamEventsForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/events/stream][%d] streamEventsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *StreamEventsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *StreamEventsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamEventsNotFound creates a StreamEventsNotFound with default headers values
+func NewStreamEventsNotFound() *StreamEventsNotFound {
+	return &StreamEventsNotFound{}
+}
+
+/*StreamEventsNotFound handles this case with default header values.
+
+Error.
+*/
+type StreamEventsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *StreamEventsNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/events/stream][%d] streamEventsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *StreamEventsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *StreamEventsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamEventsMethodNotAllowed creates a StreamEventsMethodNotAllowed with default headers values
+func NewStreamEventsMethodNotAllowed() *StreamEventsMethodNotAllowed {
+	return &StreamEventsMethodNotAllowed{}
+}
+
+/*StreamEventsMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type StreamEventsMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *StreamEventsMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/events/stream][%d] streamEventsMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *StreamEventsMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *StreamEventsMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamEventsInternalServerError creates a StreamEventsInternalServerError with default headers values
+func NewStreamEventsInternalServerError() *StreamEventsInternalServerError {
+	return &StreamEventsInternalServerError{}
+}
+
+/*StreamEventsInternalServerError handles this case with default header values.
+
+Error.
+*/
+type StreamEventsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *StreamEventsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/events/stream][%d] streamEventsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *StreamEventsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *StreamEventsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package history
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the history client
+type API interface {
+	/*
+	   ListClusterStatusHistory Retrieves the status changes of a cluster in the order they occurred.*/
+	ListClusterStatusHistory(ctx context.Context, params *ListClusterStatusHistoryParams) (*ListClusterStatusHistoryOK, error)
+	/*
+	   ListHostStatusHistory Retrieves the status changes of a host in the order they occurred.*/
+	ListHostStatusHistory(ctx context.Context, params *ListHostStatusHistoryParams) (*ListHostStatusHistoryOK, error)
+}
+
+// New creates a new history API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for history API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+ListClusterStatusHistory Retrieves the status changes of a cluster in the order they occurred.
+*/
+func (a *Client) ListClusterStatusHistory(ctx context.Context, params *ListClusterStatusHistoryParams) (*ListClusterStatusHistoryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterStatusHistory",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListClusterStatusHistoryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterStatusHistoryOK), nil
+
+}
+
+/*
+ListHostStatusHistory Retrieves the status changes of a host in the order they occurred.
+*/
+func (a *Client) ListHostStatusHistory(ctx context.Context, params *ListHostStatusHistoryParams) (*ListHostStatusHistoryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListHostStatusHistory",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListHostStatusHistoryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListHostStatusHistoryOK), nil
+
+}
@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package history
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListClusterStatusHistoryParams creates a new ListClusterStatusHistoryParams object
+// with the default values initialized.
+func NewListClusterStatusHistoryParams() *ListClusterStatusHistoryParams {
+	var ()
+	return &ListClusterStatusHistoryParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListClusterStatusHistoryParamsWithTimeout creates a new ListClusterStatusHistoryParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListClusterStatusHistoryParamsWithTimeout(timeout time.Duration) *ListClusterStatusHistoryParams {
+	var ()
+	return &ListClusterStatusHistoryParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListClusterStatusHistoryParamsWithContext creates a new ListClusterStatusHistoryParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListClusterStatusHistoryParamsWithContext(ctx context.Context) *ListClusterStatusHistoryParams {
+	var ()
+	return &ListClusterStatusHistoryParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListClusterStatusHistoryParamsWithHTTPClient creates a new ListClusterStatusHistoryParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListClusterStatusHistoryParamsWithHTTPClient(client *http.Client) *ListClusterStatusHistoryParams {
+	var ()
+	return &ListClusterStatusHistoryParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListClusterStatusHistoryParams contains all the parameters to send to the API endpoint
+for the list cluster status history operation typically these are written to a http.Request
+*/
+type ListClusterStatusHistoryParams struct {
+
+	/*ClusterID
+	  The cluster whose status history should be retrieved.
+
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list cluster status history params
+func (o *ListClusterStatusHistoryParams) WithTimeout(timeout time.Duration) *ListClusterStatusHistoryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list cluster status history params
+func (o *ListClusterStatusHistoryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list cluster status history params
+func (o *ListClusterStatusHistoryParams) WithContext(ctx context.Context) *ListClusterStatusHistoryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list cluster status history params
+func (o *ListClusterStatusHistoryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list cluster status history params
+func (o *ListClusterStatusHistoryParams) WithHTTPClient(client *http.Client) *ListClusterStatusHistoryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list cluster status history params
+func (o *ListClusterStatusHistoryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list cluster status history params
+func (o *ListClusterStatusHistoryParams) WithClusterID(clusterID strfmt.UUID) *ListClusterStatusHistoryParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list cluster status history params
+func (o *ListClusterStatusHistoryParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListClusterStatusHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
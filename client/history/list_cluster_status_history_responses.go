@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package history
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListClusterStatusHistoryReader is a Reader for the ListClusterStatusHistory structure.
+type ListClusterStatusHistoryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListClusterStatusHistoryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListClusterStatusHistoryOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListClusterStatusHistoryUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListClusterStatusHistoryForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewListClusterStatusHistoryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewListClusterStatusHistoryMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListClusterStatusHistoryInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListClusterStatusHistoryOK creates a ListClusterStatusHistoryOK with default headers values
+func NewListClusterStatusHistoryOK() *ListClusterStatusHistoryOK {
+	return &ListClusterStatusHistoryOK{}
+}
+
+/*ListClusterStatusHistoryOK handles this case with default header values.
+
+Success.
+*/
+type ListClusterStatusHistoryOK struct {
+	Payload models.StatusHistory
+}
+
+func (o *ListClusterStatusHistoryOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/history][%d] listClusterStatusHistoryOK  %+v", 200, o.Payload)
+}
+
+func (o *ListClusterStatusHistoryOK) GetPayload() models.StatusHistory {
+	return o.Payload
+}
+
+func (o *ListClusterStatusHistoryOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterStatusHistoryUnauthorized creates a ListClusterStatusHistoryUnauthorized with default headers values
+func NewListClusterStatusHistoryUnauthorized() *ListClusterStatusHistoryUnauthorized {
+	return &ListClusterStatusHistoryUnauthorized{}
+}
+
+/*ListClusterStatusHistoryUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ListClusterStatusHistoryUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ListClusterStatusHistoryUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/history][%d] listClusterStatusHistoryUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListClusterStatusHistoryUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListClusterStatusHistoryUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterStatusHistoryForbidden creates a ListClusterStatusHistoryForbidden with default headers values
+func NewListClusterStatusHistoryForbidden() *ListClusterStatusHistoryForbidden {
+	return &ListClusterStatusHistoryForbidden{}
+}
+
+/*ListClusterStatusHistoryForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ListClusterStatusHistoryForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ListClusterStatusHistoryForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/history][%d] listClusterStatusHistoryForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListClusterStatusHistoryForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListClusterStatusHistoryForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterStatusHistoryNotFound creates a ListClusterStatusHistoryNotFound with default headers values
+func NewListClusterStatusHistoryNotFound() *ListClusterStatusHistoryNotFound {
+	return &ListClusterStatusHistoryNotFound{}
+}
+
+/*ListClusterStatusHistoryNotFound handles this case with default header values.
+
+Error.
+*/
+type ListClusterStatusHistoryNotFound struct {
+	Payload *models.Error
+}
+
+func (o *ListClusterStatusHistoryNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/history][%d] listClusterStatusHistoryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListClusterStatusHistoryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListClusterStatusHistoryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterStatusHistoryMethodNotAllowed creates a ListClusterStatusHistoryMethodNotAllowed with default headers values
+func NewListClusterStatusHistoryMethodNotAllowed() *ListClusterStatusHistoryMethodNotAllowed {
+	return &ListClusterStatusHistoryMethodNotAllowed{}
+}
+
+/*ListClusterStatusHistoryMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ListClusterStatusHistoryMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ListClusterStatusHistoryMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/history][%d] listClusterStatusHistoryMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ListClusterStatusHistoryMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListClusterStatusHistoryMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterStatusHistoryInternalServerError creates a ListClusterStatusHistoryInternalServerError with default headers values
+func NewListClusterStatusHistoryInternalServerError() *ListClusterStatusHistoryInternalServerError {
+	return &ListClusterStatusHistoryInternalServerError{}
+}
+
+/*ListClusterStatusHistoryInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ListClusterStatusHistoryInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ListClusterStatusHistoryInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/history][%d] listClusterStatusHistoryInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListClusterStatusHistoryInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListClusterStatusHistoryInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package history
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListHostStatusHistoryParams creates a new ListHostStatusHistoryParams object
+// with the default values initialized.
+func NewListHostStatusHistoryParams() *ListHostStatusHistoryParams {
+	var ()
+	return &ListHostStatusHistoryParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListHostStatusHistoryParamsWithTimeout creates a new ListHostStatusHistoryParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListHostStatusHistoryParamsWithTimeout(timeout time.Duration) *ListHostStatusHistoryParams {
+	var ()
+	return &ListHostStatusHistoryParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListHostStatusHistoryParamsWithContext creates a new ListHostStatusHistoryParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListHostStatusHistoryParamsWithContext(ctx context.Context) *ListHostStatusHistoryParams {
+	var ()
+	return &ListHostStatusHistoryParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListHostStatusHistoryParamsWithHTTPClient creates a new ListHostStatusHistoryParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListHostStatusHistoryParamsWithHTTPClient(client *http.Client) *ListHostStatusHistoryParams {
+	var ()
+	return &ListHostStatusHistoryParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListHostStatusHistoryParams contains all the parameters to send to the API endpoint
+for the list host status history operation typically these are written to a http.Request
+*/
+type ListHostStatusHistoryParams struct {
+
+	/*ClusterID
+	  The cluster whose status history should be retrieved.
+
+	*/
+	ClusterID strfmt.UUID
+	/*HostID
+	  The host whose status history should be retrieved.
+
+	*/
+	HostID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list host status history params
+func (o *ListHostStatusHistoryParams) WithTimeout(timeout time.Duration) *ListHostStatusHistoryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list host status history params
+func (o *ListHostStatusHistoryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list host status history params
+func (o *ListHostStatusHistoryParams) WithContext(ctx context.Context) *ListHostStatusHistoryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list host status history params
+func (o *ListHostStatusHistoryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list host status history params
+func (o *ListHostStatusHistoryParams) WithHTTPClient(client *http.Client) *ListHostStatusHistoryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list host status history params
+func (o *ListHostStatusHistoryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list host status history params
+func (o *ListHostStatusHistoryParams) WithClusterID(clusterID strfmt.UUID) *ListHostStatusHistoryParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list host status history params
+func (o *ListHostStatusHistoryParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithHostID adds the hostID to the list host status history params
+func (o *ListHostStatusHistoryParams) WithHostID(hostID strfmt.UUID) *ListHostStatusHistoryParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the list host status history params
+func (o *ListHostStatusHistoryParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListHostStatusHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
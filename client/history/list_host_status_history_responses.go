@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package history
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListHostStatusHistoryReader is a Reader for the ListHostStatusHistory structure.
+type ListHostStatusHistoryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListHostStatusHistoryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListHostStatusHistoryOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListHostStatusHistoryUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListHostStatusHistoryForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewListHostStatusHistoryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewListHostStatusHistoryMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListHostStatusHistoryInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListHostStatusHistoryOK creates a ListHostStatusHistoryOK with default headers values
+func NewListHostStatusHistoryOK() *ListHostStatusHistoryOK {
+	return &ListHostStatusHistoryOK{}
+}
+
+/*ListHostStatusHistoryOK handles this case with default header values.
+
+Success.
+*/
+type ListHostStatusHistoryOK struct {
+	Payload models.StatusHistory
+}
+
+func (o *ListHostStatusHistoryOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/history][%d] listHostStatusHistoryOK  %+v", 200, o.Payload)
+}
+
+func (o *ListHostStatusHistoryOK) GetPayload() models.StatusHistory {
+	return o.Payload
+}
+
+func (o *ListHostStatusHistoryOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListHostStatusHistoryUnauthorized creates a ListHostStatusHistoryUnauthorized with default headers values
+func NewListHostStatusHistoryUnauthorized() *ListHostStatusHistoryUnauthorized {
+	return &ListHostStatusHistoryUnauthorized{}
+}
+
+/*ListHostStatusHistoryUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ListHostStatusHistoryUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ListHostStatusHistoryUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/history][%d] listHostStatusHistoryUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListHostStatusHistoryUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListHostStatusHistoryUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListHostStatusHistoryForbidden creates a ListHostStatusHistoryForbidden with default headers values
+func NewListHostStatusHistoryForbidden() *ListHostStatusHistoryForbidden {
+	return &ListHostStatusHistoryForbidden{}
+}
+
+/*ListHostStatusHistoryForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ListHostStatusHistoryForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ListHostStatusHistoryForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/history][%d] listHostStatusHistoryForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListHostStatusHistoryForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListHostStatusHistoryForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListHostStatusHistoryNotFound creates a ListHostStatusHistoryNotFound with default headers values
+func NewListHostStatusHistoryNotFound() *ListHostStatusHistoryNotFound {
+	return &ListHostStatusHistoryNotFound{}
+}
+
+/*ListHostStatusHistoryNotFound handles this case with default header values.
+
+Error.
+*/
+type ListHostStatusHistoryNotFound struct {
+	Payload *models.Error
+}
+
+func (o *ListHostStatusHistoryNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/history][%d] listHostStatusHistoryNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListHostStatusHistoryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListHostStatusHistoryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListHostStatusHistoryMethodNotAllowed creates a ListHostStatusHistoryMethodNotAllowed with default headers values
+func NewListHostStatusHistoryMethodNotAllowed() *ListHostStatusHistoryMethodNotAllowed {
+	return &ListHostStatusHistoryMethodNotAllowed{}
+}
+
+/*ListHostStatusHistoryMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ListHostStatusHistoryMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ListHostStatusHistoryMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/history][%d] listHostStatusHistoryMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ListHostStatusHistoryMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListHostStatusHistoryMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListHostStatusHistoryInternalServerError creates a ListHostStatusHistoryInternalServerError with default headers values
+func NewListHostStatusHistoryInternalServerError() *ListHostStatusHistoryInternalServerError {
+	return &ListHostStatusHistoryInternalServerError{}
+}
+
+/*ListHostStatusHistoryInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ListHostStatusHistoryInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ListHostStatusHistoryInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/history][%d] listHostStatusHistoryInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListHostStatusHistoryInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListHostStatusHistoryInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewCollectHostLogsParams creates a new CollectHostLogsParams object
+// with the default values initialized.
+func NewCollectHostLogsParams() *CollectHostLogsParams {
+	var ()
+	return &CollectHostLogsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCollectHostLogsParamsWithTimeout creates a new CollectHostLogsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCollectHostLogsParamsWithTimeout(timeout time.Duration) *CollectHostLogsParams {
+	var ()
+	return &CollectHostLogsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCollectHostLogsParamsWithContext creates a new CollectHostLogsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCollectHostLogsParamsWithContext(ctx context.Context) *CollectHostLogsParams {
+	var ()
+	return &CollectHostLogsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCollectHostLogsParamsWithHTTPClient creates a new CollectHostLogsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCollectHostLogsParamsWithHTTPClient(client *http.Client) *CollectHostLogsParams {
+	var ()
+	return &CollectHostLogsParams{
+		HTTPClient: client,
+	}
+}
+
+/*CollectHostLogsParams contains all the parameters to send to the API endpoint
+for the collect host logs operation typically these are written to a http.Request
+*/
+type CollectHostLogsParams struct {
+
+	/*ClusterID
+	  The cluster of the host whose logs should be collected.
+
+	*/
+	ClusterID strfmt.UUID
+	/*CollectLogsParams
+	  The journal units and directories to include in the logs, in addition to the default ones.
+
+	*/
+	CollectLogsParams *models.HostLogsCollectionParams
+	/*HostID
+	  The host whose logs should be collected.
+
+	*/
+	HostID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the collect host logs params
+func (o *CollectHostLogsParams) WithTimeout(timeout time.Duration) *CollectHostLogsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the collect host logs params
+func (o *CollectHostLogsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the collect host logs params
+func (o *CollectHostLogsParams) WithContext(ctx context.Context) *CollectHostLogsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the collect host logs params
+func (o *CollectHostLogsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the collect host logs params
+func (o *CollectHostLogsParams) WithHTTPClient(client *http.Client) *CollectHostLogsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the collect host logs params
+func (o *CollectHostLogsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the collect host logs params
+func (o *CollectHostLogsParams) WithClusterID(clusterID strfmt.UUID) *CollectHostLogsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the collect host logs params
+func (o *CollectHostLogsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithCollectLogsParams adds the collectLogsParams to the collect host logs params
+func (o *CollectHostLogsParams) WithCollectLogsParams(collectLogsParams *models.HostLogsCollectionParams) *CollectHostLogsParams {
+	o.SetCollectLogsParams(collectLogsParams)
+	return o
+}
+
+// SetCollectLogsParams adds the collectLogsParams to the collect host logs params
+func (o *CollectHostLogsParams) SetCollectLogsParams(collectLogsParams *models.HostLogsCollectionParams) {
+	o.CollectLogsParams = collectLogsParams
+}
+
+// WithHostID adds the hostID to the collect host logs params
+func (o *CollectHostLogsParams) WithHostID(hostID strfmt.UUID) *CollectHostLogsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the collect host logs params
+func (o *CollectHostLogsParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CollectHostLogsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.CollectLogsParams != nil {
+		if err := r.SetBodyParam(o.CollectLogsParams); err != nil {
+			return err
+		}
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// CollectHostLogsReader is a Reader for the CollectHostLogs structure.
+type CollectHostLogsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CollectHostLogsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewCollectHostLogsAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCollectHostLogsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewCollectHostLogsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewCollectHostLogsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewCollectHostLogsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewCollectHostLogsConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewCollectHostLogsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewCollectHostLogsAccepted creates a CollectHostLogsAccepted with default headers values
+func NewCollectHostLogsAccepted() *CollectHostLogsAccepted {
+	return &CollectHostLogsAccepted{}
+}
+
+/*CollectHostLogsAccepted handles this case with default header values.
+
+Success.
+*/
+type CollectHostLogsAccepted struct {
+	Payload *models.Host
+}
+
+func (o *CollectHostLogsAccepted) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs][%d] collectHostLogsAccepted  %+v", 202, o.Payload)
+}
+
+func (o *CollectHostLogsAccepted) GetPayload() *models.Host {
+	return o.Payload
+}
+
+func (o *CollectHostLogsAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Host)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCollectHostLogsBadRequest creates a CollectHostLogsBadRequest with default headers values
+func NewCollectHostLogsBadRequest() *CollectHostLogsBadRequest {
+	return &CollectHostLogsBadRequest{}
+}
+
+/*CollectHostLogsBadRequest handles this case with default header values.
+
+Error.
+*/
+type CollectHostLogsBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *CollectHostLogsBadRequest) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs][%d] collectHostLogsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CollectHostLogsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CollectHostLogsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCollectHostLogsUnauthorized creates a CollectHostLogsUnauthorized with default headers values
+func NewCollectHostLogsUnauthorized() *CollectHostLogsUnauthorized {
+	return &CollectHostLogsUnauthorized{}
+}
+
+/*CollectHostLogsUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type CollectHostLogsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *CollectHostLogsUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs][%d] collectHostLogsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *CollectHostLogsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *CollectHostLogsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCollectHostLogsForbidden creates a CollectHostLogsForbidden with default headers values
+func NewCollectHostLogsForbidden() *CollectHostLogsForbidden {
+	return &CollectHostLogsForbidden{}
+}
+
+/*CollectHostLogsForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type CollectHostLogsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *CollectHostLogsForbidden) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs][%d] collectHostLogsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *CollectHostLogsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *CollectHostLogsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCollectHostLogsNotFound creates a CollectHostLogsNotFound with default headers values
+func NewCollectHostLogsNotFound() *CollectHostLogsNotFound {
+	return &CollectHostLogsNotFound{}
+}
+
+/*CollectHostLogsNotFound handles this case with default header values.
+
+Error.
+*/
+type CollectHostLogsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *CollectHostLogsNotFound) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs][%d] collectHostLogsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CollectHostLogsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CollectHostLogsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCollectHostLogsConflict creates a CollectHostLogsConflict with default headers values
+func NewCollectHostLogsConflict() *CollectHostLogsConflict {
+	return &CollectHostLogsConflict{}
+}
+
+/*CollectHostLogsConflict handles this case with default header values.
+
+Error.
+*/
+type CollectHostLogsConflict struct {
+	Payload *models.Error
+}
+
+func (o *CollectHostLogsConflict) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs][%d] collectHostLogsConflict  %+v", 409, o.Payload)
+}
+
+func (o *CollectHostLogsConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CollectHostLogsConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCollectHostLogsInternalServerError creates a CollectHostLogsInternalServerError with default headers values
+func NewCollectHostLogsInternalServerError() *CollectHostLogsInternalServerError {
+	return &CollectHostLogsInternalServerError{}
+}
+
+/*CollectHostLogsInternalServerError handles this case with default header values.
+
+Error.
+*/
+type CollectHostLogsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *CollectHostLogsInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs][%d] collectHostLogsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CollectHostLogsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CollectHostLogsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDryRunClusterParams creates a new DryRunClusterParams object
+// with the default values initialized.
+func NewDryRunClusterParams() *DryRunClusterParams {
+	var ()
+	return &DryRunClusterParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDryRunClusterParamsWithTimeout creates a new DryRunClusterParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDryRunClusterParamsWithTimeout(timeout time.Duration) *DryRunClusterParams {
+	var ()
+	return &DryRunClusterParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDryRunClusterParamsWithContext creates a new DryRunClusterParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDryRunClusterParamsWithContext(ctx context.Context) *DryRunClusterParams {
+	var ()
+	return &DryRunClusterParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDryRunClusterParamsWithHTTPClient creates a new DryRunClusterParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDryRunClusterParamsWithHTTPClient(client *http.Client) *DryRunClusterParams {
+	var ()
+	return &DryRunClusterParams{
+		HTTPClient: client,
+	}
+}
+
+/*DryRunClusterParams contains all the parameters to send to the API endpoint
+for the dry run cluster operation typically these are written to a http.Request
+*/
+type DryRunClusterParams struct {
+
+	/*ClusterID
+	  The cluster whose installation assets should be rendered.
+
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the dry run cluster params
+func (o *DryRunClusterParams) WithTimeout(timeout time.Duration) *DryRunClusterParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the dry run cluster params
+func (o *DryRunClusterParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the dry run cluster params
+func (o *DryRunClusterParams) WithContext(ctx context.Context) *DryRunClusterParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the dry run cluster params
+func (o *DryRunClusterParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the dry run cluster params
+func (o *DryRunClusterParams) WithHTTPClient(client *http.Client) *DryRunClusterParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the dry run cluster params
+func (o *DryRunClusterParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the dry run cluster params
+func (o *DryRunClusterParams) WithClusterID(clusterID strfmt.UUID) *DryRunClusterParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the dry run cluster params
+func (o *DryRunClusterParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DryRunClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
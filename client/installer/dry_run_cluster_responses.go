@@ -0,0 +1,304 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// DryRunClusterReader is a Reader for the DryRunCluster structure.
+type DryRunClusterReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DryRunClusterReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDryRunClusterOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewDryRunClusterUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewDryRunClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDryRunClusterNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewDryRunClusterMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewDryRunClusterConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDryRunClusterInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDryRunClusterOK creates a DryRunClusterOK with default headers values
+func NewDryRunClusterOK(writer io.Writer) *DryRunClusterOK {
+	return &DryRunClusterOK{
+		Payload: writer,
+	}
+}
+
+/*DryRunClusterOK handles this case with default header values.
+
+Success.
+*/
+type DryRunClusterOK struct {
+	Payload io.Writer
+}
+
+func (o *DryRunClusterOK) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/actions/dry-run][%d] dryRunClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *DryRunClusterOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *DryRunClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDryRunClusterUnauthorized creates a DryRunClusterUnauthorized with default headers values
+func NewDryRunClusterUnauthorized() *DryRunClusterUnauthorized {
+	return &DryRunClusterUnauthorized{}
+}
+
+/*DryRunClusterUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type DryRunClusterUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *DryRunClusterUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/actions/dry-run][%d] dryRunClusterUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DryRunClusterUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *DryRunClusterUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDryRunClusterForbidden creates a DryRunClusterForbidden with default headers values
+func NewDryRunClusterForbidden() *DryRunClusterForbidden {
+	return &DryRunClusterForbidden{}
+}
+
+/*DryRunClusterForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type DryRunClusterForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *DryRunClusterForbidden) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/actions/dry-run][%d] dryRunClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DryRunClusterForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *DryRunClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDryRunClusterNotFound creates a DryRunClusterNotFound with default headers values
+func NewDryRunClusterNotFound() *DryRunClusterNotFound {
+	return &DryRunClusterNotFound{}
+}
+
+/*DryRunClusterNotFound handles this case with default header values.
+
+Error.
+*/
+type DryRunClusterNotFound struct {
+	Payload *models.Error
+}
+
+func (o *DryRunClusterNotFound) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/actions/dry-run][%d] dryRunClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DryRunClusterNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DryRunClusterNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDryRunClusterMethodNotAllowed creates a DryRunClusterMethodNotAllowed with default headers values
+func NewDryRunClusterMethodNotAllowed() *DryRunClusterMethodNotAllowed {
+	return &DryRunClusterMethodNotAllowed{}
+}
+
+/*DryRunClusterMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type DryRunClusterMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *DryRunClusterMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/actions/dry-run][%d] dryRunClusterMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *DryRunClusterMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DryRunClusterMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDryRunClusterConflict creates a DryRunClusterConflict with default headers values
+func NewDryRunClusterConflict() *DryRunClusterConflict {
+	return &DryRunClusterConflict{}
+}
+
+/*DryRunClusterConflict handles this case with default header values.
+
+Error.
+*/
+type DryRunClusterConflict struct {
+	Payload *models.Error
+}
+
+func (o *DryRunClusterConflict) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/actions/dry-run][%d] dryRunClusterConflict  %+v", 409, o.Payload)
+}
+
+func (o *DryRunClusterConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DryRunClusterConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDryRunClusterInternalServerError creates a DryRunClusterInternalServerError with default headers values
+func NewDryRunClusterInternalServerError() *DryRunClusterInternalServerError {
+	return &DryRunClusterInternalServerError{}
+}
+
+/*DryRunClusterInternalServerError handles this case with default header values.
+
+Error.
+*/
+type DryRunClusterInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *DryRunClusterInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/actions/dry-run][%d] dryRunClusterInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DryRunClusterInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DryRunClusterInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
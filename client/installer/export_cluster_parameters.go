@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewExportClusterParams creates a new ExportClusterParams object
+// with the default values initialized.
+func NewExportClusterParams() *ExportClusterParams {
+	var ()
+	return &ExportClusterParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewExportClusterParamsWithTimeout creates a new ExportClusterParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewExportClusterParamsWithTimeout(timeout time.Duration) *ExportClusterParams {
+	var ()
+	return &ExportClusterParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewExportClusterParamsWithContext creates a new ExportClusterParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewExportClusterParamsWithContext(ctx context.Context) *ExportClusterParams {
+	var ()
+	return &ExportClusterParams{
+
+		Context: ctx,
+	}
+}
+
+// NewExportClusterParamsWithHTTPClient creates a new ExportClusterParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewExportClusterParamsWithHTTPClient(client *http.Client) *ExportClusterParams {
+	var ()
+	return &ExportClusterParams{
+		HTTPClient: client,
+	}
+}
+
+/*ExportClusterParams contains all the parameters to send to the API endpoint
+for the export cluster operation typically these are written to a http.Request
+*/
+type ExportClusterParams struct {
+
+	/*ClusterID
+	  The cluster to be exported.
+
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the export cluster params
+func (o *ExportClusterParams) WithTimeout(timeout time.Duration) *ExportClusterParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the export cluster params
+func (o *ExportClusterParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the export cluster params
+func (o *ExportClusterParams) WithContext(ctx context.Context) *ExportClusterParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the export cluster params
+func (o *ExportClusterParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the export cluster params
+func (o *ExportClusterParams) WithHTTPClient(client *http.Client) *ExportClusterParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the export cluster params
+func (o *ExportClusterParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the export cluster params
+func (o *ExportClusterParams) WithClusterID(clusterID strfmt.UUID) *ExportClusterParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the export cluster params
+func (o *ExportClusterParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ExportClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
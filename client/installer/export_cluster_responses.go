@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ExportClusterReader is a Reader for the ExportCluster structure.
+type ExportClusterReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ExportClusterReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewExportClusterOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewExportClusterUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewExportClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewExportClusterNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewExportClusterMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewExportClusterInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewExportClusterOK creates a ExportClusterOK with default headers values
+func NewExportClusterOK() *ExportClusterOK {
+	return &ExportClusterOK{}
+}
+
+/*ExportClusterOK handles this case with default header values.
+
+Success.
+*/
+type ExportClusterOK struct {
+	Payload *models.ClusterExport
+}
+
+func (o *ExportClusterOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/export][%d] exportClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportClusterOK) GetPayload() *models.ClusterExport {
+	return o.Payload
+}
+
+func (o *ExportClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterExport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportClusterUnauthorized creates a ExportClusterUnauthorized with default headers values
+func NewExportClusterUnauthorized() *ExportClusterUnauthorized {
+	return &ExportClusterUnauthorized{}
+}
+
+/*ExportClusterUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ExportClusterUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ExportClusterUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/export][%d] exportClusterUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ExportClusterUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ExportClusterUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportClusterForbidden creates a ExportClusterForbidden with default headers values
+func NewExportClusterForbidden() *ExportClusterForbidden {
+	return &ExportClusterForbidden{}
+}
+
+/*ExportClusterForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ExportClusterForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ExportClusterForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/export][%d] exportClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ExportClusterForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ExportClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportClusterNotFound creates a ExportClusterNotFound with default headers values
+func NewExportClusterNotFound() *ExportClusterNotFound {
+	return &ExportClusterNotFound{}
+}
+
+/*ExportClusterNotFound handles this case with default header values.
+
+Error.
+*/
+type ExportClusterNotFound struct {
+	Payload *models.Error
+}
+
+func (o *ExportClusterNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/export][%d] exportClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportClusterNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ExportClusterNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportClusterMethodNotAllowed creates a ExportClusterMethodNotAllowed with default headers values
+func NewExportClusterMethodNotAllowed() *ExportClusterMethodNotAllowed {
+	return &ExportClusterMethodNotAllowed{}
+}
+
+/*ExportClusterMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ExportClusterMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ExportClusterMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/export][%d] exportClusterMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ExportClusterMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ExportClusterMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportClusterInternalServerError creates a ExportClusterInternalServerError with default headers values
+func NewExportClusterInternalServerError() *ExportClusterInternalServerError {
+	return &ExportClusterInternalServerError{}
+}
+
+/*ExportClusterInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ExportClusterInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ExportClusterInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/export][%d] exportClusterInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportClusterInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ExportClusterInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
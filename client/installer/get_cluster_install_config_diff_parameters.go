@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetClusterInstallConfigDiffParams creates a new GetClusterInstallConfigDiffParams object
+// with the default values initialized.
+func NewGetClusterInstallConfigDiffParams() *GetClusterInstallConfigDiffParams {
+	var ()
+	return &GetClusterInstallConfigDiffParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetClusterInstallConfigDiffParamsWithTimeout creates a new GetClusterInstallConfigDiffParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetClusterInstallConfigDiffParamsWithTimeout(timeout time.Duration) *GetClusterInstallConfigDiffParams {
+	var ()
+	return &GetClusterInstallConfigDiffParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetClusterInstallConfigDiffParamsWithContext creates a new GetClusterInstallConfigDiffParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetClusterInstallConfigDiffParamsWithContext(ctx context.Context) *GetClusterInstallConfigDiffParams {
+	var ()
+	return &GetClusterInstallConfigDiffParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetClusterInstallConfigDiffParamsWithHTTPClient creates a new GetClusterInstallConfigDiffParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetClusterInstallConfigDiffParamsWithHTTPClient(client *http.Client) *GetClusterInstallConfigDiffParams {
+	var ()
+	return &GetClusterInstallConfigDiffParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetClusterInstallConfigDiffParams contains all the parameters to send to the API endpoint
+for the get cluster install config diff operation typically these are written to a http.Request
+*/
+type GetClusterInstallConfigDiffParams struct {
+
+	/*ClusterID
+	  The cluster whose install config differences are being retrieved.
+
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get cluster install config diff params
+func (o *GetClusterInstallConfigDiffParams) WithTimeout(timeout time.Duration) *GetClusterInstallConfigDiffParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get cluster install config diff params
+func (o *GetClusterInstallConfigDiffParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get cluster install config diff params
+func (o *GetClusterInstallConfigDiffParams) WithContext(ctx context.Context) *GetClusterInstallConfigDiffParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get cluster install config diff params
+func (o *GetClusterInstallConfigDiffParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get cluster install config diff params
+func (o *GetClusterInstallConfigDiffParams) WithHTTPClient(client *http.Client) *GetClusterInstallConfigDiffParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get cluster install config diff params
+func (o *GetClusterInstallConfigDiffParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the get cluster install config diff params
+func (o *GetClusterInstallConfigDiffParams) WithClusterID(clusterID strfmt.UUID) *GetClusterInstallConfigDiffParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the get cluster install config diff params
+func (o *GetClusterInstallConfigDiffParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetClusterInstallConfigDiffParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
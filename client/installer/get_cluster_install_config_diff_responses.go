@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetClusterInstallConfigDiffReader is a Reader for the GetClusterInstallConfigDiff structure.
+type GetClusterInstallConfigDiffReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetClusterInstallConfigDiffReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetClusterInstallConfigDiffOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetClusterInstallConfigDiffUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetClusterInstallConfigDiffForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetClusterInstallConfigDiffNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewGetClusterInstallConfigDiffMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetClusterInstallConfigDiffInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetClusterInstallConfigDiffOK creates a GetClusterInstallConfigDiffOK with default headers values
+func NewGetClusterInstallConfigDiffOK() *GetClusterInstallConfigDiffOK {
+	return &GetClusterInstallConfigDiffOK{}
+}
+
+/*GetClusterInstallConfigDiffOK handles this case with default header values.
+
+Success.
+*/
+type GetClusterInstallConfigDiffOK struct {
+	Payload *models.ConfigDiff
+}
+
+func (o *GetClusterInstallConfigDiffOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/install-config/diff][%d] getClusterInstallConfigDiffOK  %+v", 200, o.Payload)
+}
+
+func (o *GetClusterInstallConfigDiffOK) GetPayload() *models.ConfigDiff {
+	return o.Payload
+}
+
+func (o *GetClusterInstallConfigDiffOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ConfigDiff)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterInstallConfigDiffUnauthorized creates a GetClusterInstallConfigDiffUnauthorized with default headers values
+func NewGetClusterInstallConfigDiffUnauthorized() *GetClusterInstallConfigDiffUnauthorized {
+	return &GetClusterInstallConfigDiffUnauthorized{}
+}
+
+/*GetClusterInstallConfigDiffUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type GetClusterInstallConfigDiffUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *GetClusterInstallConfigDiffUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/install-config/diff][%d] getClusterInstallConfigDiffUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetClusterInstallConfigDiffUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetClusterInstallConfigDiffUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterInstallConfigDiffForbidden creates a GetClusterInstallConfigDiffForbidden with default headers values
+func NewGetClusterInstallConfigDiffForbidden() *GetClusterInstallConfigDiffForbidden {
+	return &GetClusterInstallConfigDiffForbidden{}
+}
+
+/*GetClusterInstallConfigDiffForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type GetClusterInstallConfigDiffForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *GetClusterInstallConfigDiffForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/install-config/diff][%d] getClusterInstallConfigDiffForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetClusterInstallConfigDiffForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetClusterInstallConfigDiffForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterInstallConfigDiffNotFound creates a GetClusterInstallConfigDiffNotFound with default headers values
+func NewGetClusterInstallConfigDiffNotFound() *GetClusterInstallConfigDiffNotFound {
+	return &GetClusterInstallConfigDiffNotFound{}
+}
+
+/*GetClusterInstallConfigDiffNotFound handles this case with default header values.
+
+Error.
+*/
+type GetClusterInstallConfigDiffNotFound struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterInstallConfigDiffNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/install-config/diff][%d] getClusterInstallConfigDiffNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetClusterInstallConfigDiffNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterInstallConfigDiffNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterInstallConfigDiffMethodNotAllowed creates a GetClusterInstallConfigDiffMethodNotAllowed with default headers values
+func NewGetClusterInstallConfigDiffMethodNotAllowed() *GetClusterInstallConfigDiffMethodNotAllowed {
+	return &GetClusterInstallConfigDiffMethodNotAllowed{}
+}
+
+/*GetClusterInstallConfigDiffMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type GetClusterInstallConfigDiffMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterInstallConfigDiffMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/install-config/diff][%d] getClusterInstallConfigDiffMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *GetClusterInstallConfigDiffMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterInstallConfigDiffMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterInstallConfigDiffInternalServerError creates a GetClusterInstallConfigDiffInternalServerError with default headers values
+func NewGetClusterInstallConfigDiffInternalServerError() *GetClusterInstallConfigDiffInternalServerError {
+	return &GetClusterInstallConfigDiffInternalServerError{}
+}
+
+/*GetClusterInstallConfigDiffInternalServerError handles this case with default header values.
+
+Error.
+*/
+type GetClusterInstallConfigDiffInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterInstallConfigDiffInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/install-config/diff][%d] getClusterInstallConfigDiffInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetClusterInstallConfigDiffInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterInstallConfigDiffInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
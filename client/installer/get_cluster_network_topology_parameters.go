@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetClusterNetworkTopologyParams creates a new GetClusterNetworkTopologyParams object
+// with the default values initialized.
+func NewGetClusterNetworkTopologyParams() *GetClusterNetworkTopologyParams {
+	var ()
+	return &GetClusterNetworkTopologyParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetClusterNetworkTopologyParamsWithTimeout creates a new GetClusterNetworkTopologyParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetClusterNetworkTopologyParamsWithTimeout(timeout time.Duration) *GetClusterNetworkTopologyParams {
+	var ()
+	return &GetClusterNetworkTopologyParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetClusterNetworkTopologyParamsWithContext creates a new GetClusterNetworkTopologyParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetClusterNetworkTopologyParamsWithContext(ctx context.Context) *GetClusterNetworkTopologyParams {
+	var ()
+	return &GetClusterNetworkTopologyParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetClusterNetworkTopologyParamsWithHTTPClient creates a new GetClusterNetworkTopologyParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetClusterNetworkTopologyParamsWithHTTPClient(client *http.Client) *GetClusterNetworkTopologyParams {
+	var ()
+	return &GetClusterNetworkTopologyParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetClusterNetworkTopologyParams contains all the parameters to send to the API endpoint
+for the get cluster network topology operation typically these are written to a http.Request
+*/
+type GetClusterNetworkTopologyParams struct {
+
+	/*ClusterID
+	  The cluster whose network topology is being retrieved.
+
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get cluster network topology params
+func (o *GetClusterNetworkTopologyParams) WithTimeout(timeout time.Duration) *GetClusterNetworkTopologyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get cluster network topology params
+func (o *GetClusterNetworkTopologyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get cluster network topology params
+func (o *GetClusterNetworkTopologyParams) WithContext(ctx context.Context) *GetClusterNetworkTopologyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get cluster network topology params
+func (o *GetClusterNetworkTopologyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get cluster network topology params
+func (o *GetClusterNetworkTopologyParams) WithHTTPClient(client *http.Client) *GetClusterNetworkTopologyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get cluster network topology params
+func (o *GetClusterNetworkTopologyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the get cluster network topology params
+func (o *GetClusterNetworkTopologyParams) WithClusterID(clusterID strfmt.UUID) *GetClusterNetworkTopologyParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the get cluster network topology params
+func (o *GetClusterNetworkTopologyParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetClusterNetworkTopologyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
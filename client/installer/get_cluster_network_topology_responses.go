@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetClusterNetworkTopologyReader is a Reader for the GetClusterNetworkTopology structure.
+type GetClusterNetworkTopologyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetClusterNetworkTopologyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetClusterNetworkTopologyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetClusterNetworkTopologyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetClusterNetworkTopologyForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetClusterNetworkTopologyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewGetClusterNetworkTopologyMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetClusterNetworkTopologyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetClusterNetworkTopologyOK creates a GetClusterNetworkTopologyOK with default headers values
+func NewGetClusterNetworkTopologyOK() *GetClusterNetworkTopologyOK {
+	return &GetClusterNetworkTopologyOK{}
+}
+
+/*GetClusterNetworkTopologyOK handles this case with default header values.
+
+Success.
+*/
+type GetClusterNetworkTopologyOK struct {
+	Payload *models.NetworkTopology
+}
+
+func (o *GetClusterNetworkTopologyOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/network-topology][%d] getClusterNetworkTopologyOK  %+v", 200, o.Payload)
+}
+
+func (o *GetClusterNetworkTopologyOK) GetPayload() *models.NetworkTopology {
+	return o.Payload
+}
+
+func (o *GetClusterNetworkTopologyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NetworkTopology)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterNetworkTopologyUnauthorized creates a GetClusterNetworkTopologyUnauthorized with default headers values
+func NewGetClusterNetworkTopologyUnauthorized() *GetClusterNetworkTopologyUnauthorized {
+	return &GetClusterNetworkTopologyUnauthorized{}
+}
+
+/*GetClusterNetworkTopologyUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type GetClusterNetworkTopologyUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *GetClusterNetworkTopologyUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/network-topology][%d] getClusterNetworkTopologyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetClusterNetworkTopologyUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetClusterNetworkTopologyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterNetworkTopologyForbidden creates a GetClusterNetworkTopologyForbidden with default headers values
+func NewGetClusterNetworkTopologyForbidden() *GetClusterNetworkTopologyForbidden {
+	return &GetClusterNetworkTopologyForbidden{}
+}
+
+/*GetClusterNetworkTopologyForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type GetClusterNetworkTopologyForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *GetClusterNetworkTopologyForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/network-topology][%d] getClusterNetworkTopologyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetClusterNetworkTopologyForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetClusterNetworkTopologyForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterNetworkTopologyNotFound creates a GetClusterNetworkTopologyNotFound with default headers values
+func NewGetClusterNetworkTopologyNotFound() *GetClusterNetworkTopologyNotFound {
+	return &GetClusterNetworkTopologyNotFound{}
+}
+
+/*GetClusterNetworkTopologyNotFound handles this case with default header values.
+
+Error.
+*/
+type GetClusterNetworkTopologyNotFound struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterNetworkTopologyNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/network-topology][%d] getClusterNetworkTopologyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetClusterNetworkTopologyNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterNetworkTopologyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterNetworkTopologyMethodNotAllowed creates a GetClusterNetworkTopologyMethodNotAllowed with default headers values
+func NewGetClusterNetworkTopologyMethodNotAllowed() *GetClusterNetworkTopologyMethodNotAllowed {
+	return &GetClusterNetworkTopologyMethodNotAllowed{}
+}
+
+/*GetClusterNetworkTopologyMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type GetClusterNetworkTopologyMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterNetworkTopologyMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/network-topology][%d] getClusterNetworkTopologyMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *GetClusterNetworkTopologyMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterNetworkTopologyMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterNetworkTopologyInternalServerError creates a GetClusterNetworkTopologyInternalServerError with default headers values
+func NewGetClusterNetworkTopologyInternalServerError() *GetClusterNetworkTopologyInternalServerError {
+	return &GetClusterNetworkTopologyInternalServerError{}
+}
+
+/*GetClusterNetworkTopologyInternalServerError handles this case with default header values.
+
+Error.
+*/
+type GetClusterNetworkTopologyInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterNetworkTopologyInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/network-topology][%d] getClusterNetworkTopologyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetClusterNetworkTopologyInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterNetworkTopologyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
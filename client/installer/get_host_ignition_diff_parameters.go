@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetHostIgnitionDiffParams creates a new GetHostIgnitionDiffParams object
+// with the default values initialized.
+func NewGetHostIgnitionDiffParams() *GetHostIgnitionDiffParams {
+	var ()
+	return &GetHostIgnitionDiffParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetHostIgnitionDiffParamsWithTimeout creates a new GetHostIgnitionDiffParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetHostIgnitionDiffParamsWithTimeout(timeout time.Duration) *GetHostIgnitionDiffParams {
+	var ()
+	return &GetHostIgnitionDiffParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetHostIgnitionDiffParamsWithContext creates a new GetHostIgnitionDiffParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetHostIgnitionDiffParamsWithContext(ctx context.Context) *GetHostIgnitionDiffParams {
+	var ()
+	return &GetHostIgnitionDiffParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetHostIgnitionDiffParamsWithHTTPClient creates a new GetHostIgnitionDiffParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetHostIgnitionDiffParamsWithHTTPClient(client *http.Client) *GetHostIgnitionDiffParams {
+	var ()
+	return &GetHostIgnitionDiffParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetHostIgnitionDiffParams contains all the parameters to send to the API endpoint
+for the get host ignition diff operation typically these are written to a http.Request
+*/
+type GetHostIgnitionDiffParams struct {
+
+	/*ClusterID
+	  The cluster of the host whose ignition config differences are being retrieved.
+
+	*/
+	ClusterID strfmt.UUID
+	/*HostID
+	  The host whose ignition config differences are being retrieved.
+
+	*/
+	HostID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) WithTimeout(timeout time.Duration) *GetHostIgnitionDiffParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) WithContext(ctx context.Context) *GetHostIgnitionDiffParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) WithHTTPClient(client *http.Client) *GetHostIgnitionDiffParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) WithClusterID(clusterID strfmt.UUID) *GetHostIgnitionDiffParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithHostID adds the hostID to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) WithHostID(hostID strfmt.UUID) *GetHostIgnitionDiffParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the get host ignition diff params
+func (o *GetHostIgnitionDiffParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetHostIgnitionDiffParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
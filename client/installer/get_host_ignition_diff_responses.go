@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetHostIgnitionDiffReader is a Reader for the GetHostIgnitionDiff structure.
+type GetHostIgnitionDiffReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetHostIgnitionDiffReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetHostIgnitionDiffOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetHostIgnitionDiffUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetHostIgnitionDiffForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetHostIgnitionDiffNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewGetHostIgnitionDiffMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetHostIgnitionDiffInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetHostIgnitionDiffOK creates a GetHostIgnitionDiffOK with default headers values
+func NewGetHostIgnitionDiffOK() *GetHostIgnitionDiffOK {
+	return &GetHostIgnitionDiffOK{}
+}
+
+/*GetHostIgnitionDiffOK handles this case with default header values.
+
+Success.
+*/
+type GetHostIgnitionDiffOK struct {
+	Payload *models.ConfigDiff
+}
+
+func (o *GetHostIgnitionDiffOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/ignition/diff][%d] getHostIgnitionDiffOK  %+v", 200, o.Payload)
+}
+
+func (o *GetHostIgnitionDiffOK) GetPayload() *models.ConfigDiff {
+	return o.Payload
+}
+
+func (o *GetHostIgnitionDiffOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ConfigDiff)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetHostIgnitionDiffUnauthorized creates a GetHostIgnitionDiffUnauthorized with default headers values
+func NewGetHostIgnitionDiffUnauthorized() *GetHostIgnitionDiffUnauthorized {
+	return &GetHostIgnitionDiffUnauthorized{}
+}
+
+/*GetHostIgnitionDiffUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type GetHostIgnitionDiffUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *GetHostIgnitionDiffUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/ignition/diff][%d] getHostIgnitionDiffUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetHostIgnitionDiffUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetHostIgnitionDiffUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetHostIgnitionDiffForbidden creates a GetHostIgnitionDiffForbidden with default headers values
+func NewGetHostIgnitionDiffForbidden() *GetHostIgnitionDiffForbidden {
+	return &GetHostIgnitionDiffForbidden{}
+}
+
+/*GetHostIgnitionDiffForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type GetHostIgnitionDiffForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *GetHostIgnitionDiffForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/ignition/diff][%d] getHostIgnitionDiffForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetHostIgnitionDiffForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetHostIgnitionDiffForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetHostIgnitionDiffNotFound creates a GetHostIgnitionDiffNotFound with default headers values
+func NewGetHostIgnitionDiffNotFound() *GetHostIgnitionDiffNotFound {
+	return &GetHostIgnitionDiffNotFound{}
+}
+
+/*GetHostIgnitionDiffNotFound handles this case with default header values.
+
+Error.
+*/
+type GetHostIgnitionDiffNotFound struct {
+	Payload *models.Error
+}
+
+func (o *GetHostIgnitionDiffNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/ignition/diff][%d] getHostIgnitionDiffNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetHostIgnitionDiffNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetHostIgnitionDiffNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetHostIgnitionDiffMethodNotAllowed creates a GetHostIgnitionDiffMethodNotAllowed with default headers values
+func NewGetHostIgnitionDiffMethodNotAllowed() *GetHostIgnitionDiffMethodNotAllowed {
+	return &GetHostIgnitionDiffMethodNotAllowed{}
+}
+
+/*GetHostIgnitionDiffMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type GetHostIgnitionDiffMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *GetHostIgnitionDiffMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/ignition/diff][%d] getHostIgnitionDiffMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *GetHostIgnitionDiffMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetHostIgnitionDiffMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetHostIgnitionDiffInternalServerError creates a GetHostIgnitionDiffInternalServerError with default headers values
+func NewGetHostIgnitionDiffInternalServerError() *GetHostIgnitionDiffInternalServerError {
+	return &GetHostIgnitionDiffInternalServerError{}
+}
+
+/*GetHostIgnitionDiffInternalServerError handles this case with default header values.
+
+Error.
+*/
+type GetHostIgnitionDiffInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *GetHostIgnitionDiffInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/ignition/diff][%d] getHostIgnitionDiffInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetHostIgnitionDiffInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetHostIgnitionDiffInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
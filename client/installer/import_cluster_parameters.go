@@ -0,0 +1,138 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewImportClusterParams creates a new ImportClusterParams object
+// with the default values initialized.
+func NewImportClusterParams() *ImportClusterParams {
+	var ()
+	return &ImportClusterParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewImportClusterParamsWithTimeout creates a new ImportClusterParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewImportClusterParamsWithTimeout(timeout time.Duration) *ImportClusterParams {
+	var ()
+	return &ImportClusterParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewImportClusterParamsWithContext creates a new ImportClusterParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewImportClusterParamsWithContext(ctx context.Context) *ImportClusterParams {
+	var ()
+	return &ImportClusterParams{
+
+		Context: ctx,
+	}
+}
+
+// NewImportClusterParamsWithHTTPClient creates a new ImportClusterParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewImportClusterParamsWithHTTPClient(client *http.Client) *ImportClusterParams {
+	var ()
+	return &ImportClusterParams{
+		HTTPClient: client,
+	}
+}
+
+/*ImportClusterParams contains all the parameters to send to the API endpoint
+for the import cluster operation typically these are written to a http.Request
+*/
+type ImportClusterParams struct {
+
+	/*ImportClusterParams
+	  The exported cluster bundle and the properties that are not part of it.
+
+	*/
+	ImportClusterParams *models.ImportClusterParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the import cluster params
+func (o *ImportClusterParams) WithTimeout(timeout time.Duration) *ImportClusterParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the import cluster params
+func (o *ImportClusterParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the import cluster params
+func (o *ImportClusterParams) WithContext(ctx context.Context) *ImportClusterParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the import cluster params
+func (o *ImportClusterParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the import cluster params
+func (o *ImportClusterParams) WithHTTPClient(client *http.Client) *ImportClusterParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the import cluster params
+func (o *ImportClusterParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithImportClusterParams adds the importClusterParams to the import cluster params
+func (o *ImportClusterParams) WithImportClusterParams(importClusterParams *models.ImportClusterParams) *ImportClusterParams {
+	o.SetImportClusterParams(importClusterParams)
+	return o
+}
+
+// SetImportClusterParams adds the importClusterParams to the import cluster params
+func (o *ImportClusterParams) SetImportClusterParams(importClusterParams *models.ImportClusterParams) {
+	o.ImportClusterParams = importClusterParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ImportClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.ImportClusterParams != nil {
+		if err := r.SetBodyParam(o.ImportClusterParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ImportClusterReader is a Reader for the ImportCluster structure.
+type ImportClusterReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ImportClusterReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewImportClusterCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewImportClusterBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewImportClusterUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewImportClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewImportClusterMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewImportClusterInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewImportClusterCreated creates a ImportClusterCreated with default headers values
+func NewImportClusterCreated() *ImportClusterCreated {
+	return &ImportClusterCreated{}
+}
+
+/*ImportClusterCreated handles this case with default header values.
+
+Success.
+*/
+type ImportClusterCreated struct {
+	Payload *models.Cluster
+}
+
+func (o *ImportClusterCreated) Error() string {
+	return fmt.Sprintf("[POST /clusters/import][%d] importClusterCreated  %+v", 201, o.Payload)
+}
+
+func (o *ImportClusterCreated) GetPayload() *models.Cluster {
+	return o.Payload
+}
+
+func (o *ImportClusterCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Cluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportClusterBadRequest creates a ImportClusterBadRequest with default headers values
+func NewImportClusterBadRequest() *ImportClusterBadRequest {
+	return &ImportClusterBadRequest{}
+}
+
+/*ImportClusterBadRequest handles this case with default header values.
+
+Error.
+*/
+type ImportClusterBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *ImportClusterBadRequest) Error() string {
+	return fmt.Sprintf("[POST /clusters/import][%d] importClusterBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ImportClusterBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ImportClusterBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportClusterUnauthorized creates a ImportClusterUnauthorized with default headers values
+func NewImportClusterUnauthorized() *ImportClusterUnauthorized {
+	return &ImportClusterUnauthorized{}
+}
+
+/*ImportClusterUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ImportClusterUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ImportClusterUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /clusters/import][%d] importClusterUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ImportClusterUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ImportClusterUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportClusterForbidden creates a ImportClusterForbidden with default headers values
+func NewImportClusterForbidden() *ImportClusterForbidden {
+	return &ImportClusterForbidden{}
+}
+
+/*ImportClusterForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ImportClusterForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ImportClusterForbidden) Error() string {
+	return fmt.Sprintf("[POST /clusters/import][%d] importClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ImportClusterForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ImportClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportClusterMethodNotAllowed creates a ImportClusterMethodNotAllowed with default headers values
+func NewImportClusterMethodNotAllowed() *ImportClusterMethodNotAllowed {
+	return &ImportClusterMethodNotAllowed{}
+}
+
+/*ImportClusterMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ImportClusterMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ImportClusterMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /clusters/import][%d] importClusterMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ImportClusterMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ImportClusterMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportClusterInternalServerError creates a ImportClusterInternalServerError with default headers values
+func NewImportClusterInternalServerError() *ImportClusterInternalServerError {
+	return &ImportClusterInternalServerError{}
+}
+
+/*ImportClusterInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ImportClusterInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ImportClusterInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /clusters/import][%d] importClusterInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ImportClusterInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ImportClusterInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
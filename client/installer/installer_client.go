@@ -21,6 +21,9 @@ type API interface {
 	/*
 	   CancelInstallation Cancels an ongoing installation.*/
 	CancelInstallation(ctx context.Context, params *CancelInstallationParams) (*CancelInstallationAccepted, error)
+	/*
+	   CollectHostLogs Requests the agent of the host to collect and upload its logs, whatever the status of the host is.*/
+	CollectHostLogs(ctx context.Context, params *CollectHostLogsParams) (*CollectHostLogsAccepted, error)
 	/*
 	   CompleteInstallation Agent API to mark a finalizing installation as complete.*/
 	CompleteInstallation(ctx context.Context, params *CompleteInstallationParams) (*CompleteInstallationAccepted, error)
@@ -54,9 +57,15 @@ type API interface {
 	/*
 	   DownloadHostLogs Download host logs.*/
 	DownloadHostLogs(ctx context.Context, params *DownloadHostLogsParams, writer io.Writer) (*DownloadHostLogsOK, error)
+	/*
+	   DryRunCluster Renders the install config, manifests and ignitions of the cluster without installing it, and returns them as a tar.gz bundle with their secrets redacted. The cluster and its hosts are not modified.*/
+	DryRunCluster(ctx context.Context, params *DryRunClusterParams, writer io.Writer) (*DryRunClusterOK, error)
 	/*
 	   EnableHost Enables a host for inclusion in the cluster.*/
 	EnableHost(ctx context.Context, params *EnableHostParams) (*EnableHostOK, error)
+	/*
+	   ExportCluster Exports the definition of the cluster as a portable bundle that can be imported by this or another assisted-service instance. The pull secret of the cluster is not exported.*/
+	ExportCluster(ctx context.Context, params *ExportClusterParams) (*ExportClusterOK, error)
 	/*
 	   GenerateClusterISO Creates a new OpenShift per-cluster Discovery ISO.*/
 	GenerateClusterISO(ctx context.Context, params *GenerateClusterISOParams) (*GenerateClusterISOCreated, error)
@@ -72,6 +81,12 @@ type API interface {
 	/*
 	   GetClusterInstallConfig Get the cluster's install config YAML.*/
 	GetClusterInstallConfig(ctx context.Context, params *GetClusterInstallConfigParams) (*GetClusterInstallConfigOK, error)
+	/*
+	   GetClusterInstallConfigDiff Get the differences between the install config that the service generates for the cluster and the effective install config after the install config overrides of the cluster.*/
+	GetClusterInstallConfigDiff(ctx context.Context, params *GetClusterInstallConfigDiffParams) (*GetClusterInstallConfigDiffOK, error)
+	/*
+	   GetClusterNetworkTopology Retrieves the network topology of the cluster as the connectivity checks of its hosts see it.*/
+	GetClusterNetworkTopology(ctx context.Context, params *GetClusterNetworkTopologyParams) (*GetClusterNetworkTopologyOK, error)
 	/*
 	   GetCredentials Get the cluster admin credentials.*/
 	GetCredentials(ctx context.Context, params *GetCredentialsParams) (*GetCredentialsOK, error)
@@ -90,6 +105,9 @@ type API interface {
 	/*
 	   GetHostIgnition Get the customized ignition file for this host*/
 	GetHostIgnition(ctx context.Context, params *GetHostIgnitionParams) (*GetHostIgnitionOK, error)
+	/*
+	   GetHostIgnitionDiff Get the differences between the ignition config that the service generates for the host and the effective ignition config after the ignition config overrides of the host. Until the installation assets of the cluster are generated, the generated ignition config only includes the values that the service sets for the host.*/
+	GetHostIgnitionDiff(ctx context.Context, params *GetHostIgnitionDiffParams) (*GetHostIgnitionDiffOK, error)
 	/*
 	   GetHostRequirements Get minimum host requirements.*/
 	GetHostRequirements(ctx context.Context, params *GetHostRequirementsParams) (*GetHostRequirementsOK, error)
@@ -102,6 +120,9 @@ type API interface {
 	/*
 	   GetPresignedForClusterFiles Retrieves a pre-signed S3 URL for downloading cluster files.*/
 	GetPresignedForClusterFiles(ctx context.Context, params *GetPresignedForClusterFilesParams) (*GetPresignedForClusterFilesOK, error)
+	/*
+	   ImportCluster Creates a new OpenShift cluster definition from a bundle exported by this or another assisted-service instance. The host assignments of the bundle are applied as hosts with matching MAC addresses or serial numbers register to the new cluster.*/
+	ImportCluster(ctx context.Context, params *ImportClusterParams) (*ImportClusterCreated, error)
 	/*
 	   InstallCluster Installs the OpenShift cluster.*/
 	InstallCluster(ctx context.Context, params *InstallClusterParams) (*InstallClusterAccepted, error)
@@ -173,6 +194,9 @@ type API interface {
 	/*
 	   UploadLogs Agent API to upload logs.*/
 	UploadLogs(ctx context.Context, params *UploadLogsParams) (*UploadLogsNoContent, error)
+	/*
+	   ValidateStaticNetworkConfig Validates static network configurations and renders the NetworkManager keyfiles that the discovery image would configure the hosts with.*/
+	ValidateStaticNetworkConfig(ctx context.Context, params *ValidateStaticNetworkConfigParams) (*ValidateStaticNetworkConfigOK, error)
 }
 
 // New creates a new installer API client.
@@ -218,6 +242,31 @@ func (a *Client) CancelInstallation(ctx context.Context, params *CancelInstallat
 
 }
 
+/*
+CollectHostLogs Requests the agent of the host to collect and upload its logs, whatever the status of the host is.
+*/
+func (a *Client) CollectHostLogs(ctx context.Context, params *CollectHostLogsParams) (*CollectHostLogsAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CollectHostLogs",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CollectHostLogsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CollectHostLogsAccepted), nil
+
+}
+
 /*
 CompleteInstallation Agent API to mark a finalizing installation as complete.
 */
@@ -493,6 +542,31 @@ func (a *Client) DownloadHostLogs(ctx context.Context, params *DownloadHostLogsP
 
 }
 
+/*
+DryRunCluster Renders the install config, manifests and ignitions of the cluster without installing it, and returns them as a tar.gz bundle with their secrets redacted. The cluster and its hosts are not modified.
+*/
+func (a *Client) DryRunCluster(ctx context.Context, params *DryRunClusterParams, writer io.Writer) (*DryRunClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DryRunCluster",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/actions/dry-run",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DryRunClusterReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DryRunClusterOK), nil
+
+}
+
 /*
 EnableHost Enables a host for inclusion in the cluster.
 */
@@ -518,6 +592,31 @@ func (a *Client) EnableHost(ctx context.Context, params *EnableHostParams) (*Ena
 
 }
 
+/*
+ExportCluster Exports the definition of the cluster as a portable bundle that can be imported by this or another assisted-service instance. The pull secret of the cluster is not exported.
+*/
+func (a *Client) ExportCluster(ctx context.Context, params *ExportClusterParams) (*ExportClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ExportCluster",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ExportClusterOK), nil
+
+}
+
 /*
 GenerateClusterISO Creates a new OpenShift per-cluster Discovery ISO.
 */
@@ -643,6 +742,56 @@ func (a *Client) GetClusterInstallConfig(ctx context.Context, params *GetCluster
 
 }
 
+/*
+GetClusterInstallConfigDiff Get the differences between the install config that the service generates for the cluster and the effective install config after the install config overrides of the cluster.
+*/
+func (a *Client) GetClusterInstallConfigDiff(ctx context.Context, params *GetClusterInstallConfigDiffParams) (*GetClusterInstallConfigDiffOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterInstallConfigDiff",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/install-config/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterInstallConfigDiffReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterInstallConfigDiffOK), nil
+
+}
+
+/*
+GetClusterNetworkTopology Retrieves the network topology of the cluster as the connectivity checks of its hosts see it.
+*/
+func (a *Client) GetClusterNetworkTopology(ctx context.Context, params *GetClusterNetworkTopologyParams) (*GetClusterNetworkTopologyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterNetworkTopology",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/network-topology",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterNetworkTopologyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterNetworkTopologyOK), nil
+
+}
+
 /*
 GetCredentials Get the cluster admin credentials.
 */
@@ -771,6 +920,31 @@ func (a *Client) GetHostIgnition(ctx context.Context, params *GetHostIgnitionPar
 
 }
 
+/*
+GetHostIgnitionDiff Get the differences between the ignition config that the service generates for the host and the effective ignition config after the ignition config overrides of the host. Until the installation assets of the cluster are generated, the generated ignition config only includes the values that the service sets for the host.
+*/
+func (a *Client) GetHostIgnitionDiff(ctx context.Context, params *GetHostIgnitionDiffParams) (*GetHostIgnitionDiffOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetHostIgnitionDiff",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/ignition/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetHostIgnitionDiffReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetHostIgnitionDiffOK), nil
+
+}
+
 /*
 GetHostRequirements Get minimum host requirements.
 */
@@ -871,6 +1045,31 @@ func (a *Client) GetPresignedForClusterFiles(ctx context.Context, params *GetPre
 
 }
 
+/*
+ImportCluster Creates a new OpenShift cluster definition from a bundle exported by this or another assisted-service instance. The host assignments of the bundle are applied as hosts with matching MAC addresses or serial numbers register to the new cluster.
+*/
+func (a *Client) ImportCluster(ctx context.Context, params *ImportClusterParams) (*ImportClusterCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ImportCluster",
+		Method:             "POST",
+		PathPattern:        "/clusters/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ImportClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ImportClusterCreated), nil
+
+}
+
 /*
 InstallCluster Installs the OpenShift cluster.
 */
@@ -1447,3 +1646,28 @@ func (a *Client) UploadLogs(ctx context.Context, params *UploadLogsParams) (*Upl
 	return result.(*UploadLogsNoContent), nil
 
 }
+
+/*
+ValidateStaticNetworkConfig Validates static network configurations and renders the NetworkManager keyfiles that the discovery image would configure the hosts with.
+*/
+func (a *Client) ValidateStaticNetworkConfig(ctx context.Context, params *ValidateStaticNetworkConfigParams) (*ValidateStaticNetworkConfigOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ValidateStaticNetworkConfig",
+		Method:             "POST",
+		PathPattern:        "/static-network-config/validate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ValidateStaticNetworkConfigReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ValidateStaticNetworkConfigOK), nil
+
+}
@@ -47,6 +47,12 @@ func (o *UploadHostLogsReader) ReadResponse(response runtime.ClientResponse, con
 			return nil, err
 		}
 		return nil, result
+	case 413:
+		result := NewUploadHostLogsRequestEntityTooLarge()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewUploadHostLogsInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -185,6 +191,39 @@ func (o *UploadHostLogsNotFound) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewUploadHostLogsRequestEntityTooLarge creates a UploadHostLogsRequestEntityTooLarge with default headers values
+func NewUploadHostLogsRequestEntityTooLarge() *UploadHostLogsRequestEntityTooLarge {
+	return &UploadHostLogsRequestEntityTooLarge{}
+}
+
+/*UploadHostLogsRequestEntityTooLarge handles this case with default header values.
+
+Uploading the logs would exceed the log storage quota.
+*/
+type UploadHostLogsRequestEntityTooLarge struct {
+	Payload *models.Error
+}
+
+func (o *UploadHostLogsRequestEntityTooLarge) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/logs][%d] uploadHostLogsRequestEntityTooLarge  %+v", 413, o.Payload)
+}
+
+func (o *UploadHostLogsRequestEntityTooLarge) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UploadHostLogsRequestEntityTooLarge) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUploadHostLogsInternalServerError creates a UploadHostLogsInternalServerError with default headers values
 func NewUploadHostLogsInternalServerError() *UploadHostLogsInternalServerError {
 	return &UploadHostLogsInternalServerError{}
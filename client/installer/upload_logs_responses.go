@@ -47,6 +47,12 @@ func (o *UploadLogsReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return nil, result
+	case 413:
+		result := NewUploadLogsRequestEntityTooLarge()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewUploadLogsInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -185,6 +191,39 @@ func (o *UploadLogsNotFound) readResponse(response runtime.ClientResponse, consu
 	return nil
 }
 
+// NewUploadLogsRequestEntityTooLarge creates a UploadLogsRequestEntityTooLarge with default headers values
+func NewUploadLogsRequestEntityTooLarge() *UploadLogsRequestEntityTooLarge {
+	return &UploadLogsRequestEntityTooLarge{}
+}
+
+/*UploadLogsRequestEntityTooLarge handles this case with default header values.
+
+Uploading the logs would exceed the log storage quota.
+*/
+type UploadLogsRequestEntityTooLarge struct {
+	Payload *models.Error
+}
+
+func (o *UploadLogsRequestEntityTooLarge) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/logs][%d] uploadLogsRequestEntityTooLarge  %+v", 413, o.Payload)
+}
+
+func (o *UploadLogsRequestEntityTooLarge) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UploadLogsRequestEntityTooLarge) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUploadLogsInternalServerError creates a UploadLogsInternalServerError with default headers values
 func NewUploadLogsInternalServerError() *UploadLogsInternalServerError {
 	return &UploadLogsInternalServerError{}
@@ -0,0 +1,138 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewValidateStaticNetworkConfigParams creates a new ValidateStaticNetworkConfigParams object
+// with the default values initialized.
+func NewValidateStaticNetworkConfigParams() *ValidateStaticNetworkConfigParams {
+	var ()
+	return &ValidateStaticNetworkConfigParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewValidateStaticNetworkConfigParamsWithTimeout creates a new ValidateStaticNetworkConfigParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewValidateStaticNetworkConfigParamsWithTimeout(timeout time.Duration) *ValidateStaticNetworkConfigParams {
+	var ()
+	return &ValidateStaticNetworkConfigParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewValidateStaticNetworkConfigParamsWithContext creates a new ValidateStaticNetworkConfigParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewValidateStaticNetworkConfigParamsWithContext(ctx context.Context) *ValidateStaticNetworkConfigParams {
+	var ()
+	return &ValidateStaticNetworkConfigParams{
+
+		Context: ctx,
+	}
+}
+
+// NewValidateStaticNetworkConfigParamsWithHTTPClient creates a new ValidateStaticNetworkConfigParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewValidateStaticNetworkConfigParamsWithHTTPClient(client *http.Client) *ValidateStaticNetworkConfigParams {
+	var ()
+	return &ValidateStaticNetworkConfigParams{
+		HTTPClient: client,
+	}
+}
+
+/*ValidateStaticNetworkConfigParams contains all the parameters to send to the API endpoint
+for the validate static network config operation typically these are written to a http.Request
+*/
+type ValidateStaticNetworkConfigParams struct {
+
+	/*StaticNetworkConfigValidateParams
+	  The static network configurations of the hosts, as they would be passed to the image creation.
+
+	*/
+	StaticNetworkConfigValidateParams *models.StaticNetworkConfigValidateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the validate static network config params
+func (o *ValidateStaticNetworkConfigParams) WithTimeout(timeout time.Duration) *ValidateStaticNetworkConfigParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the validate static network config params
+func (o *ValidateStaticNetworkConfigParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the validate static network config params
+func (o *ValidateStaticNetworkConfigParams) WithContext(ctx context.Context) *ValidateStaticNetworkConfigParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the validate static network config params
+func (o *ValidateStaticNetworkConfigParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the validate static network config params
+func (o *ValidateStaticNetworkConfigParams) WithHTTPClient(client *http.Client) *ValidateStaticNetworkConfigParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the validate static network config params
+func (o *ValidateStaticNetworkConfigParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithStaticNetworkConfigValidateParams adds the staticNetworkConfigValidateParams to the validate static network config params
+func (o *ValidateStaticNetworkConfigParams) WithStaticNetworkConfigValidateParams(staticNetworkConfigValidateParams *models.StaticNetworkConfigValidateParams) *ValidateStaticNetworkConfigParams {
+	o.SetStaticNetworkConfigValidateParams(staticNetworkConfigValidateParams)
+	return o
+}
+
+// SetStaticNetworkConfigValidateParams adds the staticNetworkConfigValidateParams to the validate static network config params
+func (o *ValidateStaticNetworkConfigParams) SetStaticNetworkConfigValidateParams(staticNetworkConfigValidateParams *models.StaticNetworkConfigValidateParams) {
+	o.StaticNetworkConfigValidateParams = staticNetworkConfigValidateParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ValidateStaticNetworkConfigParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.StaticNetworkConfigValidateParams != nil {
+		if err := r.SetBodyParam(o.StaticNetworkConfigValidateParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ValidateStaticNetworkConfigReader is a Reader for the ValidateStaticNetworkConfig structure.
+type ValidateStaticNetworkConfigReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ValidateStaticNetworkConfigReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewValidateStaticNetworkConfigOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewValidateStaticNetworkConfigBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewValidateStaticNetworkConfigUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewValidateStaticNetworkConfigForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewValidateStaticNetworkConfigMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewValidateStaticNetworkConfigInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewValidateStaticNetworkConfigOK creates a ValidateStaticNetworkConfigOK with default headers values
+func NewValidateStaticNetworkConfigOK() *ValidateStaticNetworkConfigOK {
+	return &ValidateStaticNetworkConfigOK{}
+}
+
+/*ValidateStaticNetworkConfigOK handles this case with default header values.
+
+Success.
+*/
+type ValidateStaticNetworkConfigOK struct {
+	Payload *models.StaticNetworkConfigValidation
+}
+
+func (o *ValidateStaticNetworkConfigOK) Error() string {
+	return fmt.Sprintf("[POST /static-network-config/validate][%d] validateStaticNetworkConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *ValidateStaticNetworkConfigOK) GetPayload() *models.StaticNetworkConfigValidation {
+	return o.Payload
+}
+
+func (o *ValidateStaticNetworkConfigOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.StaticNetworkConfigValidation)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewValidateStaticNetworkConfigBadRequest creates a ValidateStaticNetworkConfigBadRequest with default headers values
+func NewValidateStaticNetworkConfigBadRequest() *ValidateStaticNetworkConfigBadRequest {
+	return &ValidateStaticNetworkConfigBadRequest{}
+}
+
+/*ValidateStaticNetworkConfigBadRequest handles this case with default header values.
+
+Error.
+*/
+type ValidateStaticNetworkConfigBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *ValidateStaticNetworkConfigBadRequest) Error() string {
+	return fmt.Sprintf("[POST /static-network-config/validate][%d] validateStaticNetworkConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ValidateStaticNetworkConfigBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ValidateStaticNetworkConfigBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewValidateStaticNetworkConfigUnauthorized creates a ValidateStaticNetworkConfigUnauthorized with default headers values
+func NewValidateStaticNetworkConfigUnauthorized() *ValidateStaticNetworkConfigUnauthorized {
+	return &ValidateStaticNetworkConfigUnauthorized{}
+}
+
+/*ValidateStaticNetworkConfigUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ValidateStaticNetworkConfigUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ValidateStaticNetworkConfigUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /static-network-config/validate][%d] validateStaticNetworkConfigUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ValidateStaticNetworkConfigUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ValidateStaticNetworkConfigUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewValidateStaticNetworkConfigForbidden creates a ValidateStaticNetworkConfigForbidden with default headers values
+func NewValidateStaticNetworkConfigForbidden() *ValidateStaticNetworkConfigForbidden {
+	return &ValidateStaticNetworkConfigForbidden{}
+}
+
+/*ValidateStaticNetworkConfigForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ValidateStaticNetworkConfigForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ValidateStaticNetworkConfigForbidden) Error() string {
+	return fmt.Sprintf("[POST /static-network-config/validate][%d] validateStaticNetworkConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ValidateStaticNetworkConfigForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ValidateStaticNetworkConfigForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewValidateStaticNetworkConfigMethodNotAllowed creates a ValidateStaticNetworkConfigMethodNotAllowed with default headers values
+func NewValidateStaticNetworkConfigMethodNotAllowed() *ValidateStaticNetworkConfigMethodNotAllowed {
+	return &ValidateStaticNetworkConfigMethodNotAllowed{}
+}
+
+/*ValidateStaticNetworkConfigMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ValidateStaticNetworkConfigMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ValidateStaticNetworkConfigMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /static-network-config/validate][%d] validateStaticNetworkConfigMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ValidateStaticNetworkConfigMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ValidateStaticNetworkConfigMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewValidateStaticNetworkConfigInternalServerError creates a ValidateStaticNetworkConfigInternalServerError with default headers values
+func NewValidateStaticNetworkConfigInternalServerError() *ValidateStaticNetworkConfigInternalServerError {
+	return &ValidateStaticNetworkConfigInternalServerError{}
+}
+
+/*ValidateStaticNetworkConfigInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ValidateStaticNetworkConfigInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ValidateStaticNetworkConfigInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /static-network-config/validate][%d] validateStaticNetworkConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ValidateStaticNetworkConfigInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ValidateStaticNetworkConfigInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
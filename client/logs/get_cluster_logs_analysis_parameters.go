@@ -0,0 +1,192 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package logs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetClusterLogsAnalysisParams creates a new GetClusterLogsAnalysisParams object
+// with the default values initialized.
+func NewGetClusterLogsAnalysisParams() *GetClusterLogsAnalysisParams {
+	var ()
+	return &GetClusterLogsAnalysisParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetClusterLogsAnalysisParamsWithTimeout creates a new GetClusterLogsAnalysisParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetClusterLogsAnalysisParamsWithTimeout(timeout time.Duration) *GetClusterLogsAnalysisParams {
+	var ()
+	return &GetClusterLogsAnalysisParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetClusterLogsAnalysisParamsWithContext creates a new GetClusterLogsAnalysisParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetClusterLogsAnalysisParamsWithContext(ctx context.Context) *GetClusterLogsAnalysisParams {
+	var ()
+	return &GetClusterLogsAnalysisParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetClusterLogsAnalysisParamsWithHTTPClient creates a new GetClusterLogsAnalysisParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetClusterLogsAnalysisParamsWithHTTPClient(client *http.Client) *GetClusterLogsAnalysisParams {
+	var ()
+	return &GetClusterLogsAnalysisParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetClusterLogsAnalysisParams contains all the parameters to send to the API endpoint
+for the get cluster logs analysis operation typically these are written to a http.Request
+*/
+type GetClusterLogsAnalysisParams struct {
+
+	/*ClusterID
+	  The cluster whose logs analysis should be retrieved.
+
+	*/
+	ClusterID strfmt.UUID
+	/*HostID
+	  A specific host in the cluster whose logs analysis should be retrieved.
+
+	*/
+	HostID *strfmt.UUID
+	/*Severities
+	  Only findings of these severities are retrieved.
+
+	*/
+	Severities []string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) WithTimeout(timeout time.Duration) *GetClusterLogsAnalysisParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) WithContext(ctx context.Context) *GetClusterLogsAnalysisParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) WithHTTPClient(client *http.Client) *GetClusterLogsAnalysisParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) WithClusterID(clusterID strfmt.UUID) *GetClusterLogsAnalysisParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithHostID adds the hostID to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) WithHostID(hostID *strfmt.UUID) *GetClusterLogsAnalysisParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) SetHostID(hostID *strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithSeverities adds the severities to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) WithSeverities(severities []string) *GetClusterLogsAnalysisParams {
+	o.SetSeverities(severities)
+	return o
+}
+
+// SetSeverities adds the severities to the get cluster logs analysis params
+func (o *GetClusterLogsAnalysisParams) SetSeverities(severities []string) {
+	o.Severities = severities
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetClusterLogsAnalysisParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.HostID != nil {
+
+		// query param host_id
+		var qrHostID strfmt.UUID
+		if o.HostID != nil {
+			qrHostID = *o.HostID
+		}
+		qHostID := qrHostID.String()
+		if qHostID != "" {
+			if err := r.SetQueryParam("host_id", qHostID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	valuesSeverities := o.Severities
+
+	joinedSeverities := swag.JoinByFormat(valuesSeverities, "csv")
+	// query array param severities
+	if err := r.SetQueryParam("severities", joinedSeverities...); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package logs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetClusterLogsAnalysisReader is a Reader for the GetClusterLogsAnalysis structure.
+type GetClusterLogsAnalysisReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetClusterLogsAnalysisReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetClusterLogsAnalysisOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetClusterLogsAnalysisUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetClusterLogsAnalysisForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetClusterLogsAnalysisNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewGetClusterLogsAnalysisMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetClusterLogsAnalysisInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetClusterLogsAnalysisOK creates a GetClusterLogsAnalysisOK with default headers values
+func NewGetClusterLogsAnalysisOK() *GetClusterLogsAnalysisOK {
+	return &GetClusterLogsAnalysisOK{}
+}
+
+/*GetClusterLogsAnalysisOK handles this case with default header values.
+
+Success.
+*/
+type GetClusterLogsAnalysisOK struct {
+	Payload models.LogFindings
+}
+
+func (o *GetClusterLogsAnalysisOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/logs/analysis][%d] getClusterLogsAnalysisOK  %+v", 200, o.Payload)
+}
+
+func (o *GetClusterLogsAnalysisOK) GetPayload() models.LogFindings {
+	return o.Payload
+}
+
+func (o *GetClusterLogsAnalysisOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterLogsAnalysisUnauthorized creates a GetClusterLogsAnalysisUnauthorized with default headers values
+func NewGetClusterLogsAnalysisUnauthorized() *GetClusterLogsAnalysisUnauthorized {
+	return &GetClusterLogsAnalysisUnauthorized{}
+}
+
+/*GetClusterLogsAnalysisUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type GetClusterLogsAnalysisUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *GetClusterLogsAnalysisUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/logs/analysis][%d] getClusterLogsAnalysisUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetClusterLogsAnalysisUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetClusterLogsAnalysisUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterLogsAnalysisForbidden creates a GetClusterLogsAnalysisForbidden with default headers values
+func NewGetClusterLogsAnalysisForbidden() *GetClusterLogsAnalysisForbidden {
+	return &GetClusterLogsAnalysisForbidden{}
+}
+
+/*GetClusterLogsAnalysisForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type GetClusterLogsAnalysisForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *GetClusterLogsAnalysisForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/logs/analysis][%d] getClusterLogsAnalysisForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetClusterLogsAnalysisForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetClusterLogsAnalysisForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterLogsAnalysisNotFound creates a GetClusterLogsAnalysisNotFound with default headers values
+func NewGetClusterLogsAnalysisNotFound() *GetClusterLogsAnalysisNotFound {
+	return &GetClusterLogsAnalysisNotFound{}
+}
+
+/*GetClusterLogsAnalysisNotFound handles this case with default header values.
+
+Error.
+*/
+type GetClusterLogsAnalysisNotFound struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterLogsAnalysisNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/logs/analysis][%d] getClusterLogsAnalysisNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetClusterLogsAnalysisNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterLogsAnalysisNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterLogsAnalysisMethodNotAllowed creates a GetClusterLogsAnalysisMethodNotAllowed with default headers values
+func NewGetClusterLogsAnalysisMethodNotAllowed() *GetClusterLogsAnalysisMethodNotAllowed {
+	return &GetClusterLogsAnalysisMethodNotAllowed{}
+}
+
+/*GetClusterLogsAnalysisMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type GetClusterLogsAnalysisMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterLogsAnalysisMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/logs/analysis][%d] getClusterLogsAnalysisMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *GetClusterLogsAnalysisMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterLogsAnalysisMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterLogsAnalysisInternalServerError creates a GetClusterLogsAnalysisInternalServerError with default headers values
+func NewGetClusterLogsAnalysisInternalServerError() *GetClusterLogsAnalysisInternalServerError {
+	return &GetClusterLogsAnalysisInternalServerError{}
+}
+
+/*GetClusterLogsAnalysisInternalServerError handles this case with default header values.
+
+Error.
+*/
+type GetClusterLogsAnalysisInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterLogsAnalysisInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/logs/analysis][%d] getClusterLogsAnalysisInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetClusterLogsAnalysisInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterLogsAnalysisInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
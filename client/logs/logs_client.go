@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package logs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the logs client
+type API interface {
+	/*
+	   GetClusterLogsAnalysis Retrieves the known failures that were found in the uploaded logs of the cluster and its hosts.*/
+	GetClusterLogsAnalysis(ctx context.Context, params *GetClusterLogsAnalysisParams) (*GetClusterLogsAnalysisOK, error)
+}
+
+// New creates a new logs API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for logs API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+GetClusterLogsAnalysis Retrieves the known failures that were found in the uploaded logs of the cluster and its hosts.
+*/
+func (a *Client) GetClusterLogsAnalysis(ctx context.Context, params *GetClusterLogsAnalysisParams) (*GetClusterLogsAnalysisOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterLogsAnalysis",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/logs/analysis",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterLogsAnalysisReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterLogsAnalysisOK), nil
+
+}
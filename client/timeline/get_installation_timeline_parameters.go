@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetInstallationTimelineParams creates a new GetInstallationTimelineParams object
+// with the default values initialized.
+func NewGetInstallationTimelineParams() *GetInstallationTimelineParams {
+	var ()
+	return &GetInstallationTimelineParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetInstallationTimelineParamsWithTimeout creates a new GetInstallationTimelineParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetInstallationTimelineParamsWithTimeout(timeout time.Duration) *GetInstallationTimelineParams {
+	var ()
+	return &GetInstallationTimelineParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetInstallationTimelineParamsWithContext creates a new GetInstallationTimelineParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetInstallationTimelineParamsWithContext(ctx context.Context) *GetInstallationTimelineParams {
+	var ()
+	return &GetInstallationTimelineParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetInstallationTimelineParamsWithHTTPClient creates a new GetInstallationTimelineParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetInstallationTimelineParamsWithHTTPClient(client *http.Client) *GetInstallationTimelineParams {
+	var ()
+	return &GetInstallationTimelineParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetInstallationTimelineParams contains all the parameters to send to the API endpoint
+for the get installation timeline operation typically these are written to a http.Request
+*/
+type GetInstallationTimelineParams struct {
+
+	/*ClusterID
+	  The cluster whose installation timeline should be retrieved.
+
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get installation timeline params
+func (o *GetInstallationTimelineParams) WithTimeout(timeout time.Duration) *GetInstallationTimelineParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get installation timeline params
+func (o *GetInstallationTimelineParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get installation timeline params
+func (o *GetInstallationTimelineParams) WithContext(ctx context.Context) *GetInstallationTimelineParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get installation timeline params
+func (o *GetInstallationTimelineParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get installation timeline params
+func (o *GetInstallationTimelineParams) WithHTTPClient(client *http.Client) *GetInstallationTimelineParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get installation timeline params
+func (o *GetInstallationTimelineParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the get installation timeline params
+func (o *GetInstallationTimelineParams) WithClusterID(clusterID strfmt.UUID) *GetInstallationTimelineParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the get installation timeline params
+func (o *GetInstallationTimelineParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetInstallationTimelineParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetInstallationTimelineReader is a Reader for the GetInstallationTimeline structure.
+type GetInstallationTimelineReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetInstallationTimelineReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetInstallationTimelineOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetInstallationTimelineUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetInstallationTimelineForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetInstallationTimelineNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewGetInstallationTimelineMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetInstallationTimelineInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetInstallationTimelineOK creates a GetInstallationTimelineOK with default headers values
+func NewGetInstallationTimelineOK() *GetInstallationTimelineOK {
+	return &GetInstallationTimelineOK{}
+}
+
+/*GetInstallationTimelineOK handles this case with default header values.
+
+Success.
+*/
+type GetInstallationTimelineOK struct {
+	Payload *models.InstallationTimeline
+}
+
+func (o *GetInstallationTimelineOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/installation-timeline][%d] getInstallationTimelineOK  %+v", 200, o.Payload)
+}
+
+func (o *GetInstallationTimelineOK) GetPayload() *models.InstallationTimeline {
+	return o.Payload
+}
+
+func (o *GetInstallationTimelineOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InstallationTimeline)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetInstallationTimelineUnauthorized creates a GetInstallationTimelineUnauthorized with default headers values
+func NewGetInstallationTimelineUnauthorized() *GetInstallationTimelineUnauthorized {
+	return &GetInstallationTimelineUnauthorized{}
+}
+
+/*GetInstallationTimelineUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type GetInstallationTimelineUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *GetInstallationTimelineUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/installation-timeline][%d] getInstallationTimelineUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetInstallationTimelineUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetInstallationTimelineUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetInstallationTimelineForbidden creates a GetInstallationTimelineForbidden with default headers values
+func NewGetInstallationTimelineForbidden() *GetInstallationTimelineForbidden {
+	return &GetInstallationTimelineForbidden{}
+}
+
+/*GetInstallationTimelineForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type GetInstallationTimelineForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *GetInstallationTimelineForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/installation-timeline][%d] getInstallationTimelineForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetInstallationTimelineForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *GetInstallationTimelineForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetInstallationTimelineNotFound creates a GetInstallationTimelineNotFound with default headers values
+func NewGetInstallationTimelineNotFound() *GetInstallationTimelineNotFound {
+	return &GetInstallationTimelineNotFound{}
+}
+
+/*GetInstallationTimelineNotFound handles this case with default header values.
+
+Error.
+*/
+type GetInstallationTimelineNotFound struct {
+	Payload *models.Error
+}
+
+func (o *GetInstallationTimelineNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/installation-timeline][%d] getInstallationTimelineNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetInstallationTimelineNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetInstallationTimelineNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetInstallationTimelineMethodNotAllowed creates a GetInstallationTimelineMethodNotAllowed with default headers values
+func NewGetInstallationTimelineMethodNotAllowed() *GetInstallationTimelineMethodNotAllowed {
+	return &GetInstallationTimelineMethodNotAllowed{}
+}
+
+/*GetInstallationTimelineMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type GetInstallationTimelineMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *GetInstallationTimelineMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/installation-timeline][%d] getInstallationTimelineMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *GetInstallationTimelineMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetInstallationTimelineMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetInstallationTimelineInternalServerError creates a GetInstallationTimelineInternalServerError with default headers values
+func NewGetInstallationTimelineInternalServerError() *GetInstallationTimelineInternalServerError {
+	return &GetInstallationTimelineInternalServerError{}
+}
+
+/*GetInstallationTimelineInternalServerError handles this case with default header values.
+
+Error.
+*/
+type GetInstallationTimelineInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *GetInstallationTimelineInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/installation-timeline][%d] getInstallationTimelineInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetInstallationTimelineInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetInstallationTimelineInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
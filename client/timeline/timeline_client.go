@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the timeline client
+type API interface {
+	/*
+	   GetInstallationTimeline Retrieves the installation of a cluster laid out over time, including the stages of every host, the status changes of the cluster, the validations that changed and the progress of the operators.*/
+	GetInstallationTimeline(ctx context.Context, params *GetInstallationTimelineParams) (*GetInstallationTimelineOK, error)
+}
+
+// New creates a new timeline API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for timeline API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+GetInstallationTimeline Retrieves the installation of a cluster laid out over time, including the stages of every host, the status changes of the cluster, the validations that changed and the progress of the operators.
+*/
+func (a *Client) GetInstallationTimeline(ctx context.Context, params *GetInstallationTimelineParams) (*GetInstallationTimelineOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetInstallationTimeline",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/installation-timeline",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetInstallationTimelineReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetInstallationTimelineOK), nil
+
+}
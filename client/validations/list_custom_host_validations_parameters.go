@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListCustomHostValidationsParams creates a new ListCustomHostValidationsParams object
+// with the default values initialized.
+func NewListCustomHostValidationsParams() *ListCustomHostValidationsParams {
+	var ()
+	return &ListCustomHostValidationsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListCustomHostValidationsParamsWithTimeout creates a new ListCustomHostValidationsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListCustomHostValidationsParamsWithTimeout(timeout time.Duration) *ListCustomHostValidationsParams {
+	var ()
+	return &ListCustomHostValidationsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListCustomHostValidationsParamsWithContext creates a new ListCustomHostValidationsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListCustomHostValidationsParamsWithContext(ctx context.Context) *ListCustomHostValidationsParams {
+	var ()
+	return &ListCustomHostValidationsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListCustomHostValidationsParamsWithHTTPClient creates a new ListCustomHostValidationsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListCustomHostValidationsParamsWithHTTPClient(client *http.Client) *ListCustomHostValidationsParams {
+	var ()
+	return &ListCustomHostValidationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListCustomHostValidationsParams contains all the parameters to send to the API endpoint
+for the list custom host validations operation typically these are written to a http.Request
+*/
+type ListCustomHostValidationsParams struct {
+
+	/*ClusterID
+	  The cluster whose custom host validations are affected.
+
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list custom host validations params
+func (o *ListCustomHostValidationsParams) WithTimeout(timeout time.Duration) *ListCustomHostValidationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list custom host validations params
+func (o *ListCustomHostValidationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list custom host validations params
+func (o *ListCustomHostValidationsParams) WithContext(ctx context.Context) *ListCustomHostValidationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list custom host validations params
+func (o *ListCustomHostValidationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list custom host validations params
+func (o *ListCustomHostValidationsParams) WithHTTPClient(client *http.Client) *ListCustomHostValidationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list custom host validations params
+func (o *ListCustomHostValidationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list custom host validations params
+func (o *ListCustomHostValidationsParams) WithClusterID(clusterID strfmt.UUID) *ListCustomHostValidationsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list custom host validations params
+func (o *ListCustomHostValidationsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListCustomHostValidationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListCustomHostValidationsReader is a Reader for the ListCustomHostValidations structure.
+type ListCustomHostValidationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListCustomHostValidationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListCustomHostValidationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListCustomHostValidationsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListCustomHostValidationsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewListCustomHostValidationsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewListCustomHostValidationsMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListCustomHostValidationsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListCustomHostValidationsOK creates a ListCustomHostValidationsOK with default headers values
+func NewListCustomHostValidationsOK() *ListCustomHostValidationsOK {
+	return &ListCustomHostValidationsOK{}
+}
+
+/*ListCustomHostValidationsOK handles this case with default header values.
+
+Success.
+*/
+type ListCustomHostValidationsOK struct {
+	Payload models.CustomHostValidationList
+}
+
+func (o *ListCustomHostValidationsOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/custom-host-validations][%d] listCustomHostValidationsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListCustomHostValidationsOK) GetPayload() models.CustomHostValidationList {
+	return o.Payload
+}
+
+func (o *ListCustomHostValidationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListCustomHostValidationsUnauthorized creates a ListCustomHostValidationsUnauthorized with default headers values
+func NewListCustomHostValidationsUnauthorized() *ListCustomHostValidationsUnauthorized {
+	return &ListCustomHostValidationsUnauthorized{}
+}
+
+/*ListCustomHostValidationsUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ListCustomHostValidationsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ListCustomHostValidationsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/custom-host-validations][%d] listCustomHostValidationsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListCustomHostValidationsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListCustomHostValidationsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListCustomHostValidationsForbidden creates a ListCustomHostValidationsForbidden with default headers values
+func NewListCustomHostValidationsForbidden() *ListCustomHostValidationsForbidden {
+	return &ListCustomHostValidationsForbidden{}
+}
+
+/*ListCustomHostValidationsForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ListCustomHostValidationsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ListCustomHostValidationsForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/custom-host-validations][%d] listCustomHostValidationsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListCustomHostValidationsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListCustomHostValidationsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListCustomHostValidationsNotFound creates a ListCustomHostValidationsNotFound with default headers values
+func NewListCustomHostValidationsNotFound() *ListCustomHostValidationsNotFound {
+	return &ListCustomHostValidationsNotFound{}
+}
+
+/*ListCustomHostValidationsNotFound handles this case with default header values.
+
+Error.
+*/
+type ListCustomHostValidationsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *ListCustomHostValidationsNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/custom-host-validations][%d] listCustomHostValidationsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListCustomHostValidationsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListCustomHostValidationsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListCustomHostValidationsMethodNotAllowed creates a ListCustomHostValidationsMethodNotAllowed with default headers values
+func NewListCustomHostValidationsMethodNotAllowed() *ListCustomHostValidationsMethodNotAllowed {
+	return &ListCustomHostValidationsMethodNotAllowed{}
+}
+
+/*ListCustomHostValidationsMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ListCustomHostValidationsMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ListCustomHostValidationsMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/custom-host-validations][%d] listCustomHostValidationsMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ListCustomHostValidationsMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListCustomHostValidationsMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListCustomHostValidationsInternalServerError creates a ListCustomHostValidationsInternalServerError with default headers values
+func NewListCustomHostValidationsInternalServerError() *ListCustomHostValidationsInternalServerError {
+	return &ListCustomHostValidationsInternalServerError{}
+}
+
+/*ListCustomHostValidationsInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ListCustomHostValidationsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ListCustomHostValidationsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/custom-host-validations][%d] listCustomHostValidationsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListCustomHostValidationsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListCustomHostValidationsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewResetCustomHostValidationsParams creates a new ResetCustomHostValidationsParams object
+// with the default values initialized.
+func NewResetCustomHostValidationsParams() *ResetCustomHostValidationsParams {
+	var ()
+	return &ResetCustomHostValidationsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewResetCustomHostValidationsParamsWithTimeout creates a new ResetCustomHostValidationsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewResetCustomHostValidationsParamsWithTimeout(timeout time.Duration) *ResetCustomHostValidationsParams {
+	var ()
+	return &ResetCustomHostValidationsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewResetCustomHostValidationsParamsWithContext creates a new ResetCustomHostValidationsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewResetCustomHostValidationsParamsWithContext(ctx context.Context) *ResetCustomHostValidationsParams {
+	var ()
+	return &ResetCustomHostValidationsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewResetCustomHostValidationsParamsWithHTTPClient creates a new ResetCustomHostValidationsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewResetCustomHostValidationsParamsWithHTTPClient(client *http.Client) *ResetCustomHostValidationsParams {
+	var ()
+	return &ResetCustomHostValidationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ResetCustomHostValidationsParams contains all the parameters to send to the API endpoint
+for the reset custom host validations operation typically these are written to a http.Request
+*/
+type ResetCustomHostValidationsParams struct {
+
+	/*ClusterID
+	  The cluster whose custom host validations are affected.
+
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the reset custom host validations params
+func (o *ResetCustomHostValidationsParams) WithTimeout(timeout time.Duration) *ResetCustomHostValidationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reset custom host validations params
+func (o *ResetCustomHostValidationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reset custom host validations params
+func (o *ResetCustomHostValidationsParams) WithContext(ctx context.Context) *ResetCustomHostValidationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reset custom host validations params
+func (o *ResetCustomHostValidationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reset custom host validations params
+func (o *ResetCustomHostValidationsParams) WithHTTPClient(client *http.Client) *ResetCustomHostValidationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reset custom host validations params
+func (o *ResetCustomHostValidationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the reset custom host validations params
+func (o *ResetCustomHostValidationsParams) WithClusterID(clusterID strfmt.UUID) *ResetCustomHostValidationsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the reset custom host validations params
+func (o *ResetCustomHostValidationsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ResetCustomHostValidationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
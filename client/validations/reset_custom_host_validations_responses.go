@@ -0,0 +1,252 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ResetCustomHostValidationsReader is a Reader for the ResetCustomHostValidations structure.
+type ResetCustomHostValidationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ResetCustomHostValidationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewResetCustomHostValidationsNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewResetCustomHostValidationsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewResetCustomHostValidationsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewResetCustomHostValidationsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewResetCustomHostValidationsMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewResetCustomHostValidationsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewResetCustomHostValidationsNoContent creates a ResetCustomHostValidationsNoContent with default headers values
+func NewResetCustomHostValidationsNoContent() *ResetCustomHostValidationsNoContent {
+	return &ResetCustomHostValidationsNoContent{}
+}
+
+/*ResetCustomHostValidationsNoContent handles this case with default header values.
+
+Success.
+*/
+type ResetCustomHostValidationsNoContent struct {
+}
+
+func (o *ResetCustomHostValidationsNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/custom-host-validations][%d] resetCustomHostValidationsNoContent ", 204)
+}
+
+func (o *ResetCustomHostValidationsNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewResetCustomHostValidationsUnauthorized creates a ResetCustomHostValidationsUnauthorized with default headers values
+func NewResetCustomHostValidationsUnauthorized() *ResetCustomHostValidationsUnauthorized {
+	return &ResetCustomHostValidationsUnauthorized{}
+}
+
+/*ResetCustomHostValidationsUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ResetCustomHostValidationsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ResetCustomHostValidationsUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/custom-host-validations][%d] resetCustomHostValidationsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ResetCustomHostValidationsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ResetCustomHostValidationsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewResetCustomHostValidationsForbidden creates a ResetCustomHostValidationsForbidden with default headers values
+func NewResetCustomHostValidationsForbidden() *ResetCustomHostValidationsForbidden {
+	return &ResetCustomHostValidationsForbidden{}
+}
+
+/*ResetCustomHostValidationsForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ResetCustomHostValidationsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ResetCustomHostValidationsForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/custom-host-validations][%d] resetCustomHostValidationsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ResetCustomHostValidationsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ResetCustomHostValidationsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewResetCustomHostValidationsNotFound creates a ResetCustomHostValidationsNotFound with default headers values
+func NewResetCustomHostValidationsNotFound() *ResetCustomHostValidationsNotFound {
+	return &ResetCustomHostValidationsNotFound{}
+}
+
+/*ResetCustomHostValidationsNotFound handles this case with default header values.
+
+Error.
+*/
+type ResetCustomHostValidationsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *ResetCustomHostValidationsNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/custom-host-validations][%d] resetCustomHostValidationsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ResetCustomHostValidationsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ResetCustomHostValidationsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewResetCustomHostValidationsMethodNotAllowed creates a ResetCustomHostValidationsMethodNotAllowed with default headers values
+func NewResetCustomHostValidationsMethodNotAllowed() *ResetCustomHostValidationsMethodNotAllowed {
+	return &ResetCustomHostValidationsMethodNotAllowed{}
+}
+
+/*ResetCustomHostValidationsMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ResetCustomHostValidationsMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ResetCustomHostValidationsMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/custom-host-validations][%d] resetCustomHostValidationsMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ResetCustomHostValidationsMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ResetCustomHostValidationsMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewResetCustomHostValidationsInternalServerError creates a ResetCustomHostValidationsInternalServerError with default headers values
+func NewResetCustomHostValidationsInternalServerError() *ResetCustomHostValidationsInternalServerError {
+	return &ResetCustomHostValidationsInternalServerError{}
+}
+
+/*ResetCustomHostValidationsInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ResetCustomHostValidationsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ResetCustomHostValidationsInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/custom-host-validations][%d] resetCustomHostValidationsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ResetCustomHostValidationsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ResetCustomHostValidationsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewUpdateCustomHostValidationsParams creates a new UpdateCustomHostValidationsParams object
+// with the default values initialized.
+func NewUpdateCustomHostValidationsParams() *UpdateCustomHostValidationsParams {
+	var ()
+	return &UpdateCustomHostValidationsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateCustomHostValidationsParamsWithTimeout creates a new UpdateCustomHostValidationsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdateCustomHostValidationsParamsWithTimeout(timeout time.Duration) *UpdateCustomHostValidationsParams {
+	var ()
+	return &UpdateCustomHostValidationsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdateCustomHostValidationsParamsWithContext creates a new UpdateCustomHostValidationsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdateCustomHostValidationsParamsWithContext(ctx context.Context) *UpdateCustomHostValidationsParams {
+	var ()
+	return &UpdateCustomHostValidationsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdateCustomHostValidationsParamsWithHTTPClient creates a new UpdateCustomHostValidationsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdateCustomHostValidationsParamsWithHTTPClient(client *http.Client) *UpdateCustomHostValidationsParams {
+	var ()
+	return &UpdateCustomHostValidationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*UpdateCustomHostValidationsParams contains all the parameters to send to the API endpoint
+for the update custom host validations operation typically these are written to a http.Request
+*/
+type UpdateCustomHostValidationsParams struct {
+
+	/*ClusterID
+	  The cluster whose custom host validations are affected.
+
+	*/
+	ClusterID strfmt.UUID
+	/*CustomHostValidations*/
+	CustomHostValidations models.CustomHostValidationList
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) WithTimeout(timeout time.Duration) *UpdateCustomHostValidationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) WithContext(ctx context.Context) *UpdateCustomHostValidationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) WithHTTPClient(client *http.Client) *UpdateCustomHostValidationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) WithClusterID(clusterID strfmt.UUID) *UpdateCustomHostValidationsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithCustomHostValidations adds the customHostValidations to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) WithCustomHostValidations(customHostValidations models.CustomHostValidationList) *UpdateCustomHostValidationsParams {
+	o.SetCustomHostValidations(customHostValidations)
+	return o
+}
+
+// SetCustomHostValidations adds the customHostValidations to the update custom host validations params
+func (o *UpdateCustomHostValidationsParams) SetCustomHostValidations(customHostValidations models.CustomHostValidationList) {
+	o.CustomHostValidations = customHostValidations
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateCustomHostValidationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.CustomHostValidations != nil {
+		if err := r.SetBodyParam(o.CustomHostValidations); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// UpdateCustomHostValidationsReader is a Reader for the UpdateCustomHostValidations structure.
+type UpdateCustomHostValidationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateCustomHostValidationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateCustomHostValidationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewUpdateCustomHostValidationsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewUpdateCustomHostValidationsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewUpdateCustomHostValidationsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateCustomHostValidationsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewUpdateCustomHostValidationsMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewUpdateCustomHostValidationsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewUpdateCustomHostValidationsOK creates a UpdateCustomHostValidationsOK with default headers values
+func NewUpdateCustomHostValidationsOK() *UpdateCustomHostValidationsOK {
+	return &UpdateCustomHostValidationsOK{}
+}
+
+/*UpdateCustomHostValidationsOK handles this case with default header values.
+
+Success.
+*/
+type UpdateCustomHostValidationsOK struct {
+	Payload models.CustomHostValidationList
+}
+
+func (o *UpdateCustomHostValidationsOK) Error() string {
+	return fmt.Sprintf("[PUT /clusters/{cluster_id}/custom-host-validations][%d] updateCustomHostValidationsOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateCustomHostValidationsOK) GetPayload() models.CustomHostValidationList {
+	return o.Payload
+}
+
+func (o *UpdateCustomHostValidationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateCustomHostValidationsBadRequest creates a UpdateCustomHostValidationsBadRequest with default headers values
+func NewUpdateCustomHostValidationsBadRequest() *UpdateCustomHostValidationsBadRequest {
+	return &UpdateCustomHostValidationsBadRequest{}
+}
+
+/*UpdateCustomHostValidationsBadRequest handles this case with default header values.
+
+Error.
+*/
+type UpdateCustomHostValidationsBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *UpdateCustomHostValidationsBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /clusters/{cluster_id}/custom-host-validations][%d] updateCustomHostValidationsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateCustomHostValidationsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateCustomHostValidationsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateCustomHostValidationsUnauthorized creates a UpdateCustomHostValidationsUnauthorized with default headers values
+func NewUpdateCustomHostValidationsUnauthorized() *UpdateCustomHostValidationsUnauthorized {
+	return &UpdateCustomHostValidationsUnauthorized{}
+}
+
+/*UpdateCustomHostValidationsUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type UpdateCustomHostValidationsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *UpdateCustomHostValidationsUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /clusters/{cluster_id}/custom-host-validations][%d] updateCustomHostValidationsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *UpdateCustomHostValidationsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *UpdateCustomHostValidationsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateCustomHostValidationsForbidden creates a UpdateCustomHostValidationsForbidden with default headers values
+func NewUpdateCustomHostValidationsForbidden() *UpdateCustomHostValidationsForbidden {
+	return &UpdateCustomHostValidationsForbidden{}
+}
+
+/*UpdateCustomHostValidationsForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type UpdateCustomHostValidationsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *UpdateCustomHostValidationsForbidden) Error() string {
+	return fmt.Sprintf("[PUT /clusters/{cluster_id}/custom-host-validations][%d] updateCustomHostValidationsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UpdateCustomHostValidationsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *UpdateCustomHostValidationsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateCustomHostValidationsNotFound creates a UpdateCustomHostValidationsNotFound with default headers values
+func NewUpdateCustomHostValidationsNotFound() *UpdateCustomHostValidationsNotFound {
+	return &UpdateCustomHostValidationsNotFound{}
+}
+
+/*UpdateCustomHostValidationsNotFound handles this case with default header values.
+
+Error.
+*/
+type UpdateCustomHostValidationsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *UpdateCustomHostValidationsNotFound) Error() string {
+	return fmt.Sprintf("[PUT /clusters/{cluster_id}/custom-host-validations][%d] updateCustomHostValidationsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateCustomHostValidationsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateCustomHostValidationsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateCustomHostValidationsMethodNotAllowed creates a UpdateCustomHostValidationsMethodNotAllowed with default headers values
+func NewUpdateCustomHostValidationsMethodNotAllowed() *UpdateCustomHostValidationsMethodNotAllowed {
+	return &UpdateCustomHostValidationsMethodNotAllowed{}
+}
+
+/*UpdateCustomHostValidationsMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type UpdateCustomHostValidationsMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *UpdateCustomHostValidationsMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[PUT /clusters/{cluster_id}/custom-host-validations][%d] updateCustomHostValidationsMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *UpdateCustomHostValidationsMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateCustomHostValidationsMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateCustomHostValidationsInternalServerError creates a UpdateCustomHostValidationsInternalServerError with default headers values
+func NewUpdateCustomHostValidationsInternalServerError() *UpdateCustomHostValidationsInternalServerError {
+	return &UpdateCustomHostValidationsInternalServerError{}
+}
+
+/*UpdateCustomHostValidationsInternalServerError handles this case with default header values.
+
+Error.
+*/
+type UpdateCustomHostValidationsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *UpdateCustomHostValidationsInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /clusters/{cluster_id}/custom-host-validations][%d] updateCustomHostValidationsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpdateCustomHostValidationsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateCustomHostValidationsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the validations client
+type API interface {
+	/*
+	   ListCustomHostValidations Retrieves the custom validations that are evaluated for the hosts of the cluster, which are the service-wide defaults unless the cluster has its own.*/
+	ListCustomHostValidations(ctx context.Context, params *ListCustomHostValidationsParams) (*ListCustomHostValidationsOK, error)
+	/*
+	   ResetCustomHostValidations Removes the custom validations of the cluster, so that the service-wide defaults are evaluated for its hosts.*/
+	ResetCustomHostValidations(ctx context.Context, params *ResetCustomHostValidationsParams) (*ResetCustomHostValidationsNoContent, error)
+	/*
+	   UpdateCustomHostValidations Replaces the custom validations that are evaluated for the hosts of the cluster instead of the service-wide defaults.*/
+	UpdateCustomHostValidations(ctx context.Context, params *UpdateCustomHostValidationsParams) (*UpdateCustomHostValidationsOK, error)
+}
+
+// New creates a new validations API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for validations API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+ListCustomHostValidations Retrieves the custom validations that are evaluated for the hosts of the cluster, which are the service-wide defaults unless the cluster has its own.
+*/
+func (a *Client) ListCustomHostValidations(ctx context.Context, params *ListCustomHostValidationsParams) (*ListCustomHostValidationsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListCustomHostValidations",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/custom-host-validations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListCustomHostValidationsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListCustomHostValidationsOK), nil
+
+}
+
+/*
+ResetCustomHostValidations Removes the custom validations of the cluster, so that the service-wide defaults are evaluated for its hosts.
+*/
+func (a *Client) ResetCustomHostValidations(ctx context.Context, params *ResetCustomHostValidationsParams) (*ResetCustomHostValidationsNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ResetCustomHostValidations",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/custom-host-validations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ResetCustomHostValidationsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ResetCustomHostValidationsNoContent), nil
+
+}
+
+/*
+UpdateCustomHostValidations Replaces the custom validations that are evaluated for the hosts of the cluster instead of the service-wide defaults.
+*/
+func (a *Client) UpdateCustomHostValidations(ctx context.Context, params *UpdateCustomHostValidationsParams) (*UpdateCustomHostValidationsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateCustomHostValidations",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/custom-host-validations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateCustomHostValidationsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateCustomHostValidationsOK), nil
+
+}
@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeregisterWebhookParams creates a new DeregisterWebhookParams object
+// with the default values initialized.
+func NewDeregisterWebhookParams() *DeregisterWebhookParams {
+	var ()
+	return &DeregisterWebhookParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeregisterWebhookParamsWithTimeout creates a new DeregisterWebhookParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeregisterWebhookParamsWithTimeout(timeout time.Duration) *DeregisterWebhookParams {
+	var ()
+	return &DeregisterWebhookParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeregisterWebhookParamsWithContext creates a new DeregisterWebhookParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeregisterWebhookParamsWithContext(ctx context.Context) *DeregisterWebhookParams {
+	var ()
+	return &DeregisterWebhookParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDeregisterWebhookParamsWithHTTPClient creates a new DeregisterWebhookParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeregisterWebhookParamsWithHTTPClient(client *http.Client) *DeregisterWebhookParams {
+	var ()
+	return &DeregisterWebhookParams{
+		HTTPClient: client,
+	}
+}
+
+/*DeregisterWebhookParams contains all the parameters to send to the API endpoint
+for the deregister webhook operation typically these are written to a http.Request
+*/
+type DeregisterWebhookParams struct {
+
+	/*ClusterID
+	  The cluster of the webhook.
+
+	*/
+	ClusterID strfmt.UUID
+	/*WebhookID
+	  The webhook to deregister.
+
+	*/
+	WebhookID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the deregister webhook params
+func (o *DeregisterWebhookParams) WithTimeout(timeout time.Duration) *DeregisterWebhookParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the deregister webhook params
+func (o *DeregisterWebhookParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the deregister webhook params
+func (o *DeregisterWebhookParams) WithContext(ctx context.Context) *DeregisterWebhookParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the deregister webhook params
+func (o *DeregisterWebhookParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the deregister webhook params
+func (o *DeregisterWebhookParams) WithHTTPClient(client *http.Client) *DeregisterWebhookParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the deregister webhook params
+func (o *DeregisterWebhookParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the deregister webhook params
+func (o *DeregisterWebhookParams) WithClusterID(clusterID strfmt.UUID) *DeregisterWebhookParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the deregister webhook params
+func (o *DeregisterWebhookParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithWebhookID adds the webhookID to the deregister webhook params
+func (o *DeregisterWebhookParams) WithWebhookID(webhookID strfmt.UUID) *DeregisterWebhookParams {
+	o.SetWebhookID(webhookID)
+	return o
+}
+
+// SetWebhookID adds the webhookId to the deregister webhook params
+func (o *DeregisterWebhookParams) SetWebhookID(webhookID strfmt.UUID) {
+	o.WebhookID = webhookID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeregisterWebhookParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	// path param webhook_id
+	if err := r.SetPathParam("webhook_id", o.WebhookID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
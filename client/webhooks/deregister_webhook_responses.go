@@ -0,0 +1,252 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// DeregisterWebhookReader is a Reader for the DeregisterWebhook structure.
+type DeregisterWebhookReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeregisterWebhookReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeregisterWebhookNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewDeregisterWebhookUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewDeregisterWebhookForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDeregisterWebhookNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewDeregisterWebhookMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDeregisterWebhookInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDeregisterWebhookNoContent creates a DeregisterWebhookNoContent with default headers values
+func NewDeregisterWebhookNoContent() *DeregisterWebhookNoContent {
+	return &DeregisterWebhookNoContent{}
+}
+
+/*DeregisterWebhookNoContent handles this case with default header values.
+
+Success.
+*/
+type DeregisterWebhookNoContent struct {
+}
+
+func (o *DeregisterWebhookNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/webhooks/{webhook_id}][%d] deregisterWebhookNoContent ", 204)
+}
+
+func (o *DeregisterWebhookNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeregisterWebhookUnauthorized creates a DeregisterWebhookUnauthorized with default headers values
+func NewDeregisterWebhookUnauthorized() *DeregisterWebhookUnauthorized {
+	return &DeregisterWebhookUnauthorized{}
+}
+
+/*DeregisterWebhookUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type DeregisterWebhookUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *DeregisterWebhookUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/webhooks/{webhook_id}][%d] deregisterWebhookUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DeregisterWebhookUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *DeregisterWebhookUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeregisterWebhookForbidden creates a DeregisterWebhookForbidden with default headers values
+func NewDeregisterWebhookForbidden() *DeregisterWebhookForbidden {
+	return &DeregisterWebhookForbidden{}
+}
+
+/*DeregisterWebhookForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type DeregisterWebhookForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *DeregisterWebhookForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/webhooks/{webhook_id}][%d] deregisterWebhookForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeregisterWebhookForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *DeregisterWebhookForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeregisterWebhookNotFound creates a DeregisterWebhookNotFound with default headers values
+func NewDeregisterWebhookNotFound() *DeregisterWebhookNotFound {
+	return &DeregisterWebhookNotFound{}
+}
+
+/*DeregisterWebhookNotFound handles this case with default header values.
+
+Error.
+*/
+type DeregisterWebhookNotFound struct {
+	Payload *models.Error
+}
+
+func (o *DeregisterWebhookNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/webhooks/{webhook_id}][%d] deregisterWebhookNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeregisterWebhookNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeregisterWebhookNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeregisterWebhookMethodNotAllowed creates a DeregisterWebhookMethodNotAllowed with default headers values
+func NewDeregisterWebhookMethodNotAllowed() *DeregisterWebhookMethodNotAllowed {
+	return &DeregisterWebhookMethodNotAllowed{}
+}
+
+/*DeregisterWebhookMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type DeregisterWebhookMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *DeregisterWebhookMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/webhooks/{webhook_id}][%d] deregisterWebhookMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *DeregisterWebhookMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeregisterWebhookMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeregisterWebhookInternalServerError creates a DeregisterWebhookInternalServerError with default headers values
+func NewDeregisterWebhookInternalServerError() *DeregisterWebhookInternalServerError {
+	return &DeregisterWebhookInternalServerError{}
+}
+
+/*DeregisterWebhookInternalServerError handles this case with default header values.
+
+Error.
+*/
+type DeregisterWebhookInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *DeregisterWebhookInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/webhooks/{webhook_id}][%d] deregisterWebhookInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeregisterWebhookInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeregisterWebhookInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListWebhookDeliveriesParams creates a new ListWebhookDeliveriesParams object
+// with the default values initialized.
+func NewListWebhookDeliveriesParams() *ListWebhookDeliveriesParams {
+	var ()
+	return &ListWebhookDeliveriesParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListWebhookDeliveriesParamsWithTimeout creates a new ListWebhookDeliveriesParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListWebhookDeliveriesParamsWithTimeout(timeout time.Duration) *ListWebhookDeliveriesParams {
+	var ()
+	return &ListWebhookDeliveriesParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListWebhookDeliveriesParamsWithContext creates a new ListWebhookDeliveriesParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListWebhookDeliveriesParamsWithContext(ctx context.Context) *ListWebhookDeliveriesParams {
+	var ()
+	return &ListWebhookDeliveriesParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListWebhookDeliveriesParamsWithHTTPClient creates a new ListWebhookDeliveriesParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListWebhookDeliveriesParamsWithHTTPClient(client *http.Client) *ListWebhookDeliveriesParams {
+	var ()
+	return &ListWebhookDeliveriesParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListWebhookDeliveriesParams contains all the parameters to send to the API endpoint
+for the list webhook deliveries operation typically these are written to a http.Request
+*/
+type ListWebhookDeliveriesParams struct {
+
+	/*ClusterID
+	  The cluster of the webhook.
+
+	*/
+	ClusterID strfmt.UUID
+	/*WebhookID
+	  The webhook to return deliveries for.
+
+	*/
+	WebhookID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithTimeout(timeout time.Duration) *ListWebhookDeliveriesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithContext(ctx context.Context) *ListWebhookDeliveriesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithHTTPClient(client *http.Client) *ListWebhookDeliveriesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithClusterID(clusterID strfmt.UUID) *ListWebhookDeliveriesParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithWebhookID adds the webhookID to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithWebhookID(webhookID strfmt.UUID) *ListWebhookDeliveriesParams {
+	o.SetWebhookID(webhookID)
+	return o
+}
+
+// SetWebhookID adds the webhookId to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetWebhookID(webhookID strfmt.UUID) {
+	o.WebhookID = webhookID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListWebhookDeliveriesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	// path param webhook_id
+	if err := r.SetPathParam("webhook_id", o.WebhookID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
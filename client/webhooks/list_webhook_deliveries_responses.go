@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListWebhookDeliveriesReader is a Reader for the ListWebhookDeliveries structure.
+type ListWebhookDeliveriesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListWebhookDeliveriesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListWebhookDeliveriesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListWebhookDeliveriesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListWebhookDeliveriesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewListWebhookDeliveriesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewListWebhookDeliveriesMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListWebhookDeliveriesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListWebhookDeliveriesOK creates a ListWebhookDeliveriesOK with default headers values
+func NewListWebhookDeliveriesOK() *ListWebhookDeliveriesOK {
+	return &ListWebhookDeliveriesOK{}
+}
+
+/*ListWebhookDeliveriesOK handles this case with default header values.
+
+Success.
+*/
+type ListWebhookDeliveriesOK struct {
+	Payload models.WebhookDeliveryList
+}
+
+func (o *ListWebhookDeliveriesOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks/{webhook_id}/deliveries][%d] listWebhookDeliveriesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListWebhookDeliveriesOK) GetPayload() models.WebhookDeliveryList {
+	return o.Payload
+}
+
+func (o *ListWebhookDeliveriesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhookDeliveriesUnauthorized creates a ListWebhookDeliveriesUnauthorized with default headers values
+func NewListWebhookDeliveriesUnauthorized() *ListWebhookDeliveriesUnauthorized {
+	return &ListWebhookDeliveriesUnauthorized{}
+}
+
+/*ListWebhookDeliveriesUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ListWebhookDeliveriesUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ListWebhookDeliveriesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks/{webhook_id}/deliveries][%d] listWebhookDeliveriesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListWebhookDeliveriesUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListWebhookDeliveriesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhookDeliveriesForbidden creates a ListWebhookDeliveriesForbidden with default headers values
+func NewListWebhookDeliveriesForbidden() *ListWebhookDeliveriesForbidden {
+	return &ListWebhookDeliveriesForbidden{}
+}
+
+/*ListWebhookDeliveriesForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ListWebhookDeliveriesForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ListWebhookDeliveriesForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks/{webhook_id}/deliveries][%d] listWebhookDeliveriesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListWebhookDeliveriesForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListWebhookDeliveriesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhookDeliveriesNotFound creates a ListWebhookDeliveriesNotFound with default headers values
+func NewListWebhookDeliveriesNotFound() *ListWebhookDeliveriesNotFound {
+	return &ListWebhookDeliveriesNotFound{}
+}
+
+/*ListWebhookDeliveriesNotFound handles this case with default header values.
+
+Error.
+*/
+type ListWebhookDeliveriesNotFound struct {
+	Payload *models.Error
+}
+
+func (o *ListWebhookDeliveriesNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks/{webhook_id}/deliveries][%d] listWebhookDeliveriesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListWebhookDeliveriesNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListWebhookDeliveriesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhookDeliveriesMethodNotAllowed creates a ListWebhookDeliveriesMethodNotAllowed with default headers values
+func NewListWebhookDeliveriesMethodNotAllowed() *ListWebhookDeliveriesMethodNotAllowed {
+	return &ListWebhookDeliveriesMethodNotAllowed{}
+}
+
+/*ListWebhookDeliveriesMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ListWebhookDeliveriesMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ListWebhookDeliveriesMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks/{webhook_id}/deliveries][%d] listWebhookDeliveriesMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ListWebhookDeliveriesMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListWebhookDeliveriesMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhookDeliveriesInternalServerError creates a ListWebhookDeliveriesInternalServerError with default headers values
+func NewListWebhookDeliveriesInternalServerError() *ListWebhookDeliveriesInternalServerError {
+	return &ListWebhookDeliveriesInternalServerError{}
+}
+
+/*ListWebhookDeliveriesInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ListWebhookDeliveriesInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ListWebhookDeliveriesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks/{webhook_id}/deliveries][%d] listWebhookDeliveriesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListWebhookDeliveriesInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListWebhookDeliveriesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
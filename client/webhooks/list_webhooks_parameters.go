@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListWebhooksParams creates a new ListWebhooksParams object
+// with the default values initialized.
+func NewListWebhooksParams() *ListWebhooksParams {
+	var ()
+	return &ListWebhooksParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListWebhooksParamsWithTimeout creates a new ListWebhooksParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListWebhooksParamsWithTimeout(timeout time.Duration) *ListWebhooksParams {
+	var ()
+	return &ListWebhooksParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListWebhooksParamsWithContext creates a new ListWebhooksParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListWebhooksParamsWithContext(ctx context.Context) *ListWebhooksParams {
+	var ()
+	return &ListWebhooksParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListWebhooksParamsWithHTTPClient creates a new ListWebhooksParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListWebhooksParamsWithHTTPClient(client *http.Client) *ListWebhooksParams {
+	var ()
+	return &ListWebhooksParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListWebhooksParams contains all the parameters to send to the API endpoint
+for the list webhooks operation typically these are written to a http.Request
+*/
+type ListWebhooksParams struct {
+
+	/*ClusterID
+	  The cluster to return webhooks for.
+
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list webhooks params
+func (o *ListWebhooksParams) WithTimeout(timeout time.Duration) *ListWebhooksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list webhooks params
+func (o *ListWebhooksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list webhooks params
+func (o *ListWebhooksParams) WithContext(ctx context.Context) *ListWebhooksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list webhooks params
+func (o *ListWebhooksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list webhooks params
+func (o *ListWebhooksParams) WithHTTPClient(client *http.Client) *ListWebhooksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list webhooks params
+func (o *ListWebhooksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list webhooks params
+func (o *ListWebhooksParams) WithClusterID(clusterID strfmt.UUID) *ListWebhooksParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list webhooks params
+func (o *ListWebhooksParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListWebhooksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
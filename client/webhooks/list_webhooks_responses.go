@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListWebhooksReader is a Reader for the ListWebhooks structure.
+type ListWebhooksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListWebhooksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListWebhooksOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListWebhooksUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListWebhooksForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewListWebhooksNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewListWebhooksMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListWebhooksInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListWebhooksOK creates a ListWebhooksOK with default headers values
+func NewListWebhooksOK() *ListWebhooksOK {
+	return &ListWebhooksOK{}
+}
+
+/*ListWebhooksOK handles this case with default header values.
+
+Success.
+*/
+type ListWebhooksOK struct {
+	Payload models.WebhookList
+}
+
+func (o *ListWebhooksOK) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks][%d] listWebhooksOK  %+v", 200, o.Payload)
+}
+
+func (o *ListWebhooksOK) GetPayload() models.WebhookList {
+	return o.Payload
+}
+
+func (o *ListWebhooksOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhooksUnauthorized creates a ListWebhooksUnauthorized with default headers values
+func NewListWebhooksUnauthorized() *ListWebhooksUnauthorized {
+	return &ListWebhooksUnauthorized{}
+}
+
+/*ListWebhooksUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type ListWebhooksUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *ListWebhooksUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks][%d] listWebhooksUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListWebhooksUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListWebhooksUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhooksForbidden creates a ListWebhooksForbidden with default headers values
+func NewListWebhooksForbidden() *ListWebhooksForbidden {
+	return &ListWebhooksForbidden{}
+}
+
+/*ListWebhooksForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type ListWebhooksForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *ListWebhooksForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks][%d] listWebhooksForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListWebhooksForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *ListWebhooksForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhooksNotFound creates a ListWebhooksNotFound with default headers values
+func NewListWebhooksNotFound() *ListWebhooksNotFound {
+	return &ListWebhooksNotFound{}
+}
+
+/*ListWebhooksNotFound handles this case with default header values.
+
+Error.
+*/
+type ListWebhooksNotFound struct {
+	Payload *models.Error
+}
+
+func (o *ListWebhooksNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks][%d] listWebhooksNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListWebhooksNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListWebhooksNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhooksMethodNotAllowed creates a ListWebhooksMethodNotAllowed with default headers values
+func NewListWebhooksMethodNotAllowed() *ListWebhooksMethodNotAllowed {
+	return &ListWebhooksMethodNotAllowed{}
+}
+
+/*ListWebhooksMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type ListWebhooksMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *ListWebhooksMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks][%d] listWebhooksMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *ListWebhooksMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListWebhooksMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhooksInternalServerError creates a ListWebhooksInternalServerError with default headers values
+func NewListWebhooksInternalServerError() *ListWebhooksInternalServerError {
+	return &ListWebhooksInternalServerError{}
+}
+
+/*ListWebhooksInternalServerError handles this case with default header values.
+
+Error.
+*/
+type ListWebhooksInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *ListWebhooksInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/webhooks][%d] listWebhooksInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListWebhooksInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListWebhooksInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
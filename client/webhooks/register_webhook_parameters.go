@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewRegisterWebhookParams creates a new RegisterWebhookParams object
+// with the default values initialized.
+func NewRegisterWebhookParams() *RegisterWebhookParams {
+	var ()
+	return &RegisterWebhookParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRegisterWebhookParamsWithTimeout creates a new RegisterWebhookParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRegisterWebhookParamsWithTimeout(timeout time.Duration) *RegisterWebhookParams {
+	var ()
+	return &RegisterWebhookParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRegisterWebhookParamsWithContext creates a new RegisterWebhookParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRegisterWebhookParamsWithContext(ctx context.Context) *RegisterWebhookParams {
+	var ()
+	return &RegisterWebhookParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRegisterWebhookParamsWithHTTPClient creates a new RegisterWebhookParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRegisterWebhookParamsWithHTTPClient(client *http.Client) *RegisterWebhookParams {
+	var ()
+	return &RegisterWebhookParams{
+		HTTPClient: client,
+	}
+}
+
+/*RegisterWebhookParams contains all the parameters to send to the API endpoint
+for the register webhook operation typically these are written to a http.Request
+*/
+type RegisterWebhookParams struct {
+
+	/*ClusterID
+	  The cluster to register the webhook for.
+
+	*/
+	ClusterID strfmt.UUID
+	/*NewWebhookParams
+	  The webhook to register.
+
+	*/
+	NewWebhookParams *models.WebhookCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the register webhook params
+func (o *RegisterWebhookParams) WithTimeout(timeout time.Duration) *RegisterWebhookParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the register webhook params
+func (o *RegisterWebhookParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the register webhook params
+func (o *RegisterWebhookParams) WithContext(ctx context.Context) *RegisterWebhookParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the register webhook params
+func (o *RegisterWebhookParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the register webhook params
+func (o *RegisterWebhookParams) WithHTTPClient(client *http.Client) *RegisterWebhookParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the register webhook params
+func (o *RegisterWebhookParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the register webhook params
+func (o *RegisterWebhookParams) WithClusterID(clusterID strfmt.UUID) *RegisterWebhookParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the register webhook params
+func (o *RegisterWebhookParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithNewWebhookParams adds the newWebhookParams to the register webhook params
+func (o *RegisterWebhookParams) WithNewWebhookParams(newWebhookParams *models.WebhookCreateParams) *RegisterWebhookParams {
+	o.SetNewWebhookParams(newWebhookParams)
+	return o
+}
+
+// SetNewWebhookParams adds the newWebhookParams to the register webhook params
+func (o *RegisterWebhookParams) SetNewWebhookParams(newWebhookParams *models.WebhookCreateParams) {
+	o.NewWebhookParams = newWebhookParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RegisterWebhookParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.NewWebhookParams != nil {
+		if err := r.SetBodyParam(o.NewWebhookParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// RegisterWebhookReader is a Reader for the RegisterWebhook structure.
+type RegisterWebhookReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RegisterWebhookReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewRegisterWebhookCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRegisterWebhookBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewRegisterWebhookUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRegisterWebhookForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRegisterWebhookNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewRegisterWebhookMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRegisterWebhookInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewRegisterWebhookCreated creates a RegisterWebhookCreated with default headers values
+func NewRegisterWebhookCreated() *RegisterWebhookCreated {
+	return &RegisterWebhookCreated{}
+}
+
+/*RegisterWebhookCreated handles this case with default header values.
+
+Success.
+*/
+type RegisterWebhookCreated struct {
+	Payload *models.Webhook
+}
+
+func (o *RegisterWebhookCreated) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/webhooks][%d] registerWebhookCreated  %+v", 201, o.Payload)
+}
+
+func (o *RegisterWebhookCreated) GetPayload() *models.Webhook {
+	return o.Payload
+}
+
+func (o *RegisterWebhookCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Webhook)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRegisterWebhookBadRequest creates a RegisterWebhookBadRequest with default headers values
+func NewRegisterWebhookBadRequest() *RegisterWebhookBadRequest {
+	return &RegisterWebhookBadRequest{}
+}
+
+/*RegisterWebhookBadRequest handles this case with default header values.
+
+Error.
+*/
+type RegisterWebhookBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *RegisterWebhookBadRequest) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/webhooks][%d] registerWebhookBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RegisterWebhookBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RegisterWebhookBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRegisterWebhookUnauthorized creates a RegisterWebhookUnauthorized with default headers values
+func NewRegisterWebhookUnauthorized() *RegisterWebhookUnauthorized {
+	return &RegisterWebhookUnauthorized{}
+}
+
+/*RegisterWebhookUnauthorized handles this case with default header values.
+
+Unauthorized.
+*/
+type RegisterWebhookUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *RegisterWebhookUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/webhooks][%d] registerWebhookUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *RegisterWebhookUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *RegisterWebhookUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRegisterWebhookForbidden creates a RegisterWebhookForbidden with default headers values
+func NewRegisterWebhookForbidden() *RegisterWebhookForbidden {
+	return &RegisterWebhookForbidden{}
+}
+
+/*RegisterWebhookForbidden handles this case with default header values.
+
+Forbidden.
+*/
+type RegisterWebhookForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *RegisterWebhookForbidden) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/webhooks][%d] registerWebhookForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RegisterWebhookForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *RegisterWebhookForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRegisterWebhookNotFound creates a RegisterWebhookNotFound with default headers values
+func NewRegisterWebhookNotFound() *RegisterWebhookNotFound {
+	return &RegisterWebhookNotFound{}
+}
+
+/*RegisterWebhookNotFound handles this case with default header values.
+
+Error.
+*/
+type RegisterWebhookNotFound struct {
+	Payload *models.Error
+}
+
+func (o *RegisterWebhookNotFound) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/webhooks][%d] registerWebhookNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RegisterWebhookNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RegisterWebhookNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRegisterWebhookMethodNotAllowed creates a RegisterWebhookMethodNotAllowed with default headers values
+func NewRegisterWebhookMethodNotAllowed() *RegisterWebhookMethodNotAllowed {
+	return &RegisterWebhookMethodNotAllowed{}
+}
+
+/*RegisterWebhookMethodNotAllowed handles this case with default header values.
+
+Method Not Allowed.
+*/
+type RegisterWebhookMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *RegisterWebhookMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/webhooks][%d] registerWebhookMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *RegisterWebhookMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RegisterWebhookMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRegisterWebhookInternalServerError creates a RegisterWebhookInternalServerError with default headers values
+func NewRegisterWebhookInternalServerError() *RegisterWebhookInternalServerError {
+	return &RegisterWebhookInternalServerError{}
+}
+
+/*RegisterWebhookInternalServerError handles this case with default header values.
+
+Error.
+*/
+type RegisterWebhookInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *RegisterWebhookInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/webhooks][%d] registerWebhookInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RegisterWebhookInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RegisterWebhookInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
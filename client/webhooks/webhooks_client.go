@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the webhooks client
+type API interface {
+	/*
+	   DeregisterWebhook Deregisters a webhook of a cluster.*/
+	DeregisterWebhook(ctx context.Context, params *DeregisterWebhookParams) (*DeregisterWebhookNoContent, error)
+	/*
+	   ListWebhookDeliveries Lists the delivery log of a webhook.*/
+	ListWebhookDeliveries(ctx context.Context, params *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesOK, error)
+	/*
+	   ListWebhooks Lists the webhooks that are registered for a cluster.*/
+	ListWebhooks(ctx context.Context, params *ListWebhooksParams) (*ListWebhooksOK, error)
+	/*
+	   RegisterWebhook Registers a webhook that is notified about the events and status changes of a cluster and its hosts.*/
+	RegisterWebhook(ctx context.Context, params *RegisterWebhookParams) (*RegisterWebhookCreated, error)
+}
+
+// New creates a new webhooks API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for webhooks API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+DeregisterWebhook Deregisters a webhook of a cluster.
+*/
+func (a *Client) DeregisterWebhook(ctx context.Context, params *DeregisterWebhookParams) (*DeregisterWebhookNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeregisterWebhook",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/webhooks/{webhook_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeregisterWebhookReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeregisterWebhookNoContent), nil
+
+}
+
+/*
+ListWebhookDeliveries Lists the delivery log of a webhook.
+*/
+func (a *Client) ListWebhookDeliveries(ctx context.Context, params *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListWebhookDeliveries",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/webhooks/{webhook_id}/deliveries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListWebhookDeliveriesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListWebhookDeliveriesOK), nil
+
+}
+
+/*
+ListWebhooks Lists the webhooks that are registered for a cluster.
+*/
+func (a *Client) ListWebhooks(ctx context.Context, params *ListWebhooksParams) (*ListWebhooksOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListWebhooks",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListWebhooksReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListWebhooksOK), nil
+
+}
+
+/*
+RegisterWebhook Registers a webhook that is notified about the events and status changes of a cluster and its hosts.
+*/
+func (a *Client) RegisterWebhook(ctx context.Context, params *RegisterWebhookParams) (*RegisterWebhookCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RegisterWebhook",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RegisterWebhookReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RegisterWebhookCreated), nil
+
+}
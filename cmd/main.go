@@ -21,29 +21,38 @@ import (
 	"github.com/openshift/assisted-service/internal/bootfiles"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	aiv1beta1 "github.com/openshift/assisted-service/internal/controller/api/v1beta1"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
+	"github.com/openshift/assisted-service/internal/customvalidations"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/domains"
+	"github.com/openshift/assisted-service/internal/dryrun"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/imgexpirer"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/loganalysis"
+	"github.com/openshift/assisted-service/internal/logretention"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/migrations"
 	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/notifications"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
 	"github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/spec"
+	"github.com/openshift/assisted-service/internal/timeline"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -97,6 +106,7 @@ var Options struct {
 	Auth                        auth.Config
 	BMConfig                    bminventory.Config
 	DBConfig                    dbPkg.Config
+	DNSConfig                   dns.Config
 	HWValidatorConfig           hardware.ValidatorCfg
 	JobConfig                   job.Config
 	InstructionConfig           hostcommands.InstructionConfig
@@ -131,6 +141,9 @@ var Options struct {
 	HTTPSKeyFile                string        `envconfig:"HTTPS_KEY_FILE" default:""`
 	HTTPSCertFile               string        `envconfig:"HTTPS_CERT_FILE" default:""`
 	FileSystemUsageThreshold    int           `envconfig:"FILESYSTEM_USAGE_THRESHOLD" default:"80"`
+	NotificationsConfig         notifications.Config
+	LogAnalysisConfig           loganalysis.Config
+	LogRetentionConfig          logretention.Config
 }
 
 func InitLogs() *logrus.Entry {
@@ -199,7 +212,8 @@ func main() {
 	usageManager := usage.NewManager(log)
 
 	crdEventsHandler := createCRDEventsHandler()
-	eventsHandler := createEventsHandler(crdEventsHandler, db, log)
+	notificationsManager := notifications.NewManager(db, log.WithField("pkg", "notifications"), Options.NotificationsConfig)
+	eventsHandler := createEventsHandler(crdEventsHandler, notificationsManager, db, log)
 
 	prometheusRegistry := prometheus.DefaultRegisterer
 	metricsManager := metrics.NewMetricsManager(prometheusRegistry, eventsHandler)
@@ -233,7 +247,9 @@ func main() {
 	createS3Bucket(objectHandler, log)
 
 	manifestsApi := manifests.NewManifestsAPI(db, log.WithField("pkg", "manifests"), objectHandler)
-	operatorsManager := operators.NewManager(log, manifestsApi, Options.OperatorsConfig)
+	operatorPlugins, err := declarative.LoadOperators(log.WithField("pkg", "operators"), Options.OperatorsConfig.PluginsDir)
+	failOnError(err, "failed to load operator plugins from %s", Options.OperatorsConfig.PluginsDir)
+	operatorsManager := operators.NewManager(log, manifestsApi, Options.OperatorsConfig, operatorPlugins...)
 	hwValidator := hardware.NewValidator(log.WithField("pkg", "validators"), Options.HWValidatorConfig, operatorsManager)
 	connectivityValidator := connectivity.NewValidator(log.WithField("pkg", "validators"))
 	instructionApi := hostcommands.NewInstructionManager(log.WithField("pkg", "instructions"), db, hwValidator,
@@ -303,11 +319,13 @@ func main() {
 	failOnError(autoMigrationWithLeader(autoMigrationLeader, db, log), "Failed auto migration process")
 
 	hostApi := host.NewManager(log.WithField("pkg", "host-state"), db, eventsHandler, hwValidator,
-		instructionApi, &Options.HWValidatorConfig, metricsManager, &Options.HostConfig, lead, operatorsManager)
-	dnsApi := dns.NewDNSHandler(Options.BMConfig.BaseDNSDomains, log)
+		instructionApi, &Options.HWValidatorConfig, metricsManager, &Options.HostConfig, lead, operatorsManager, notificationsManager,
+		ignition.NewHostIgnitionDiffer(objectHandler))
+	dnsApi := dns.NewDNSHandler(Options.BMConfig.BaseDNSDomains, Options.DNSConfig, log)
 	manifestsGenerator := network.NewManifestsGenerator(manifestsApi)
 	clusterApi := cluster.NewManager(Options.ClusterConfig, log.WithField("pkg", "cluster-state"), db,
-		eventsHandler, hostApi, metricsManager, manifestsGenerator, lead, operatorsManager, ocmClient, objectHandler, dnsApi)
+		eventsHandler, hostApi, metricsManager, manifestsGenerator, lead, operatorsManager, ocmClient, objectHandler, dnsApi, notificationsManager,
+		installConfigBuilder)
 	bootFilesApi := bootfiles.NewBootFilesAPI(log.WithField("pkg", "bootfiles"), objectHandler)
 
 	clusterStateMonitor := thread.New(
@@ -320,6 +338,12 @@ func main() {
 	hostStateMonitor.Start()
 	defer hostStateMonitor.Stop()
 
+	webhookDeliveryWorker := notifications.NewDeliveryWorker(Options.NotificationsConfig, db, log.WithField("pkg", "webhook-delivery"), lead)
+	webhookDelivery := thread.New(
+		log.WithField("pkg", "webhook-delivery"), "Webhook Delivery", Options.NotificationsConfig.DeliveryInterval, webhookDeliveryWorker.DeliverPendingNotifications)
+	webhookDelivery.Start()
+	defer webhookDelivery.Stop()
+
 	newUrl, err = s3wrapper.FixEndpointURL(Options.BMConfig.S3EndpointURL)
 	failOnError(err, "failed to create valid bm config S3 endpoint URL from %s", Options.BMConfig.S3EndpointURL)
 	Options.BMConfig.S3EndpointURL = newUrl
@@ -357,11 +381,32 @@ func main() {
 		}
 	}
 
+	logAnalyzer := loganalysis.NewAnalyzer(Options.LogAnalysisConfig, db, log.WithField("pkg", "loganalysis"), objectHandler, eventsHandler)
+	logAnalyzer.Start()
+	defer logAnalyzer.Stop()
+	logRetention := logretention.NewManager(Options.LogRetentionConfig, db, log.WithField("pkg", "logretention"), objectHandler, eventsHandler, lead)
+	logRetentionWorker := thread.New(
+		log.WithField("pkg", "logretention"), "Log Retention Worker", Options.LogRetentionConfig.Interval, logRetention.EnforceRetention)
+	logRetentionWorker.Start()
+	defer logRetentionWorker.Stop()
+	dryRunRenderer := dryrun.NewRenderer(log.WithField("pkg", "dryrun"), dryrun.Config{
+		WorkDir:            Options.JobConfig.WorkDir,
+		ReleaseImageMirror: Options.JobConfig.ReleaseImageMirror,
+		ServiceCACertPath:  Options.JobConfig.ServiceCACertPath,
+		DummyIgnition:      Options.JobConfig.DummyIgnition,
+	}, objectHandler, operatorsManager)
+	clusterTemplatesApi := clustertemplates.NewApi(db, log.WithField("pkg", "clustertemplates"), operatorsManager)
 	bm := bminventory.NewBareMetalInventory(db, log.WithField("pkg", "Inventory"), hostApi, clusterApi, Options.BMConfig,
 		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, ocpClient, ocmClient,
-		lead, pullSecretValidator, versionHandler, isoEditorFactory, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder)
+		lead, pullSecretValidator, versionHandler, isoEditorFactory, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, logAnalyzer, logRetention,
+		dryRunRenderer, manifestsApi, clusterTemplatesApi, staticNetworkConfig)
 
-	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
+	eventsBroadcaster := events.NewBroadcaster()
+	go func() {
+		failOnError(eventsBroadcaster.Listen(context.Background(), getDBConnectionString(), log.WithField("pkg", "events-stream")),
+			"Failed to listen for events")
+	}()
+	events := events.NewApi(eventsHandler, eventsBroadcaster, logrus.WithField("pkg", "eventsApi"), db)
 	expirer := imgexpirer.NewManager(objectHandler, eventsHandler, Options.BMConfig.ImageExpirationTime, lead, Options.EnableKubeAPI)
 	imageExpirationMonitor := thread.New(
 		log.WithField("pkg", "image-expiration-monitor"), "Image Expiration Monitor", Options.ImageExpirationInterval, expirer.ExpirationTask)
@@ -395,6 +440,12 @@ func main() {
 		ManifestsAPI:          manifestsApi,
 		BootfilesAPI:          bootFilesApi,
 		OperatorsAPI:          operatorsHandler,
+		WebhooksAPI:           notificationsManager,
+		TimelineAPI:           timeline.NewApi(db, log.WithField("pkg", "timeline")),
+		HistoryAPI:            history.NewApi(db, log.WithField("pkg", "history")),
+		ValidationsAPI:        customvalidations.NewApi(db, log.WithField("pkg", "customvalidations"), Options.HostConfig.CustomHostValidations),
+		LogsAPI:               loganalysis.NewApi(db, log.WithField("pkg", "loganalysis")),
+		ClusterTemplatesAPI:   clusterTemplatesApi,
 	})
 	failOnError(err, "Failed to init rest handler")
 
@@ -512,9 +563,13 @@ func newISOInstallConfigGenerator(log *logrus.Entry, objectHandler s3wrapper.API
 	return configGenerator
 }
 
-func setupDB(log logrus.FieldLogger) *gorm.DB {
-	dbConnectionStr := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable",
+func getDBConnectionString() string {
+	return fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable",
 		Options.DBConfig.Host, Options.DBConfig.Port, Options.DBConfig.User, Options.DBConfig.Name, Options.DBConfig.Pass)
+}
+
+func setupDB(log logrus.FieldLogger) *gorm.DB {
+	dbConnectionStr := getDBConnectionString()
 	var db *gorm.DB
 	var err error
 	// Tries to open a db connection every 2 seconds for up to 10 seconds.
@@ -653,8 +708,8 @@ func autoMigrationWithLeader(migrationLeader leader.ElectorInterface, db *gorm.D
 	})
 }
 
-func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, db *gorm.DB, log logrus.FieldLogger) events.Handler {
-	eventsHandler := events.New(db, log.WithField("pkg", "events"))
+func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, notifier events.Listener, db *gorm.DB, log logrus.FieldLogger) events.Handler {
+	eventsHandler := events.New(db, log.WithField("pkg", "events"), notifier)
 
 	if crdEventsHandler != nil {
 		return controllers.NewControllerEventsWrapper(crdEventsHandler, eventsHandler, db, log)
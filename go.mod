@@ -29,6 +29,8 @@ require (
 	github.com/go-openapi/validate v0.19.10
 	github.com/golang-collections/go-datastructures v0.0.0-20150211160725-59788d5eb259
 	github.com/golang/mock v1.5.0
+	github.com/golang/protobuf v1.4.3
+	github.com/google/cel-go v0.7.3
 	github.com/google/renameio v0.1.0
 	github.com/google/uuid v1.1.2
 	github.com/hashicorp/go-multierror v1.1.0
@@ -37,7 +39,9 @@ require (
 	github.com/jinzhu/gorm v1.9.12
 	github.com/kelseyhightower/envconfig v1.4.0
 	github.com/kennygrant/sanitize v1.2.4
+	github.com/lib/pq v1.2.0
 	github.com/metal3-io/baremetal-operator v0.0.0-20210317131627-82fd2d7f8daa
+	github.com/miekg/dns v1.1.29
 	github.com/moby/moby v1.13.1
 	github.com/onsi/ginkgo v1.14.1
 	github.com/onsi/gomega v1.10.2
@@ -75,7 +79,6 @@ require (
 	k8s.io/utils v0.0.0-20201110183641-67b214c5f920
 	sigs.k8s.io/controller-runtime v0.7.2
 	sigs.k8s.io/yaml v1.2.0
-
 )
 
 replace (
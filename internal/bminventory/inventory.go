@@ -1,11 +1,13 @@
 package bminventory
 
 import (
+	"bytes"
 	"context"
 
 	// #nosec
 	"crypto/md5"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +15,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -27,9 +30,11 @@ import (
 	"github.com/kennygrant/sanitize"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
+	"github.com/openshift/assisted-service/internal/dryrun"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/hardware"
@@ -40,6 +45,8 @@ import (
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/loganalysis"
+	"github.com/openshift/assisted-service/internal/logretention"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
@@ -61,6 +68,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -100,6 +108,9 @@ type Config struct {
 
 const minimalOpenShiftVersionForSingleNode = "4.8"
 
+// minMtu is the minimal MTU of an IPv4 network, which the cluster create params enforce as well
+const minMtu = 576
+
 type OCPClusterAPI interface {
 	RegisterOCPCluster(ctx context.Context) error
 }
@@ -154,6 +165,12 @@ type bareMetalInventory struct {
 	IgnitionBuilder      ignition.IgnitionBuilder
 	hwValidator          hardware.Validator
 	installConfigBuilder installcfg.InstallConfigBuilder
+	logAnalyzer          loganalysis.Analyzer
+	logRetention         logretention.API
+	dryRunRenderer       dryrun.Renderer
+	manifestsApi         manifests.ClusterManifestsInternals
+	clusterTemplates     clustertemplates.Renderer
+	staticNetworkConfig  staticnetworkconfig.StaticNetworkConfig
 }
 
 func NewBareMetalInventory(
@@ -180,6 +197,12 @@ func NewBareMetalInventory(
 	hwValidator hardware.Validator,
 	dnsApi dns.DNSApi,
 	installConfigBuilder installcfg.InstallConfigBuilder,
+	logAnalyzer loganalysis.Analyzer,
+	logRetention logretention.API,
+	dryRunRenderer dryrun.Renderer,
+	manifestsApi manifests.ClusterManifestsInternals,
+	clusterTemplates clustertemplates.Renderer,
+	staticNetworkConfig staticnetworkconfig.StaticNetworkConfig,
 ) *bareMetalInventory {
 	return &bareMetalInventory{
 		db:                   db,
@@ -205,6 +228,12 @@ func NewBareMetalInventory(
 		IgnitionBuilder:      IgnitionBuilder,
 		hwValidator:          hwValidator,
 		installConfigBuilder: installConfigBuilder,
+		logAnalyzer:          logAnalyzer,
+		logRetention:         logRetention,
+		dryRunRenderer:       dryRunRenderer,
+		manifestsApi:         manifestsApi,
+		clusterTemplates:     clusterTemplates,
+		staticNetworkConfig:  staticNetworkConfig,
 	}
 }
 
@@ -280,13 +309,135 @@ func (b *bareMetalInventory) UpdateDiscoveryIgnitionInternal(ctx context.Context
 }
 
 func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params installer.RegisterClusterParams) middleware.Responder {
-	c, err := b.RegisterClusterInternal(ctx, nil, params)
+	var c *common.Cluster
+	var err error
+	if params.NewClusterParams.TemplateID != nil {
+		c, err = b.registerClusterFromTemplate(ctx, params)
+	} else if len(params.NewClusterParams.TemplateVariables) > 0 {
+		err = common.NewApiError(http.StatusBadRequest, errors.New("Template variables cannot be set without a cluster template"))
+	} else {
+		c, err = b.RegisterClusterInternal(ctx, nil, params)
+	}
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
 	return installer.NewRegisterClusterCreated().WithPayload(&c.Cluster)
 }
 
+// registerClusterFromTemplate registers a cluster with the settings of the cluster template that the parameters
+// don't set, and copies the install config overrides and the manifests of the template to the new cluster
+func (b *bareMetalInventory) registerClusterFromTemplate(ctx context.Context, params installer.RegisterClusterParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	templateID := *params.NewClusterParams.TemplateID
+
+	template, err := b.clusterTemplates.Render(ctx, templateID, clustertemplates.Variables{
+		Name:          swag.StringValue(params.NewClusterParams.Name),
+		BaseDNSDomain: params.NewClusterParams.BaseDNSDomain,
+		APIVip:        params.NewClusterParams.APIVip,
+		IngressVip:    params.NewClusterParams.IngressVip,
+		Variables:     params.NewClusterParams.TemplateVariables,
+	})
+	if err != nil {
+		return nil, err
+	}
+	applyClusterTemplate(params.NewClusterParams, template)
+
+	cluster, err := b.RegisterClusterInternal(ctx, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = b.copyClusterTemplateResources(ctx, *cluster.ID, template); err != nil {
+		log.WithError(err).Errorf("failed to copy cluster template %s to cluster %s, deregistering it", templateID, cluster.ID)
+		if deregisterErr := b.DeregisterClusterInternal(ctx, installer.DeregisterClusterParams{ClusterID: *cluster.ID}); deregisterErr != nil {
+			log.WithError(deregisterErr).Errorf("failed to deregister cluster %s", cluster.ID)
+		}
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Cluster was created from cluster template %s (%s) with %d custom manifests",
+		swag.StringValue(template.Name), templateID, len(template.Manifests))
+	b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityInfo, msg, time.Now())
+	return b.GetClusterInternal(ctx, installer.GetClusterParams{ClusterID: *cluster.ID})
+}
+
+// applyClusterTemplate sets the settings of the cluster template that the parameters don't set
+func applyClusterTemplate(params *models.ClusterCreateParams, template *models.ClusterTemplate) {
+	if swag.StringValue(params.OpenshiftVersion) == "" {
+		params.OpenshiftVersion = optionalString(template.OpenshiftVersion)
+	}
+	if params.ClusterNetworkCidr == nil {
+		params.ClusterNetworkCidr = optionalString(template.ClusterNetworkCidr)
+	}
+	if params.ClusterNetworkHostPrefix == 0 {
+		params.ClusterNetworkHostPrefix = template.ClusterNetworkHostPrefix
+	}
+	if params.ServiceNetworkCidr == nil {
+		params.ServiceNetworkCidr = optionalString(template.ServiceNetworkCidr)
+	}
+	if params.HTTPProxy == nil {
+		params.HTTPProxy = optionalString(template.HTTPProxy)
+	}
+	if params.HTTPSProxy == nil {
+		params.HTTPSProxy = optionalString(template.HTTPSProxy)
+	}
+	if params.NoProxy == nil {
+		params.NoProxy = optionalString(template.NoProxy)
+	}
+	if params.AdditionalNtpSource == nil {
+		params.AdditionalNtpSource = optionalString(template.AdditionalNtpSource)
+	}
+	if params.SSHPublicKey == "" {
+		params.SSHPublicKey = template.SSHPublicKey
+	}
+	if params.HighAvailabilityMode == nil {
+		params.HighAvailabilityMode = template.HighAvailabilityMode
+	}
+	if params.Hyperthreading == nil {
+		params.Hyperthreading = template.Hyperthreading
+	}
+	if params.UserManagedNetworking == nil {
+		params.UserManagedNetworking = template.UserManagedNetworking
+	}
+	if params.VipDhcpAllocation == nil {
+		params.VipDhcpAllocation = template.VipDhcpAllocation
+	}
+	if params.OlmOperators == nil {
+		params.OlmOperators = template.OlmOperators
+	}
+}
+
+func (b *bareMetalInventory) copyClusterTemplateResources(ctx context.Context, clusterID strfmt.UUID, template *models.ClusterTemplate) error {
+	if template.InstallConfigOverrides != "" {
+		if _, err := b.UpdateClusterInstallConfigInternal(ctx, installer.UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: template.InstallConfigOverrides,
+		}); err != nil {
+			return err
+		}
+	}
+	return b.createClusterManifests(ctx, clusterID, template.Manifests)
+}
+
+func (b *bareMetalInventory) createClusterManifests(ctx context.Context, clusterID strfmt.UUID, manifests []*models.CreateManifestParams) error {
+	for _, manifest := range manifests {
+		if _, err := b.manifestsApi.CreateClusterManifestInternal(ctx, operations.CreateClusterManifestParams{
+			ClusterID:            clusterID,
+			CreateManifestParams: manifest,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return swag.String(value)
+}
+
 func (b *bareMetalInventory) setDefaultRegisterClusterParams(_ context.Context, params installer.RegisterClusterParams) installer.RegisterClusterParams {
 	if params.NewClusterParams.ClusterNetworkCidr == nil {
 		params.NewClusterParams.ClusterNetworkCidr = &b.Config.DefaultClusterNetworkCidr
@@ -298,7 +449,8 @@ func (b *bareMetalInventory) setDefaultRegisterClusterParams(_ context.Context,
 		params.NewClusterParams.ServiceNetworkCidr = &b.Config.DefaultServiceNetworkCidr
 	}
 	if params.NewClusterParams.VipDhcpAllocation == nil {
-		params.NewClusterParams.VipDhcpAllocation = swag.Bool(true)
+		// The VIPs are allocated by DHCP unless the user picks the API VIP
+		params.NewClusterParams.VipDhcpAllocation = swag.Bool(params.NewClusterParams.APIVip == "")
 	}
 	if params.NewClusterParams.UserManagedNetworking == nil {
 		params.NewClusterParams.UserManagedNetworking = swag.Bool(false)
@@ -338,8 +490,20 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		}
 	}()
 
-	if err = validations.ValidateIPAddressFamily(b.IPv6Support, params.NewClusterParams.ClusterNetworkCidr, params.NewClusterParams.ServiceNetworkCidr,
-		&params.NewClusterParams.IngressVip); err != nil {
+	dualStackCidrs, err := verifyDualStackParams(params.NewClusterParams.MachineNetworks, params.NewClusterParams.ClusterNetworks, params.NewClusterParams.ServiceNetworks)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	setPrimaryNetworks(&params.NewClusterParams.ClusterNetworkCidr, &params.NewClusterParams.ClusterNetworkHostPrefix, &params.NewClusterParams.ServiceNetworkCidr,
+		params.NewClusterParams.ClusterNetworks, params.NewClusterParams.ServiceNetworks)
+
+	if err = validations.ValidateIPAddressFamily(b.IPv6Support, append(dualStackCidrs, params.NewClusterParams.ClusterNetworkCidr, params.NewClusterParams.ServiceNetworkCidr,
+		&params.NewClusterParams.APIVip, &params.NewClusterParams.IngressVip)...); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if swag.StringValue(params.NewClusterParams.OpenshiftVersion) == "" {
+		err = errors.New("Openshift version must be set when the cluster is not created from a cluster template")
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
@@ -377,6 +541,15 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			err = errors.Errorf("Ingress VIP cannot be set with User Managed Networking")
 			return nil, common.NewApiError(http.StatusBadRequest, err)
 		}
+		if params.NewClusterParams.APIVip != "" {
+			err = errors.Errorf("API VIP cannot be set with User Managed Networking")
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	if params.NewClusterParams.APIVip != "" && swag.BoolValue(params.NewClusterParams.VipDhcpAllocation) {
+		err = errors.Errorf("API VIP cannot be set with VIP DHCP Allocation")
+		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
 	if params.NewClusterParams.AdditionalNtpSource == nil {
@@ -421,6 +594,7 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			BaseDNSDomain:            params.NewClusterParams.BaseDNSDomain,
 			ClusterNetworkCidr:       swag.StringValue(params.NewClusterParams.ClusterNetworkCidr),
 			ClusterNetworkHostPrefix: params.NewClusterParams.ClusterNetworkHostPrefix,
+			APIVip:                   params.NewClusterParams.APIVip,
 			IngressVip:               params.NewClusterParams.IngressVip,
 			Name:                     swag.StringValue(params.NewClusterParams.Name),
 			OpenshiftVersion:         *openshiftVersion.ReleaseVersion,
@@ -440,6 +614,7 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			MonitoredOperators:       monitoredOperators,
 			HighAvailabilityMode:     params.NewClusterParams.HighAvailabilityMode,
 			Hyperthreading:           swag.StringValue(params.NewClusterParams.Hyperthreading),
+			Mtu:                      swag.Int64Value(params.NewClusterParams.Mtu),
 		},
 		KubeKeyName:      kubeKey.Name,
 		KubeKeyNamespace: kubeKey.Namespace,
@@ -472,6 +647,15 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if len(dualStackCidrs) > 0 {
+		if err = setDualStackNetworks(&cluster, params.NewClusterParams.MachineNetworks, params.NewClusterParams.ClusterNetworks, params.NewClusterParams.ServiceNetworks); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err = network.VerifyDualStackNetworks(&cluster, 0); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	if sshPublicKey := swag.StringValue(&cluster.SSHPublicKey); sshPublicKey != "" {
 		sshPublicKey = strings.TrimSpace(cluster.SSHPublicKey)
 		if err = validations.ValidateSSHPublicKey(sshPublicKey); err != nil {
@@ -1199,6 +1383,11 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 		return nil, common.NewApiError(http.StatusInternalServerError, errors.New("Failed to generated additional cluster manifest"))
 	}
 
+	if err = b.manifestsApi.AddSecondaryDisksManifests(ctx, cluster); err != nil {
+		log.WithError(err).Errorf("Failed to generate the secondary disks manifests")
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.New("Failed to generate the secondary disks manifests"))
+	}
+
 	// Delete previews installation log files from object storage (if exist).
 	if err := b.clusterApi.DeleteClusterLogs(ctx, cluster, b.objectHandler); err != nil {
 		log.WithError(err).Warnf("Failed deleting s3 logs of cluster %s", cluster.ID.String())
@@ -1378,6 +1567,502 @@ func (b *bareMetalInventory) InstallHosts(ctx context.Context, params installer.
 	return installer.NewInstallHostsAccepted().WithPayload(&cluster.Cluster)
 }
 
+func (b *bareMetalInventory) DryRunCluster(ctx context.Context, params installer.DryRunClusterParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	log.Infof("rendering the installation assets of cluster %s in a dry run", params.ClusterID)
+	cluster, err := common.GetClusterFromDBWithoutDisabledHosts(b.db, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if ok, reason := b.clusterApi.IsReadyForInstallation(cluster); !ok {
+		return common.NewApiError(http.StatusConflict,
+			errors.Errorf("Cluster is not ready for installation, %s", reason))
+	}
+	if err = b.assignDryRunRoles(ctx, cluster); err != nil {
+		log.WithError(err).Errorf("failed to assign the roles of the hosts of cluster %s in a dry run", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	cfg, err := b.installConfigBuilder.GetInstallConfig(cluster, b.Config.InstallRHCa, ignition.RedhatRootCA)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get install config for cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	releaseImage, err := b.versionsHandler.GetReleaseImage(cluster.OpenshiftVersion)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get release image for cluster %s with openshift version %s", params.ClusterID, cluster.OpenshiftVersion)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	bundle, err := b.dryRunRenderer.Render(ctx, cluster, cfg, releaseImage)
+	if err != nil {
+		log.WithError(err).Errorf("failed to render the installation assets of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	fileName := fmt.Sprintf("%s_%s_dry_run.tar.gz", sanitize.Name(cluster.Name), cluster.ID)
+	return filemiddleware.NewResponder(installer.NewDryRunClusterOK().WithPayload(ioutil.NopCloser(bytes.NewReader(bundle))),
+		fileName, int64(len(bundle)))
+}
+
+// assignDryRunRoles assigns the roles of the hosts and selects the bootstrap host the way an installation
+// would, but only in memory: the role assignment is rolled back and the bootstrap host is not stored
+func (b *bareMetalInventory) assignDryRunRoles(ctx context.Context, cluster *common.Cluster) error {
+	tx := b.db.Begin()
+	defer tx.Rollback()
+
+	for i := range cluster.Hosts {
+		if err := b.hostApi.AutoAssignRole(ctx, cluster.Hosts[i], tx); err != nil {
+			return err
+		}
+	}
+	for _, h := range cluster.Hosts {
+		if h.Bootstrap {
+			return nil
+		}
+	}
+	masterNodesIds, err := b.clusterApi.GetMasterNodesIds(ctx, cluster, tx)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to get cluster %s master node id's", cluster.ID)
+	}
+	if len(masterNodesIds) == 0 {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("Cluster have no master hosts that can operate as bootstrap"))
+	}
+	bootstrapId := masterNodesIds[len(masterNodesIds)-1]
+	for _, h := range cluster.Hosts {
+		if h.ID.String() == bootstrapId.String() {
+			h.Bootstrap = true
+		}
+	}
+	return nil
+}
+
+// clusterExportFormatVersion is the version of the cluster bundles that ExportCluster creates and
+// ImportCluster accepts
+const clusterExportFormatVersion = 1
+
+func (b *bareMetalInventory) ExportCluster(ctx context.Context, params installer.ExportClusterParams) middleware.Responder {
+	bundle, err := b.ExportClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewExportClusterOK().WithPayload(bundle)
+}
+
+func (b *bareMetalInventory) ExportClusterInternal(ctx context.Context, params installer.ExportClusterParams) (*models.ClusterExport, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterManifests, err := b.exportClusterManifests(ctx, cluster)
+	if err != nil {
+		log.WithError(err).Errorf("failed to export the manifests of cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	hostAssignments := make([]*models.HostAssignment, 0)
+	for _, h := range cluster.Hosts {
+		assignment, assignmentErr := exportHostAssignment(h)
+		if assignmentErr != nil {
+			log.WithError(assignmentErr).Warnf("failed to export the assignment of host %s", h.ID)
+			continue
+		}
+		if assignment != nil {
+			hostAssignments = append(hostAssignments, assignment)
+		}
+	}
+
+	staticNetworkConfig := ""
+	if cluster.ImageInfo != nil {
+		staticNetworkConfig = cluster.ImageInfo.StaticNetworkConfig
+	}
+
+	log.Infof("Exported cluster %s with %d manifests and %d host assignments", params.ClusterID, len(clusterManifests), len(hostAssignments))
+	return &models.ClusterExport{
+		FormatVersion:       swag.Int64(clusterExportFormatVersion),
+		ExportedAt:          strfmt.DateTime(time.Now()),
+		Cluster:             exportedCluster(cluster),
+		Manifests:           clusterManifests,
+		StaticNetworkConfig: staticNetworkConfig,
+		HostAssignments:     hostAssignments,
+	}, nil
+}
+
+// exportedCluster returns the configuration of the cluster, leaving out its state and secrets
+func exportedCluster(cluster *common.Cluster) *models.ExportedCluster {
+	monitoredOperators := make(models.MonitoredOperatorsList, 0, len(cluster.MonitoredOperators))
+	for _, operator := range cluster.MonitoredOperators {
+		monitoredOperators = append(monitoredOperators, &models.MonitoredOperator{
+			Name:             operator.Name,
+			Namespace:        operator.Namespace,
+			OperatorType:     operator.OperatorType,
+			Properties:       operator.Properties,
+			SubscriptionName: operator.SubscriptionName,
+			TimeoutSeconds:   operator.TimeoutSeconds,
+		})
+	}
+	return &models.ExportedCluster{
+		ID:                       *cluster.ID,
+		Name:                     swag.String(cluster.Name),
+		OpenshiftVersion:         swag.String(cluster.OpenshiftVersion),
+		BaseDNSDomain:            cluster.BaseDNSDomain,
+		ClusterNetworkCidr:       cluster.ClusterNetworkCidr,
+		ClusterNetworkHostPrefix: cluster.ClusterNetworkHostPrefix,
+		ServiceNetworkCidr:       cluster.ServiceNetworkCidr,
+		MachineNetworkCidr:       cluster.MachineNetworkCidr,
+		APIVip:                   cluster.APIVip,
+		IngressVip:               cluster.IngressVip,
+		SSHPublicKey:             cluster.SSHPublicKey,
+		HTTPProxy:                cluster.HTTPProxy,
+		HTTPSProxy:               cluster.HTTPSProxy,
+		NoProxy:                  cluster.NoProxy,
+		VipDhcpAllocation:        cluster.VipDhcpAllocation,
+		UserManagedNetworking:    cluster.UserManagedNetworking,
+		AdditionalNtpSource:      cluster.AdditionalNtpSource,
+		HighAvailabilityMode:     swag.StringValue(cluster.HighAvailabilityMode),
+		Hyperthreading:           cluster.Hyperthreading,
+		InstallConfigOverrides:   cluster.InstallConfigOverrides,
+		IgnitionConfigOverrides:  cluster.IgnitionConfigOverrides,
+		MonitoredOperators:       monitoredOperators,
+	}
+}
+
+func (b *bareMetalInventory) exportClusterManifests(ctx context.Context, cluster *common.Cluster) ([]*models.CreateManifestParams, error) {
+	listedManifests, err := b.manifestsApi.ListClusterManifestsInternal(ctx, operations.ListClusterManifestsParams{ClusterID: *cluster.ID})
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*models.CreateManifestParams, 0, len(listedManifests))
+	for _, manifest := range listedManifests {
+		objectName := manifests.GetManifestObjectName(*cluster.ID, filepath.Join(manifest.Folder, manifest.FileName))
+		respBody, _, err := b.objectHandler.Download(ctx, objectName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to download %s", objectName)
+		}
+		content, err := ioutil.ReadAll(respBody)
+		respBody.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", objectName)
+		}
+		exported = append(exported, &models.CreateManifestParams{
+			Folder:   swag.String(manifest.Folder),
+			FileName: swag.String(manifest.FileName),
+			Content:  swag.String(base64.StdEncoding.EncodeToString(content)),
+		})
+	}
+	return exported, nil
+}
+
+// exportHostAssignment returns the role, hostname and installation disk of the host keyed by the MAC addresses
+// and the serial number of the host, or nil if the host has not reported any of them
+func exportHostAssignment(h *models.Host) (*models.HostAssignment, error) {
+	if h.Inventory == "" {
+		return nil, nil
+	}
+	inventory, err := hostutil.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		return nil, err
+	}
+	macAddresses := inventoryMacAddresses(inventory)
+	serialNumber := ""
+	if inventory.SystemVendor != nil {
+		serialNumber = inventory.SystemVendor.SerialNumber
+	}
+	if len(macAddresses) == 0 && serialNumber == "" {
+		return nil, nil
+	}
+
+	role := models.HostRoleUpdateParams(h.Role)
+	switch h.Role {
+	case "":
+		role = models.HostRoleUpdateParamsAutoAssign
+	case models.HostRoleBootstrap:
+		role = models.HostRoleUpdateParamsMaster
+	}
+	return &models.HostAssignment{
+		MacAddresses:       macAddresses,
+		SerialNumber:       serialNumber,
+		Role:               role,
+		RequestedHostname:  h.RequestedHostname,
+		InstallationDiskID: h.InstallationDiskID,
+	}, nil
+}
+
+func inventoryMacAddresses(inventory *models.Inventory) []string {
+	macAddresses := make([]string, 0, len(inventory.Interfaces))
+	for _, iface := range inventory.Interfaces {
+		if iface.MacAddress != "" {
+			macAddresses = append(macAddresses, strings.ToLower(iface.MacAddress))
+		}
+	}
+	return macAddresses
+}
+
+func (b *bareMetalInventory) ImportCluster(ctx context.Context, params installer.ImportClusterParams) middleware.Responder {
+	c, err := b.ImportClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewImportClusterCreated().WithPayload(&c.Cluster)
+}
+
+func (b *bareMetalInventory) ImportClusterInternal(ctx context.Context, params installer.ImportClusterParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	bundle := params.ImportClusterParams.Bundle
+
+	if swag.Int64Value(bundle.FormatVersion) != clusterExportFormatVersion {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Unsupported cluster bundle format version %d, expected %d", swag.Int64Value(bundle.FormatVersion), clusterExportFormatVersion))
+	}
+
+	cluster, err := b.RegisterClusterInternal(ctx, nil, installer.RegisterClusterParams{
+		NewClusterParams: importedClusterCreateParams(params.ImportClusterParams),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = b.importClusterSettings(ctx, *cluster.ID, bundle); err != nil {
+		log.WithError(err).Errorf("failed to import the settings of cluster %s, deregistering it", cluster.ID)
+		if deregisterErr := b.DeregisterClusterInternal(ctx, installer.DeregisterClusterParams{ClusterID: *cluster.ID}); deregisterErr != nil {
+			log.WithError(deregisterErr).Errorf("failed to deregister cluster %s", cluster.ID)
+		}
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Cluster was imported from cluster %s with %d custom manifests and %d host assignments",
+		bundle.Cluster.ID, len(bundle.Manifests), len(bundle.HostAssignments))
+	b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityInfo, msg, time.Now())
+	log.Infof("Imported cluster %s from cluster %s", cluster.ID, bundle.Cluster.ID)
+	return b.GetClusterInternal(ctx, installer.GetClusterParams{ClusterID: *cluster.ID})
+}
+
+func importedClusterCreateParams(params *models.ImportClusterParams) *models.ClusterCreateParams {
+	exported := params.Bundle.Cluster
+	name := exported.Name
+	if params.Name != "" {
+		name = swag.String(params.Name)
+	}
+
+	olmOperators := make([]*models.OperatorCreateParams, 0)
+	for _, operator := range exported.MonitoredOperators {
+		if operator.OperatorType == models.OperatorTypeOlm {
+			olmOperators = append(olmOperators, &models.OperatorCreateParams{Name: operator.Name, Properties: operator.Properties})
+		}
+	}
+
+	return &models.ClusterCreateParams{
+		Name:                     name,
+		OpenshiftVersion:         exported.OpenshiftVersion,
+		PullSecret:               params.PullSecret,
+		BaseDNSDomain:            exported.BaseDNSDomain,
+		ClusterNetworkCidr:       optionalString(exported.ClusterNetworkCidr),
+		ClusterNetworkHostPrefix: exported.ClusterNetworkHostPrefix,
+		ServiceNetworkCidr:       optionalString(exported.ServiceNetworkCidr),
+		IngressVip:               exported.IngressVip,
+		SSHPublicKey:             exported.SSHPublicKey,
+		HTTPProxy:                optionalString(exported.HTTPProxy),
+		HTTPSProxy:               optionalString(exported.HTTPSProxy),
+		NoProxy:                  optionalString(exported.NoProxy),
+		VipDhcpAllocation:        exported.VipDhcpAllocation,
+		UserManagedNetworking:    exported.UserManagedNetworking,
+		AdditionalNtpSource:      optionalString(exported.AdditionalNtpSource),
+		HighAvailabilityMode:     optionalString(exported.HighAvailabilityMode),
+		Hyperthreading:           optionalString(exported.Hyperthreading),
+		OlmOperators:             olmOperators,
+	}
+}
+
+// importClusterSettings applies the settings of the bundle that cannot be set when the cluster is registered
+func (b *bareMetalInventory) importClusterSettings(ctx context.Context, clusterID strfmt.UUID, bundle *models.ClusterExport) error {
+	exported := bundle.Cluster
+
+	// The network settings are updated as a user would update them, so that the bundle gets the same validations
+	updateParams := &models.ClusterUpdateParams{}
+	switch {
+	case swag.BoolValue(exported.UserManagedNetworking):
+		if exported.HighAvailabilityMode == models.ClusterHighAvailabilityModeNone && exported.MachineNetworkCidr != "" {
+			updateParams.MachineNetworkCidr = swag.String(exported.MachineNetworkCidr)
+		}
+	case swag.BoolValue(exported.VipDhcpAllocation):
+		// The VIPs of the cluster are allocated again in its machine network
+		if exported.MachineNetworkCidr != "" {
+			updateParams.MachineNetworkCidr = swag.String(exported.MachineNetworkCidr)
+		}
+	default:
+		// The machine network of the cluster is calculated again from its API VIP and the networks of its hosts
+		if exported.APIVip != "" {
+			updateParams.APIVip = swag.String(exported.APIVip)
+		}
+	}
+	if updateParams.MachineNetworkCidr != nil || updateParams.APIVip != nil {
+		if _, err := b.UpdateClusterInternal(ctx, installer.UpdateClusterParams{
+			ClusterID:           clusterID,
+			ClusterUpdateParams: updateParams,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if bundle.StaticNetworkConfig != "" {
+		if err := b.importStaticNetworkConfig(clusterID, bundle.StaticNetworkConfig); err != nil {
+			return err
+		}
+	}
+
+	if exported.InstallConfigOverrides != "" {
+		if _, err := b.UpdateClusterInstallConfigInternal(ctx, installer.UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: exported.InstallConfigOverrides,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if exported.IgnitionConfigOverrides != "" {
+		if err := b.UpdateDiscoveryIgnitionInternal(ctx, installer.UpdateDiscoveryIgnitionParams{
+			ClusterID:               clusterID,
+			DiscoveryIgnitionParams: &models.DiscoveryIgnitionParams{Config: exported.IgnitionConfigOverrides},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := b.createClusterManifests(ctx, clusterID, bundle.Manifests); err != nil {
+		return err
+	}
+
+	for _, assignment := range bundle.HostAssignments {
+		hostAssignment := &common.HostAssignment{
+			ClusterID:          clusterID,
+			MacAddresses:       strings.ToLower(strings.Join(assignment.MacAddresses, ",")),
+			SerialNumber:       assignment.SerialNumber,
+			Role:               models.HostRole(assignment.Role),
+			RequestedHostname:  assignment.RequestedHostname,
+			InstallationDiskID: assignment.InstallationDiskID,
+		}
+		if hostAssignment.RequestedHostname != "" {
+			if err := hostutil.ValidateHostname(hostAssignment.RequestedHostname); err != nil {
+				return common.NewApiError(http.StatusBadRequest, err)
+			}
+		}
+		if err := b.db.Create(hostAssignment).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	return nil
+}
+
+// importStaticNetworkConfig validates the static network config of the bundle as ValidateStaticNetworkConfig does,
+// before it is used for the discovery images of the cluster
+func (b *bareMetalInventory) importStaticNetworkConfig(clusterID strfmt.UUID, staticNetworkConfig string) error {
+	hostsConfig, err := staticnetworkconfig.ParseStaticNetworkConfigFromDB(staticNetworkConfig)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	validation := b.staticNetworkConfig.ValidateStaticNetworkConfig(hostsConfig)
+	if !swag.BoolValue(validation.Valid) {
+		var validationErrors []string
+		for _, hostValidation := range validation.Hosts {
+			for _, validationError := range hostValidation.Errors {
+				validationErrors = append(validationErrors, fmt.Sprintf("host %d: %s", hostValidation.HostIndex, validationError.Message))
+			}
+		}
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Invalid static network config: %s", strings.Join(validationErrors, "; ")))
+	}
+	err = b.db.Model(&common.Cluster{}).Where("id = ?", clusterID).
+		Update("image_static_network_config", staticnetworkconfig.FormatStaticNetworkConfigForDB(hostsConfig)).Error
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// applyHostAssignment applies the imported assignment whose MAC addresses or serial number match the inventory of
+// the host, if there is one. Failing to apply an assignment does not fail the inventory update of the host.
+func (b *bareMetalInventory) applyHostAssignment(ctx context.Context, h *models.Host) error {
+	log := logutil.FromContext(ctx, b.log)
+
+	var assignments []*common.HostAssignment
+	if err := b.db.Where("cluster_id = ?", h.ClusterID).Find(&assignments).Error; err != nil {
+		return err
+	}
+	if len(assignments) == 0 {
+		return nil
+	}
+	inventory, err := hostutil.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		return err
+	}
+	assignment := matchHostAssignment(assignments, inventory)
+	if assignment == nil {
+		return nil
+	}
+
+	// Deleting the assignment first guarantees that it is applied to a single host
+	reply := b.db.Delete(assignment)
+	if reply.Error != nil {
+		return reply.Error
+	}
+	if reply.RowsAffected == 0 {
+		return nil
+	}
+
+	var applyErrors []string
+	if assignment.Role != "" && assignment.Role != h.Role {
+		if err = b.hostApi.UpdateRole(ctx, h, assignment.Role, b.db); err != nil {
+			applyErrors = append(applyErrors, fmt.Sprintf("role %s: %s", assignment.Role, err))
+		}
+	}
+	if assignment.RequestedHostname != "" {
+		if err = b.hostApi.UpdateHostname(ctx, h, assignment.RequestedHostname, b.db); err != nil {
+			applyErrors = append(applyErrors, fmt.Sprintf("hostname %s: %s", assignment.RequestedHostname, err))
+		}
+	}
+	if assignment.InstallationDiskID != "" && assignment.InstallationDiskID != h.InstallationDiskID {
+		if err = b.hostApi.UpdateInstallationDisk(ctx, b.db, h, assignment.InstallationDiskID); err != nil {
+			applyErrors = append(applyErrors, fmt.Sprintf("installation disk %s: %s", assignment.InstallationDiskID, err))
+		}
+	}
+
+	if len(applyErrors) > 0 {
+		msg := fmt.Sprintf("Host %s: failed to apply the imported host assignment: %s", hostutil.GetHostnameForMsg(h), strings.Join(applyErrors, "; "))
+		log.Warn(msg)
+		b.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning, msg, time.Now())
+		return nil
+	}
+	msg := fmt.Sprintf("Host %s: imported host assignment was applied", hostutil.GetHostnameForMsg(h))
+	log.Info(msg)
+	b.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityInfo, msg, time.Now())
+	return nil
+}
+
+// matchHostAssignment returns the assignment that shares a MAC address with the inventory, or else the one with the
+// serial number of the inventory
+func matchHostAssignment(assignments []*common.HostAssignment, inventory *models.Inventory) *common.HostAssignment {
+	macAddresses := inventoryMacAddresses(inventory)
+	for _, assignment := range assignments {
+		for _, macAddress := range strings.Split(assignment.MacAddresses, ",") {
+			if macAddress != "" && funk.ContainsString(macAddresses, macAddress) {
+				return assignment
+			}
+		}
+	}
+	if inventory.SystemVendor == nil || inventory.SystemVendor.SerialNumber == "" {
+		return nil
+	}
+	for _, assignment := range assignments {
+		if assignment.SerialNumber == inventory.SystemVendor.SerialNumber {
+			return assignment
+		}
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) setBootstrapHost(ctx context.Context, cluster common.Cluster, db *gorm.DB) error {
 	log := logutil.FromContext(ctx, b.log)
 
@@ -1425,6 +2110,20 @@ func (b *bareMetalInventory) GetClusterInstallConfig(ctx context.Context, params
 	return installer.NewGetClusterInstallConfigOK().WithPayload(string(cfg))
 }
 
+func (b *bareMetalInventory) GetClusterInstallConfigDiff(ctx context.Context, params installer.GetClusterInstallConfigDiffParams) middleware.Responder {
+	c, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	diff, err := b.installConfigBuilder.GetInstallConfigDiff(c)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	return installer.NewGetClusterInstallConfigDiffOK().WithPayload(diff)
+}
+
 func (b *bareMetalInventory) GetClusterDefaultConfig(_ context.Context, _ installer.GetClusterDefaultConfigParams) middleware.Responder {
 	body := models.ClusterDefaultConfig{}
 
@@ -1465,6 +2164,7 @@ func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Cont
 	}
 
 	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Custom install config was applied to the cluster", time.Now())
+	cluster.InstallConfigOverrides = params.InstallConfigParams
 	log.Infof("Custom install config was applied to cluster %s", params.ClusterID)
 	return &cluster, nil
 }
@@ -1591,8 +2291,20 @@ func (b *bareMetalInventory) validateAndUpdateClusterParams(ctx context.Context,
 		}
 	}
 
-	if err := validations.ValidateIPAddressFamily(b.IPv6Support, params.ClusterUpdateParams.ClusterNetworkCidr, params.ClusterUpdateParams.ServiceNetworkCidr,
-		params.ClusterUpdateParams.MachineNetworkCidr, params.ClusterUpdateParams.APIVip, params.ClusterUpdateParams.IngressVip); err != nil {
+	dualStackCidrs, err := verifyDualStackParams(params.ClusterUpdateParams.MachineNetworks, params.ClusterUpdateParams.ClusterNetworks, params.ClusterUpdateParams.ServiceNetworks)
+	if err != nil {
+		return installer.UpdateClusterParams{}, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if len(params.ClusterUpdateParams.ClusterNetworks) > 0 {
+		params.ClusterUpdateParams.ClusterNetworkCidr = swag.String(params.ClusterUpdateParams.ClusterNetworks[0].Cidr)
+		params.ClusterUpdateParams.ClusterNetworkHostPrefix = swag.Int64(params.ClusterUpdateParams.ClusterNetworks[0].HostPrefix)
+	}
+	if len(params.ClusterUpdateParams.ServiceNetworks) > 0 {
+		params.ClusterUpdateParams.ServiceNetworkCidr = swag.String(params.ClusterUpdateParams.ServiceNetworks[0].Cidr)
+	}
+
+	if err := validations.ValidateIPAddressFamily(b.IPv6Support, append(dualStackCidrs, params.ClusterUpdateParams.ClusterNetworkCidr, params.ClusterUpdateParams.ServiceNetworkCidr,
+		params.ClusterUpdateParams.MachineNetworkCidr, params.ClusterUpdateParams.APIVip, params.ClusterUpdateParams.IngressVip)...); err != nil {
 		return installer.UpdateClusterParams{}, common.NewApiError(http.StatusBadRequest, err)
 	}
 
@@ -1616,6 +2328,18 @@ func (b *bareMetalInventory) validateAndUpdateClusterParams(ctx context.Context,
 		return installer.UpdateClusterParams{}, err
 	}
 
+	if params.ClusterUpdateParams.RoleAssignmentPolicy != nil {
+		if err := host.ValidateRoleAssignmentPolicy(params.ClusterUpdateParams.RoleAssignmentPolicy); err != nil {
+			return installer.UpdateClusterParams{}, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	if params.ClusterUpdateParams.DiskSelectionPolicy != nil {
+		if err := hardware.ValidateDiskSelectionPolicy(params.ClusterUpdateParams.DiskSelectionPolicy); err != nil {
+			return installer.UpdateClusterParams{}, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	return *params, nil
 }
 
@@ -1831,6 +2555,8 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 	optionalParam(params.ClusterUpdateParams.NoProxy, "no_proxy", updates)
 	optionalParam(params.ClusterUpdateParams.SSHPublicKey, "ssh_public_key", updates)
 	optionalParam(params.ClusterUpdateParams.Hyperthreading, "hyperthreading", updates)
+	setRoleAssignmentPolicy(params.ClusterUpdateParams.RoleAssignmentPolicy, updates)
+	setDiskSelectionPolicy(params.ClusterUpdateParams.DiskSelectionPolicy, updates)
 
 	b.setProxyUsage(params.ClusterUpdateParams.HTTPProxy, params.ClusterUpdateParams.HTTPSProxy, params.ClusterUpdateParams.NoProxy, usages)
 
@@ -1871,6 +2597,39 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 	return nil
 }
 
+// setRoleAssignmentPolicy replaces the role assignment policy of the cluster, if the parameters set one
+func setRoleAssignmentPolicy(policy *models.RoleAssignmentPolicy, updates map[string]interface{}) {
+	if policy == nil {
+		return
+	}
+	spreadBy := swag.StringValue(policy.SpreadBy)
+	if spreadBy == "" {
+		spreadBy = models.RoleAssignmentPolicySpreadByNone
+	}
+	updates["role_assignment_master_hostname_pattern"] = policy.MasterHostnamePattern
+	updates["role_assignment_exclude_virtual_masters"] = policy.ExcludeVirtualMasters
+	updates["role_assignment_prefer_most_resources"] = policy.PreferMostResources
+	updates["role_assignment_spread_by"] = spreadBy
+	updates["role_assignment_serial_number_prefix_length"] = policy.SerialNumberPrefixLength
+}
+
+// setDiskSelectionPolicy replaces the disk selection policy of the cluster, if the parameters set one
+func setDiskSelectionPolicy(policy *models.DiskSelectionPolicy, updates map[string]interface{}) {
+	if policy == nil {
+		return
+	}
+	matchBy := swag.StringValue(policy.MatchBy)
+	if matchBy == "" {
+		matchBy = models.DiskSelectionPolicyMatchByNone
+	}
+	updates["disk_selection_match_by"] = matchBy
+	updates["disk_selection_match_pattern"] = policy.MatchPattern
+	updates["disk_selection_avoid_partitioned_disks"] = policy.AvoidPartitionedDisks
+	updates["disk_selection_prefer_ssd"] = policy.PreferSsd
+	updates["disk_selection_prefer_fastest"] = policy.PreferFastest
+	updates["disk_selection_prefer_smallest"] = policy.PreferSmallest
+}
+
 func (b *bareMetalInventory) updateNetworkParams(params installer.UpdateClusterParams, cluster *common.Cluster, updates map[string]interface{}, usages map[string]models.Usage, log logrus.FieldLogger) error {
 	var err error
 	machineCidr := cluster.MachineNetworkCidr
@@ -1907,6 +2666,12 @@ func (b *bareMetalInventory) updateNetworkParams(params installer.UpdateClusterP
 		serviceCidr = *params.ClusterUpdateParams.ServiceNetworkCidr
 		updates["service_network_cidr"] = serviceCidr
 	}
+	if params.ClusterUpdateParams.Mtu != nil {
+		if mtu := *params.ClusterUpdateParams.Mtu; mtu != 0 && mtu < minMtu {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("MTU %d is lower than the minimal MTU %d", mtu, minMtu))
+		}
+		updates["mtu"] = *params.ClusterUpdateParams.Mtu
+	}
 
 	if params.ClusterUpdateParams.UserManagedNetworking != nil && swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking) != userManagedNetworking {
 		userManagedNetworking = swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking)
@@ -1959,10 +2724,123 @@ func (b *bareMetalInventory) updateNetworkParams(params installer.UpdateClusterP
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if err = updateDualStackNetworks(params.ClusterUpdateParams, cluster, machineCidr, clusterCidr, hostNetworkPrefix, serviceCidr, updates); err != nil {
+		return err
+	}
+
 	b.setUsage(vipDhcpAllocation, usage.VipDhcpAllocationUsage, nil, usages)
 	return nil
 }
 
+// verifyDualStackParams checks that the dual-stack networks are of distinct IP address families, the IPv4 ones first,
+// and returns their CIDRs
+func verifyDualStackParams(machineNetworks []*models.MachineNetwork, clusterNetworks []*models.ClusterNetwork, serviceNetworks []*models.ServiceNetwork) ([]*string, error) {
+	var machineCidrs, clusterCidrs, serviceCidrs []string
+	for _, n := range machineNetworks {
+		machineCidrs = append(machineCidrs, n.Cidr)
+	}
+	for _, n := range clusterNetworks {
+		clusterCidrs = append(clusterCidrs, n.Cidr)
+	}
+	for _, n := range serviceNetworks {
+		serviceCidrs = append(serviceCidrs, n.Cidr)
+	}
+	if err := network.VerifyNetworkFamilies("Machine", machineCidrs); err != nil {
+		return nil, err
+	}
+	if err := network.VerifyNetworkFamilies("Cluster", clusterCidrs); err != nil {
+		return nil, err
+	}
+	if err := network.VerifyNetworkFamilies("Service", serviceCidrs); err != nil {
+		return nil, err
+	}
+	ret := make([]*string, 0)
+	for _, cidrs := range [][]string{machineCidrs, clusterCidrs, serviceCidrs} {
+		for i := range cidrs {
+			ret = append(ret, &cidrs[i])
+		}
+	}
+	return ret, nil
+}
+
+// setPrimaryNetworks sets the single network fields from the first dual-stack networks
+func setPrimaryNetworks(clusterCidr **string, hostPrefix *int64, serviceCidr **string, clusterNetworks []*models.ClusterNetwork, serviceNetworks []*models.ServiceNetwork) {
+	if len(clusterNetworks) > 0 {
+		*clusterCidr = swag.String(clusterNetworks[0].Cidr)
+		*hostPrefix = clusterNetworks[0].HostPrefix
+	}
+	if len(serviceNetworks) > 0 {
+		*serviceCidr = swag.String(serviceNetworks[0].Cidr)
+	}
+}
+
+func marshalNetworks(networks interface{}, count int) (string, error) {
+	if count == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(networks)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal networks")
+	}
+	return string(b), nil
+}
+
+func setDualStackNetworks(cluster *common.Cluster, machineNetworks []*models.MachineNetwork, clusterNetworks []*models.ClusterNetwork, serviceNetworks []*models.ServiceNetwork) error {
+	var err error
+	if cluster.MachineNetworks, err = marshalNetworks(machineNetworks, len(machineNetworks)); err != nil {
+		return err
+	}
+	if cluster.ClusterNetworks, err = marshalNetworks(clusterNetworks, len(clusterNetworks)); err != nil {
+		return err
+	}
+	cluster.ServiceNetworks, err = marshalNetworks(serviceNetworks, len(serviceNetworks))
+	return err
+}
+
+// updateDualStackNetworks updates the dual-stack networks of the cluster and verifies them along with the single
+// network fields that the update sets
+func updateDualStackNetworks(params *models.ClusterUpdateParams, cluster *common.Cluster, machineCidr, clusterCidr string, hostNetworkPrefix int64,
+	serviceCidr string, updates map[string]interface{}) error {
+	updated := common.Cluster{Cluster: models.Cluster{
+		MachineNetworkCidr:       machineCidr,
+		ClusterNetworkCidr:       clusterCidr,
+		ClusterNetworkHostPrefix: hostNetworkPrefix,
+		ServiceNetworkCidr:       serviceCidr,
+		MachineNetworks:          cluster.MachineNetworks,
+		ClusterNetworks:          cluster.ClusterNetworks,
+		ServiceNetworks:          cluster.ServiceNetworks,
+		UserManagedNetworking:    cluster.UserManagedNetworking,
+	}}
+	if value, ok := updates["user_managed_networking"]; ok {
+		updated.UserManagedNetworking = swag.Bool(value.(bool))
+	}
+	if params.MachineNetworks == nil && params.ClusterNetworks == nil && params.ServiceNetworks == nil && !network.IsDualStack(&updated) {
+		return nil
+	}
+	if err := setDualStackNetworks(&updated, params.MachineNetworks, params.ClusterNetworks, params.ServiceNetworks); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if params.MachineNetworks == nil {
+		updated.MachineNetworks = cluster.MachineNetworks
+	} else {
+		updates["machine_networks"] = updated.MachineNetworks
+	}
+	if params.ClusterNetworks == nil {
+		updated.ClusterNetworks = cluster.ClusterNetworks
+	} else {
+		updates["cluster_networks"] = updated.ClusterNetworks
+	}
+	if params.ServiceNetworks == nil {
+		updated.ServiceNetworks = cluster.ServiceNetworks
+	} else {
+		updates["service_networks"] = updated.ServiceNetworks
+	}
+	if err := network.VerifyDualStackNetworks(&updated, len(cluster.Hosts)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	return nil
+}
+
 func setCommonUserNetworkManagedParams(params *models.ClusterUpdateParams, singleNodeCluster bool, machineCidr string, updates map[string]interface{}, log logrus.FieldLogger) (error, bool) {
 	err := validateUserManagedNetworkConflicts(params, singleNodeCluster, log)
 	if err != nil {
@@ -2127,6 +3005,27 @@ func (b *bareMetalInventory) updateHostNames(ctx context.Context, params install
 	return nil
 }
 
+// updateHostsInstallationDisks picks the installation disks of the hosts again when the disk selection policy of the
+// cluster changes, before the disks that the user picks in the same update are set
+func (b *bareMetalInventory) updateHostsInstallationDisks(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
+	if params.ClusterUpdateParams.DiskSelectionPolicy == nil {
+		return nil
+	}
+	var hosts []*models.Host
+	if err := db.Find(&hosts, "cluster_id = ?", params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the hosts of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	for _, h := range hosts {
+		if err := b.hostApi.SelectInstallationDisk(ctx, db, h, params.ClusterUpdateParams.DiskSelectionPolicy); err != nil {
+			log.WithError(err).Errorf("failed to select the installation disk of host <%s> in cluster <%s>",
+				h.ID, params.ClusterID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) updateHostsDiskSelection(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
 	for i := range params.ClusterUpdateParams.DisksSelectedConfig {
 		disksConfig := params.ClusterUpdateParams.DisksSelectedConfig[i]
@@ -2141,6 +3040,13 @@ func (b *bareMetalInventory) updateHostsDiskSelection(ctx context.Context, param
 			return models.DiskRoleInstall == diskConfigParams.Role
 		}).([]*models.DiskConfigParams)
 
+		secondaryDisks := funk.Filter(disksConfig.DisksConfig, func(diskConfigParams *models.DiskConfigParams) bool {
+			return models.DiskRoleInstall != diskConfigParams.Role
+		}).([]*models.DiskConfigParams)
+		assignsSecondaryDisks := funk.Contains(secondaryDisks, func(diskConfigParams *models.DiskConfigParams) bool {
+			return diskConfigParams.Role != models.DiskRoleNone && diskConfigParams.Role != ""
+		})
+
 		installationDiskId := ""
 
 		if len(disksToInstallOn) > 1 {
@@ -2149,14 +3055,25 @@ func (b *bareMetalInventory) updateHostsDiskSelection(ctx context.Context, param
 			installationDiskId = *disksToInstallOn[0].ID
 		}
 
-		log.Infof("Update host %s to install from disk id %s", hostId, installationDiskId)
-		err = b.hostApi.UpdateInstallationDisk(ctx, db, &host.Host, installationDiskId)
-		if err != nil {
-			log.WithError(err).Errorf("failed to set installation disk path <%s> host <%s> in cluster <%s>",
-				installationDiskId,
-				hostId,
-				params.ClusterID)
-			return common.NewApiError(http.StatusConflict, err)
+		// Assigning secondary disks keeps the installation disk of the host
+		if len(disksToInstallOn) > 0 || !assignsSecondaryDisks {
+			log.Infof("Update host %s to install from disk id %s", hostId, installationDiskId)
+			err = b.hostApi.UpdateInstallationDisk(ctx, db, &host.Host, installationDiskId)
+			if err != nil {
+				log.WithError(err).Errorf("failed to set installation disk path <%s> host <%s> in cluster <%s>",
+					installationDiskId,
+					hostId,
+					params.ClusterID)
+				return common.NewApiError(http.StatusConflict, err)
+			}
+		}
+
+		if assignsSecondaryDisks || (len(secondaryDisks) > 0 && host.SecondaryDisks != "") {
+			log.Infof("Update secondary disks of host %s", hostId)
+			if err = b.hostApi.UpdateSecondaryDisks(ctx, db, &host.Host, secondaryDisks); err != nil {
+				log.WithError(err).Errorf("failed to set secondary disks of host <%s> in cluster <%s>", hostId, params.ClusterID)
+				return err
+			}
 		}
 	}
 	return nil
@@ -2185,6 +3102,26 @@ func (b *bareMetalInventory) updateHostsMachineConfigPoolNames(ctx context.Conte
 	return nil
 }
 
+func (b *bareMetalInventory) updateHostsRackLabels(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
+	for i := range params.ClusterUpdateParams.HostsRackLabels {
+		rackLabel := params.ClusterUpdateParams.HostsRackLabels[i]
+		log.Infof("Update host %s to rack label %s", rackLabel.ID, rackLabel.RackLabel)
+		host, err := common.GetHostFromDB(db, params.ClusterID.String(), rackLabel.ID.String())
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				rackLabel.ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		err = b.hostApi.UpdateRackLabel(ctx, db, &host.Host, rackLabel.RackLabel)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set rack label <%s> host <%s> in cluster <%s>",
+				rackLabel.RackLabel, rackLabel.ID, params.ClusterID)
+			return common.NewApiError(http.StatusConflict, err)
+		}
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) updateHostsData(ctx context.Context, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
 	if err := b.updateHostRoles(ctx, params, db, log); err != nil {
 		return err
@@ -2194,6 +3131,10 @@ func (b *bareMetalInventory) updateHostsData(ctx context.Context, params install
 		return err
 	}
 
+	if err := b.updateHostsInstallationDisks(ctx, params, db, log); err != nil {
+		return err
+	}
+
 	if err := b.updateHostsDiskSelection(ctx, params, db, log); err != nil {
 		return err
 	}
@@ -2202,6 +3143,10 @@ func (b *bareMetalInventory) updateHostsData(ctx context.Context, params install
 		return err
 	}
 
+	if err := b.updateHostsRackLabels(ctx, params, db, log); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -2287,7 +3232,7 @@ func (b *bareMetalInventory) calculateHostNetworks(log logrus.FieldLogger, clust
 			log.WithError(err).Warnf("Could not parse inventory of host %s", *h.ID)
 			continue
 		}
-		for _, intf := range inventory.Interfaces {
+		for _, intf := range network.LogicalInterfaces(inventory.Interfaces) {
 			var addrRange []string
 			if b.Config.IPv6Support {
 				addrRange = append(intf.IPV4Addresses, intf.IPV6Addresses...)
@@ -2394,6 +3339,15 @@ func (b *bareMetalInventory) GetClusterInternal(ctx context.Context, params inst
 	return cluster, nil
 }
 
+func (b *bareMetalInventory) ValidateStaticNetworkConfig(ctx context.Context, params installer.ValidateStaticNetworkConfigParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	validation := b.staticNetworkConfig.ValidateStaticNetworkConfig(params.StaticNetworkConfigValidateParams.StaticNetworkConfig)
+	if !swag.BoolValue(validation.Valid) {
+		log.Infof("Static network config of %d hosts is invalid", len(params.StaticNetworkConfigValidateParams.StaticNetworkConfig))
+	}
+	return installer.NewValidateStaticNetworkConfigOK().WithPayload(validation)
+}
+
 func (b *bareMetalInventory) GetHostRequirements(_ context.Context, params installer.GetHostRequirementsParams) middleware.Responder {
 	requirements := b.hwValidator.GetHostRequirements(swag.BoolValue(params.SingleNode))
 	return installer.NewGetHostRequirementsOK().WithPayload(
@@ -2933,6 +3887,11 @@ func handleReplyByType(params installer.PostStepReplyParams, b *bareMetalInvento
 	switch params.Reply.StepType {
 	case models.StepTypeInventory:
 		err = b.hostApi.UpdateInventory(ctx, &host, stepReply)
+		if err == nil {
+			if assignmentErr := b.applyHostAssignment(ctx, &host); assignmentErr != nil {
+				logutil.FromContext(ctx, b.log).WithError(assignmentErr).Warnf("failed to apply the imported host assignment to host %s", host.ID)
+			}
+		}
 	case models.StepTypeConnectivityCheck:
 		err = b.hostApi.UpdateConnectivityReport(ctx, &host, stepReply)
 	case models.StepTypeAPIVipConnectivityCheck:
@@ -3388,6 +4347,33 @@ func (b *bareMetalInventory) GetHostIgnition(ctx context.Context, params install
 	return installer.NewGetHostIgnitionOK().WithPayload(&models.HostIgnitionParams{Config: string(respBytes)})
 }
 
+func (b *bareMetalInventory) GetHostIgnitionDiff(ctx context.Context, params installer.GetHostIgnitionDiffParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	h, err := b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	diff, err := b.getHostIgnitionDiff(ctx, &h.Host)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the ignition config differences of host %s", params.HostID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	return installer.NewGetHostIgnitionDiffOK().WithPayload(diff)
+}
+
+// getHostIgnitionDiff returns the differences between the ignition config generated for the host and the one
+// the host gets after its ignition config overrides
+func (b *bareMetalInventory) getHostIgnitionDiff(ctx context.Context, host *models.Host) (*models.ConfigDiff, error) {
+	roleConfig, err := ignition.RoleIgnition(ctx, b.objectHandler, host)
+	if err != nil {
+		return nil, err
+	}
+	return ignition.HostIgnitionDiff(roleConfig, host)
+}
+
 func (b *bareMetalInventory) DownloadHostIgnition(ctx context.Context, params installer.DownloadHostIgnitionParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	fileName, respBody, contentLength, err := b.downloadHostIgnition(ctx, params.ClusterID.String(), params.HostID.String())
@@ -3849,6 +4835,27 @@ func (b *bareMetalInventory) ResetHost(ctx context.Context, params installer.Res
 	return installer.NewResetHostOK().WithPayload(&host.Host)
 }
 
+func (b *bareMetalInventory) CollectHostLogs(ctx context.Context, params installer.CollectHostLogsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Requesting logs collection from host %s in cluster %s", params.HostID, params.ClusterID)
+
+	if _, err := b.getCluster(ctx, params.ClusterID.String()); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	host, err := b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = b.hostApi.RequestLogsCollection(ctx, &host.Host, params.CollectLogsParams); err != nil {
+		log.WithError(err).Errorf("failed to request logs collection from host %s", params.HostID)
+		return common.GenerateErrorResponder(err)
+	}
+	if host, err = b.getHost(ctx, params.ClusterID.String(), params.HostID.String()); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewCollectHostLogsAccepted().WithPayload(&host.Host)
+}
+
 func (b *bareMetalInventory) CompleteInstallation(ctx context.Context, params installer.CompleteInstallationParams) middleware.Responder {
 	// TODO: MGMT-4458
 	// This function can be removed once the controller will stop sending this request
@@ -3968,6 +4975,22 @@ func (b *bareMetalInventory) GetFreeAddresses(ctx context.Context, params instal
 	return installer.NewGetFreeAddressesOK().WithPayload(results)
 }
 
+func (b *bareMetalInventory) GetClusterNetworkTopology(ctx context.Context, params installer.GetClusterNetworkTopologyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	topology, err := network.BuildNetworkTopology(cluster, log)
+	if err != nil {
+		log.WithError(err).Errorf("failed to build the network topology of cluster %s", params.ClusterID.String())
+		return common.GenerateErrorResponder(err)
+	}
+	topology.Dot = network.NetworkTopologyDOT(topology)
+	return installer.NewGetClusterNetworkTopologyOK().WithPayload(topology)
+}
+
 func (b *bareMetalInventory) UpdateClusterLogsProgress(ctx context.Context, params installer.UpdateClusterLogsProgressParams) middleware.Responder {
 	var err error
 	var currentCluster *common.Cluster
@@ -4022,25 +5045,40 @@ func (b *bareMetalInventory) uploadLogs(ctx context.Context, params installer.Up
 		}
 	}()
 
+	currentCluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return err
+	}
 	if params.LogsType == string(models.LogsTypeHost) {
-		err := b.uploadHostLogs(ctx, params.ClusterID.String(), params.HostID.String(), params.Upfile)
+		err = b.uploadHostLogs(ctx, currentCluster, params.HostID.String(), params.Upfile, getUploadSize(params.HTTPRequest))
 		if err != nil {
 			return err
 		}
 		return nil
 	}
 
-	currentCluster, err := b.getCluster(ctx, params.ClusterID.String())
+	fileName := b.getLogsFullName(params.ClusterID.String(), params.LogsType)
+	if err = b.logRetention.CheckQuota(ctx, currentCluster, fileName, getUploadSize(params.HTTPRequest)); err != nil {
+		log.WithError(err).Warnf("Rejecting upload of %s", fileName)
+		return err
+	}
+	upload, err := b.logRetention.LimitUpload(ctx, currentCluster, fileName, params.Upfile)
 	if err != nil {
 		return err
 	}
-	fileName := b.getLogsFullName(params.ClusterID.String(), params.LogsType)
 	log.Debugf("Start upload log file %s to bucket %s", fileName, b.S3Bucket)
-	err = b.objectHandler.UploadStream(ctx, params.Upfile, fileName)
+	err = b.objectHandler.UploadStream(ctx, upload, fileName)
 	if err != nil {
+		if quotaErr := upload.QuotaErr(); quotaErr != nil {
+			log.WithError(quotaErr).Warnf("Rejecting upload of %s", fileName)
+			return quotaErr
+		}
 		log.WithError(err).Errorf("Failed to upload %s to s3", fileName)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	if err = b.logRetention.RecordUpload(ctx, currentCluster, params.HostID, models.LogsType(params.LogsType), fileName); err != nil {
+		log.WithError(err).Warnf("Failed to record the size of %s", fileName)
+	}
 	if params.LogsType == string(models.LogsTypeController) {
 		err = b.clusterApi.SetUploadControllerLogsAt(ctx, currentCluster, b.db)
 		if err != nil {
@@ -4053,26 +5091,43 @@ func (b *bareMetalInventory) uploadLogs(ctx context.Context, params installer.Up
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 	}
+	b.logAnalyzer.AnalyzeLogs(ctx, params.ClusterID, params.HostID, models.LogsType(params.LogsType), fileName)
 
 	log.Infof("Done uploading file %s", fileName)
 	return nil
 }
 
-func (b *bareMetalInventory) uploadHostLogs(ctx context.Context, clusterId string, hostId string, upFile io.ReadCloser) error {
+func (b *bareMetalInventory) uploadHostLogs(ctx context.Context, cluster *common.Cluster, hostId string, upFile io.ReadCloser, size int64) error {
 	log := logutil.FromContext(ctx, b.log)
+	clusterId := cluster.ID.String()
 	currentHost, err := b.getHost(ctx, clusterId, hostId)
 	if err != nil {
 		return err
 	}
 
 	fileName := b.getLogsFullName(clusterId, hostId)
+	if err = b.logRetention.CheckQuota(ctx, cluster, fileName, size); err != nil {
+		log.WithError(err).Warnf("Rejecting upload of %s for host %s", fileName, hostId)
+		return err
+	}
 
+	upload, err := b.logRetention.LimitUpload(ctx, cluster, fileName, upFile)
+	if err != nil {
+		return err
+	}
 	log.Debugf("Start upload log file %s to bucket %s", fileName, b.S3Bucket)
-	err = b.objectHandler.UploadStream(ctx, upFile, fileName)
+	err = b.objectHandler.UploadStream(ctx, upload, fileName)
 	if err != nil {
+		if quotaErr := upload.QuotaErr(); quotaErr != nil {
+			log.WithError(quotaErr).Warnf("Rejecting upload of %s for host %s", fileName, hostId)
+			return quotaErr
+		}
 		log.WithError(err).Errorf("Failed to upload %s to s3 for host %s", fileName, hostId)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	if err = b.logRetention.RecordUpload(ctx, cluster, currentHost.ID, models.LogsTypeHost, fileName); err != nil {
+		log.WithError(err).Warnf("Failed to record the size of %s", fileName)
+	}
 
 	err = b.hostApi.SetUploadLogsAt(ctx, &currentHost.Host, b.db)
 	if err != nil {
@@ -4084,6 +5139,7 @@ func (b *bareMetalInventory) uploadHostLogs(ctx context.Context, clusterId strin
 		log.WithError(err).Errorf("Failed update host %s log progress %s", hostId, string(models.LogsStateCollecting))
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	b.logAnalyzer.AnalyzeLogs(ctx, currentHost.ClusterID, currentHost.ID, models.LogsTypeHost, fileName)
 	return nil
 }
 
@@ -4146,6 +5202,21 @@ func (b *bareMetalInventory) prepareClusterLogs(ctx context.Context, cluster *co
 	return fileName, nil
 }
 
+// getUploadSize returns the size of the uploaded file, or the length of the whole request if the size
+// of the file is unknown. Uploads of unknown size are only checked against the quota while they are
+// streamed.
+func getUploadSize(request *http.Request) int64 {
+	if request.MultipartForm != nil {
+		if files := request.MultipartForm.File["upfile"]; len(files) > 0 {
+			return files[0].Size
+		}
+	}
+	if request.ContentLength > 0 {
+		return request.ContentLength
+	}
+	return 0
+}
+
 func (b *bareMetalInventory) getLogsFullName(clusterId string, logId string) string {
 	return fmt.Sprintf("%s/logs/%s/logs.tar.gz", clusterId, logId)
 }
@@ -3,6 +3,7 @@ package bminventory
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -33,9 +34,11 @@ import (
 	amgmtv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
+	"github.com/openshift/assisted-service/internal/dryrun"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/hardware"
@@ -43,7 +46,11 @@ import (
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/loganalysis"
+	"github.com/openshift/assisted-service/internal/logretention"
+	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
@@ -54,8 +61,10 @@ import (
 	"github.com/openshift/assisted-service/pkg/k8sclient"
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/types"
@@ -82,6 +91,12 @@ var (
 	mockHwValidator          *hardware.MockValidator
 	mockIgnitionBuilder      *ignition.MockIgnitionBuilder
 	mockInstallConfigBuilder *installcfg.MockInstallConfigBuilder
+	mockLogAnalyzer          *loganalysis.MockAnalyzer
+	mockLogRetention         *logretention.MockAPI
+	mockDryRunRenderer       *dryrun.MockRenderer
+	mockManifestsApi         *manifests.MockClusterManifestsInternals
+	mockClusterTemplates     *clustertemplates.MockRenderer
+	mockStaticNetworkConfig  *staticnetworkconfig.MockStaticNetworkConfig
 	secondDayWorkerIgnition  = []byte(`{
 		"ignition": {
 		  "version": "3.1.0",
@@ -1898,6 +1913,7 @@ var _ = Describe("cluster", func() {
 
 	mockGenerateAdditionalManifestsSuccess := func() {
 		mockClusterApi.EXPECT().GenerateAdditionalManifests(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockManifestsApi.EXPECT().AddSecondaryDisksManifests(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	}
 
 	sortedHosts := func(arr []strfmt.UUID) []strfmt.UUID {
@@ -2037,7 +2053,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("happy flow", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(bm, true)
 			noneHaMode := models.ClusterHighAvailabilityModeNone
@@ -2062,7 +2078,7 @@ var _ = Describe("cluster", func() {
 
 		It("create non ha cluster fail", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			noneHaMode := models.ClusterHighAvailabilityModeNone
 			insufficientOpenShiftVersionForNoneHA := "4.7"
 			reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
@@ -2312,7 +2328,7 @@ var _ = Describe("cluster", func() {
 			Context("RegisterCluster", func() {
 				BeforeEach(func() {
 					bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-						db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil)
+						db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				})
 
 				It("OLM register default value - only builtins", func() {
@@ -2860,6 +2876,201 @@ var _ = Describe("cluster", func() {
 			})
 		})
 
+		Context("Role assignment policy", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("sets the policy", func() {
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						RoleAssignmentPolicy: &models.RoleAssignmentPolicy{
+							ExcludeVirtualMasters:    true,
+							PreferMostResources:      true,
+							SpreadBy:                 swag.String(models.RoleAssignmentPolicySpreadBySerialNumberPrefix),
+							SerialNumberPrefixLength: 4,
+						},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+				c, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.RoleAssignmentPolicy).To(Equal(&models.RoleAssignmentPolicy{
+					ExcludeVirtualMasters:    true,
+					PreferMostResources:      true,
+					SpreadBy:                 swag.String(models.RoleAssignmentPolicySpreadBySerialNumberPrefix),
+					SerialNumberPrefixLength: 4,
+				}))
+			})
+
+			It("rejects an invalid hostname pattern", func() {
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						RoleAssignmentPolicy: &models.RoleAssignmentPolicy{MasterHostnamePattern: "^cp-("},
+					},
+				})
+				verifyApiError(reply, http.StatusBadRequest)
+			})
+		})
+
+		Context("Disk selection policy", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("sets the policy", func() {
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				policy := &models.DiskSelectionPolicy{
+					MatchBy:               swag.String(models.DiskSelectionPolicyMatchByByPath),
+					MatchPattern:          "^pci-0000:00:1f.2-ata-1",
+					AvoidPartitionedDisks: true,
+					PreferSsd:             true,
+				}
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID:           clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{DiskSelectionPolicy: policy},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+				c, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.DiskSelectionPolicy).To(Equal(policy))
+			})
+
+			It("picks the installation disks of the hosts again", func() {
+				hostID := strfmt.UUID(uuid.New().String())
+				addHost(hostID, models.HostRoleWorker, models.HostStatusKnown, models.HostKindHost, clusterID, getInventoryStr("hostname0", "bootMode", "1.2.3.4/24", "10.11.50.90/16"), db)
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				policy := &models.DiskSelectionPolicy{PreferSsd: true}
+				mockHostApi.EXPECT().SelectInstallationDisk(gomock.Any(), gomock.Any(), gomock.Any(), policy).
+					DoAndReturn(func(_ context.Context, _ *gorm.DB, h *models.Host, _ *models.DiskSelectionPolicy) error {
+						Expect(*h.ID).To(Equal(hostID))
+						return nil
+					}).Times(1)
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID:           clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{DiskSelectionPolicy: policy},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+			})
+
+			It("rejects a match without a pattern", func() {
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						DiskSelectionPolicy: &models.DiskSelectionPolicy{MatchBy: swag.String(models.DiskSelectionPolicyMatchByWwn)},
+					},
+				})
+				verifyApiError(reply, http.StatusBadRequest)
+			})
+		})
+
+		Context("Dual-stack networks", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID:                       &clusterID,
+					ClusterNetworkCidr:       "10.128.0.0/14",
+					ClusterNetworkHostPrefix: 23,
+					ServiceNetworkCidr:       "172.30.0.0/16",
+				}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("sets the networks of both families", func() {
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						MachineNetworks: []*models.MachineNetwork{{Cidr: "1001:db8::/120"}},
+						ClusterNetworks: []*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}, {Cidr: "fd01::/48", HostPrefix: 64}},
+						ServiceNetworks: []*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}, {Cidr: "fd02::/112"}},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+				c, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(network.IsDualStack(c)).To(BeTrue())
+				Expect(network.MachineNetworkCidrs(c)).To(Equal([]string{"1001:db8::/120"}))
+				Expect(network.ServiceNetworks(c)).To(Equal([]*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}, {Cidr: "fd02::/112"}}))
+			})
+
+			It("rejects networks of the same family", func() {
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						ClusterNetworks: []*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}, {Cidr: "10.132.0.0/14", HostPrefix: 23}},
+					},
+				})
+				verifyApiError(reply, http.StatusBadRequest)
+			})
+
+			It("rejects cluster and service networks of different families", func() {
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						ClusterNetworks: []*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}, {Cidr: "fd01::/48", HostPrefix: 64}},
+					},
+				})
+				verifyApiError(reply, http.StatusBadRequest)
+			})
+		})
+
+		Context("MTU", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID:  &clusterID,
+					Mtu: 1500,
+				}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			updateMtu := func(mtu int64) middleware.Responder {
+				return bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID:           clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{Mtu: swag.Int64(mtu)},
+				})
+			}
+
+			It("sets and unsets the MTU", func() {
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(2)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				mockSetConnectivityMajorityGroupsForClusterTimes(mockClusterApi, 2)
+				Expect(updateMtu(9000)).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+				c, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Mtu).To(BeEquivalentTo(9000))
+
+				Expect(updateMtu(0)).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+				c, err = common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Mtu).To(BeZero())
+			})
+
+			It("rejects an MTU lower than the minimal MTU", func() {
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				verifyApiError(updateMtu(500), http.StatusBadRequest)
+			})
+		})
+
 		Context("Hostname", func() {
 			BeforeEach(func() {
 				clusterID = strfmt.UUID(uuid.New().String())
@@ -3038,6 +3249,46 @@ var _ = Describe("cluster", func() {
 				})
 				verifyApiError(reply, http.StatusConflict)
 			})
+
+			It("assigns secondary disks and keeps the install disk", func() {
+				mockHostApi.EXPECT().UpdateSecondaryDisks(gomock.Any(), gomock.Any(), gomock.Any(), []*models.DiskConfigParams{
+					{ID: &diskID2, Role: models.DiskRoleVarLibContainers},
+				}).Return(nil).Times(1)
+				mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Cluster{}, nil).Times(1)
+				mockHostApi.EXPECT().RefreshInventory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						DisksSelectedConfig: []*models.ClusterUpdateParamsDisksSelectedConfigItems0{
+							{
+								DisksConfig: []*models.DiskConfigParams{{ID: &diskID2, Role: models.DiskRoleVarLibContainers}},
+								ID:          masterHostId1,
+							},
+						},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+			})
+
+			It("rejects invalid secondary disks", func() {
+				mockHostApi.EXPECT().UpdateSecondaryDisks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(common.NewApiError(http.StatusBadRequest, errors.New("not part of the inventory"))).Times(1)
+				reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{
+						DisksSelectedConfig: []*models.ClusterUpdateParamsDisksSelectedConfigItems0{
+							{
+								DisksConfig: []*models.DiskConfigParams{{ID: &diskID2, Role: models.DiskRoleVarLibEtcd}},
+								ID:          masterHostId1,
+							},
+						},
+					},
+				})
+				verifyApiError(reply, http.StatusBadRequest)
+			})
 		})
 
 		Context("Day2 api vip dnsname/ip", func() {
@@ -4126,7 +4377,7 @@ var _ = Describe("UploadClusterIngressCert test", func() {
 		bm = createInventory(db, cfg)
 		mockOperators := operators.NewMockAPI(ctrl)
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil)
+			db, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:               &clusterID,
 			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
@@ -4417,6 +4668,13 @@ var _ = Describe("Upload and Download logs test", func() {
 		ctrl.Finish()
 	})
 
+	expectLimitUpload := func(fileName string, limit int64) {
+		mockLogRetention.EXPECT().LimitUpload(gomock.Any(), gomock.Any(), fileName, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *common.Cluster, _ string, upload io.Reader) (*logretention.UploadReader, error) {
+				return logretention.NewUploadReader(upload, limit), nil
+			}).Times(1)
+	}
+
 	It("Upload logs cluster not exits", func() {
 		clusterId := strToUUID(uuid.New().String())
 		params := installer.UploadHostLogsParams{
@@ -4448,9 +4706,61 @@ var _ = Describe("Upload and Download logs test", func() {
 			HTTPRequest: request,
 		}
 		fileName := bm.getLogsFullName(clusterID.String(), host.ID.String())
+		mockLogRetention.EXPECT().CheckQuota(gomock.Any(), gomock.Any(), fileName, gomock.Any()).Return(nil).Times(1)
+		expectLimitUpload(fileName, logretention.Unlimited)
 		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fileName).Return(errors.Errorf("Dummy")).Times(1)
 		verifyApiError(bm.UploadHostLogs(ctx, params), http.StatusInternalServerError)
 	})
+	It("Upload Hosts logs over quota while streaming", func() {
+		newHostID := strfmt.UUID(uuid.New().String())
+		host := addHost(newHostID, models.HostRoleMaster, "known", models.HostKindHost, clusterID, "{}", db)
+		params := installer.UploadHostLogsParams{
+			ClusterID:   clusterID,
+			HostID:      *host.ID,
+			Upfile:      ioutil.NopCloser(strings.NewReader("logs that are longer than the quota")),
+			HTTPRequest: request,
+		}
+		fileName := bm.getLogsFullName(clusterID.String(), host.ID.String())
+		mockLogRetention.EXPECT().CheckQuota(gomock.Any(), gomock.Any(), fileName, gomock.Any()).Return(nil).Times(1)
+		expectLimitUpload(fileName, 10)
+		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fileName).DoAndReturn(
+			func(_ context.Context, reader io.Reader, _ string) error {
+				_, err := ioutil.ReadAll(reader)
+				return errors.Wrap(err, "upload failed")
+			}).Times(1)
+		verifyApiError(bm.UploadHostLogs(ctx, params), http.StatusRequestEntityTooLarge)
+	})
+	It("Upload Hosts logs over quota", func() {
+		newHostID := strfmt.UUID(uuid.New().String())
+		host := addHost(newHostID, models.HostRoleMaster, "known", models.HostKindHost, clusterID, "{}", db)
+		params := installer.UploadHostLogsParams{
+			ClusterID:   clusterID,
+			HostID:      *host.ID,
+			Upfile:      kubeconfigFile,
+			HTTPRequest: request,
+		}
+		fileName := bm.getLogsFullName(clusterID.String(), host.ID.String())
+		mockLogRetention.EXPECT().CheckQuota(gomock.Any(), gomock.Any(), fileName, request.MultipartForm.File["upfile"][0].Size).
+			Return(common.NewApiError(http.StatusRequestEntityTooLarge, errors.New("over quota"))).Times(1)
+		verifyApiError(bm.UploadHostLogs(ctx, params), http.StatusRequestEntityTooLarge)
+	})
+	It("Collect host logs on demand", func() {
+		params := installer.CollectHostLogsParams{
+			ClusterID:         clusterID,
+			HostID:            hostID,
+			CollectLogsParams: &models.HostLogsCollectionParams{JournalUnits: []string{"kubelet.service"}},
+		}
+		mockHostApi.EXPECT().RequestLogsCollection(gomock.Any(), gomock.Any(), params.CollectLogsParams).Return(nil).Times(1)
+		reply := bm.CollectHostLogs(ctx, params)
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewCollectHostLogsAccepted()))
+	})
+	It("Collect host logs of a missing host", func() {
+		params := installer.CollectHostLogsParams{
+			ClusterID: clusterID,
+			HostID:    strfmt.UUID(uuid.New().String()),
+		}
+		verifyApiError(bm.CollectHostLogs(ctx, params), http.StatusNotFound)
+	})
 	It("Upload Hosts logs Happy flow", func() {
 
 		newHostID := strfmt.UUID(uuid.New().String())
@@ -4462,9 +4772,13 @@ var _ = Describe("Upload and Download logs test", func() {
 			HTTPRequest: request,
 		}
 		fileName := bm.getLogsFullName(clusterID.String(), host.ID.String())
+		mockLogRetention.EXPECT().CheckQuota(gomock.Any(), gomock.Any(), fileName, gomock.Any()).Return(nil).Times(1)
+		expectLimitUpload(fileName, logretention.Unlimited)
 		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fileName).Return(nil).Times(1)
+		mockLogRetention.EXPECT().RecordUpload(gomock.Any(), gomock.Any(), host.ID, models.LogsTypeHost, fileName).Return(nil).Times(1)
 		mockHostApi.EXPECT().SetUploadLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockHostApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
+		mockLogAnalyzer.EXPECT().AnalyzeLogs(gomock.Any(), clusterID, host.ID, models.LogsTypeHost, fileName).Times(1)
 		reply := bm.UploadHostLogs(ctx, params)
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewUploadHostLogsNoContent()))
 	})
@@ -4507,9 +4821,13 @@ var _ = Describe("Upload and Download logs test", func() {
 			LogsType:    string(models.LogsTypeController),
 		}
 		fileName := bm.getLogsFullName(clusterID.String(), string(models.LogsTypeController))
+		mockLogRetention.EXPECT().CheckQuota(gomock.Any(), gomock.Any(), fileName, gomock.Any()).Return(nil).Times(1)
+		expectLimitUpload(fileName, logretention.Unlimited)
 		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fileName).Return(nil).Times(1)
+		mockLogRetention.EXPECT().RecordUpload(gomock.Any(), gomock.Any(), nil, models.LogsTypeController, fileName).Return(nil).Times(1)
 		mockClusterApi.EXPECT().SetUploadControllerLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockClusterApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
+		mockLogAnalyzer.EXPECT().AnalyzeLogs(gomock.Any(), clusterID, nil, models.LogsTypeController, fileName).Times(1)
 		reply := bm.UploadLogs(ctx, params)
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewUploadLogsNoContent()))
 	})
@@ -4796,6 +5114,593 @@ var _ = Describe("GetClusterInstallConfig", func() {
 	})
 })
 
+var _ = Describe("DryRunCluster", func() {
+	var (
+		bm           *bareMetalInventory
+		cfg          Config
+		db           *gorm.DB
+		ctx          = context.Background()
+		clusterID    strfmt.UUID
+		masterHostID strfmt.UUID
+		autoHostID   strfmt.UUID
+		dbName       string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		masterHostID = strfmt.UUID(uuid.New().String())
+		autoHostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		err := db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Name:             "dry",
+			BaseDNSDomain:    "example.com",
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+			Status:           swag.String(models.ClusterStatusReady),
+		}}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		addHost(masterHostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, clusterID, getInventoryStr("hostname0", "bootMode", "1.2.3.4/24"), db)
+		addHost(autoHostID, models.HostRoleAutoAssign, models.HostStatusKnown, models.HostKindHost, clusterID, getInventoryStr("hostname1", "bootMode", "1.2.3.5/24"), db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	mockAutoAssignRole := func() {
+		mockHostApi.EXPECT().AutoAssignRole(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, h *models.Host, tx *gorm.DB) error {
+				if h.Role != models.HostRoleAutoAssign {
+					return nil
+				}
+				h.Role = models.HostRoleWorker
+				return tx.Model(&models.Host{}).Where("id = ?", h.ID.String()).UpdateColumn("role", h.Role).Error
+			}).Times(2)
+	}
+
+	It("renders the installation assets without changing the cluster", func() {
+		mockClusterApi.EXPECT().IsReadyForInstallation(gomock.Any()).Return(true, "").Times(1)
+		mockAutoAssignRole()
+		mockClusterApi.EXPECT().GetMasterNodesIds(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*strfmt.UUID{&masterHostID}, nil).Times(1)
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), false, gomock.Any()).Return([]byte("install config"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(common.TestDefaultConfig.OpenShiftVersion).Return("releaseImage", nil).Times(1)
+		mockDryRunRenderer.EXPECT().Render(gomock.Any(), gomock.Any(), []byte("install config"), "releaseImage").
+			DoAndReturn(func(_ context.Context, c *common.Cluster, _ []byte, _ string) ([]byte, error) {
+				Expect(c.Hosts).To(HaveLen(2))
+				for _, h := range c.Hosts {
+					Expect(h.Role).NotTo(Equal(models.HostRoleAutoAssign))
+					Expect(h.Bootstrap).To(Equal(*h.ID == masterHostID))
+				}
+				return []byte("bundle"), nil
+			}).Times(1)
+
+		reply := bm.DryRunCluster(ctx, installer.DryRunClusterParams{ClusterID: clusterID})
+		Expect(reply).Should(Equal(filemiddleware.NewResponder(
+			installer.NewDryRunClusterOK().WithPayload(ioutil.NopCloser(bytes.NewReader([]byte("bundle")))),
+			fmt.Sprintf("dry_%s_dry_run.tar.gz", clusterID), 6)))
+
+		By("rolling back the role assignment and not storing the bootstrap host")
+		c, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(c.Status)).To(Equal(models.ClusterStatusReady))
+		for _, h := range c.Hosts {
+			Expect(h.Bootstrap).To(BeFalse())
+			if *h.ID == autoHostID {
+				Expect(h.Role).To(Equal(models.HostRoleAutoAssign))
+			}
+		}
+	})
+
+	It("fails when the cluster is not ready for installation", func() {
+		mockClusterApi.EXPECT().IsReadyForInstallation(gomock.Any()).Return(false, "not ready").Times(1)
+		reply := bm.DryRunCluster(ctx, installer.DryRunClusterParams{ClusterID: clusterID})
+		verifyApiError(reply, http.StatusConflict)
+	})
+
+	It("fails when the cluster does not exist", func() {
+		reply := bm.DryRunCluster(ctx, installer.DryRunClusterParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("fails when the assets cannot be rendered", func() {
+		mockClusterApi.EXPECT().IsReadyForInstallation(gomock.Any()).Return(true, "").Times(1)
+		mockAutoAssignRole()
+		mockClusterApi.EXPECT().GetMasterNodesIds(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*strfmt.UUID{&masterHostID}, nil).Times(1)
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), false, gomock.Any()).Return([]byte("install config"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any()).Return("releaseImage", nil).Times(1)
+		mockDryRunRenderer.EXPECT().Render(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("openshift-install failed")).Times(1)
+
+		reply := bm.DryRunCluster(ctx, installer.DryRunClusterParams{ClusterID: clusterID})
+		verifyApiError(reply, http.StatusInternalServerError)
+	})
+})
+
+var _ = Describe("ExportCluster", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		err := db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:                     &clusterID,
+			Name:                   "exported",
+			OpenshiftVersion:       common.TestDefaultConfig.OpenShiftVersion,
+			BaseDNSDomain:          "example.com",
+			APIVip:                 "1.2.3.100",
+			MachineNetworkCidr:     "1.2.3.0/24",
+			VipDhcpAllocation:      swag.Bool(false),
+			Status:                 swag.String(models.ClusterStatusReady),
+			InstallConfigOverrides: `{"fips": true}`,
+			ImageInfo:              &models.ImageInfo{StaticNetworkConfig: "static network config"},
+			MonitoredOperators: []*models.MonitoredOperator{
+				{Name: "lso", OperatorType: models.OperatorTypeOlm, Status: models.OperatorStatusAvailable, Properties: "properties"},
+			},
+		}, PullSecret: "secret"}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		host := addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, clusterID,
+			`{"interfaces": [{"mac_address": "AA:BB:CC:DD:EE:FF"}], "system_vendor": {"serial_number": "serial"}}`, db)
+		Expect(db.Model(&host).Updates(map[string]interface{}{"requested_hostname": "master-0", "installation_disk_id": "/dev/disk/by-id/wwn-1"}).Error).
+			ShouldNot(HaveOccurred())
+		addHost(strfmt.UUID(uuid.New().String()), models.HostRoleAutoAssign, models.HostStatusDiscovering, models.HostKindHost, clusterID, "", db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("exports the cluster configuration, manifests and host assignments", func() {
+		manifestContent := "apiVersion: v1\nkind: ConfigMap\n"
+		mockManifestsApi.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).
+			Return(models.ListManifests{{Folder: "openshift", FileName: "custom.yaml"}}, nil).Times(1)
+		mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/manifests/openshift/custom.yaml", clusterID)).
+			Return(ioutil.NopCloser(strings.NewReader(manifestContent)), int64(len(manifestContent)), nil).Times(1)
+
+		reply := bm.ExportCluster(ctx, installer.ExportClusterParams{ClusterID: clusterID})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewExportClusterOK()))
+		bundle := reply.(*installer.ExportClusterOK).Payload
+		Expect(swag.Int64Value(bundle.FormatVersion)).To(Equal(int64(clusterExportFormatVersion)))
+		Expect(bundle.Cluster.ID).To(Equal(clusterID))
+		Expect(swag.StringValue(bundle.Cluster.Name)).To(Equal("exported"))
+		Expect(bundle.Cluster.APIVip).To(Equal("1.2.3.100"))
+		Expect(bundle.Cluster.InstallConfigOverrides).To(Equal(`{"fips": true}`))
+		Expect(bundle.Cluster.MonitoredOperators).To(Equal(models.MonitoredOperatorsList{
+			{Name: "lso", OperatorType: models.OperatorTypeOlm, Properties: "properties"},
+		}))
+		Expect(bundle.StaticNetworkConfig).To(Equal("static network config"))
+		Expect(bundle.Manifests).To(Equal([]*models.CreateManifestParams{{
+			Folder:   swag.String("openshift"),
+			FileName: swag.String("custom.yaml"),
+			Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(manifestContent))),
+		}}))
+		Expect(bundle.HostAssignments).To(Equal([]*models.HostAssignment{{
+			MacAddresses:       []string{"aa:bb:cc:dd:ee:ff"},
+			SerialNumber:       "serial",
+			Role:               models.HostRoleUpdateParamsMaster,
+			RequestedHostname:  "master-0",
+			InstallationDiskID: "/dev/disk/by-id/wwn-1",
+		}}))
+	})
+
+	It("returns not found for a non-existent cluster", func() {
+		reply := bm.ExportCluster(ctx, installer.ExportClusterParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+})
+
+var _ = Describe("ImportCluster", func() {
+	var (
+		bm     *bareMetalInventory
+		cfg    Config
+		db     *gorm.DB
+		ctx    = context.Background()
+		dbName string
+		bundle *models.ClusterExport
+	)
+
+	const pullSecret = "{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"
+
+	staticNetworkConfig := []*models.HostStaticNetworkConfig{{
+		NetworkYaml:     "interfaces:\n- name: eth0\n  type: ethernet\n  state: up\n",
+		MacInterfaceMap: models.MacInterfaceMap{{MacAddress: "aa:bb:cc:dd:ee:ff", LogicalNicName: "eth0"}},
+	}}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperatorManager, nil, mockS3Client, nil, nil, nil)
+		mockUsageReports()
+		mockOperatorManager.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		bundle = &models.ClusterExport{
+			FormatVersion: swag.Int64(clusterExportFormatVersion),
+			Cluster: &models.ExportedCluster{
+				ID:                       strfmt.UUID(uuid.New().String()),
+				Name:                     swag.String("exported"),
+				OpenshiftVersion:         swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				BaseDNSDomain:            "example.com",
+				ClusterNetworkCidr:       "10.128.0.0/14",
+				ClusterNetworkHostPrefix: 23,
+				ServiceNetworkCidr:       "172.30.0.0/16",
+				MachineNetworkCidr:       "1.2.3.0/24",
+				APIVip:                   "1.2.3.100",
+				IngressVip:               "1.2.3.101",
+				VipDhcpAllocation:        swag.Bool(false),
+				InstallConfigOverrides:   `{"fips": true}`,
+			},
+			Manifests: []*models.CreateManifestParams{{
+				Folder:   swag.String("openshift"),
+				FileName: swag.String("custom.yaml"),
+				Content:  swag.String(base64.StdEncoding.EncodeToString([]byte("apiVersion: v1\nkind: ConfigMap\n"))),
+			}},
+			StaticNetworkConfig: staticnetworkconfig.FormatStaticNetworkConfigForDB(staticNetworkConfig),
+			HostAssignments: []*models.HostAssignment{{
+				MacAddresses:      []string{"AA:BB:CC:DD:EE:FF", "aa:bb:cc:dd:ee:00"},
+				Role:              models.HostRoleUpdateParamsMaster,
+				RequestedHostname: "master-0",
+			}},
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	importCluster := func() middleware.Responder {
+		return bm.ImportCluster(ctx, installer.ImportClusterParams{ImportClusterParams: &models.ImportClusterParams{
+			Name:       "imported",
+			PullSecret: swag.String(pullSecret),
+			Bundle:     bundle,
+		}})
+	}
+
+	It("registers a cluster with the settings of the bundle", func() {
+		mockClusterRegisterSteps()
+		mockMetric.EXPECT().ClusterRegistered(common.TestDefaultConfig.ReleaseVersion, gomock.Any(), gomock.Any()).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), nil, models.EventSeverityInfo,
+			fmt.Sprintf("Cluster was imported from cluster %s with 1 custom manifests and 1 host assignments", bundle.Cluster.ID), gomock.Any()).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).AnyTimes()
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), `{"fips": true}`).Return(nil).Times(1)
+		mockStaticNetworkConfig.EXPECT().ValidateStaticNetworkConfig(staticNetworkConfig).
+			Return(&models.StaticNetworkConfigValidation{Valid: swag.Bool(true)}).Times(1)
+		mockManifestsApi.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).
+			Return(&models.Manifest{Folder: "openshift", FileName: "custom.yaml"}, nil).Times(1)
+
+		reply := importCluster()
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewImportClusterCreated()))
+		clusterID := *reply.(*installer.ImportClusterCreated).Payload.ID
+		Expect(clusterID).NotTo(Equal(bundle.Cluster.ID))
+
+		imported, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(imported.Name).To(Equal("imported"))
+		Expect(imported.PullSecret).To(Equal(pullSecret))
+		Expect(imported.APIVip).To(Equal("1.2.3.100"))
+		// The machine network is calculated from the API VIP once the hosts are discovered
+		Expect(imported.MachineNetworkCidr).To(BeEmpty())
+		Expect(imported.IngressVip).To(Equal("1.2.3.101"))
+		Expect(imported.InstallConfigOverrides).To(Equal(`{"fips": true}`))
+		Expect(imported.ImageInfo.StaticNetworkConfig).To(Equal(bundle.StaticNetworkConfig))
+
+		var assignments []*common.HostAssignment
+		Expect(db.Where("cluster_id = ?", clusterID).Find(&assignments).Error).ShouldNot(HaveOccurred())
+		Expect(assignments).To(HaveLen(1))
+		Expect(assignments[0].MacAddresses).To(Equal("aa:bb:cc:dd:ee:ff,aa:bb:cc:dd:ee:00"))
+		Expect(assignments[0].Role).To(Equal(models.HostRoleMaster))
+		Expect(assignments[0].RequestedHostname).To(Equal("master-0"))
+	})
+
+	It("deregisters the cluster when the settings of the bundle cannot be applied", func() {
+		mockClusterRegisterSteps()
+		mockMetric.EXPECT().ClusterRegistered(common.TestDefaultConfig.ReleaseVersion, gomock.Any(), gomock.Any()).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).AnyTimes()
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockStaticNetworkConfig.EXPECT().ValidateStaticNetworkConfig(gomock.Any()).
+			Return(&models.StaticNetworkConfigValidation{Valid: swag.Bool(true)}).Times(1)
+		mockManifestsApi.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("Manifest content has an invalid YAML format"))).Times(1)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+
+		verifyApiError(importCluster(), http.StatusBadRequest)
+		var count int
+		Expect(db.Model(&common.Cluster{}).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).To(Equal(0))
+		Expect(db.Model(&common.HostAssignment{}).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).To(Equal(0))
+	})
+
+	It("rejects an API VIP that the cluster update rejects", func() {
+		bundle.Cluster.APIVip = bundle.Cluster.IngressVip
+		mockClusterRegisterSteps()
+		mockMetric.EXPECT().ClusterRegistered(common.TestDefaultConfig.ReleaseVersion, gomock.Any(), gomock.Any()).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).AnyTimes()
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+
+		verifyApiError(importCluster(), http.StatusBadRequest)
+		var count int
+		Expect(db.Model(&common.Cluster{}).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).To(Equal(0))
+	})
+
+	It("rejects an invalid static network config", func() {
+		mockClusterRegisterSteps()
+		mockMetric.EXPECT().ClusterRegistered(common.TestDefaultConfig.ReleaseVersion, gomock.Any(), gomock.Any()).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).AnyTimes()
+		mockStaticNetworkConfig.EXPECT().ValidateStaticNetworkConfig(staticNetworkConfig).Return(&models.StaticNetworkConfigValidation{
+			Valid: swag.Bool(false),
+			Hosts: []*models.StaticNetworkConfigHostValidation{{HostIndex: 0, Errors: []*models.StaticNetworkConfigError{{
+				Type: models.StaticNetworkConfigErrorTypeInvalidYaml, Message: "invalid YAML",
+			}}}},
+		}).Times(1)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+
+		verifyApiErrorString(importCluster(), http.StatusBadRequest, "host 0: invalid YAML")
+	})
+
+	It("rejects an unsupported bundle format version", func() {
+		bundle.FormatVersion = swag.Int64(clusterExportFormatVersion + 1)
+		verifyApiError(importCluster(), http.StatusBadRequest)
+	})
+})
+
+var _ = Describe("RegisterCluster from a cluster template", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		dbName     string
+		templateID strfmt.UUID
+		template   *models.ClusterTemplate
+		params     installer.RegisterClusterParams
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil, nil)
+		mockUsageReports()
+		templateID = strfmt.UUID(uuid.New().String())
+		template = &models.ClusterTemplate{
+			ID:                     &templateID,
+			Name:                   swag.String("edge"),
+			OpenshiftVersion:       common.TestDefaultConfig.OpenShiftVersion,
+			ServiceNetworkCidr:     "172.31.0.0/16",
+			NoProxy:                ".edge-042.example.com",
+			AdditionalNtpSource:    "ntp.dc1.example.com",
+			VipDhcpAllocation:      swag.Bool(false),
+			InstallConfigOverrides: `{"fips": true}`,
+			Manifests: []*models.CreateManifestParams{{
+				Folder:   swag.String("openshift"),
+				FileName: swag.String("site.yaml"),
+				Content:  swag.String(base64.StdEncoding.EncodeToString([]byte("site: dc1\n"))),
+			}},
+		}
+		params = installer.RegisterClusterParams{NewClusterParams: &models.ClusterCreateParams{
+			Name:               swag.String("edge-042"),
+			PullSecret:         swag.String("{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"),
+			BaseDNSDomain:      "example.com",
+			APIVip:             "1.2.3.100",
+			IngressVip:         "1.2.3.101",
+			ServiceNetworkCidr: swag.String("172.30.0.0/16"),
+			TemplateID:         &templateID,
+			TemplateVariables:  map[string]string{"site": "dc1"},
+		}}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("registers the cluster with the settings of the template that the parameters don't set", func() {
+		mockClusterTemplates.EXPECT().Render(ctx, templateID, clustertemplates.Variables{
+			Name:          "edge-042",
+			BaseDNSDomain: "example.com",
+			APIVip:        "1.2.3.100",
+			IngressVip:    "1.2.3.101",
+			Variables:     map[string]string{"site": "dc1"},
+		}).Return(template, nil).Times(1)
+		mockClusterRegisterSteps()
+		mockMetric.EXPECT().ClusterRegistered(common.TestDefaultConfig.ReleaseVersion, gomock.Any(), gomock.Any()).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), nil, models.EventSeverityInfo,
+			fmt.Sprintf("Cluster was created from cluster template edge (%s) with 1 custom manifests", templateID), gomock.Any()).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).AnyTimes()
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), `{"fips": true}`).Return(nil).Times(1)
+		mockManifestsApi.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, manifestParams operations.CreateClusterManifestParams) (*models.Manifest, error) {
+				Expect(manifestParams.CreateManifestParams).To(Equal(template.Manifests[0]))
+				return &models.Manifest{Folder: "openshift", FileName: "site.yaml"}, nil
+			}).Times(1)
+
+		reply := bm.RegisterCluster(ctx, params)
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewRegisterClusterCreated()))
+		clusterID := *reply.(*installer.RegisterClusterCreated).Payload.ID
+
+		c, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(c.Name).To(Equal("edge-042"))
+		Expect(c.APIVip).To(Equal("1.2.3.100"))
+		Expect(c.IngressVip).To(Equal("1.2.3.101"))
+		Expect(c.ServiceNetworkCidr).To(Equal("172.30.0.0/16"))
+		Expect(c.NoProxy).To(Equal(".edge-042.example.com"))
+		Expect(c.AdditionalNtpSource).To(Equal("ntp.dc1.example.com"))
+		Expect(swag.BoolValue(c.VipDhcpAllocation)).To(BeFalse())
+		Expect(c.InstallConfigOverrides).To(Equal(`{"fips": true}`))
+	})
+
+	It("deregisters the cluster when the manifests of the template cannot be copied", func() {
+		template.InstallConfigOverrides = ""
+		mockClusterTemplates.EXPECT().Render(ctx, templateID, gomock.Any()).Return(template, nil).Times(1)
+		mockClusterRegisterSteps()
+		mockMetric.EXPECT().ClusterRegistered(common.TestDefaultConfig.ReleaseVersion, gomock.Any(), gomock.Any()).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).AnyTimes()
+		mockManifestsApi.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("Manifest content has an invalid YAML format"))).Times(1)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+
+		verifyApiError(bm.RegisterCluster(ctx, params), http.StatusBadRequest)
+		var count int
+		Expect(db.Model(&common.Cluster{}).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).To(Equal(0))
+	})
+
+	It("fails when the template cannot be rendered", func() {
+		mockClusterTemplates.EXPECT().Render(ctx, templateID, gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusNotFound, errors.New("cluster template not found"))).Times(1)
+		verifyApiError(bm.RegisterCluster(ctx, params), http.StatusNotFound)
+	})
+
+	It("rejects template variables without a template", func() {
+		params.NewClusterParams.TemplateID = nil
+		verifyApiError(bm.RegisterCluster(ctx, params), http.StatusBadRequest)
+	})
+
+	It("rejects a cluster without an OpenShift version", func() {
+		params.NewClusterParams.TemplateID = nil
+		params.NewClusterParams.TemplateVariables = nil
+		verifyApiError(bm.RegisterCluster(ctx, params), http.StatusBadRequest)
+	})
+
+	It("rejects an API VIP with VIP DHCP allocation", func() {
+		params.NewClusterParams.TemplateID = nil
+		params.NewClusterParams.TemplateVariables = nil
+		params.NewClusterParams.OpenshiftVersion = swag.String(common.TestDefaultConfig.OpenShiftVersion)
+		params.NewClusterParams.VipDhcpAllocation = swag.Bool(true)
+		verifyApiError(bm.RegisterCluster(ctx, params), http.StatusBadRequest)
+	})
+})
+
+var _ = Describe("applyHostAssignment", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		host      models.Host
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		host = addHost(strfmt.UUID(uuid.New().String()), models.HostRoleAutoAssign, models.HostStatusKnown, models.HostKindHost, clusterID,
+			`{"interfaces": [{"mac_address": "AA:BB:CC:DD:EE:FF"}], "system_vendor": {"serial_number": "serial"}}`, db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	addAssignment := func(assignment common.HostAssignment) {
+		assignment.ClusterID = clusterID
+		Expect(db.Create(&assignment).Error).ShouldNot(HaveOccurred())
+	}
+
+	countAssignments := func() int {
+		var count int
+		Expect(db.Model(&common.HostAssignment{}).Where("cluster_id = ?", clusterID).Count(&count).Error).ShouldNot(HaveOccurred())
+		return count
+	}
+
+	It("applies the assignment that matches a MAC address of the host", func() {
+		addAssignment(common.HostAssignment{MacAddresses: "11:22:33:44:55:66", SerialNumber: "serial", Role: models.HostRoleWorker})
+		addAssignment(common.HostAssignment{MacAddresses: "11:22:33:44:55:77,aa:bb:cc:dd:ee:ff", Role: models.HostRoleMaster,
+			RequestedHostname: "master-0", InstallationDiskID: "/dev/disk/by-id/wwn-1"})
+		mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), models.HostRoleMaster, gomock.Any()).Return(nil).Times(1)
+		mockHostApi.EXPECT().UpdateHostname(gomock.Any(), gomock.Any(), "master-0", gomock.Any()).Return(nil).Times(1)
+		mockHostApi.EXPECT().UpdateInstallationDisk(gomock.Any(), gomock.Any(), gomock.Any(), "/dev/disk/by-id/wwn-1").Return(nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, host.ID, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+
+		Expect(bm.applyHostAssignment(ctx, &host)).To(Succeed())
+		Expect(countAssignments()).To(Equal(1))
+	})
+
+	It("falls back to the serial number and reports assignments that cannot be applied", func() {
+		addAssignment(common.HostAssignment{MacAddresses: "11:22:33:44:55:66", SerialNumber: "serial", Role: models.HostRoleWorker})
+		mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), models.HostRoleWorker, gomock.Any()).
+			Return(errors.New("role cannot be updated")).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, host.ID, models.EventSeverityWarning, gomock.Any(), gomock.Any()).Times(1)
+
+		Expect(bm.applyHostAssignment(ctx, &host)).To(Succeed())
+		Expect(countAssignments()).To(Equal(0))
+	})
+
+	It("keeps the assignments that do not match the host", func() {
+		addAssignment(common.HostAssignment{MacAddresses: "11:22:33:44:55:66", SerialNumber: "other", Role: models.HostRoleWorker})
+
+		Expect(bm.applyHostAssignment(ctx, &host)).To(Succeed())
+		Expect(countAssignments()).To(Equal(1))
+	})
+
+	It("doesn't fail the inventory update when the assignment cannot be applied", func() {
+		addAssignment(common.HostAssignment{MacAddresses: "aa:bb:cc:dd:ee:ff", Role: models.HostRoleMaster})
+		host.Inventory = "{"
+		mockHostApi.EXPECT().UpdateInventory(gomock.Any(), gomock.Any(), "inventory").Return(nil).Times(1)
+
+		Expect(bm.applyHostAssignment(ctx, &host)).NotTo(Succeed())
+		params := installer.PostStepReplyParams{Reply: &models.StepReply{StepType: models.StepTypeInventory}}
+		Expect(handleReplyByType(params, bm, ctx, host, "inventory")).To(Succeed())
+		Expect(countAssignments()).To(Equal(1))
+	})
+})
+
+var _ = Describe("GetClusterInstallConfigDiff", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("returns the install config differences", func() {
+		diff := &models.ConfigDiff{Operations: []*models.ConfigDiffOperation{
+			{Op: swag.String(models.ConfigDiffOperationOpAdd), Path: swag.String("/fips"), Value: true},
+		}}
+		mockInstallConfigBuilder.EXPECT().GetInstallConfigDiff(gomock.Any()).Return(diff, nil).Times(1)
+		reply := bm.GetClusterInstallConfigDiff(ctx, installer.GetClusterInstallConfigDiffParams{ClusterID: clusterID})
+		Expect(reply).To(Equal(installer.NewGetClusterInstallConfigDiffOK().WithPayload(diff)))
+	})
+
+	It("returns not found for a non-existent cluster", func() {
+		reply := bm.GetClusterInstallConfigDiff(ctx, installer.GetClusterInstallConfigDiffParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+})
+
 var _ = Describe("UpdateClusterInstallConfig", func() {
 	var (
 		bm        *bareMetalInventory
@@ -5539,7 +6444,7 @@ var _ = Describe("TestRegisterCluster", func() {
 		db, dbName = common.PrepareTestDB()
 		bm = createInventory(db, cfg)
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil)
+			db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		mockUsageReports()
 	})
 
@@ -5768,7 +6673,7 @@ var _ = Describe("AMS subscriptions", func() {
 	BeforeEach(func() {
 		db, dbName = common.PrepareTestDB()
 		bm = createInventory(db, cfg)
-		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil)
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		bm.ocmClient.Config.WithAMSSubscriptions = true
 		mockUsageReports()
 	})
@@ -5838,7 +6743,7 @@ var _ = Describe("AMS subscriptions", func() {
 			mockS3Client = s3wrapper.NewMockAPI(ctrl)
 			mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, mockS3Client, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil, nil)
 			mockClusterRegisterSuccess(bm, true)
 			mockAMSSubscription(ctx)
 
@@ -5861,7 +6766,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("update cluster name happy flow", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(bm, true)
 			mockAMSSubscription(ctx)
@@ -5896,7 +6801,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("update cluster day1 with APIVipDNSName failed", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(bm, true)
 			mockAMSSubscription(ctx)
@@ -5927,7 +6832,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("update cluster name with same name", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(bm, true)
 			mockAMSSubscription(ctx)
@@ -5960,7 +6865,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("update cluster without name field", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(bm, true)
 			mockAMSSubscription(ctx)
@@ -5996,7 +6901,7 @@ var _ = Describe("AMS subscriptions", func() {
 			mockS3Client = s3wrapper.NewMockAPI(ctrl)
 			mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, mockS3Client, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil, nil)
 			bm.ocmClient = nil
 			mockClusterRegisterSuccess(bm, true)
 
@@ -6019,7 +6924,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("cluster update failure on inventory refresh failure", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(bm, true)
 			mockAMSSubscription(ctx)
@@ -6330,6 +7235,64 @@ var _ = Describe("UpdateHostIgnition", func() {
 	})
 })
 
+var _ = Describe("GetHostIgnitionDiff", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		addHost(hostID, models.HostRoleWorker, models.HostStatusKnown, models.HostKindHost, clusterID, getInventoryStr("hostname0", "bootMode", "1.2.3.4/24"), db)
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).Update("ignition_config_overrides",
+			`{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/etc/hostname", "contents": {"source": "data:,other"}}]}}`).Error).
+			ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	verifyHostnameReplaced := func(reply middleware.Responder) {
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewGetHostIgnitionDiffOK()))
+		diff := reply.(*installer.GetHostIgnitionDiffOK).Payload
+		Expect(diff.Operations).To(HaveLen(1))
+		Expect(*diff.Operations[0].Op).To(Equal(models.ConfigDiffOperationOpReplace))
+		Expect(*diff.Operations[0].Path).To(Equal("/storage/files/0/contents/source"))
+		Expect(diff.Operations[0].BaseValue).To(Equal("data:,hostname0"))
+		Expect(diff.Operations[0].Value).To(Equal("data:,other"))
+		Expect(diff.Warnings).To(HaveLen(1))
+	}
+
+	It("compares with the values set by the service before the ignitions are generated", func() {
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/worker.ign", clusterID)).Return(false, nil).Times(1)
+		verifyHostnameReplaced(bm.GetHostIgnitionDiff(ctx, installer.GetHostIgnitionDiffParams{ClusterID: clusterID, HostID: hostID}))
+	})
+
+	It("compares with the generated ignition of the host role", func() {
+		workerIgnition := `{"ignition": {"version": "3.1.0", "config": {"merge": [{"source": "https://api-int.test:22623/config/worker"}]}}}`
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/worker.ign", clusterID)).Return(true, nil).Times(1)
+		mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/worker.ign", clusterID)).
+			Return(ioutil.NopCloser(strings.NewReader(workerIgnition)), int64(len(workerIgnition)), nil).Times(1)
+		verifyHostnameReplaced(bm.GetHostIgnitionDiff(ctx, installer.GetHostIgnitionDiffParams{ClusterID: clusterID, HostID: hostID}))
+	})
+
+	It("returns not found for a non-existent host", func() {
+		reply := bm.GetHostIgnitionDiff(ctx, installer.GetHostIgnitionDiffParams{ClusterID: clusterID, HostID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+})
+
 var _ = Describe("UpdateHostInstallerArgs", func() {
 	var (
 		bm        *bareMetalInventory
@@ -6575,11 +7538,18 @@ func createInventory(db *gorm.DB, cfg Config) *bareMetalInventory {
 	mockIgnitionBuilder = ignition.NewMockIgnitionBuilder(ctrl)
 	mockInstallConfigBuilder = installcfg.NewMockInstallConfigBuilder(ctrl)
 	mockHwValidator = hardware.NewMockValidator(ctrl)
-	dnsApi := dns.NewDNSHandler(cfg.BaseDNSDomains, common.GetTestLog())
+	mockLogAnalyzer = loganalysis.NewMockAnalyzer(ctrl)
+	mockLogRetention = logretention.NewMockAPI(ctrl)
+	mockDryRunRenderer = dryrun.NewMockRenderer(ctrl)
+	mockManifestsApi = manifests.NewMockClusterManifestsInternals(ctrl)
+	mockClusterTemplates = clustertemplates.NewMockRenderer(ctrl)
+	mockStaticNetworkConfig = staticnetworkconfig.NewMockStaticNetworkConfig(ctrl)
+	dnsApi := dns.NewDNSHandler(cfg.BaseDNSDomains, dns.Config{}, common.GetTestLog())
 	return NewBareMetalInventory(db, common.GetTestLog(), mockHostApi, mockClusterApi, cfg,
 		mockGenerator, mockEvents, mockS3Client, mockMetric, mockUsage, mockOperatorManager,
 		getTestAuthHandler(), mockK8sClient, ocmClient, nil, mockSecretValidator, mockVersions,
-		mockIsoEditorFactory, mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder)
+		mockIsoEditorFactory, mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder, mockLogAnalyzer, mockLogRetention,
+		mockDryRunRenderer, mockManifestsApi, mockClusterTemplates, mockStaticNetworkConfig)
 }
 
 var _ = Describe("IPv6 support disabled", func() {
@@ -6724,6 +7694,93 @@ var _ = Describe("GetCredentials", func() {
 	})
 })
 
+var _ = Describe("ValidateStaticNetworkConfig", func() {
+	var (
+		ctx    = context.Background()
+		cfg    = Config{}
+		bm     *bareMetalInventory
+		db     *gorm.DB
+		dbName string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("returns the validation of the static network config", func() {
+		staticNetworkConfig := []*models.HostStaticNetworkConfig{{NetworkYaml: "interfaces: []"}}
+		validation := &models.StaticNetworkConfigValidation{
+			Valid: swag.Bool(false),
+			Hosts: []*models.StaticNetworkConfigHostValidation{{Errors: []*models.StaticNetworkConfigError{
+				{Type: models.StaticNetworkConfigErrorTypeMissingMac, Interface: "eth0"},
+			}}},
+		}
+		mockStaticNetworkConfig.EXPECT().ValidateStaticNetworkConfig(staticNetworkConfig).Return(validation).Times(1)
+		reply := bm.ValidateStaticNetworkConfig(ctx, installer.ValidateStaticNetworkConfigParams{
+			StaticNetworkConfigValidateParams: &models.StaticNetworkConfigValidateParams{StaticNetworkConfig: staticNetworkConfig},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewValidateStaticNetworkConfigOK()))
+		Expect(reply.(*installer.ValidateStaticNetworkConfigOK).Payload).To(Equal(validation))
+	})
+})
+
+var _ = Describe("GetClusterNetworkTopology", func() {
+	var (
+		ctx       = context.Background()
+		cfg       = Config{}
+		bm        *bareMetalInventory
+		db        *gorm.DB
+		dbName    string
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("returns the topology of the hosts of the cluster", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		otherHostID := strfmt.UUID(uuid.New().String())
+		for _, id := range []strfmt.UUID{hostID, otherHostID} {
+			addHost(id, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, clusterID, common.GenerateTestDefaultInventory(), db)
+		}
+		connectivity, err := json.Marshal(&models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+			HostID:         otherHostID,
+			L2Connectivity: []*models.L2Connectivity{{RemoteIPAddress: "1.2.3.5", Successful: false}},
+		}}})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).Update("connectivity", string(connectivity)).Error).ShouldNot(HaveOccurred())
+
+		reply := bm.GetClusterNetworkTopology(ctx, installer.GetClusterNetworkTopologyParams{ClusterID: clusterID})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewGetClusterNetworkTopologyOK()))
+		topology := reply.(*installer.GetClusterNetworkTopologyOK).Payload
+		Expect(topology.Hosts).To(HaveLen(2))
+		Expect(topology.Links).To(HaveLen(1))
+		Expect(topology.Links[0].HostID).To(Equal(hostID))
+		Expect(swag.BoolValue(topology.Links[0].L2Successful)).To(BeFalse())
+		Expect(topology.Dot).To(ContainSubstring("color=orange"))
+	})
+
+	It("fails for a missing cluster", func() {
+		reply := bm.GetClusterNetworkTopology(ctx, installer.GetClusterNetworkTopologyParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+})
+
 var _ = Describe("AddOpenshiftVersion", func() {
 	var (
 		cfg          = Config{}
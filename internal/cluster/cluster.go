@@ -24,9 +24,12 @@ import (
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/notifications"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/timeline"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/commonutils"
 	"github.com/openshift/assisted-service/pkg/leader"
@@ -141,7 +144,8 @@ type Manager struct {
 
 func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handler,
 	hostAPI host.API, metricApi metrics.API, manifestsGeneratorAPI network.ManifestsGeneratorAPI,
-	leaderElector leader.Leader, operatorsApi operators.API, ocmClient *ocm.Client, objectHandler s3wrapper.API, dnsApi dns.DNSApi) *Manager {
+	leaderElector leader.Leader, operatorsApi operators.API, ocmClient *ocm.Client, objectHandler s3wrapper.API, dnsApi dns.DNSApi, notifier notifications.Notifier,
+	installConfigBuilder installcfg.InstallConfigBuilder) *Manager {
 	th := &transitionHandler{
 		log:           log,
 		db:            db,
@@ -154,10 +158,10 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler e
 		registrationAPI:       NewRegistrar(log, db),
 		installationAPI:       NewInstaller(log, db),
 		eventsHandler:         eventsHandler,
-		sm:                    NewClusterStateMachine(th),
+		sm:                    newNotifyingStateMachine(newStatusHistoryStateMachine(NewClusterStateMachine(th), th), notifier, th.transitionDB),
 		metricAPI:             metricApi,
 		manifestsGeneratorAPI: manifestsGeneratorAPI,
-		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi),
+		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi, installConfigBuilder),
 		hostAPI:               hostAPI,
 		leaderElector:         leaderElector,
 		prevMonitorInvokedAt:  time.Now(),
@@ -264,6 +268,11 @@ func (m *Manager) reportValidationStatusChanged(ctx context.Context, c *common.C
 	for vCategory, vRes := range newValidationRes {
 		for _, v := range vRes {
 			if currentStatus, ok := m.getValidationStatus(currentValidationRes, vCategory, v.ID); ok {
+				if v.Status != currentStatus {
+					if err := timeline.RecordClusterValidation(m.db, *c.ID, v.ID.String(), v.Status.String(), v.Message); err != nil {
+						m.log.WithError(err).Warnf("failed to record validation %s change of cluster %s in the timeline", v.ID, c.ID.String())
+					}
+				}
 				if v.Status == ValidationFailure && currentStatus == ValidationSuccess {
 					m.metricAPI.ClusterValidationChanged(c.OpenshiftVersion, c.EmailDomain, models.ClusterValidationID(v.ID))
 					eventMsg := fmt.Sprintf("Cluster validation '%s' that used to succeed is now failing", v.ID)
@@ -399,6 +408,10 @@ func (m *Manager) GetMasterNodesIds(ctx context.Context, c *common.Cluster, db *
 
 func (m *Manager) tryAssignMachineCidrDHCPMode(cluster *common.Cluster) error {
 	networks := network.GetClusterNetworks(cluster.Hosts, m.log)
+	if network.IsDualStack(cluster) {
+		// The VIPs of a dual-stack cluster, and so its machine network CIDR, are of the IPv4 family
+		networks = funk.FilterString(networks, network.IsIPV4CIDR)
+	}
 	if len(networks) == 1 {
 		/*
 		 * Auto assign machine network CIDR is relevant if there is only single host network.  Otherwise the user
@@ -934,7 +947,16 @@ func (m *Manager) deleteClusterManifests(ctx context.Context, c *common.Cluster,
 }
 
 func (m *Manager) DeleteClusterLogs(ctx context.Context, c *common.Cluster, objectHandler s3wrapper.API) error {
-	return m.deleteClusterFiles(ctx, c, objectHandler, "logs")
+	if err := m.deleteClusterFiles(ctx, c, objectHandler, "logs"); err != nil {
+		return err
+	}
+	if err := m.db.Where("cluster_id = ?", c.ID.String()).Delete(&common.LogObject{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete the logs records of cluster %s", c.ID)
+	}
+	if err := m.db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).UpdateColumn("logs_size_bytes", 0).Error; err != nil {
+		return errors.Wrapf(err, "failed to reset the logs size of cluster %s", c.ID)
+	}
+	return m.db.Model(&common.Host{}).Where("cluster_id = ?", c.ID.String()).UpdateColumn("logs_size_bytes", 0).Error
 }
 
 func (m *Manager) DeleteClusterFiles(ctx context.Context, c *common.Cluster, objectHandler s3wrapper.API) error {
@@ -1002,6 +1024,26 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 		if err := common.DeleteRecordsByClusterID(db, *c.ID, models.MonitoredOperator{}); err != nil {
 			m.log.WithError(err).Warnf("Failed deleting operators from db for cluster %s", c.ID.String())
 		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, models.WebhookDelivery{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting webhook deliveries from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, models.Webhook{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting webhooks from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, common.TimelineRecord{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting timeline records from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, common.StatusHistory{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting status history from db for cluster %s", c.ID.String())
+		}
+
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, models.LogFinding{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting logs analysis from db for cluster %s", c.ID.String())
+		}
 	}
 	return nil
 }
@@ -1046,6 +1088,12 @@ func (m *Manager) GenerateAdditionalManifests(ctx context.Context, cluster *comm
 		}
 	}
 
+	if cluster.Mtu != 0 {
+		if err := m.manifestsGeneratorAPI.AddNetworkMtuManifest(ctx, log, cluster); err != nil {
+			return errors.Wrap(err, "failed to add network MTU manifest")
+		}
+	}
+
 	if err := m.rp.operatorsAPI.GenerateManifests(ctx, cluster); err != nil {
 		return errors.Wrap(err, "failed to add operator manifests")
 	}
@@ -1089,6 +1137,7 @@ func (m *Manager) CompleteInstallation(ctx context.Context, db *gorm.DB,
 		log.Error(err)
 		return nil, err
 	}
+	recordStatusChange(log, db, clusterAfterUpdate, models.ClusterStatusFinalizing, statusTriggerCompleteInstallation)
 
 	if !successfullyFinished {
 		result = models.ClusterStatusError
@@ -19,6 +19,8 @@ const (
 	UnPreparingtHostsExist       = conditionId("unpreparing-hosts-exist")
 	ClusterPreparationSucceeded  = conditionId("cluster-preparation-succeeded")
 	ClusterPreparationFailed     = conditionId("cluster-preparation-failed")
+	// OperatorsRequirementsSatisfied is set when the validations of all the operators of the cluster succeeded
+	OperatorsRequirementsSatisfied = conditionId("operators-requirements-satisfied")
 )
 
 func (c conditionId) String() string {
@@ -53,10 +53,12 @@ func (i *installer) Install(ctx context.Context, c *common.Cluster, db *gorm.DB)
 		return errors.Errorf("cluster %s state is unclear - cluster state: %s", c.ID, swag.StringValue(c.Status))
 	}
 
-	if _, err := updateClusterStatus(i.log, db, *c.ID, swag.StringValue(c.Status),
-		models.ClusterStatusInstalling, statusInfoInstalling); err != nil {
+	cluster, err := updateClusterStatus(i.log, db, *c.ID, swag.StringValue(c.Status),
+		models.ClusterStatusInstalling, statusInfoInstalling)
+	if err != nil {
 		return err
 	}
+	recordStatusChange(log, db, cluster, swag.StringValue(c.Status), statusTriggerInstall)
 
 	return nil
 }
@@ -0,0 +1,33 @@
+package cluster
+
+import (
+	"github.com/filanov/stateswitch"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/notifications"
+)
+
+// notifyingStateMachine notifies about every successful transition that changed the status of a cluster
+type notifyingStateMachine struct {
+	stateswitch.StateMachine
+	notifier notifications.Notifier
+	// transitionDB returns the DB that the transition ran with, which the notification is queued with
+	transitionDB func(args stateswitch.TransitionArgs) *gorm.DB
+}
+
+func newNotifyingStateMachine(sm stateswitch.StateMachine, notifier notifications.Notifier, transitionDB func(args stateswitch.TransitionArgs) *gorm.DB) stateswitch.StateMachine {
+	if notifier == nil {
+		return sm
+	}
+	return &notifyingStateMachine{StateMachine: sm, notifier: notifier, transitionDB: transitionDB}
+}
+
+func (n *notifyingStateMachine) Run(transitionType stateswitch.TransitionType, stateSwitch stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	if err := n.StateMachine.Run(transitionType, stateSwitch, args); err != nil {
+		return err
+	}
+	if sCluster, ok := stateSwitch.(*stateCluster); ok && sCluster.srcState != swag.StringValue(sCluster.cluster.Status) {
+		n.notifier.NotifyClusterStatusChange(n.transitionDB(args), &sCluster.cluster.Cluster, sCluster.srcState, string(transitionType))
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/models"
@@ -33,10 +34,11 @@ type refreshPreprocessor struct {
 	operatorsAPI operators.API
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API, installConfigBuilder installcfg.InstallConfigBuilder) *refreshPreprocessor {
 	v := clusterValidator{
-		log:     log,
-		hostAPI: hostAPI,
+		log:                  log,
+		hostAPI:              hostAPI,
+		installConfigBuilder: installConfigBuilder,
 	}
 
 	return &refreshPreprocessor{
@@ -77,14 +79,13 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 	if err != nil {
 		return nil, nil, err
 	}
+	operatorsSatisfied := true
 	for _, result := range results {
 		stateMachineInput[result.ValidationId] = result.Status == api.Success
+		operatorsSatisfied = operatorsSatisfied && result.Status == api.Success
 		id := ValidationID(result.ValidationId)
-		category, err := id.Category()
-		if err != nil {
-			logrus.WithError(err).Warn("id.category()")
-			return nil, nil, err
-		}
+		// Operator plugins have validation IDs that are not known in advance
+		category := operatorsCategory
 
 		status := ValidationStatus(result.Status)
 		validationsOutput[category] = append(validationsOutput[category], ValidationResult{
@@ -94,6 +95,8 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 		})
 	}
 
+	stateMachineInput[OperatorsRequirementsSatisfied.String()] = operatorsSatisfied
+
 	for _, condition := range r.conditions {
 		stateMachineInput[condition.id.String()] = condition.fn(c)
 	}
@@ -187,6 +190,11 @@ func newValidations(v *clusterValidator) []validation {
 			condition: v.isNtpServerConfigured,
 			formatter: v.printNtpServerConfigured,
 		},
+		{
+			id:        AreInstallConfigOverridesSafe,
+			condition: v.areInstallConfigOverridesSafe,
+			formatter: v.printInstallConfigOverridesSafe,
+		},
 	}
 	return ret
 }
@@ -113,6 +113,11 @@ func (r *registrar) DeregisterCluster(ctx context.Context, cluster *common.Clust
 		return errors.Errorf("failed to deregister host while unregistering cluster %s", cluster.ID)
 	}
 
+	if txErr = tx.Where("cluster_id = ?", cluster.ID).Delete(&common.HostAssignment{}).Error; txErr != nil {
+		tx.Rollback()
+		return errors.Errorf("failed to delete host assignments while unregistering cluster %s", cluster.ID)
+	}
+
 	if txErr = tx.Delete(cluster).Error; txErr != nil {
 		tx.Rollback()
 		return errors.Errorf("failed to delete cluster %s", cluster.ID)
@@ -3,6 +3,7 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
@@ -35,6 +37,86 @@ type transitionHandler struct {
 	prepareConfig PrepareConfig
 }
 
+// transitionDB returns the DB that the transition updates the cluster with, the transaction of the caller when it
+// passes one
+func (th *transitionHandler) transitionDB(args stateswitch.TransitionArgs) *gorm.DB {
+	var db *gorm.DB
+	switch params := args.(type) {
+	case *TransitionArgsCancelInstallation:
+		db = params.db
+	case *TransitionArgsResetCluster:
+		db = params.db
+	case *TransitionArgsPrepareForInstallation:
+		db = params.db
+	case *TransitionArgsRefreshCluster:
+		db = params.db
+	}
+	if db == nil {
+		return th.db
+	}
+	return db
+}
+
+// statusHistoryStateMachine records the transitions of the state machine in the status history
+type statusHistoryStateMachine struct {
+	stateswitch.StateMachine
+	th *transitionHandler
+}
+
+func newStatusHistoryStateMachine(sm stateswitch.StateMachine, th *transitionHandler) stateswitch.StateMachine {
+	return &statusHistoryStateMachine{StateMachine: sm, th: th}
+}
+
+func (s *statusHistoryStateMachine) Run(transitionType stateswitch.TransitionType, stateSwitch stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	if err := s.StateMachine.Run(transitionType, stateSwitch, args); err != nil {
+		return err
+	}
+	if sCluster, ok := stateSwitch.(*stateCluster); ok {
+		s.th.recordStatusHistory(sCluster, transitionType, args)
+	}
+	return nil
+}
+
+// The triggers of the status changes that are not transitions of the state machine
+const (
+	statusTriggerInstall              = "Install"
+	statusTriggerCompleteInstallation = "CompleteInstallation"
+)
+
+// recordStatusHistory appends a transition that changed the status of the cluster to the status history. The entry is
+// written with the DB of the transition, so that it is committed or rolled back together with the status.
+func (th *transitionHandler) recordStatusHistory(sCluster *stateCluster, transitionType stateswitch.TransitionType, args stateswitch.TransitionArgs) {
+	recordStatusChange(th.log, th.transitionDB(args), sCluster.cluster, sCluster.srcState, string(transitionType))
+}
+
+// recordStatusChange appends the status change of the cluster, together with the validations that were failing at
+// that moment, to the status history. The installation timeline shows the cluster statuses from the same entries.
+func recordStatusChange(log logrus.FieldLogger, db *gorm.DB, cluster *common.Cluster, srcStatus, trigger string) {
+	if srcStatus == swag.StringValue(cluster.Status) {
+		return
+	}
+	if err := history.RecordClusterStatusChange(db, &cluster.Cluster, srcStatus, trigger, failedValidations(cluster)); err != nil {
+		log.WithError(err).Warnf("failed to record status change of cluster %s in the status history", cluster.ID.String())
+	}
+}
+
+func failedValidations(c *common.Cluster) []string {
+	validations, err := GetValidations(c)
+	if err != nil {
+		return nil
+	}
+	ret := make([]string, 0)
+	for _, results := range validations {
+		for _, v := range results {
+			if v.Status == ValidationFailure {
+				ret = append(ret, v.ID.String())
+			}
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 ////////////////////////////////////////////////////////////////////////////
 // CancelInstallation
 ////////////////////////////////////////////////////////////////////////////
@@ -29,8 +29,13 @@ const (
 	IsOcsRequirementsSatisfied          = ValidationID(models.ClusterValidationIDOcsRequirementsSatisfied)
 	IsLsoRequirementsSatisfied          = ValidationID(models.ClusterValidationIDLsoRequirementsSatisfied)
 	IsCnvRequirementsSatisfied          = ValidationID(models.ClusterValidationIDCnvRequirementsSatisfied)
+	IsSriovRequirementsSatisfied        = ValidationID(models.ClusterValidationIDSriovRequirementsSatisfied)
+	AreInstallConfigOverridesSafe       = ValidationID(models.ClusterValidationIDInstallConfigOverridesSafe)
 )
 
+// operatorsCategory is the category of the validations of all the OLM operators, including plugins
+const operatorsCategory = "operators"
+
 func (v ValidationID) Category() (string, error) {
 	switch v {
 	case IsMachineCidrDefined, IsMachineCidrEqualsToCalculatedCidr, IsApiVipDefined, IsApiVipValid, IsIngressVipDefined, IsIngressVipValid,
@@ -38,10 +43,10 @@ func (v ValidationID) Category() (string, error) {
 		return "network", nil
 	case AllHostsAreReadyToInstall, SufficientMastersCount:
 		return "hosts-data", nil
-	case IsPullSecretSet:
+	case IsPullSecretSet, AreInstallConfigOverridesSafe:
 		return "configuration", nil
-	case IsOcsRequirementsSatisfied, IsLsoRequirementsSatisfied, IsCnvRequirementsSatisfied:
-		return "operators", nil
+	case IsOcsRequirementsSatisfied, IsLsoRequirementsSatisfied, IsCnvRequirementsSatisfied, IsSriovRequirementsSatisfied:
+		return operatorsCategory, nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected cluster validation id %s", string(v)))
 }
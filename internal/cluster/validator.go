@@ -3,13 +3,16 @@ package cluster
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/configdiff"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
@@ -71,21 +74,24 @@ func boolValue(b bool) ValidationStatus {
 }
 
 type clusterValidator struct {
-	log     logrus.FieldLogger
-	hostAPI host.API
+	log                  logrus.FieldLogger
+	hostAPI              host.API
+	installConfigBuilder installcfg.InstallConfigBuilder
 }
 
 func (v *clusterValidator) isMachineCidrDefined(c *clusterPreprocessContext) ValidationStatus {
 	if swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsSingleNodeCluster(c.cluster) {
 		return ValidationSuccess
 	}
-	return boolValue(c.cluster.MachineNetworkCidr != "")
+	return boolValue(c.cluster.MachineNetworkCidr != "" && len(network.MissingMachineNetworkFamilies(c.cluster)) == 0)
 }
 
 func (v *clusterValidator) printIsMachineCidrDefined(context *clusterPreprocessContext, status ValidationStatus) string {
 	switch status {
 	case ValidationFailure:
-		if swag.BoolValue(context.cluster.VipDhcpAllocation) {
+		if missing := network.MissingMachineNetworkFamilies(context.cluster); context.cluster.MachineNetworkCidr != "" && len(missing) > 0 {
+			return fmt.Sprintf("The Machine Network CIDR of the %s cluster networks is undefined; it must be set in the machine networks of the dual-stack cluster.", strings.Join(missing, ", "))
+		} else if swag.BoolValue(context.cluster.VipDhcpAllocation) {
 			return "The Machine Network CIDR is undefined; setting the Machine Network CIDR initiates the VIPs DHCP lease allocation."
 		} else if common.IsSingleNodeCluster(context.cluster) {
 			return "The Machine Network CIDR is undefined; Setting Machine Network CIDR is required for single node cluster"
@@ -420,7 +426,7 @@ func (v *clusterValidator) noCidrsOverlapping(c *clusterPreprocessContext) Valid
 			return ValidationPending
 		}
 	}
-	return boolValue(network.VerifyClusterCIDRsNotOverlap(c.cluster.MachineNetworkCidr, c.cluster.ClusterNetworkCidr, c.cluster.ServiceNetworkCidr, swag.BoolValue(c.cluster.UserManagedNetworking)) == nil)
+	return boolValue(network.VerifyDualStackCIDRsNotOverlap(c.cluster) == nil)
 }
 
 func (v *clusterValidator) printNoCidrsOverlapping(c *clusterPreprocessContext, status ValidationStatus) string {
@@ -428,7 +434,7 @@ func (v *clusterValidator) printNoCidrsOverlapping(c *clusterPreprocessContext,
 	case ValidationSuccess:
 		return "No CIDRS are overlapping."
 	case ValidationFailure:
-		if err := network.VerifyClusterCIDRsNotOverlap(c.cluster.MachineNetworkCidr, c.cluster.ClusterNetworkCidr, c.cluster.ServiceNetworkCidr, swag.BoolValue(c.cluster.UserManagedNetworking)); err != nil {
+		if err := network.VerifyDualStackCIDRsNotOverlap(c.cluster); err != nil {
 			return fmt.Sprintf("CIDRS Overlapping: %s.", err.Error())
 		}
 		return ""
@@ -461,8 +467,21 @@ func (v *clusterValidator) networkPrefixValid(c *clusterPreprocessContext) Valid
 	if c.cluster.ClusterNetworkCidr == "" {
 		return ValidationPending
 	}
-	return boolValue(network.VerifyNetworkHostPrefix(c.cluster.ClusterNetworkHostPrefix) == nil &&
-		network.VerifyClusterCidrSize(int(c.cluster.ClusterNetworkHostPrefix), c.cluster.ClusterNetworkCidr, len(c.cluster.Hosts)) == nil)
+	return boolValue(v.clusterNetworksPrefixFailure(c) == "")
+}
+
+// clusterNetworksPrefixFailure returns why the host prefix of one of the cluster networks, one per IP address family,
+// is invalid, or an empty string
+func (v *clusterValidator) clusterNetworksPrefixFailure(c *clusterPreprocessContext) string {
+	for _, n := range network.ClusterNetworks(c.cluster) {
+		if err := network.VerifyNetworkHostPrefix(n.HostPrefix); err != nil {
+			return fmt.Sprintf("Invalid Cluster Network prefix: %s.", err.Error())
+		}
+		if err := network.VerifyClusterCidrSize(int(n.HostPrefix), n.Cidr, len(c.cluster.Hosts)); err != nil {
+			return err.Error()
+		}
+	}
+	return ""
 }
 
 func (v *clusterValidator) printNetworkPrefixValid(c *clusterPreprocessContext, status ValidationStatus) string {
@@ -470,13 +489,7 @@ func (v *clusterValidator) printNetworkPrefixValid(c *clusterPreprocessContext,
 	case ValidationSuccess:
 		return "The Cluster Network prefix is valid."
 	case ValidationFailure:
-		var err error
-		if err = network.VerifyNetworkHostPrefix(c.cluster.ClusterNetworkHostPrefix); err != nil {
-			return fmt.Sprintf("Invalid Cluster Network prefix: %s.", err.Error())
-		} else if err = network.VerifyClusterCidrSize(int(c.cluster.ClusterNetworkHostPrefix), c.cluster.ClusterNetworkCidr, len(c.cluster.Hosts)); err != nil {
-			return err.Error()
-		}
-		return ""
+		return v.clusterNetworksPrefixFailure(c)
 	case ValidationPending:
 		return "The Cluster Network CIDR is undefined."
 	default:
@@ -522,3 +535,45 @@ func (v *clusterValidator) printNtpServerConfigured(c *clusterPreprocessContext,
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
 }
+
+// overriddenInstallConfigPaths returns the paths of the values set by the service that the install config overrides
+// of the cluster replace or remove
+func (v *clusterValidator) overriddenInstallConfigPaths(c *clusterPreprocessContext) ([]string, error) {
+	if c.cluster.InstallConfigOverrides == "" || v.installConfigBuilder == nil {
+		return nil, nil
+	}
+	diff, err := v.installConfigBuilder.GetInstallConfigDiff(c.cluster)
+	if err != nil {
+		return nil, err
+	}
+	return configdiff.OverriddenPaths(diff), nil
+}
+
+// areInstallConfigOverridesSafe warns about install config overrides that replace values set by the service. It
+// doesn't block the installation, since the overrides may replace the values on purpose.
+func (v *clusterValidator) areInstallConfigOverridesSafe(c *clusterPreprocessContext) ValidationStatus {
+	paths, err := v.overriddenInstallConfigPaths(c)
+	if err != nil {
+		v.log.WithError(err).Warnf("failed to get the install config differences of cluster %s", c.clusterId)
+		return ValidationFailure
+	}
+	return boolValue(len(paths) == 0)
+}
+
+func (v *clusterValidator) printInstallConfigOverridesSafe(c *clusterPreprocessContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if c.cluster.InstallConfigOverrides == "" {
+			return "The install config is not overridden"
+		}
+		return "The install config overrides don't replace values set by the service"
+	case ValidationFailure:
+		paths, err := v.overriddenInstallConfigPaths(c)
+		if err != nil {
+			return fmt.Sprintf("The install config overrides can't be applied: %s", err.Error())
+		}
+		return fmt.Sprintf("The install config overrides replace values set by the service: %s", strings.Join(paths, ", "))
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
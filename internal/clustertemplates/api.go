@@ -0,0 +1,215 @@
+package clustertemplates
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.ClusterTemplatesAPI = &Api{}
+var _ Renderer = &Api{}
+
+type Api struct {
+	db           *gorm.DB
+	log          logrus.FieldLogger
+	operatorsApi operators.API
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger, operatorsApi operators.API) *Api {
+	return &Api{
+		db:           db,
+		log:          log,
+		operatorsApi: operatorsApi,
+	}
+}
+
+func (a *Api) CreateClusterTemplate(ctx context.Context, params operations.CreateClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	p := params.NewClusterTemplateParams
+	if err := a.validate(p); err != nil {
+		log.WithError(err).Errorf("invalid cluster template %s", swag.StringValue(p.Name))
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	r, err := json.Marshal(&resources{OlmOperators: p.OlmOperators, Manifests: p.Manifests})
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	id := strfmt.UUID(uuid.New().String())
+	t := common.ClusterTemplate{
+		ClusterTemplate: models.ClusterTemplate{
+			ID:                       &id,
+			Name:                     p.Name,
+			Description:              p.Description,
+			UserName:                 ocm.UserNameFromContext(ctx),
+			OrgID:                    ocm.OrgIDFromContext(ctx),
+			CreatedAt:                strfmt.DateTime(time.Now()),
+			OpenshiftVersion:         p.OpenshiftVersion,
+			ClusterNetworkCidr:       p.ClusterNetworkCidr,
+			ClusterNetworkHostPrefix: p.ClusterNetworkHostPrefix,
+			ServiceNetworkCidr:       p.ServiceNetworkCidr,
+			HTTPProxy:                p.HTTPProxy,
+			HTTPSProxy:               p.HTTPSProxy,
+			NoProxy:                  p.NoProxy,
+			AdditionalNtpSource:      p.AdditionalNtpSource,
+			SSHPublicKey:             p.SSHPublicKey,
+			HighAvailabilityMode:     p.HighAvailabilityMode,
+			Hyperthreading:           p.Hyperthreading,
+			UserManagedNetworking:    p.UserManagedNetworking,
+			VipDhcpAllocation:        p.VipDhcpAllocation,
+			InstallConfigOverrides:   p.InstallConfigOverrides,
+		},
+		Resources: string(r),
+	}
+	if err = a.db.Create(&t).Error; err != nil {
+		log.WithError(err).Errorf("failed to create cluster template %s", swag.StringValue(p.Name))
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Created cluster template %s (%s)", id, swag.StringValue(p.Name))
+	ret, err := toModel(&t)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewCreateClusterTemplateCreated().WithPayload(ret)
+}
+
+func (a *Api) ListClusterTemplates(ctx context.Context, params operations.ListClusterTemplatesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	var templates []*common.ClusterTemplate
+	if err := a.db.Order("created_at").Find(&templates, identity.AddUserFilter(ctx, "")).Error; err != nil {
+		log.WithError(err).Error("failed to list cluster templates")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.ClusterTemplateList, 0, len(templates))
+	for _, t := range templates {
+		m, err := toModel(t)
+		if err != nil {
+			log.WithError(err).Errorf("failed to parse cluster template %s", t.ID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		ret = append(ret, m)
+	}
+	return operations.NewListClusterTemplatesOK().WithPayload(ret)
+}
+
+func (a *Api) GetClusterTemplate(ctx context.Context, params operations.GetClusterTemplateParams) middleware.Responder {
+	t, err := a.getTemplate(ctx, params.TemplateID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewGetClusterTemplateOK().WithPayload(t)
+}
+
+func (a *Api) DeleteClusterTemplate(ctx context.Context, params operations.DeleteClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	if _, err := a.getTemplate(ctx, params.TemplateID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	// Clusters created from the template keep their settings, they don't refer to the template
+	if err := a.db.Delete(&common.ClusterTemplate{}, "id = ?", params.TemplateID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to delete cluster template %s", params.TemplateID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Deleted cluster template %s", params.TemplateID)
+	return operations.NewDeleteClusterTemplateNoContent()
+}
+
+func (a *Api) Render(ctx context.Context, templateID strfmt.UUID, variables Variables) (*models.ClusterTemplate, error) {
+	t, err := a.getTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := render(t, variables)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "failed to render cluster template %s", templateID))
+	}
+	return ret, nil
+}
+
+// validate checks that the free form fields of the template are valid Go templates, that its manifests are
+// base64 encoded and that its OLM operators are supported
+func (a *Api) validate(p *models.ClusterTemplateCreateParams) error {
+	for name, text := range map[string]string{
+		"http_proxy":               p.HTTPProxy,
+		"https_proxy":              p.HTTPSProxy,
+		"no_proxy":                 p.NoProxy,
+		"additional_ntp_source":    p.AdditionalNtpSource,
+		"install_config_overrides": p.InstallConfigOverrides,
+	} {
+		if _, err := parse(name, text); err != nil {
+			return err
+		}
+	}
+	for _, operator := range p.OlmOperators {
+		if _, err := a.operatorsApi.GetOperatorByName(operator.Name); err != nil {
+			return err
+		}
+		if _, err := parse("properties of operator "+operator.Name, operator.Properties); err != nil {
+			return err
+		}
+	}
+	for _, manifest := range p.Manifests {
+		fileName := swag.StringValue(manifest.FileName)
+		content, err := base64.StdEncoding.DecodeString(swag.StringValue(manifest.Content))
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode manifest %s", fileName)
+		}
+		if _, err = parse("manifest "+fileName, string(content)); err != nil {
+			return err
+		}
+		if manifest.Folder == nil {
+			manifest.Folder = swag.String(models.CreateManifestParamsFolderManifests)
+		}
+	}
+	return nil
+}
+
+func (a *Api) getTemplate(ctx context.Context, templateID strfmt.UUID) (*models.ClusterTemplate, error) {
+	var t common.ClusterTemplate
+	if err := a.db.First(&t, identity.AddUserFilter(ctx, "id = ?"), templateID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("cluster template %s not found", templateID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return toModel(&t)
+}
+
+func toModel(t *common.ClusterTemplate) (*models.ClusterTemplate, error) {
+	var r resources
+	if t.Resources != "" {
+		if err := json.Unmarshal([]byte(t.Resources), &r); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal the resources of cluster template %s", t.ID)
+		}
+	}
+	ret := t.ClusterTemplate
+	ret.OlmOperators = r.OlmOperators
+	ret.Manifests = r.Manifests
+	if ret.OlmOperators == nil {
+		ret.OlmOperators = []*models.OperatorCreateParams{}
+	}
+	if ret.Manifests == nil {
+		ret.Manifests = []*models.CreateManifestParams{}
+	}
+	return &ret, nil
+}
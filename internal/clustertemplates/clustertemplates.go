@@ -0,0 +1,109 @@
+package clustertemplates
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"strings"
+	"text/template"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -source=clustertemplates.go -package=clustertemplates -destination=mock_clustertemplates.go
+
+type Renderer interface {
+	// Render returns the cluster template with its fields rendered with the variables of a new cluster.
+	// It fails with a not found API error when the template doesn't exist or belongs to another user, and with
+	// a bad request API error when the template refers to a missing variable.
+	Render(ctx context.Context, templateID strfmt.UUID, variables Variables) (*models.ClusterTemplate, error)
+}
+
+// Variables are the values of a new cluster that the fields of a cluster template can refer to, e.g.
+// {{ .Name }}, {{ .BaseDNSDomain }} or {{ .Variables.rack }}
+type Variables struct {
+	Name          string
+	BaseDNSDomain string
+	APIVip        string
+	IngressVip    string
+	Variables     map[string]string
+}
+
+// resources are the OLM operators and the manifests of a cluster template, kept JSON encoded in the database
+type resources struct {
+	OlmOperators []*models.OperatorCreateParams `json:"olm_operators,omitempty"`
+	Manifests    []*models.CreateManifestParams `json:"manifests,omitempty"`
+}
+
+// render returns a copy of the template in which the free form fields are rendered as Go templates
+func render(t *models.ClusterTemplate, variables Variables) (*models.ClusterTemplate, error) {
+	ret := *t
+	for name, field := range map[string]*string{
+		"http_proxy":               &ret.HTTPProxy,
+		"https_proxy":              &ret.HTTPSProxy,
+		"no_proxy":                 &ret.NoProxy,
+		"additional_ntp_source":    &ret.AdditionalNtpSource,
+		"install_config_overrides": &ret.InstallConfigOverrides,
+	} {
+		rendered, err := renderString(name, *field, variables)
+		if err != nil {
+			return nil, err
+		}
+		*field = rendered
+	}
+
+	ret.OlmOperators = make([]*models.OperatorCreateParams, 0, len(t.OlmOperators))
+	for _, operator := range t.OlmOperators {
+		properties, err := renderString("properties of operator "+operator.Name, operator.Properties, variables)
+		if err != nil {
+			return nil, err
+		}
+		ret.OlmOperators = append(ret.OlmOperators, &models.OperatorCreateParams{Name: operator.Name, Properties: properties})
+	}
+
+	ret.Manifests = make([]*models.CreateManifestParams, 0, len(t.Manifests))
+	for _, manifest := range t.Manifests {
+		fileName := swag.StringValue(manifest.FileName)
+		content, err := base64.StdEncoding.DecodeString(swag.StringValue(manifest.Content))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode manifest %s", fileName)
+		}
+		rendered, err := renderString("manifest "+fileName, string(content), variables)
+		if err != nil {
+			return nil, err
+		}
+		ret.Manifests = append(ret.Manifests, &models.CreateManifestParams{
+			FileName: manifest.FileName,
+			Folder:   manifest.Folder,
+			Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(rendered))),
+		})
+	}
+	return &ret, nil
+}
+
+func renderString(name, text string, variables Variables) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tmpl, err := parse(name, text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, variables); err != nil {
+		return "", errors.Wrapf(err, "failed to render the %s", name)
+	}
+	return buf.String(), nil
+}
+
+func parse(name, text string) (*template.Template, error) {
+	// Referring to a variable that the cluster doesn't set fails instead of rendering "<no value>"
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid template in the %s", name)
+	}
+	return tmpl, nil
+}
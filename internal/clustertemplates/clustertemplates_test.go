@@ -0,0 +1,181 @@
+package clustertemplates
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/pkg/errors"
+)
+
+func TestClusterTemplates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Cluster templates test Suite")
+}
+
+func encode(content string) *string {
+	return swag.String(base64.StdEncoding.EncodeToString([]byte(content)))
+}
+
+var variables = Variables{
+	Name:          "edge-042",
+	BaseDNSDomain: "example.com",
+	APIVip:        "10.0.42.10",
+	IngressVip:    "10.0.42.11",
+	Variables:     map[string]string{"site": "dc1", "proxy": "proxy.dc1.example.com"},
+}
+
+var _ = Describe("render", func() {
+	It("renders the free form fields, operator properties and manifests", func() {
+		t := &models.ClusterTemplate{
+			Name:                   swag.String("edge"),
+			OpenshiftVersion:       "4.8",
+			HTTPProxy:              "http://{{ .Variables.proxy }}:3128",
+			NoProxy:                ".{{ .Name }}.{{ .BaseDNSDomain }}",
+			AdditionalNtpSource:    "ntp.{{ .Variables.site }}.example.com",
+			InstallConfigOverrides: `{"metadata": {"labels": {"site": "{{ .Variables.site }}"}}}`,
+			OlmOperators:           []*models.OperatorCreateParams{{Name: "lso", Properties: `{"site": "{{ .Variables.site }}"}`}},
+			Manifests: []*models.CreateManifestParams{{
+				FileName: swag.String("vip.yaml"),
+				Folder:   swag.String(models.CreateManifestParamsFolderOpenshift),
+				Content:  encode("api: {{ .APIVip }}\ningress: {{ .IngressVip }}\n"),
+			}},
+		}
+
+		rendered, err := render(t, variables)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rendered.OpenshiftVersion).To(Equal("4.8"))
+		Expect(rendered.HTTPProxy).To(Equal("http://proxy.dc1.example.com:3128"))
+		Expect(rendered.NoProxy).To(Equal(".edge-042.example.com"))
+		Expect(rendered.AdditionalNtpSource).To(Equal("ntp.dc1.example.com"))
+		Expect(rendered.InstallConfigOverrides).To(Equal(`{"metadata": {"labels": {"site": "dc1"}}}`))
+		Expect(rendered.OlmOperators).To(Equal([]*models.OperatorCreateParams{{Name: "lso", Properties: `{"site": "dc1"}`}}))
+		Expect(rendered.Manifests).To(Equal([]*models.CreateManifestParams{{
+			FileName: swag.String("vip.yaml"),
+			Folder:   swag.String(models.CreateManifestParamsFolderOpenshift),
+			Content:  encode("api: 10.0.42.10\ningress: 10.0.42.11\n"),
+		}}))
+
+		By("keeping the template as is")
+		Expect(t.HTTPProxy).To(Equal("http://{{ .Variables.proxy }}:3128"))
+	})
+
+	It("fails on a missing variable", func() {
+		_, err := render(&models.ClusterTemplate{NoProxy: "{{ .Variables.rack }}"}, variables)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails on an invalid template", func() {
+		_, err := render(&models.ClusterTemplate{NoProxy: "{{ .Name"}, variables)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Cluster templates API", func() {
+	var (
+		ctx              = context.Background()
+		ctrl             *gomock.Controller
+		mockOperatorsApi *operators.MockAPI
+		db               *gorm.DB
+		dbName           string
+		api              *Api
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockOperatorsApi = operators.NewMockAPI(ctrl)
+		db, dbName = common.PrepareTestDB()
+		api = NewApi(db, common.GetTestLog(), mockOperatorsApi)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	create := func(params *models.ClusterTemplateCreateParams) *models.ClusterTemplate {
+		reply := api.CreateClusterTemplate(ctx, operations.CreateClusterTemplateParams{NewClusterTemplateParams: params})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewCreateClusterTemplateCreated()))
+		return reply.(*operations.CreateClusterTemplateCreated).Payload
+	}
+
+	It("creates, lists, renders and deletes templates", func() {
+		mockOperatorsApi.EXPECT().GetOperatorByName("lso").Return(&models.MonitoredOperator{Name: "lso"}, nil).Times(1)
+		t := create(&models.ClusterTemplateCreateParams{
+			Name:             swag.String("edge"),
+			OpenshiftVersion: "4.8",
+			NoProxy:          ".{{ .Name }}.{{ .BaseDNSDomain }}",
+			OlmOperators:     []*models.OperatorCreateParams{{Name: "lso"}},
+			Manifests:        []*models.CreateManifestParams{{FileName: swag.String("site.yaml"), Content: encode("site: {{ .Variables.site }}")}},
+		})
+		Expect(t.ID).NotTo(BeNil())
+		Expect(t.OlmOperators).To(HaveLen(1))
+		Expect(swag.StringValue(t.Manifests[0].Folder)).To(Equal(models.CreateManifestParamsFolderManifests))
+
+		reply := api.ListClusterTemplates(ctx, operations.ListClusterTemplatesParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListClusterTemplatesOK()))
+		templates := reply.(*operations.ListClusterTemplatesOK).Payload
+		Expect(templates).To(HaveLen(1))
+		Expect(templates[0].ID).To(Equal(t.ID))
+		Expect(templates[0].Manifests).To(Equal(t.Manifests))
+
+		rendered, err := api.Render(ctx, *t.ID, variables)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rendered.NoProxy).To(Equal(".edge-042.example.com"))
+		Expect(rendered.Manifests[0].Content).To(Equal(encode("site: dc1")))
+
+		_, err = api.Render(ctx, *t.ID, Variables{})
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+
+		Expect(api.DeleteClusterTemplate(ctx, operations.DeleteClusterTemplateParams{TemplateID: *t.ID})).
+			Should(BeAssignableToTypeOf(operations.NewDeleteClusterTemplateNoContent()))
+		reply = api.GetClusterTemplate(ctx, operations.GetClusterTemplateParams{TemplateID: *t.ID})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+
+	It("rejects invalid templates", func() {
+		reply := api.CreateClusterTemplate(ctx, operations.CreateClusterTemplateParams{
+			NewClusterTemplateParams: &models.ClusterTemplateCreateParams{Name: swag.String("edge"), NoProxy: "{{ .Name"},
+		})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+
+		reply = api.CreateClusterTemplate(ctx, operations.CreateClusterTemplateParams{
+			NewClusterTemplateParams: &models.ClusterTemplateCreateParams{
+				Name:      swag.String("edge"),
+				Manifests: []*models.CreateManifestParams{{FileName: swag.String("site.yaml"), Content: swag.String("not base64")}},
+			},
+		})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("rejects unsupported operators", func() {
+		mockOperatorsApi.EXPECT().GetOperatorByName("unknown").Return(nil, errors.New("not found")).Times(1)
+		reply := api.CreateClusterTemplate(ctx, operations.CreateClusterTemplateParams{
+			NewClusterTemplateParams: &models.ClusterTemplateCreateParams{
+				Name:         swag.String("edge"),
+				OlmOperators: []*models.OperatorCreateParams{{Name: "unknown"}},
+			},
+		})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("fails for a missing template", func() {
+		reply := api.GetClusterTemplate(ctx, operations.GetClusterTemplateParams{TemplateID: strfmt.UUID(uuid.New().String())})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+})
@@ -56,8 +56,90 @@ type Event struct {
 	models.Event
 }
 
+type Webhook struct {
+	models.Webhook
+
+	// Shared secret used to sign the notifications that are posted to the webhook
+	Secret string `gorm:"type:text"`
+
+	// JSON encoded severities, categories and event types that the webhook is notified about
+	Filters string `gorm:"type:text"`
+}
+
+type WebhookDelivery struct {
+	models.WebhookDelivery
+
+	// JSON encoded notification that is posted to the webhook
+	Payload string `gorm:"type:text"`
+}
+
+// TimelineRecord is a change in the installation progress of a cluster or of one of its hosts.
+// The records are kept so that the installation can be laid out over time after the current
+// stage or status of the cluster and its hosts was overwritten.
+type TimelineRecord struct {
+	ID        uint        `gorm:"primary_key"`
+	ClusterID strfmt.UUID `gorm:"index"`
+	HostID    strfmt.UUID
+	// The kind of the change, e.g. a host stage or a cluster status
+	Kind string
+	// The name of the stage, status, validation or operator
+	Name      string
+	Status    string
+	Info      string    `gorm:"type:text"`
+	CreatedAt time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// StatusHistory is an append-only record of a status change of a cluster or of one of its hosts
+type StatusHistory struct {
+	models.StatusHistoryEntry
+	// The failed validations of the entry in JSON format
+	FailedValidationsInfo string `gorm:"column:failed_validations;type:text"`
+}
+
+func (StatusHistory) TableName() string {
+	return "status_history"
+}
+
+// LogObject is a log archive that was uploaded from a cluster or one of its hosts to the object storage.
+// The objects are tracked so that the log retention policies can be enforced without listing the bucket.
+type LogObject struct {
+	ObjectName string      `gorm:"primary_key"`
+	ClusterID  strfmt.UUID `gorm:"index"`
+	HostID     strfmt.UUID
+	// The organization of the cluster, which the per-tenant quota applies to
+	OrgID      string `gorm:"index"`
+	LogsType   string
+	SizeBytes  int64
+	UploadedAt time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// LogObjectSizeUnknown is the size of the objects that were uploaded before they were tracked, until the
+// log retention gets their size from the object storage
+const LogObjectSizeUnknown int64 = -1
+
+// ClusterTemplate holds settings from which clusters are created
+type ClusterTemplate struct {
+	models.ClusterTemplate
+
+	// JSON encoded OLM operators and manifests of the template
+	Resources string `gorm:"type:text"`
+}
+
+// HostAssignment holds the settings of a host of an imported cluster until a host with a matching MAC address or
+// serial number reports its inventory to the cluster. Each assignment is applied to a single host.
+type HostAssignment struct {
+	ID        uint        `gorm:"primary_key"`
+	ClusterID strfmt.UUID `gorm:"index"`
+	// Comma separated, lower case MAC addresses of the exported host
+	MacAddresses       string `gorm:"type:text"`
+	SerialNumber       string
+	Role               models.HostRole
+	RequestedHostname  string
+	InstallationDiskID string
+}
+
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{}).Error
+	return db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{}, &Webhook{}, &WebhookDelivery{}, &TimelineRecord{}, &StatusHistory{}, &models.LogFinding{}, &LogObject{}, &HostAssignment{}, &ClusterTemplate{}).Error
 }
 
 type Host struct {
@@ -0,0 +1,200 @@
+package configdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// Diff returns the JSON patch operations that turn the base JSON document, generated by the service, into the
+// effective one after the user overrides, and a warning for every value of the base document that the overrides
+// replace or remove
+func Diff(base, effective []byte) (*models.ConfigDiff, error) {
+	var baseDoc, effectiveDoc interface{}
+	if err := json.Unmarshal(base, &baseDoc); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the generated configuration")
+	}
+	if err := json.Unmarshal(effective, &effectiveDoc); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the effective configuration")
+	}
+
+	result := &models.ConfigDiff{Operations: []*models.ConfigDiffOperation{}, Warnings: []string{}}
+	diff("", baseDoc, effectiveDoc, result)
+	return result, nil
+}
+
+func diff(path string, base, effective interface{}, result *models.ConfigDiff) {
+	baseMap, baseIsMap := base.(map[string]interface{})
+	effectiveMap, effectiveIsMap := effective.(map[string]interface{})
+	if baseIsMap && effectiveIsMap {
+		diffMaps(path, baseMap, effectiveMap, result)
+		return
+	}
+	baseSlice, baseIsSlice := base.([]interface{})
+	effectiveSlice, effectiveIsSlice := effective.([]interface{})
+	if baseIsSlice && effectiveIsSlice {
+		diffSlices(path, baseSlice, effectiveSlice, result)
+		return
+	}
+	if !reflect.DeepEqual(base, effective) {
+		addOperation(result, models.ConfigDiffOperationOpReplace, path, base, effective)
+	}
+}
+
+func diffMaps(path string, base, effective map[string]interface{}, result *models.ConfigDiff) {
+	keys := make([]string, 0, len(base)+len(effective))
+	for key := range base {
+		keys = append(keys, key)
+	}
+	for key := range effective {
+		if _, ok := base[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keyPath := path + "/" + escape(key)
+		baseValue, inBase := base[key]
+		effectiveValue, inEffective := effective[key]
+		switch {
+		case !inEffective:
+			addOperation(result, models.ConfigDiffOperationOpRemove, keyPath, baseValue, nil)
+		case !inBase:
+			addOperation(result, models.ConfigDiffOperationOpAdd, keyPath, nil, effectiveValue)
+		default:
+			diff(keyPath, baseValue, effectiveValue, result)
+		}
+	}
+}
+
+func diffSlices(path string, base, effective []interface{}, result *models.ConfigDiff) {
+	shared := len(base)
+	if len(effective) < shared {
+		shared = len(effective)
+	}
+	for i := 0; i < shared; i++ {
+		diff(fmt.Sprintf("%s/%d", path, i), base[i], effective[i], result)
+	}
+	for i := shared; i < len(effective); i++ {
+		addOperation(result, models.ConfigDiffOperationOpAdd, fmt.Sprintf("%s/%d", path, i), nil, effective[i])
+	}
+	// Removing from the end keeps the indexes of the remaining elements valid while the patch is applied
+	for i := len(base) - 1; i >= shared; i-- {
+		addOperation(result, models.ConfigDiffOperationOpRemove, fmt.Sprintf("%s/%d", path, i), base[i], nil)
+	}
+}
+
+func addOperation(result *models.ConfigDiff, op, path string, baseValue, value interface{}) {
+	result.Operations = append(result.Operations, &models.ConfigDiffOperation{
+		Op:        swag.String(op),
+		Path:      swag.String(path),
+		BaseValue: baseValue,
+		Value:     value,
+	})
+	switch op {
+	case models.ConfigDiffOperationOpReplace:
+		result.Warnings = append(result.Warnings, fmt.Sprintf("The overrides replace %s, which is set by the service", displayPath(path)))
+	case models.ConfigDiffOperationOpRemove:
+		result.Warnings = append(result.Warnings, fmt.Sprintf("The overrides remove %s, which is set by the service", displayPath(path)))
+	}
+}
+
+// OverriddenPaths returns the paths of the values set by the service that the overrides replace or remove
+func OverriddenPaths(d *models.ConfigDiff) []string {
+	paths := []string{}
+	for _, operation := range d.Operations {
+		if swag.StringValue(operation.Op) != models.ConfigDiffOperationOpAdd {
+			paths = append(paths, displayPath(swag.StringValue(operation.Path)))
+		}
+	}
+	return paths
+}
+
+// Redacted stands for the sensitive values of the differences
+const Redacted = "*****"
+
+// Redact hides the base and the effective values of the sensitive paths, and of everything under them, e.g. the pull
+// secret. A "*" token of a sensitive path matches any key or index.
+func Redact(d *models.ConfigDiff, sensitivePaths ...string) {
+	patterns := make([][]string, 0, len(sensitivePaths))
+	for _, path := range sensitivePaths {
+		patterns = append(patterns, tokens(path))
+	}
+	for _, operation := range d.Operations {
+		operationTokens := tokens(swag.StringValue(operation.Path))
+		operation.BaseValue = redact(operationTokens, operation.BaseValue, patterns)
+		operation.Value = redact(operationTokens, operation.Value, patterns)
+	}
+}
+
+func redact(path []string, value interface{}, patterns [][]string) interface{} {
+	if value == nil {
+		return nil
+	}
+	for _, pattern := range patterns {
+		if matches(pattern, path) {
+			return Redacted
+		}
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			ret[key] = redact(append(path[:len(path):len(path)], key), child, patterns)
+		}
+		return ret
+	case []interface{}:
+		ret := make([]interface{}, len(v))
+		for i, child := range v {
+			ret[i] = redact(append(path[:len(path):len(path)], strconv.Itoa(i)), child, patterns)
+		}
+		return ret
+	default:
+		return value
+	}
+}
+
+// matches returns true if the path is the pattern or is under it
+func matches(pattern, path []string) bool {
+	if len(pattern) > len(path) {
+		return false
+	}
+	for i, token := range pattern {
+		if token != "*" && token != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// tokens splits a JSON pointer into its unescaped reference tokens
+func tokens(path string) []string {
+	if path == "" {
+		return []string{}
+	}
+	ret := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, token := range ret {
+		ret[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return ret
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "the whole configuration"
+	}
+	return path
+}
+
+// escape encodes a key as a JSON pointer reference token, see RFC 6901
+func escape(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
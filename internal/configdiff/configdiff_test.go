@@ -0,0 +1,92 @@
+package configdiff
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+func TestConfigDiff(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Config diff test Suite")
+}
+
+func operation(op, path string, baseValue, value interface{}) *models.ConfigDiffOperation {
+	return &models.ConfigDiffOperation{Op: swag.String(op), Path: swag.String(path), BaseValue: baseValue, Value: value}
+}
+
+var _ = Describe("Diff", func() {
+	It("returns no operations for identical configurations", func() {
+		result, err := Diff([]byte(`{"a": {"b": [1, 2]}}`), []byte(`{"a": {"b": [1, 2]}}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Operations).To(BeEmpty())
+		Expect(result.Warnings).To(BeEmpty())
+	})
+
+	It("returns the added, replaced and removed values", func() {
+		base := `{"networking": {"networkType": "OpenShiftSDN", "clusterNetwork": [{"cidr": "10.128.0.0/14"}]},
+			"pullSecret": "secret", "fips": false, "a/b": 1}`
+		effective := `{"networking": {"networkType": "OVNKubernetes", "clusterNetwork": [{"cidr": "10.128.0.0/14"}, {"cidr": "fd01::/48"}]},
+			"fips": true, "controlPlane": {"hyperthreading": "Disabled"}, "a/b": 1}`
+
+		result, err := Diff([]byte(base), []byte(effective))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Operations).To(Equal([]*models.ConfigDiffOperation{
+			operation(models.ConfigDiffOperationOpAdd, "/controlPlane", nil, map[string]interface{}{"hyperthreading": "Disabled"}),
+			operation(models.ConfigDiffOperationOpReplace, "/fips", false, true),
+			operation(models.ConfigDiffOperationOpAdd, "/networking/clusterNetwork/1", nil, map[string]interface{}{"cidr": "fd01::/48"}),
+			operation(models.ConfigDiffOperationOpReplace, "/networking/networkType", "OpenShiftSDN", "OVNKubernetes"),
+			operation(models.ConfigDiffOperationOpRemove, "/pullSecret", "secret", nil),
+		}))
+		Expect(result.Warnings).To(Equal([]string{
+			"The overrides replace /fips, which is set by the service",
+			"The overrides replace /networking/networkType, which is set by the service",
+			"The overrides remove /pullSecret, which is set by the service",
+		}))
+		Expect(OverriddenPaths(result)).To(Equal([]string{"/fips", "/networking/networkType", "/pullSecret"}))
+	})
+
+	It("removes array elements from the end and escapes keys", func() {
+		result, err := Diff([]byte(`{"a/b~c": [1, 2, 3]}`), []byte(`{"a/b~c": [1]}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Operations).To(Equal([]*models.ConfigDiffOperation{
+			operation(models.ConfigDiffOperationOpRemove, "/a~1b~0c/2", float64(3), nil),
+			operation(models.ConfigDiffOperationOpRemove, "/a~1b~0c/1", float64(2), nil),
+		}))
+	})
+
+	It("fails on invalid JSON", func() {
+		_, err := Diff([]byte(`{`), []byte(`{}`))
+		Expect(err).To(HaveOccurred())
+		_, err = Diff([]byte(`{}`), []byte(`{`))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Redact", func() {
+	It("hides the values of the sensitive paths and of everything under them", func() {
+		result, err := Diff([]byte(`{"pullSecret": "secret", "fips": false, "users": [{"name": "core", "passwordHash": "hash"}]}`),
+			[]byte(`{"fips": true, "users": [{"name": "admin", "passwordHash": "other"}]}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Redact(result, "/pullSecret", "/users/*/passwordHash")
+		Expect(result.Operations).To(Equal([]*models.ConfigDiffOperation{
+			operation(models.ConfigDiffOperationOpReplace, "/fips", false, true),
+			operation(models.ConfigDiffOperationOpRemove, "/pullSecret", Redacted, nil),
+			operation(models.ConfigDiffOperationOpReplace, "/users/0/name", "core", "admin"),
+			operation(models.ConfigDiffOperationOpReplace, "/users/0/passwordHash", Redacted, Redacted),
+		}))
+	})
+
+	It("hides the sensitive values nested in the values of the operations", func() {
+		result, err := Diff([]byte(`{}`), []byte(`{"users": [{"name": "core", "passwordHash": "hash"}]}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Redact(result, "/users/*/passwordHash")
+		Expect(result.Operations).To(Equal([]*models.ConfigDiffOperation{
+			operation(models.ConfigDiffOperationOpAdd, "/users", nil,
+				[]interface{}{map[string]interface{}{"name": "core", "passwordHash": Redacted}}),
+		}))
+	})
+})
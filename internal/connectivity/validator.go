@@ -3,6 +3,7 @@ package connectivity
 import (
 	"encoding/json"
 
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -31,5 +32,5 @@ func (v *validator) GetHostValidInterfaces(host *models.Host) ([]*models.Interfa
 	if len(inventory.Interfaces) == 0 {
 		return nil, errors.Errorf("host %s doesn't have interfaces", host.ID)
 	}
-	return inventory.Interfaces, nil
+	return network.LogicalInterfaces(inventory.Interfaces), nil
 }
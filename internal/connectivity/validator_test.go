@@ -48,6 +48,22 @@ var _ = Describe("get valid interfaces", func() {
 		Expect(len(interfaces)).Should(Equal(1))
 	})
 
+	It("bond members", func() {
+		inventory.Interfaces = []*models.Interface{
+			{Name: "eth0", Type: models.InterfaceTypePhysical, MacAddress: "f8:75:a4:a4:00:ff"},
+			{Name: "eth1", Type: models.InterfaceTypePhysical, MacAddress: "f8:75:a4:a4:00:ff"},
+			{Name: "bond0", Type: models.InterfaceTypeBond, MacAddress: "f8:75:a4:a4:00:ff", Members: []string{"eth0", "eth1"},
+				IPV4Addresses: []string{"1.2.3.4/24"}},
+		}
+		hw, err := json.Marshal(&inventory)
+		Expect(err).NotTo(HaveOccurred())
+		host.Inventory = string(hw)
+		interfaces, err := connectivityValidator.GetHostValidInterfaces(host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interfaces).To(HaveLen(1))
+		Expect(interfaces[0].Name).To(Equal("bond0"))
+	})
+
 	It("invalid interfaces", func() {
 
 		host.Inventory = ""
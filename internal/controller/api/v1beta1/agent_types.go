@@ -61,6 +61,9 @@ type HostInterface struct {
 	MacAddress    string   `json:"macAddress,omitempty"`
 	Flags         []string `json:"flags"`
 	SpeedMbps     int64    `json:"speedMbps,omitempty"`
+	Type          string   `json:"type,omitempty"`
+	Members       []string `json:"members,omitempty"`
+	VlanID        int64    `json:"vlanID,omitempty"`
 }
 
 type HostInstallationEligibility struct {
@@ -435,6 +435,9 @@ func (r *AgentReconciler) updateInventory(host *models.Host, agent *aiv1beta1.Ag
 			ifcs[i].ClientId = inf.ClientID
 			ifcs[i].MacAddress = inf.MacAddress
 			ifcs[i].SpeedMbps = inf.SpeedMbps
+			ifcs[i].Type = inf.Type
+			ifcs[i].Members = inf.Members
+			ifcs[i].VlanID = inf.VlanID
 		}
 	}
 	if inventory.Disks != nil {
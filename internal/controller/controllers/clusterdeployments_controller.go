@@ -571,6 +571,19 @@ func (r *ClusterDeploymentsReconciler) createNewCluster(
 		clusterParams.ServiceNetworkCidr = swag.String(spec.Provisioning.InstallStrategy.Agent.Networking.ServiceNetwork[0])
 	}
 
+	// Networks of both IP address families make a dual-stack cluster
+	if networking := spec.Provisioning.InstallStrategy.Agent.Networking; len(networking.ClusterNetwork) > 1 || len(networking.ServiceNetwork) > 1 {
+		for _, n := range networking.ClusterNetwork {
+			clusterParams.ClusterNetworks = append(clusterParams.ClusterNetworks, &models.ClusterNetwork{Cidr: n.CIDR, HostPrefix: int64(n.HostPrefix)})
+		}
+		for _, cidr := range networking.ServiceNetwork {
+			clusterParams.ServiceNetworks = append(clusterParams.ServiceNetworks, &models.ServiceNetwork{Cidr: cidr})
+		}
+		for _, n := range networking.MachineNetwork {
+			clusterParams.MachineNetworks = append(clusterParams.MachineNetworks, &models.MachineNetwork{Cidr: n.CIDR})
+		}
+	}
+
 	if spec.Provisioning.InstallStrategy.Agent.ProvisionRequirements.ControlPlaneAgents == 1 &&
 		spec.Provisioning.InstallStrategy.Agent.ProvisionRequirements.WorkerAgents == 0 {
 		clusterParams.HighAvailabilityMode = swag.String(HighAvailabilityModeNone)
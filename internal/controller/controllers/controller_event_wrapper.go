@@ -50,3 +50,7 @@ func (c *controllerEventsWrapper) AddMetricsEvent(ctx context.Context, clusterID
 func (c *controllerEventsWrapper) GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories ...string) ([]*common.Event, error) {
 	return c.events.GetEvents(clusterID, hostID, categories...)
 }
+
+func (c *controllerEventsWrapper) QueryEvents(filter *events.Filter) ([]*common.Event, int64, error) {
+	return c.events.QueryEvents(filter)
+}
@@ -0,0 +1,103 @@
+package customvalidations
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/validations"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.ValidationsAPI = &Api{}
+
+type Api struct {
+	db       *gorm.DB
+	log      logrus.FieldLogger
+	defaults Validations
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger, defaults Validations) *Api {
+	return &Api{
+		db:       db,
+		log:      log,
+		defaults: defaults,
+	}
+}
+
+func (a *Api) ListCustomHostValidations(ctx context.Context, params operations.ListCustomHostValidationsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	cluster, err := a.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+	validations, err := ForCluster(&cluster.Cluster, a.defaults)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get custom host validations of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewListCustomHostValidationsOK().WithPayload(toPayload(validations))
+}
+
+func (a *Api) UpdateCustomHostValidations(ctx context.Context, params operations.UpdateCustomHostValidationsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	validations := Validations(params.CustomHostValidations)
+	if err := Validate(validations); err != nil {
+		log.WithError(err).Errorf("invalid custom host validations for cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if _, err := a.getCluster(ctx, params.ClusterID.String()); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+	b, err := json.Marshal(toPayload(validations))
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = a.db.Model(&common.Cluster{}).Where("id = ?", params.ClusterID.String()).
+		Update("custom_host_validations", string(b)).Error; err != nil {
+		log.WithError(err).Errorf("failed to update custom host validations of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewUpdateCustomHostValidationsOK().WithPayload(toPayload(validations))
+}
+
+func (a *Api) ResetCustomHostValidations(ctx context.Context, params operations.ResetCustomHostValidationsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	if _, err := a.getCluster(ctx, params.ClusterID.String()); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+	if err := a.db.Model(&common.Cluster{}).Where("id = ?", params.ClusterID.String()).
+		Update("custom_host_validations", "").Error; err != nil {
+		log.WithError(err).Errorf("failed to reset custom host validations of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewResetCustomHostValidationsNoContent()
+}
+
+func (a *Api) getCluster(ctx context.Context, clusterID string) (*common.Cluster, error) {
+	var cluster common.Cluster
+	if err := a.db.First(&cluster, identity.AddUserFilter(ctx, "id = ?"), clusterID).Error; err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
+
+func toPayload(validations Validations) models.CustomHostValidationList {
+	if validations == nil {
+		return models.CustomHostValidationList{}
+	}
+	return models.CustomHostValidationList(validations)
+}
@@ -0,0 +1,199 @@
+package customvalidations
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	"github.com/openshift/assisted-service/models"
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+)
+
+// Category is the category of the custom validations in the validations info of a host
+const Category = "custom"
+
+// InventoryVariable is the name of the variable that holds the inventory of the host in the expressions
+const InventoryVariable = "inventory"
+
+// Validations is a list of custom host validations that can be set from a JSON environment variable
+type Validations []*models.CustomHostValidation
+
+func (v *Validations) Decode(value string) error {
+	var validations Validations
+	if err := json.Unmarshal([]byte(value), &validations); err != nil {
+		return errors.Wrap(err, "custom host validations must be a JSON list")
+	}
+	if err := Validate(validations); err != nil {
+		return err
+	}
+	*v = validations
+	return nil
+}
+
+// ForCluster returns the custom validations of the cluster, or the given defaults if the cluster
+// doesn't have its own
+func ForCluster(cluster *models.Cluster, defaults Validations) (Validations, error) {
+	if cluster == nil || cluster.CustomHostValidations == "" {
+		return defaults, nil
+	}
+	var ret Validations
+	if err := json.Unmarshal([]byte(cluster.CustomHostValidations), &ret); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal custom host validations of cluster %s", cluster.ID)
+	}
+	return ret, nil
+}
+
+// IsBlocking tells whether a failure of the validation keeps the host from being ready for installation
+func IsBlocking(v *models.CustomHostValidation) bool {
+	return v.Blocking == nil || *v.Blocking
+}
+
+// Validate checks that the validations are well formed, that their IDs are unique and don't clash with
+// the built-in host validations, and that their expressions compile to a boolean
+func Validate(validations Validations) error {
+	ids := make(map[string]struct{}, len(validations))
+	for _, v := range validations {
+		if v == nil {
+			return errors.New("custom host validation must not be null")
+		}
+		if err := v.Validate(strfmt.Default); err != nil {
+			return err
+		}
+		id := swag.StringValue(v.ID)
+		if _, ok := ids[id]; ok {
+			return errors.Errorf("custom host validation %s is defined more than once", id)
+		}
+		ids[id] = struct{}{}
+		if models.HostValidationID(id).Validate(strfmt.Default) == nil {
+			return errors.Errorf("custom host validation %s has the same ID as a built-in host validation", id)
+		}
+		if _, err := defaultEvaluator.program(swag.StringValue(v.Expression)); err != nil {
+			return errors.Wrapf(err, "invalid expression of custom host validation %s", id)
+		}
+	}
+	return nil
+}
+
+// Evaluate evaluates the expression of the validation over the inventory of a host
+func Evaluate(v *models.CustomHostValidation, inventory string) (bool, error) {
+	return defaultEvaluator.evaluate(swag.StringValue(v.Expression), inventory)
+}
+
+var defaultEvaluator = newEvaluator()
+
+// programExpiration is how long a compiled expression is kept after it was last evaluated, so that the
+// expressions of validations that were replaced or reset are dropped
+const programExpiration = time.Hour
+
+// evaluator compiles every expression once, since the same validations are evaluated for all the
+// hosts of a cluster on every refresh
+type evaluator struct {
+	env      *cel.Env
+	programs *cache.Cache
+}
+
+func newEvaluator() *evaluator {
+	env, err := cel.NewEnv(cel.Declarations(
+		decls.NewVar(InventoryVariable, decls.NewMapType(decls.String, decls.Dyn))))
+	if err != nil {
+		panic(fmt.Sprintf("failed to create the custom host validations environment: %s", err))
+	}
+	return &evaluator{env: env, programs: cache.New(programExpiration, programExpiration)}
+}
+
+func (e *evaluator) program(expression string) (cel.Program, error) {
+	if prg, ok := e.programs.Get(expression); ok {
+		// Evaluating the expression keeps it in the cache
+		e.programs.Set(expression, prg, cache.DefaultExpiration)
+		return prg.(cel.Program), nil
+	}
+	ast, issues := e.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if !proto.Equal(ast.ResultType(), decls.Bool) && !proto.Equal(ast.ResultType(), decls.Dyn) {
+		return nil, errors.Errorf("expression must evaluate to a bool")
+	}
+	prg, err := e.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	e.programs.Set(expression, prg, cache.DefaultExpiration)
+	return prg, nil
+}
+
+func (e *evaluator) evaluate(expression, inventory string) (bool, error) {
+	prg, err := e.program(expression)
+	if err != nil {
+		return false, err
+	}
+	vars, err := unmarshalInventory(inventory)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := prg.Eval(map[string]interface{}{InventoryVariable: vars})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.(types.Bool)
+	if !ok {
+		return false, errors.Errorf("expression evaluated to %v instead of a bool", out.Value())
+	}
+	return bool(result), nil
+}
+
+// unmarshalInventory unmarshals the inventory with integral numbers as ints, since CEL doesn't compare
+// ints with doubles and the expressions compare the sizes and counts of the inventory with int literals
+func unmarshalInventory(inventory string) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(inventory)))
+	decoder.UseNumber()
+	var vars map[string]interface{}
+	if err := decoder.Decode(&vars); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal inventory")
+	}
+	ret, err := convertNumbers(vars)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal inventory")
+	}
+	return ret.(map[string]interface{}), nil
+}
+
+func convertNumbers(value interface{}) (interface{}, error) {
+	var err error
+	switch v := value.(type) {
+	case json.Number:
+		if i, intErr := v.Int64(); intErr == nil {
+			return i, nil
+		}
+		f, floatErr := v.Float64()
+		if floatErr != nil {
+			return nil, floatErr
+		}
+		if f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+			return int64(f), nil
+		}
+		return f, nil
+	case map[string]interface{}:
+		for key, item := range v {
+			if v[key], err = convertNumbers(item); err != nil {
+				return nil, err
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			if v[i], err = convertNumbers(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return value, nil
+}
@@ -0,0 +1,175 @@
+package customvalidations_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/customvalidations"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/validations"
+)
+
+func TestCustomValidations(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Custom validations test Suite")
+}
+
+func newValidation(id, expression string) *models.CustomHostValidation {
+	return &models.CustomHostValidation{ID: swag.String(id), Expression: swag.String(expression)}
+}
+
+var _ = Describe("Validate", func() {
+	It("accepts boolean expressions over the inventory", func() {
+		Expect(customvalidations.Validate(customvalidations.Validations{
+			newValidation("bmc-address", `inventory.bmc_address != ""`),
+			newValidation("intel-nic", `inventory.interfaces.exists(i, i.vendor == "0x8086")`),
+			newValidation("serial-number", `inventory.system_vendor.serial_number`),
+		})).To(Succeed())
+	})
+
+	It("rejects expressions that don't compile", func() {
+		Expect(customvalidations.Validate(customvalidations.Validations{newValidation("broken", `inventory.bmc_address ==`)})).ToNot(Succeed())
+	})
+
+	It("rejects expressions that don't evaluate to a bool", func() {
+		Expect(customvalidations.Validate(customvalidations.Validations{newValidation("string", `"yes"`)})).ToNot(Succeed())
+	})
+
+	It("rejects duplicate IDs", func() {
+		Expect(customvalidations.Validate(customvalidations.Validations{
+			newValidation("bmc-address", "true"),
+			newValidation("bmc-address", "false"),
+		})).ToNot(Succeed())
+	})
+
+	It("rejects IDs of built-in validations", func() {
+		Expect(customvalidations.Validate(customvalidations.Validations{newValidation(string(models.HostValidationIDNtpSynced), "true")})).ToNot(Succeed())
+	})
+
+	It("rejects malformed IDs", func() {
+		Expect(customvalidations.Validate(customvalidations.Validations{newValidation("Not Valid", "true")})).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Evaluate", func() {
+	var inventory string
+
+	BeforeEach(func() {
+		b, err := json.Marshal(&models.Inventory{
+			BmcAddress:   "192.168.1.10",
+			CPU:          &models.CPU{Count: 8, Frequency: 2400.5},
+			Disks:        []*models.Disk{{Name: "sda", SizeBytes: 120 * 1024 * 1024 * 1024}},
+			Interfaces:   []*models.Interface{{Name: "eth0", Vendor: "0x8086"}},
+			Memory:       &models.Memory{PhysicalBytes: 16 * 1024 * 1024 * 1024},
+			SystemVendor: &models.SystemVendor{SerialNumber: "DC1-0042"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		inventory = string(b)
+	})
+
+	It("evaluates the expression over the inventory", func() {
+		for expression, expected := range map[string]bool{
+			`inventory.bmc_address != ""`:                                true,
+			`inventory.interfaces.exists(i, i.vendor == "0x8086")`:       true,
+			`inventory.interfaces.all(i, i.vendor == "0x15b3")`:          false,
+			`inventory.system_vendor.serial_number.matches("^DC1-")`:     true,
+			`inventory.cpu.count >= 8`:                                   true,
+			`inventory.cpu.count > 8`:                                    false,
+			`inventory.cpu.frequency > 2400.0`:                           true,
+			`inventory.memory.physical_bytes >= 16 * 1024 * 1024 * 1024`: true,
+			`inventory.disks.exists(d, d.size_bytes > 100000000000)`:     true,
+			`inventory.disks.all(d, d.size_bytes > 200000000000)`:        false,
+		} {
+			ok, err := customvalidations.Evaluate(newValidation("v", expression), inventory)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(Equal(expected), expression)
+		}
+	})
+
+	It("fails for fields missing from the inventory", func() {
+		_, err := customvalidations.Evaluate(newValidation("v", `inventory.boot.current_boot_mode == "uefi"`), inventory)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Decode", func() {
+	It("decodes a JSON list", func() {
+		var validations customvalidations.Validations
+		Expect(validations.Decode(`[{"id": "bmc-address", "expression": "inventory.bmc_address != \"\"", "blocking": false}]`)).To(Succeed())
+		Expect(validations).To(HaveLen(1))
+		Expect(customvalidations.IsBlocking(validations[0])).To(BeFalse())
+	})
+
+	It("rejects invalid validations", func() {
+		var validations customvalidations.Validations
+		Expect(validations.Decode(`[{"id": "bmc-address"}]`)).ToNot(Succeed())
+		Expect(validations.Decode(`{}`)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Custom host validations API", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		dbName    string
+		api       *customvalidations.Api
+		clusterID strfmt.UUID
+		defaults  customvalidations.Validations
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		defaults = customvalidations.Validations{newValidation("default", "true")}
+		api = customvalidations.NewApi(db, common.GetTestLog(), defaults)
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	list := func() models.CustomHostValidationList {
+		reply := api.ListCustomHostValidations(ctx, operations.ListCustomHostValidationsParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListCustomHostValidationsOK()))
+		return reply.(*operations.ListCustomHostValidationsOK).Payload
+	}
+
+	It("overrides the defaults for the cluster until it is reset", func() {
+		Expect(list()).To(Equal(models.CustomHostValidationList(defaults)))
+
+		validations := models.CustomHostValidationList{newValidation("bmc-address", `inventory.bmc_address != ""`)}
+		reply := api.UpdateCustomHostValidations(ctx, operations.UpdateCustomHostValidationsParams{ClusterID: clusterID, CustomHostValidations: validations})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewUpdateCustomHostValidationsOK()))
+		Expect(list()).To(Equal(validations))
+
+		reply = api.ResetCustomHostValidations(ctx, operations.ResetCustomHostValidationsParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewResetCustomHostValidationsNoContent()))
+		Expect(list()).To(Equal(models.CustomHostValidationList(defaults)))
+	})
+
+	It("rejects invalid validations", func() {
+		reply := api.UpdateCustomHostValidations(ctx, operations.UpdateCustomHostValidationsParams{
+			ClusterID:             clusterID,
+			CustomHostValidations: models.CustomHostValidationList{newValidation("broken", "inventory.")},
+		})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("fails for a missing cluster", func() {
+		reply := api.ListCustomHostValidations(ctx, operations.ListCustomHostValidationsParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+})
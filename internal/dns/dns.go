@@ -40,7 +40,8 @@ type DNSProviderFactory interface {
 }
 
 type defaultDNSProviderFactory struct {
-	log logrus.FieldLogger
+	log    logrus.FieldLogger
+	config Config
 }
 
 type handler struct {
@@ -49,8 +50,8 @@ type handler struct {
 	providerFactory DNSProviderFactory
 }
 
-func NewDNSHandler(baseDNSDomains map[string]string, log logrus.FieldLogger) DNSApi {
-	return NewDNSHandlerWithProviders(baseDNSDomains, log, &defaultDNSProviderFactory{log})
+func NewDNSHandler(baseDNSDomains map[string]string, config Config, log logrus.FieldLogger) DNSApi {
+	return NewDNSHandlerWithProviders(baseDNSDomains, log, &defaultDNSProviderFactory{log: log, config: config})
 }
 
 func NewDNSHandlerWithProviders(baseDNSDomains map[string]string, log logrus.FieldLogger, providers DNSProviderFactory) DNSApi {
@@ -230,31 +231,3 @@ func (h *handler) checkDNSRecordsExists(names []string, domain *DNSDomain, recor
 	}
 	return nil
 }
-
-func (f *defaultDNSProviderFactory) GetProviderByRecordType(domain *DNSDomain, recordType string) dnsproviders.Provider {
-	switch domain.Provider {
-	case "route53":
-		return dnsproviders.Route53{
-			RecordSet: dnsproviders.RecordSet{
-				RecordSetType: recordType,
-				TTL:           60,
-			},
-			HostedZoneID: domain.ID,
-			SharedCreds:  true,
-		}
-	}
-	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
-	return nil
-}
-
-func (f *defaultDNSProviderFactory) GetProvider(domain *DNSDomain) dnsproviders.Provider {
-	switch domain.Provider {
-	case "route53":
-		return dnsproviders.Route53{
-			HostedZoneID: domain.ID,
-			SharedCreds:  true,
-		}
-	}
-	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
-	return nil
-}
@@ -2,12 +2,18 @@ package dns
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
 	"github.com/go-openapi/swag"
 	gomock "github.com/golang/mock/gomock"
+	miekgdns "github.com/miekg/dns"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
@@ -23,7 +29,7 @@ var _ = Describe("DNS tests", func() {
 
 	BeforeEach(func() {
 		baseDNSDomains = make(map[string]string)
-		dnsApi = NewDNSHandler(baseDNSDomains, logrus.New())
+		dnsApi = NewDNSHandler(baseDNSDomains, Config{}, logrus.New())
 	})
 
 	It("get DNS domain success", func() {
@@ -237,11 +243,11 @@ var _ = Describe("Default DNS provider tests", func() {
 		domain = &DNSDomain{
 			Provider: "route53",
 		}
-		providers = &defaultDNSProviderFactory{logrus.New()}
+		providers = &defaultDNSProviderFactory{log: logrus.New()}
 	})
 
 	It("default provider is used when no provider factory specified", func() {
-		dns := NewDNSHandler(make(map[string]string), logrus.New())
+		dns := NewDNSHandler(make(map[string]string), Config{}, logrus.New())
 		h, ok := dns.(*handler)
 		Expect(ok).To(BeTrue())
 		Expect(h.providerFactory).To(BeAssignableToTypeOf(providers))
@@ -324,3 +330,207 @@ func setUpSNO(cluster *common.Cluster, bootstrapIPAddress string) {
 		Inventory: fmt.Sprintf("{\"interfaces\":[{\"ipv4_addresses\":[\"%s/24\"]}]}", bootstrapIPAddress),
 	}
 }
+
+var _ = Describe("DNS provider registry", func() {
+	var providers DNSProviderFactory
+
+	BeforeEach(func() {
+		providers = &defaultDNSProviderFactory{log: logrus.New()}
+	})
+
+	It("supported providers", func() {
+		Expect(SupportedProviders()).To(Equal([]string{"powerdns", "rfc2136", "route53"}))
+		Expect(IsSupportedProvider("rfc2136")).To(BeTrue())
+		Expect(IsSupportedProvider("some-provider")).To(BeFalse())
+	})
+	It("rfc2136 provider uses the domain ID as zone", func() {
+		p := providers.GetProviderByRecordType(&DNSDomain{ID: "example.com", Provider: "rfc2136"}, "A")
+		rfc, ok := p.(*rfc2136Provider)
+		Expect(ok).To(BeTrue())
+		Expect(rfc.zone).To(Equal("example.com."))
+		Expect(rfc.recordType).To(Equal("A"))
+	})
+	It("powerdns provider uses the domain ID as zone ID", func() {
+		p := providers.GetProvider(&DNSDomain{ID: "example.com", Provider: "powerdns"})
+		pdns, ok := p.(*powerDNSProvider)
+		Expect(ok).To(BeTrue())
+		Expect(pdns.zoneID).To(Equal("example.com"))
+	})
+})
+
+var _ = Describe("PowerDNS provider", func() {
+	var (
+		server   *httptest.Server
+		zone     powerDNSZone
+		patches  []powerDNSRRSet
+		provider dnsproviders.Provider
+	)
+
+	BeforeEach(func() {
+		patches = nil
+		zone = powerDNSZone{
+			ID:   "example.com.",
+			Name: "example.com.",
+			RRSets: []powerDNSRRSet{
+				{Name: "api.test.example.com.", Type: "A", TTL: 60, Records: []powerDNSRecord{{Content: "10.0.0.1"}}},
+			},
+		}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(Equal("/api/v1/servers/localhost/zones/example.com."))
+			if r.Header.Get("X-API-Key") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			switch r.Method {
+			case http.MethodGet:
+				Expect(json.NewEncoder(w).Encode(zone)).To(Succeed())
+			case http.MethodPatch:
+				var body struct {
+					RRSets []powerDNSRRSet `json:"rrsets"`
+				}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				patches = append(patches, body.RRSets...)
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+		config := Config{PowerDNS: PowerDNSConfig{APIURL: server.URL, APIKey: "secret", ServerID: "localhost"}}
+		provider = newPowerDNSProvider(config, &DNSDomain{ID: "example.com"}, "A")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("get domain name", func() {
+		name, err := provider.GetDomainName()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(name).To(Equal("example.com"))
+	})
+	It("get existing record set", func() {
+		value, err := provider.GetRecordSet("api.test.example.com")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(value).To(Equal("10.0.0.1"))
+	})
+	It("get missing record set", func() {
+		value, err := provider.GetRecordSet("*.apps.test.example.com")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(value).To(BeEmpty())
+	})
+	It("create record set", func() {
+		_, err := provider.CreateRecordSet("*.apps.test.example.com", "10.0.0.2")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(patches).To(HaveLen(1))
+		Expect(patches[0].Name).To(Equal("*.apps.test.example.com."))
+		Expect(patches[0].ChangeType).To(Equal("REPLACE"))
+		Expect(patches[0].Records).To(Equal([]powerDNSRecord{{Content: "10.0.0.2"}}))
+	})
+	It("delete last record of a record set", func() {
+		_, err := provider.DeleteRecordSet("api.test.example.com", "10.0.0.1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(patches).To(HaveLen(1))
+		Expect(patches[0].ChangeType).To(Equal("DELETE"))
+		Expect(patches[0].Records).To(BeEmpty())
+	})
+	It("fails with wrong credentials", func() {
+		config := Config{PowerDNS: PowerDNSConfig{APIURL: server.URL, APIKey: "wrong", ServerID: "localhost"}}
+		_, err := newPowerDNSProvider(config, &DNSDomain{ID: "example.com"}, "A").GetDomainName()
+		Expect(err).Should(HaveOccurred())
+	})
+	It("fails when not configured", func() {
+		_, err := newPowerDNSProvider(Config{}, &DNSDomain{ID: "example.com"}, "A").GetDomainName()
+		Expect(err).Should(HaveOccurred())
+	})
+})
+
+var _ = Describe("RFC 2136 provider", func() {
+	var (
+		server   *miekgdns.Server
+		records  map[string]string
+		provider dnsproviders.Provider
+	)
+
+	BeforeEach(func() {
+		records = map[string]string{"api.test.example.com.": "10.0.0.1"}
+		mux := miekgdns.NewServeMux()
+		mux.HandleFunc("example.com.", func(w miekgdns.ResponseWriter, req *miekgdns.Msg) {
+			resp := new(miekgdns.Msg)
+			resp.SetReply(req)
+			switch req.Opcode {
+			case miekgdns.OpcodeUpdate:
+				for _, rr := range req.Ns {
+					a, ok := rr.(*miekgdns.A)
+					if !ok {
+						continue
+					}
+					if rr.Header().Class == miekgdns.ClassNONE {
+						delete(records, a.Hdr.Name)
+					} else {
+						records[a.Hdr.Name] = a.A.String()
+					}
+				}
+			default:
+				q := req.Question[0]
+				switch {
+				case q.Qtype == miekgdns.TypeSOA && q.Name == "example.com.":
+					soa, _ := miekgdns.NewRR("example.com. 60 IN SOA ns.example.com. admin.example.com. 1 60 60 60 60")
+					resp.Answer = append(resp.Answer, soa)
+				case q.Qtype == miekgdns.TypeA && records[q.Name] != "":
+					a, _ := miekgdns.NewRR(fmt.Sprintf("%s 60 IN A %s", q.Name, records[q.Name]))
+					resp.Answer = append(resp.Answer, a)
+				default:
+					resp.Rcode = miekgdns.RcodeNameError
+				}
+			}
+			_ = w.WriteMsg(resp)
+		})
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).ShouldNot(HaveOccurred())
+		started := make(chan struct{})
+		server = &miekgdns.Server{
+			PacketConn:        conn,
+			Handler:           mux,
+			NotifyStartedFunc: func() { close(started) },
+			// The default accept function rejects dynamic updates
+			MsgAcceptFunc: func(miekgdns.Header) miekgdns.MsgAcceptAction { return miekgdns.MsgAccept },
+		}
+		go func() { _ = server.ActivateAndServe() }()
+		<-started
+		config := Config{RFC2136: RFC2136Config{Server: conn.LocalAddr().String(), Timeout: time.Second}}
+		provider = newRFC2136Provider(config, &DNSDomain{ID: "example.com"}, "A")
+	})
+
+	AfterEach(func() {
+		Expect(server.Shutdown()).To(Succeed())
+	})
+
+	It("get domain name", func() {
+		name, err := provider.GetDomainName()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(name).To(Equal("example.com"))
+	})
+	It("get record set", func() {
+		value, err := provider.GetRecordSet("api.test.example.com")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(value).To(Equal("10.0.0.1"))
+		value, err = provider.GetRecordSet("*.apps.test.example.com")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(value).To(BeEmpty())
+	})
+	It("create and delete record set", func() {
+		_, err := provider.CreateRecordSet("*.apps.test.example.com", "10.0.0.2")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(records).To(HaveKeyWithValue("*.apps.test.example.com.", "10.0.0.2"))
+		_, err = provider.DeleteRecordSet("*.apps.test.example.com", "10.0.0.2")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(records).NotTo(HaveKey("*.apps.test.example.com."))
+	})
+	It("fails with an invalid value", func() {
+		_, err := provider.CreateRecordSet("api.test.example.com", "not-an-ip")
+		Expect(err).Should(HaveOccurred())
+	})
+	It("fails when not configured", func() {
+		_, err := newRFC2136Provider(Config{}, &DNSDomain{ID: "example.com"}, "A").GetDomainName()
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,214 @@
+package dns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/pkg/errors"
+)
+
+// PowerDNSConfig holds the connection details of a PowerDNS authoritative server HTTP API
+type PowerDNSConfig struct {
+	APIURL   string        `envconfig:"DNS_POWERDNS_API_URL" default:""` // e.g. http://powerdns:8081
+	APIKey   string        `envconfig:"DNS_POWERDNS_API_KEY" default:""`
+	ServerID string        `envconfig:"DNS_POWERDNS_SERVER_ID" default:"localhost"`
+	Timeout  time.Duration `envconfig:"DNS_POWERDNS_TIMEOUT" default:"10s"`
+}
+
+const (
+	powerDNSChangeTypeReplace = "REPLACE"
+	powerDNSChangeTypeDelete  = "DELETE"
+)
+
+type powerDNSRecord struct {
+	Content  string `json:"content"`
+	Disabled bool   `json:"disabled"`
+}
+
+type powerDNSRRSet struct {
+	Name       string           `json:"name"`
+	Type       string           `json:"type"`
+	TTL        int64            `json:"ttl,omitempty"`
+	ChangeType string           `json:"changetype,omitempty"`
+	Records    []powerDNSRecord `json:"records"`
+}
+
+type powerDNSZone struct {
+	ID     string          `json:"id"`
+	Name   string          `json:"name"`
+	RRSets []powerDNSRRSet `json:"rrsets"`
+}
+
+// powerDNSProvider manages record sets through the PowerDNS HTTP API.
+// The domain ID from the configuration is used as the PowerDNS zone ID.
+type powerDNSProvider struct {
+	config     PowerDNSConfig
+	zoneID     string
+	recordType string
+	client     *http.Client
+}
+
+func newPowerDNSProvider(config Config, domain *DNSDomain, recordType string) dnsproviders.Provider {
+	return &powerDNSProvider{
+		config:     config.PowerDNS,
+		zoneID:     domain.ID,
+		recordType: recordType,
+		client:     &http.Client{Timeout: config.PowerDNS.Timeout},
+	}
+}
+
+// CreateRecordSet adds the given value to the record set
+func (p *powerDNSProvider) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rrset, err := p.getRRSet(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	for _, record := range rrset.Records {
+		if record.Content == recordSetValue {
+			return "", nil
+		}
+	}
+	rrset.Records = append(rrset.Records, powerDNSRecord{Content: recordSetValue})
+	return "", p.patchRRSet(rrset, powerDNSChangeTypeReplace)
+}
+
+// UpdateRecordSet replaces the record set with the given value
+func (p *powerDNSProvider) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rrset := p.newRRSet(recordSetName)
+	rrset.Records = []powerDNSRecord{{Content: recordSetValue}}
+	return "", p.patchRRSet(rrset, powerDNSChangeTypeReplace)
+}
+
+// DeleteRecordSet removes the given value from the record set, and the record set itself once it is empty
+func (p *powerDNSProvider) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rrset, err := p.getRRSet(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	records := make([]powerDNSRecord, 0, len(rrset.Records))
+	for _, record := range rrset.Records {
+		if record.Content != recordSetValue {
+			records = append(records, record)
+		}
+	}
+	rrset.Records = records
+	if len(records) == 0 {
+		return "", p.patchRRSet(rrset, powerDNSChangeTypeDelete)
+	}
+	return "", p.patchRRSet(rrset, powerDNSChangeTypeReplace)
+}
+
+// GetRecordSet returns the first value of the record set, or an empty string if it doesn't exist
+func (p *powerDNSProvider) GetRecordSet(recordSetName string) (string, error) {
+	rrset, err := p.getRRSet(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	for _, record := range rrset.Records {
+		if !record.Disabled {
+			return record.Content, nil
+		}
+	}
+	return "", nil
+}
+
+// GetDomainName returns the name of the zone
+func (p *powerDNSProvider) GetDomainName() (string, error) {
+	zone, err := p.getZone()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(zone.Name, "."), nil
+}
+
+func (p *powerDNSProvider) newRRSet(recordSetName string) *powerDNSRRSet {
+	return &powerDNSRRSet{
+		Name: canonicalName(recordSetName),
+		Type: p.recordType,
+		TTL:  defaultRecordSetTTL,
+	}
+}
+
+func (p *powerDNSProvider) getRRSet(recordSetName string) (*powerDNSRRSet, error) {
+	zone, err := p.getZone()
+	if err != nil {
+		return nil, err
+	}
+	name := canonicalName(recordSetName)
+	for i := range zone.RRSets {
+		if strings.EqualFold(zone.RRSets[i].Name, name) && zone.RRSets[i].Type == p.recordType {
+			return &zone.RRSets[i], nil
+		}
+	}
+	return p.newRRSet(recordSetName), nil
+}
+
+func (p *powerDNSProvider) getZone() (*powerDNSZone, error) {
+	body, err := p.do(http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	var zone powerDNSZone
+	if err = json.Unmarshal(body, &zone); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse PowerDNS zone %s", p.zoneID)
+	}
+	return &zone, nil
+}
+
+func (p *powerDNSProvider) patchRRSet(rrset *powerDNSRRSet, changeType string) error {
+	rrset.ChangeType = changeType
+	if changeType == powerDNSChangeTypeDelete {
+		rrset.Records = nil
+	}
+	_, err := p.do(http.MethodPatch, map[string][]*powerDNSRRSet{"rrsets": {rrset}})
+	return err
+}
+
+func (p *powerDNSProvider) do(method string, payload interface{}) ([]byte, error) {
+	if p.config.APIURL == "" {
+		return nil, errors.New("PowerDNS API URL is not configured")
+	}
+	var reqBody []byte
+	if payload != nil {
+		var err error
+		if reqBody, err = json.Marshal(payload); err != nil {
+			return nil, err
+		}
+	}
+	zoneURL := fmt.Sprintf("%s/api/v1/servers/%s/zones/%s", strings.TrimSuffix(p.config.APIURL, "/"),
+		url.PathEscape(p.config.ServerID), url.PathEscape(canonicalName(p.zoneID)))
+	req, err := http.NewRequest(method, zoneURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-API-Key", p.config.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to communicate with PowerDNS API %s", p.config.APIURL)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, errors.Errorf("PowerDNS API %s %s returned %d: %s", method, zoneURL, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// canonicalName returns the name terminated with a dot, as expected by PowerDNS
+func canonicalName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
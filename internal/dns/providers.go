@@ -0,0 +1,75 @@
+package dns
+
+import (
+	"sort"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/openshift/assisted-service/models"
+)
+
+const defaultRecordSetTTL = 60
+
+// Config holds the credentials used by the DNS providers that are not
+// configured through a shared credentials file
+type Config struct {
+	RFC2136  RFC2136Config
+	PowerDNS PowerDNSConfig
+}
+
+// providerBuilder returns a DNS provider for the given domain. An empty record type
+// is used for operations that are not related to a specific record set (e.g. domain validation)
+type providerBuilder func(config Config, domain *DNSDomain, recordType string) dnsproviders.Provider
+
+// providerBuilders is the registry of the supported DNS providers, keyed by the provider
+// name that is specified for each base domain in the configuration (<domain-id>/<provider>)
+var providerBuilders = map[string]providerBuilder{
+	models.ManagedDomainProviderRoute53:  newRoute53Provider,
+	models.ManagedDomainProviderRfc2136:  newRFC2136Provider,
+	models.ManagedDomainProviderPowerdns: newPowerDNSProvider,
+}
+
+// IsSupportedProvider returns true if there is a registered implementation for the given DNS provider
+func IsSupportedProvider(provider string) bool {
+	_, ok := providerBuilders[provider]
+	return ok
+}
+
+// SupportedProviders returns the sorted names of all the registered DNS providers
+func SupportedProviders() []string {
+	ret := make([]string, 0, len(providerBuilders))
+	for name := range providerBuilders {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func (f *defaultDNSProviderFactory) GetProviderByRecordType(domain *DNSDomain, recordType string) dnsproviders.Provider {
+	return f.getProvider(domain, recordType)
+}
+
+func (f *defaultDNSProviderFactory) GetProvider(domain *DNSDomain) dnsproviders.Provider {
+	return f.getProvider(domain, "")
+}
+
+func (f *defaultDNSProviderFactory) getProvider(domain *DNSDomain, recordType string) dnsproviders.Provider {
+	if build, ok := providerBuilders[domain.Provider]; ok {
+		return build(f.config, domain, recordType)
+	}
+	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
+	return nil
+}
+
+func newRoute53Provider(_ Config, domain *DNSDomain, recordType string) dnsproviders.Provider {
+	provider := dnsproviders.Route53{
+		HostedZoneID: domain.ID,
+		SharedCreds:  true,
+	}
+	if recordType != "" {
+		provider.RecordSet = dnsproviders.RecordSet{
+			RecordSetType: recordType,
+			TTL:           defaultRecordSetTTL,
+		}
+	}
+	return provider
+}
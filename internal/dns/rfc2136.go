@@ -0,0 +1,154 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	miekgdns "github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+// RFC2136Config holds the connection details of a DNS server that accepts RFC 2136 dynamic updates
+type RFC2136Config struct {
+	Server        string        `envconfig:"DNS_RFC2136_SERVER" default:""` // host:port
+	TSIGKeyName   string        `envconfig:"DNS_RFC2136_TSIG_KEY_NAME" default:""`
+	TSIGSecret    string        `envconfig:"DNS_RFC2136_TSIG_SECRET" default:""` // base64 encoded
+	TSIGAlgorithm string        `envconfig:"DNS_RFC2136_TSIG_ALGORITHM" default:"hmac-sha256."`
+	Timeout       time.Duration `envconfig:"DNS_RFC2136_TIMEOUT" default:"10s"`
+}
+
+// rfc2136Provider manages record sets through RFC 2136 dynamic updates.
+// The domain ID from the configuration is used as the name of the zone to update.
+type rfc2136Provider struct {
+	config     RFC2136Config
+	zone       string
+	recordType string
+}
+
+func newRFC2136Provider(config Config, domain *DNSDomain, recordType string) dnsproviders.Provider {
+	return &rfc2136Provider{
+		config:     config.RFC2136,
+		zone:       miekgdns.Fqdn(domain.ID),
+		recordType: recordType,
+	}
+}
+
+// CreateRecordSet adds the given value to the record set
+func (p *rfc2136Provider) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rr, err := p.newRR(recordSetName, recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	msg := new(miekgdns.Msg)
+	msg.SetUpdate(p.zone)
+	msg.Insert([]miekgdns.RR{rr})
+	return p.update(msg)
+}
+
+// UpdateRecordSet replaces the record set with the given value
+func (p *rfc2136Provider) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rr, err := p.newRR(recordSetName, recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	msg := new(miekgdns.Msg)
+	msg.SetUpdate(p.zone)
+	msg.RemoveRRset([]miekgdns.RR{rr})
+	msg.Insert([]miekgdns.RR{rr})
+	return p.update(msg)
+}
+
+// DeleteRecordSet removes the given value from the record set
+func (p *rfc2136Provider) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	rr, err := p.newRR(recordSetName, recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	msg := new(miekgdns.Msg)
+	msg.SetUpdate(p.zone)
+	msg.Remove([]miekgdns.RR{rr})
+	return p.update(msg)
+}
+
+// GetRecordSet returns the first value of the record set, or an empty string if it doesn't exist
+func (p *rfc2136Provider) GetRecordSet(recordSetName string) (string, error) {
+	qtype, ok := miekgdns.StringToType[p.recordType]
+	if !ok {
+		return "", errors.Errorf("unsupported record type %q", p.recordType)
+	}
+	msg := new(miekgdns.Msg)
+	msg.SetQuestion(miekgdns.Fqdn(recordSetName), qtype)
+	resp, err := p.exchange(msg)
+	if err != nil {
+		return "", err
+	}
+	if resp.Rcode == miekgdns.RcodeNameError {
+		return "", nil
+	}
+	if resp.Rcode != miekgdns.RcodeSuccess {
+		return "", errors.Errorf("failed to query %s record %s: %s", p.recordType, recordSetName, miekgdns.RcodeToString[resp.Rcode])
+	}
+	for _, answer := range resp.Answer {
+		switch rr := answer.(type) {
+		case *miekgdns.A:
+			return rr.A.String(), nil
+		case *miekgdns.AAAA:
+			return rr.AAAA.String(), nil
+		}
+	}
+	return "", nil
+}
+
+// GetDomainName returns the zone name after verifying that the server is authoritative for it
+func (p *rfc2136Provider) GetDomainName() (string, error) {
+	msg := new(miekgdns.Msg)
+	msg.SetQuestion(p.zone, miekgdns.TypeSOA)
+	resp, err := p.exchange(msg)
+	if err != nil {
+		return "", err
+	}
+	for _, answer := range resp.Answer {
+		if soa, ok := answer.(*miekgdns.SOA); ok && strings.EqualFold(soa.Hdr.Name, p.zone) {
+			return strings.TrimSuffix(p.zone, "."), nil
+		}
+	}
+	return "", errors.Errorf("DNS server %s is not authoritative for zone %s", p.config.Server, p.zone)
+}
+
+func (p *rfc2136Provider) newRR(recordSetName, recordSetValue string) (miekgdns.RR, error) {
+	rr, err := miekgdns.NewRR(fmt.Sprintf("%s %d IN %s %s", miekgdns.Fqdn(recordSetName), defaultRecordSetTTL, p.recordType, recordSetValue))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s record (%s, %s)", p.recordType, recordSetName, recordSetValue)
+	}
+	return rr, nil
+}
+
+func (p *rfc2136Provider) update(msg *miekgdns.Msg) (string, error) {
+	resp, err := p.exchange(msg)
+	if err != nil {
+		return "", err
+	}
+	if resp.Rcode != miekgdns.RcodeSuccess {
+		return "", errors.Errorf("DNS update of zone %s was rejected: %s", p.zone, miekgdns.RcodeToString[resp.Rcode])
+	}
+	return miekgdns.RcodeToString[resp.Rcode], nil
+}
+
+func (p *rfc2136Provider) exchange(msg *miekgdns.Msg) (*miekgdns.Msg, error) {
+	if p.config.Server == "" {
+		return nil, errors.New("RFC 2136 DNS server is not configured")
+	}
+	client := &miekgdns.Client{Timeout: p.config.Timeout}
+	if p.config.TSIGKeyName != "" {
+		keyName := miekgdns.Fqdn(p.config.TSIGKeyName)
+		client.TsigSecret = map[string]string{keyName: p.config.TSIGSecret}
+		msg.SetTsig(keyName, miekgdns.Fqdn(p.config.TSIGAlgorithm), 300, time.Now().Unix())
+	}
+	resp, _, err := client.Exchange(msg, p.config.Server)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to communicate with DNS server %s", p.config.Server)
+	}
+	return resp, nil
+}
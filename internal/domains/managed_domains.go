@@ -3,9 +3,11 @@ package domains
 import (
 	"context"
 	"regexp"
+	"strings"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/restapi"
 	operations "github.com/openshift/assisted-service/restapi/operations/managed_domains"
@@ -30,6 +32,9 @@ func (h *Handler) parseDomainProvider(val string) (string, error) {
 		return "", errors.Errorf("Invalid format: %s", val)
 	}
 	s := re.Split(val, 2)
+	if !dns.IsSupportedProvider(s[1]) {
+		return "", errors.Errorf("Unsupported DNS provider %s, supported providers: %s", s[1], strings.Join(dns.SupportedProviders(), ", "))
+	}
 	return s[1], nil
 }
 
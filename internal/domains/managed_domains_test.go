@@ -41,6 +41,29 @@ var _ = Describe("list base domains", func() {
 		domains := val.Payload
 		Expect(len(domains)).Should(Equal(0))
 	})
+	It("multiple providers", func() {
+		baseDNSDomains = map[string]string{
+			"example.com":  "abc/rfc2136",
+			"example2.com": "example2.com/powerdns",
+		}
+		h = NewHandler(baseDNSDomains)
+		reply := h.ListManagedDomains(context.Background(), operations.ListManagedDomainsParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListManagedDomainsOK()))
+		val, _ := reply.(*operations.ListManagedDomainsOK)
+		providers := map[string]string{}
+		for _, d := range val.Payload {
+			providers[d.Domain] = d.Provider
+		}
+		Expect(providers).Should(Equal(map[string]string{"example.com": "rfc2136", "example2.com": "powerdns"}))
+	})
+	It("unsupported provider", func() {
+		baseDNSDomains = map[string]string{
+			"example.com": "abc/some-provider",
+		}
+		h = NewHandler(baseDNSDomains)
+		reply := h.ListManagedDomains(context.Background(), operations.ListManagedDomainsParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListManagedDomainsInternalServerError()))
+	})
 	It("invalid format", func() {
 		baseDNSDomains = map[string]string{
 			"example.com": "abcroute53",
@@ -0,0 +1,140 @@
+package dryrun
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/ignition"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockgen -source=dryrun.go -package=dryrun -destination=mock_dryrun.go
+
+type Renderer interface {
+	// Render generates the installation assets of the cluster in a scratch directory, without uploading them,
+	// and returns the install config, the rendered manifests and the ignitions as a tar.gz bundle, with their
+	// secrets redacted
+	Render(ctx context.Context, cluster *common.Cluster, installConfig []byte, releaseImage string) ([]byte, error)
+}
+
+type Config struct {
+	WorkDir            string
+	ReleaseImageMirror string
+	ServiceCACertPath  string
+	DummyIgnition      bool
+}
+
+type generatorFactory func(workDir string, cluster *common.Cluster, releaseImage string, log logrus.FieldLogger) ignition.Generator
+
+type renderer struct {
+	log          logrus.FieldLogger
+	newGenerator generatorFactory
+	workDir      string
+}
+
+func NewRenderer(log logrus.FieldLogger, cfg Config, s3Client s3wrapper.API, operatorsApi operators.API) Renderer {
+	installerCacheDir := filepath.Join(cfg.WorkDir, "installercache")
+	newGenerator := func(workDir string, cluster *common.Cluster, releaseImage string, log logrus.FieldLogger) ignition.Generator {
+		if cfg.DummyIgnition {
+			return ignition.NewDummyGenerator(workDir, cluster, s3Client, log)
+		}
+		return ignition.NewGenerator(workDir, installerCacheDir, cluster, releaseImage, cfg.ReleaseImageMirror,
+			cfg.ServiceCACertPath, s3Client, log, operatorsApi)
+	}
+	return &renderer{
+		log:          log,
+		newGenerator: newGenerator,
+		workDir:      cfg.WorkDir,
+	}
+}
+
+func (r *renderer) Render(ctx context.Context, cluster *common.Cluster, installConfig []byte, releaseImage string) ([]byte, error) {
+	log := logutil.FromContext(ctx, r.log)
+
+	workDir, err := ioutil.TempDir(r.workDir, "dry-run-"+cluster.ID.String()+"-")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the dry run working directory of cluster %s", cluster.ID)
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			log.WithError(err).Warnf("failed to remove the dry run working directory %s", workDir)
+		}
+	}()
+
+	// The generator merges the custom manifests of the cluster, but only uploads the generated files when asked to
+	if err = r.newGenerator(workDir, cluster, releaseImage, log).Generate(ctx, installConfig); err != nil {
+		return nil, errors.Wrapf(err, "failed to generate the installation assets of cluster %s", cluster.ID)
+	}
+
+	files := map[string][]byte{}
+	if files["install-config.yaml"], err = redactInstallConfig(installConfig); err != nil {
+		return nil, err
+	}
+	ignitionNames := []string{"bootstrap.ign", "master.ign", "worker.ign"}
+	for _, host := range cluster.Hosts {
+		if swag.StringValue(host.Status) != models.HostStatusDisabled {
+			ignitionNames = append(ignitionNames, filepath.Join("hosts", hostutil.IgnitionFileName(host)))
+		}
+	}
+	for _, name := range ignitionNames {
+		content, readErr := ioutil.ReadFile(filepath.Join(workDir, filepath.Base(name)))
+		if readErr != nil {
+			return nil, errors.Wrapf(readErr, "failed to read %s of cluster %s", name, cluster.ID)
+		}
+		config, manifests, redactErr := redactIgnition(content)
+		if redactErr != nil {
+			return nil, errors.Wrapf(redactErr, "failed to redact %s of cluster %s", name, cluster.ID)
+		}
+		files[filepath.Join("ignitions", name)] = config
+		// The bootstrap ignition carries the manifests that openshift-install rendered, custom manifests included
+		for manifestName, manifest := range manifests {
+			files[manifestName] = manifest
+		}
+	}
+
+	log.Infof("Rendered %d installation assets of cluster %s in a dry run", len(files), cluster.ID)
+	return createBundle(files)
+}
+
+func createBundle(files map[string][]byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	now := time.Now()
+	for _, name := range sortedKeys(files) {
+		header := &tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(files[name])),
+			ModTime:  now,
+			Typeflag: tar.TypeReg,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return nil, errors.Wrapf(err, "failed to add %s to the dry run bundle", name)
+		}
+		if _, err := tarWriter.Write(files[name]); err != nil {
+			return nil, errors.Wrapf(err, "failed to add %s to the dry run bundle", name)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close the dry run bundle")
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close the dry run bundle")
+	}
+	return buf.Bytes(), nil
+}
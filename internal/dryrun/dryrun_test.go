@@ -0,0 +1,194 @@
+package dryrun
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/ignition"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/vincent-petithory/dataurl"
+)
+
+func TestDryRun(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Dry run test Suite")
+}
+
+const installConfig = `apiVersion: v1
+baseDomain: example.com
+pullSecret: '{"auths":{"cloud.openshift.com":{"auth":"c2VjcmV0"}}}'
+sshKey: ssh-rsa AAAA
+`
+
+const pullSecretManifest = `apiVersion: v1
+kind: Secret
+metadata:
+  name: pull-secret
+  namespace: openshift-config
+data:
+  .dockerconfigjson: c2VjcmV0
+`
+
+const configMapManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: custom
+data:
+  key: value
+`
+
+func ignitionFile(path, content string) string {
+	return fmt.Sprintf(`{"path": %q, "contents": {"source": %q}}`, path, dataurl.EncodeBytes([]byte(content)))
+}
+
+func extractBundle(bundle []byte) map[string]string {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(bundle))
+	Expect(err).ShouldNot(HaveOccurred())
+	tarReader := tar.NewReader(gzipReader)
+	files := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).ShouldNot(HaveOccurred())
+		content, err := ioutil.ReadAll(tarReader)
+		Expect(err).ShouldNot(HaveOccurred())
+		files[header.Name] = string(content)
+	}
+	return files
+}
+
+var _ = Describe("Render", func() {
+	var (
+		ctx           = context.Background()
+		ctrl          *gomock.Controller
+		mockGenerator *ignition.MockGenerator
+		r             *renderer
+		cluster       *common.Cluster
+		hostID        strfmt.UUID
+		baseDir       string
+		generatorDir  string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockGenerator = ignition.NewMockGenerator(ctrl)
+		var err error
+		baseDir, err = ioutil.TempDir("", "dryrun")
+		Expect(err).ShouldNot(HaveOccurred())
+		clusterID := strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID: &clusterID,
+			Hosts: []*models.Host{
+				{ID: &hostID, ClusterID: clusterID, Role: models.HostRoleMaster, Status: swag.String(models.HostStatusKnown)},
+				{ID: strfmtUUID(), ClusterID: clusterID, Role: models.HostRoleWorker, Status: swag.String(models.HostStatusDisabled)},
+			},
+		}}
+		r = &renderer{
+			log:     logrus.New(),
+			workDir: baseDir,
+			newGenerator: func(workDir string, _ *common.Cluster, releaseImage string, _ logrus.FieldLogger) ignition.Generator {
+				Expect(releaseImage).To(Equal("release-image"))
+				generatorDir = workDir
+				return mockGenerator
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	writeIgnitions := func(_ context.Context, _ []byte) error {
+		bootstrap := fmt.Sprintf(`{"ignition": {"version": "3.1.0"},
+			"passwd": {"users": [{"name": "core", "passwordHash": "$6$hash"}]},
+			"storage": {"files": [%s, %s, %s, %s]}}`,
+			ignitionFile("/opt/openshift/tls/root-ca.key", "private key"),
+			ignitionFile("/opt/openshift/auth/kubeconfig", "kubeconfig"),
+			ignitionFile("/opt/openshift/openshift/99_openshift-config-secret-pull-secret.yaml", pullSecretManifest+"---\n"+configMapManifest),
+			ignitionFile("/opt/openshift/manifests/custom.yaml", configMapManifest))
+		files := map[string]string{
+			"bootstrap.ign":                      bootstrap,
+			"master.ign":                         `{"ignition": {"version": "3.1.0"}}`,
+			"worker.ign":                         `{"ignition": {"version": "3.1.0"}}`,
+			fmt.Sprintf("master-%s.ign", hostID): `{"ignition": {"version": "3.1.0"}}`,
+		}
+		for name, content := range files {
+			Expect(ioutil.WriteFile(filepath.Join(generatorDir, name), []byte(content), 0600)).To(Succeed())
+		}
+		return nil
+	}
+
+	It("bundles the redacted installation assets", func() {
+		mockGenerator.EXPECT().Generate(ctx, []byte(installConfig)).DoAndReturn(writeIgnitions).Times(1)
+
+		bundle, err := r.Render(ctx, cluster, []byte(installConfig), "release-image")
+		Expect(err).ShouldNot(HaveOccurred())
+		files := extractBundle(bundle)
+		Expect(files).To(HaveLen(7))
+		Expect(files).To(HaveKey("ignitions/master.ign"))
+		Expect(files).To(HaveKey("ignitions/worker.ign"))
+		Expect(files).To(HaveKey(fmt.Sprintf("ignitions/hosts/master-%s.ign", hostID)))
+
+		Expect(files["install-config.yaml"]).To(HavePrefix("apiVersion: v1\nbaseDomain: example.com\npullSecret: REDACTED\n"))
+		Expect(files["manifests/custom.yaml"]).To(Equal(configMapManifest))
+		Expect(files["openshift/99_openshift-config-secret-pull-secret.yaml"]).To(ContainSubstring(".dockerconfigjson: REDACTED"))
+		Expect(files["openshift/99_openshift-config-secret-pull-secret.yaml"]).To(ContainSubstring("key: value"))
+
+		bootstrap, err := ignition.ParseToLatest([]byte(files["ignitions/bootstrap.ign"]))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(bootstrap.Passwd.Users[0].PasswordHash)).To(Equal(redacted))
+		for _, file := range bootstrap.Storage.Files {
+			data, err := resourceContents(&file.Contents)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(data)).NotTo(ContainSubstring("c2VjcmV0"))
+			Expect(string(data)).NotTo(ContainSubstring("private key"))
+		}
+
+		By("removing the working directory")
+		Expect(generatorDir).NotTo(BeADirectory())
+	})
+
+	It("fails when the ignitions cannot be generated", func() {
+		mockGenerator.EXPECT().Generate(ctx, gomock.Any()).Return(errors.New("openshift-install failed")).Times(1)
+
+		_, err := r.Render(ctx, cluster, []byte(installConfig), "release-image")
+		Expect(err).To(HaveOccurred())
+		Expect(generatorDir).NotTo(BeADirectory())
+	})
+
+	It("fails when an ignition cannot be redacted", func() {
+		mockGenerator.EXPECT().Generate(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cfg []byte) error {
+			Expect(writeIgnitions(ctx, cfg)).To(Succeed())
+			return ioutil.WriteFile(filepath.Join(generatorDir, "worker.ign"), []byte("data"), 0600)
+		}).Times(1)
+
+		_, err := r.Render(ctx, cluster, []byte(installConfig), "release-image")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+func strfmtUUID() *strfmt.UUID {
+	id := strfmt.UUID(uuid.New().String())
+	return &id
+}
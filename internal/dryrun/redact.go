@@ -0,0 +1,164 @@
+package dryrun
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	config_latest_types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/ignition"
+	"github.com/pkg/errors"
+	"github.com/vincent-petithory/dataurl"
+	"gopkg.in/yaml.v2"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const redacted = "REDACTED"
+
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// manifestDirs maps the directories in which the bootstrap node keeps the rendered manifests to their
+// directories in the dry run bundle
+var manifestDirs = map[string]string{
+	"/opt/openshift/manifests": "manifests",
+	"/opt/openshift/openshift": "openshift",
+}
+
+func redactInstallConfig(installConfig []byte) ([]byte, error) {
+	var cfg yaml.MapSlice
+	if err := yaml.Unmarshal(installConfig, &cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the install config")
+	}
+	for i := range cfg {
+		if cfg[i].Key == "pullSecret" {
+			cfg[i].Value = redacted
+		}
+	}
+	return yaml.Marshal(cfg)
+}
+
+// isSecretFile returns true for the files written by an ignition that hold credentials: private keys,
+// kubeconfigs, pull secrets and passwords
+func isSecretFile(filePath string) bool {
+	base := path.Base(filePath)
+	return strings.HasSuffix(base, ".key") ||
+		strings.Contains(base, "kubeconfig") ||
+		strings.Contains(base, "pull-secret") ||
+		base == "config.json" ||
+		base == "kubeadmin-password"
+}
+
+// redactIgnition replaces the contents of the secret files and the password hashes of an ignition, and
+// redacts the Kubernetes secrets in the manifests it writes, whatever their file names are. The manifests
+// are returned by their path in the dry run bundle.
+func redactIgnition(content []byte) ([]byte, map[string][]byte, error) {
+	config, err := ignition.ParseToLatest(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	manifests := map[string][]byte{}
+	for i := range config.Storage.Files {
+		file := &config.Storage.Files[i]
+		dir, isManifest := manifestDirs[path.Dir(file.Node.Path)]
+		if !isManifest {
+			if isSecretFile(file.Node.Path) {
+				setResourceContents(&file.Contents, []byte(redacted))
+				for j := range file.Append {
+					setResourceContents(&file.Append[j], []byte(redacted))
+				}
+			}
+			continue
+		}
+		if file.Contents.Source == nil {
+			continue
+		}
+		data, err := resourceContents(&file.Contents)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to decode %s", file.Node.Path)
+		}
+		data = redactManifest(data)
+		setResourceContents(&file.Contents, data)
+		manifests[path.Join(dir, path.Base(file.Node.Path))] = data
+	}
+	for i := range config.Passwd.Users {
+		if config.Passwd.Users[i].PasswordHash != nil {
+			config.Passwd.Users[i].PasswordHash = swag.String(redacted)
+		}
+	}
+
+	redactedContent, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	return redactedContent, manifests, nil
+}
+
+// redactManifest replaces the values of the Kubernetes secrets in a manifest. Documents that are not secrets
+// are kept as is.
+func redactManifest(manifest []byte) []byte {
+	documents := yamlDocumentSeparator.Split(string(manifest), -1)
+	changed := false
+	for i, document := range documents {
+		var object map[string]interface{}
+		if err := k8syaml.Unmarshal([]byte(document), &object); err != nil || object["kind"] != "Secret" {
+			continue
+		}
+		for _, field := range []string{"data", "stringData"} {
+			values, ok := object[field].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for key := range values {
+				values[key] = redacted
+			}
+		}
+		redactedDocument, err := k8syaml.Marshal(object)
+		if err != nil {
+			continue
+		}
+		documents[i] = "\n" + string(redactedDocument)
+		changed = true
+	}
+	if !changed {
+		return manifest
+	}
+	return []byte(strings.Join(documents, "---"))
+}
+
+func resourceContents(resource *config_latest_types.Resource) ([]byte, error) {
+	decoded, err := dataurl.DecodeString(*resource.Source)
+	if err != nil {
+		return nil, err
+	}
+	if swag.StringValue(resource.Compression) != "gzip" {
+		return decoded.Data, nil
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(decoded.Data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+func setResourceContents(resource *config_latest_types.Resource, data []byte) {
+	resource.Source = swag.String(dataurl.EncodeBytes(data))
+	resource.Compression = nil
+	resource.Verification.Hash = nil
+}
+
+func sortedKeys(files map[string][]byte) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -25,6 +26,34 @@ type Handler interface {
 	//Get a list of events. Events can be filtered by category. if no filter is specified,
 	//events with the default category are returned
 	GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories ...string) ([]*common.Event, error)
+	//Get a page of the events that match the filter, together with the total number of matching events.
+	//if no category is specified, events with the default category are returned
+	QueryEvents(filter *Filter) ([]*common.Event, int64, error)
+}
+
+// Filter of an events query. Only the fields that are set are applied
+type Filter struct {
+	ClusterID  strfmt.UUID
+	HostID     *strfmt.UUID
+	Categories []string
+	Severities []string
+	// Events that occurred at or after this time
+	Since *time.Time
+	// Events that occurred before this time
+	Until *time.Time
+	// Case insensitive substring of the event message
+	Message *string
+	// Events that were added after the event with this ID. The events are ordered by ID instead of time, and
+	// they are not counted, since the streams query them again on every new event
+	AfterID *uint
+	Limit   *int64
+	Offset  *int64
+}
+
+// Listener is called with every event that is saved, within the transaction that saves it. A failing listener
+// doesn't fail the event.
+type Listener interface {
+	NotifyEvent(ctx context.Context, tx *gorm.DB, event *models.Event) error
 }
 
 var _ Handler = &Events{}
@@ -34,14 +63,16 @@ var DefaultEventCategories = []string{
 }
 
 type Events struct {
-	db  *gorm.DB
-	log logrus.FieldLogger
+	db        *gorm.DB
+	log       logrus.FieldLogger
+	listeners []Listener
 }
 
-func New(db *gorm.DB, log logrus.FieldLogger) *Events {
+func New(db *gorm.DB, log logrus.FieldLogger, listeners ...Listener) *Events {
 	return &Events{
-		db:  db,
-		log: log,
+		db:        db,
+		log:       log,
+		listeners: listeners,
 	}
 }
 
@@ -72,20 +103,50 @@ func (e *Events) saveEvent(ctx context.Context, clusterID strfmt.UUID, hostID *s
 	}
 
 	//each event is saved in its own embedded transaction
-	var dberr error
 	tx := e.db.Begin()
-	defer func() {
-		if dberr != nil {
-			log.Warnf("Rolling back transaction on event=%s", message)
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
-	if dberr = tx.Create(&event).Error; err != nil {
+	if err = tx.Create(&event).Error; err != nil {
 		log.WithError(err).Error("Error adding event")
+		log.Warnf("Rolling back transaction on event=%s", message)
+		tx.Rollback()
+		return err
+	}
+	for _, listener := range e.listeners {
+		e.notifyListener(ctx, tx, listener, &event.Event)
+	}
+	if err = tx.Commit().Error; err != nil {
+		log.WithError(err).Error("Error committing event")
+		return err
+	}
+	e.notifyStreams(ctx, clusterID)
+	return nil
+}
+
+// notifyStreams wakes up the event streams of the cluster in all the replicas. It is sent only after the event is
+// committed and its failure doesn't fail the event, the streams catch up on the next notification.
+func (e *Events) notifyStreams(ctx context.Context, clusterID strfmt.UUID) {
+	if err := e.db.Exec("SELECT pg_notify(?, ?)", StreamChannel, clusterID.String()).Error; err != nil {
+		logutil.FromContext(ctx, e.log).WithError(err).Warnf("Error notifying about event of cluster %s", clusterID.String())
+	}
+}
+
+// notifyListener runs the listener in a savepoint of the event transaction, so that its failure is rolled back
+// without the event
+func (e *Events) notifyListener(ctx context.Context, tx *gorm.DB, listener Listener, event *models.Event) {
+	log := logutil.FromContext(ctx, e.log)
+	if err := tx.Exec("SAVEPOINT notify_event").Error; err != nil {
+		log.WithError(err).Warn("failed to create savepoint for event listener")
+		return
+	}
+	if err := listener.NotifyEvent(ctx, tx, event); err != nil {
+		log.WithError(err).Warnf("failed to notify about event of cluster %s", event.ClusterID.String())
+		if err = tx.Exec("ROLLBACK TO SAVEPOINT notify_event").Error; err != nil {
+			log.WithError(err).Warn("failed to roll back event listener")
+		}
+		return
+	}
+	if err := tx.Exec("RELEASE SAVEPOINT notify_event").Error; err != nil {
+		log.WithError(err).Warn("failed to release savepoint of event listener")
 	}
-	return dberr
 }
 
 func (e *Events) AddEvent(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, severity string, msg string, eventTime time.Time, props ...interface{}) {
@@ -118,6 +179,57 @@ func (e Events) GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories
 	return events, err
 }
 
+func (e Events) QueryEvents(filter *Filter) ([]*common.Event, int64, error) {
+	var events []*common.Event
+	var total int64
+
+	categories := filter.Categories
+	if len(categories) == 0 {
+		categories = DefaultEventCategories
+	}
+	query := e.db.Model(&common.Event{}).Where("cluster_id = ?", filter.ClusterID.String()).
+		Where("category IN (?)", categories)
+	if filter.HostID != nil {
+		query = query.Where("host_id = ?", filter.HostID.String())
+	}
+	if len(filter.Severities) > 0 {
+		query = query.Where("severity IN (?)", filter.Severities)
+	}
+	if filter.Since != nil {
+		query = query.Where("event_time >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("event_time < ?", *filter.Until)
+	}
+	if filter.Message != nil && *filter.Message != "" {
+		query = query.Where("message ILIKE ?", "%"+escapeLikePattern(*filter.Message)+"%")
+	}
+
+	if filter.AfterID != nil {
+		query = query.Where("id > ?", *filter.AfterID).Order("id")
+	} else {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+		query = query.Order("event_time").Order("id")
+	}
+	if filter.Offset != nil && *filter.Offset > 0 {
+		query = query.Offset(*filter.Offset)
+	}
+	if filter.Limit != nil {
+		query = query.Limit(*filter.Limit)
+	}
+	if err := query.Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+// escapeLikePattern escapes the characters that have a special meaning in LIKE patterns
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func (e Events) clusterEventsQuery(events *[]*common.Event, selectedCategories []string, clusterID strfmt.UUID) *gorm.DB {
 	return e.db.Where("category IN (?)", selectedCategories).Order("event_time").
 		Find(events, "cluster_id = ?", clusterID.String())
@@ -3,11 +3,13 @@ package events_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
@@ -18,6 +20,7 @@ import (
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -68,6 +71,21 @@ var _ = Describe("Events library", func() {
 			Expect(numOfEvents(cluster2, nil)).Should(Equal(1))
 		})
 
+		It("Adding an event with a failing listener", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			defer ctrl.Finish()
+			listener := events.NewMockListener(ctrl)
+			listener.EXPECT().NotifyEvent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, tx *gorm.DB, event *models.Event) error {
+					Expect(*event.Message).Should(Equal("event1"))
+					Expect(tx.Exec("SELECT no_such_function()").Error).Should(HaveOccurred())
+					return errors.New("failed")
+				}).Times(1)
+			theEvents = events.New(db, logrus.WithField("pkg", "events"), listener)
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "event1", time.Now())
+			Expect(numOfEvents(cluster1, nil)).Should(Equal(1))
+		})
+
 		It("Adding a host event ", func() {
 			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "event1", time.Now())
 			Expect(numOfEvents(cluster1, nil)).Should(Equal(1))
@@ -175,6 +193,90 @@ var _ = Describe("Events library", func() {
 		})
 	})
 
+	Context("query events", func() {
+		var t0 time.Time
+
+		BeforeEach(func() {
+			t0 = time.Now().Add(-time.Hour)
+			for i := 0; i < 10; i++ {
+				severity := models.EventSeverityInfo
+				if i%2 == 1 {
+					severity = models.EventSeverityError
+				}
+				theEvents.AddEvent(context.TODO(), cluster1, nil, severity, fmt.Sprintf("event%d", i), t0.Add(time.Duration(i)*time.Minute))
+			}
+			theEvents.AddEvent(context.TODO(), cluster1, &host, models.EventSeverityWarning, "host 100% done_", t0.Add(time.Hour))
+			theEvents.AddMetricsEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "metrics", t0)
+			theEvents.AddEvent(context.TODO(), cluster2, nil, models.EventSeverityInfo, "event0", t0)
+		})
+
+		It("without filters", func() {
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(11)))
+			Expect(evs).Should(HaveLen(11))
+			Expect(evs[0]).Should(WithMessage(swag.String("event0")))
+		})
+
+		It("limit and offset", func() {
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Limit: swag.Int64(3), Offset: swag.Int64(4)})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(11)))
+			Expect(evs).Should(HaveLen(3))
+			Expect(evs[0]).Should(WithMessage(swag.String("event4")))
+			Expect(evs[2]).Should(WithMessage(swag.String("event6")))
+		})
+
+		It("severity and time range", func() {
+			since := t0.Add(2 * time.Minute)
+			until := t0.Add(7 * time.Minute)
+			evs, total, err := theEvents.QueryEvents(&events.Filter{
+				ClusterID:  cluster1,
+				Severities: []string{models.EventSeverityError},
+				Since:      &since,
+				Until:      &until,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(2)))
+			Expect(evs[0]).Should(WithMessage(swag.String("event3")))
+			Expect(evs[1]).Should(WithMessage(swag.String("event5")))
+		})
+
+		It("message search escapes wildcards", func() {
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Message: swag.String("100% DONE_")})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(1)))
+			Expect(evs[0]).Should(WithMessage(swag.String("host 100% done_")))
+
+			_, total, err = theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Message: swag.String("event_")})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(0)))
+		})
+
+		It("after event ID", func() {
+			evs, _, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Limit: swag.Int64(2)})
+			Expect(err).ShouldNot(HaveOccurred())
+			afterID := evs[1].ID
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, AfterID: &afterID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(evs).Should(HaveLen(9))
+			// the events of the streams are not counted
+			Expect(total).Should(Equal(int64(0)))
+			Expect(evs[0].ID).Should(BeNumerically(">", afterID))
+		})
+
+		It("host and category", func() {
+			_, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, HostID: &host})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(1)))
+
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Categories: []string{models.EventCategoryMetrics}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(1)))
+			Expect(evs[0]).Should(WithMessage(swag.String("metrics")))
+		})
+	})
+
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
 	})
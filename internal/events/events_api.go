@@ -3,48 +3,109 @@ package events
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 var _ restapi.EventsAPI = &Api{}
 
 type Api struct {
-	handler Handler
-	log     logrus.FieldLogger
+	handler     Handler
+	broadcaster *Broadcaster
+	log         logrus.FieldLogger
+	db          *gorm.DB
 }
 
-func NewApi(handler Handler, log logrus.FieldLogger) *Api {
+func NewApi(handler Handler, broadcaster *Broadcaster, log logrus.FieldLogger, db *gorm.DB) *Api {
 	return &Api{
-		handler: handler,
-		log:     log,
+		handler:     handler,
+		broadcaster: broadcaster,
+		log:         log,
+		db:          db,
 	}
 }
 
 func (a *Api) ListEvents(ctx context.Context, params events.ListEventsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, a.log)
 
-	evs, err := a.handler.GetEvents(params.ClusterID, params.HostID, params.Categories...)
+	filter := &Filter{
+		ClusterID:  params.ClusterID,
+		HostID:     params.HostID,
+		Categories: params.Categories,
+		Severities: params.Severities,
+		Message:    params.Message,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+	}
+	if params.Since != nil {
+		since := time.Time(*params.Since)
+		filter.Since = &since
+	}
+	if params.Until != nil {
+		until := time.Time(*params.Until)
+		filter.Until = &until
+	}
+	evs, total, err := a.handler.QueryEvents(filter)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get events")
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 	ret := make(models.EventList, len(evs))
 	for i, ev := range evs {
-		ret[i] = &models.Event{
-			ClusterID: ev.ClusterID,
-			HostID:    ev.HostID,
-			Severity:  ev.Severity,
-			EventTime: ev.EventTime,
-			Message:   ev.Message,
-			Props:     ev.Props,
+		ret[i] = toModel(ev)
+	}
+	return events.NewListEventsOK().WithPayload(ret).WithXTotalCount(total)
+}
+
+func (a *Api) StreamEvents(ctx context.Context, params events.StreamEventsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	if err := a.db.First(&common.Cluster{}, "id = ?", params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, err)
 		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var lastEventID uint
+	if params.LastEventID != nil {
+		lastEventID = uint(*params.LastEventID)
+	} else if params.AfterEventID != nil {
+		lastEventID = uint(*params.AfterEventID)
+	}
+	return &streamResponder{
+		ctx:         ctx,
+		log:         log,
+		handler:     a.handler,
+		broadcaster: a.broadcaster,
+		filter: Filter{
+			ClusterID:  params.ClusterID,
+			HostID:     params.HostID,
+			Categories: params.Categories,
+			AfterID:    &lastEventID,
+			Limit:      swag.Int64(streamBatchSize),
+		},
+	}
+}
+
+func toModel(ev *common.Event) *models.Event {
+	return &models.Event{
+		ClusterID: ev.ClusterID,
+		HostID:    ev.HostID,
+		Severity:  ev.Severity,
+		EventTime: ev.EventTime,
+		Message:   ev.Message,
+		Props:     ev.Props,
 	}
-	return events.NewListEventsOK().WithPayload(ret)
 }
@@ -8,7 +8,9 @@ import (
 	context "context"
 	strfmt "github.com/go-openapi/strfmt"
 	gomock "github.com/golang/mock/gomock"
+	gorm "github.com/jinzhu/gorm"
 	common "github.com/openshift/assisted-service/internal/common"
+	models "github.com/openshift/assisted-service/models"
 	reflect "reflect"
 	time "time"
 )
@@ -89,3 +91,56 @@ func (mr *MockHandlerMockRecorder) GetEvents(clusterID, hostID interface{}, cate
 	varargs := append([]interface{}{clusterID, hostID}, categories...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockHandler)(nil).GetEvents), varargs...)
 }
+
+// QueryEvents mocks base method
+func (m *MockHandler) QueryEvents(filter *Filter) ([]*common.Event, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryEvents", filter)
+	ret0, _ := ret[0].([]*common.Event)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryEvents indicates an expected call of QueryEvents
+func (mr *MockHandlerMockRecorder) QueryEvents(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryEvents", reflect.TypeOf((*MockHandler)(nil).QueryEvents), filter)
+}
+
+// MockListener is a mock of Listener interface
+type MockListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockListenerMockRecorder
+}
+
+// MockListenerMockRecorder is the mock recorder for MockListener
+type MockListenerMockRecorder struct {
+	mock *MockListener
+}
+
+// NewMockListener creates a new mock instance
+func NewMockListener(ctrl *gomock.Controller) *MockListener {
+	mock := &MockListener{ctrl: ctrl}
+	mock.recorder = &MockListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockListener) EXPECT() *MockListenerMockRecorder {
+	return m.recorder
+}
+
+// NotifyEvent mocks base method
+func (m *MockListener) NotifyEvent(ctx context.Context, tx *gorm.DB, event *models.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyEvent", ctx, tx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyEvent indicates an expected call of NotifyEvent
+func (mr *MockListenerMockRecorder) NotifyEvent(ctx, tx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyEvent", reflect.TypeOf((*MockListener)(nil).NotifyEvent), ctx, tx, event)
+}
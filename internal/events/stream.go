@@ -0,0 +1,112 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+// StreamChannel is the Postgres notification channel on which the ID of the cluster is sent
+// whenever an event is added to it
+const StreamChannel = "assisted_service_events"
+
+const listenerPingInterval = 90 * time.Second
+
+// Broadcaster wakes up the event streams of a cluster when new events are added to it.
+// The streams query the new events by themselves, so a wake up may cover several events.
+type Broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[strfmt.UUID]map[chan struct{}]struct{}
+}
+
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		subscribers: make(map[strfmt.UUID]map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe returns a channel that is signaled when events are added to the cluster,
+// and a function that must be called once the subscriber is no longer interested in them
+func (b *Broadcaster) Subscribe(clusterID strfmt.UUID) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[clusterID]; !ok {
+		b.subscribers[clusterID] = make(map[chan struct{}]struct{})
+	}
+	b.subscribers[clusterID][ch] = struct{}{}
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers[clusterID], ch)
+		if len(b.subscribers[clusterID]) == 0 {
+			delete(b.subscribers, clusterID)
+		}
+	}
+}
+
+// Publish wakes up the subscribers of the cluster
+func (b *Broadcaster) Publish(clusterID strfmt.UUID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[clusterID] {
+		wakeUp(ch)
+	}
+}
+
+// PublishAll wakes up all the subscribers, e.g. after notifications might have been missed
+func (b *Broadcaster) PublishAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, subscribers := range b.subscribers {
+		for ch := range subscribers {
+			wakeUp(ch)
+		}
+	}
+}
+
+func wakeUp(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+		// a wake up is already pending
+	}
+}
+
+// Listen forwards the notifications about new events, which are sent over the stream channel by
+// all the service replicas, to the subscribers until the context is done
+func (b *Broadcaster) Listen(ctx context.Context, dbConnectionStr string, log logrus.FieldLogger) error {
+	listener := pq.NewListener(dbConnectionStr, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.WithError(err).Warnf("Events stream listener connection event %d", ev)
+		}
+	})
+	defer listener.Close()
+	if err := listener.Listen(StreamChannel); err != nil {
+		return err
+	}
+	log.Infof("Listening for events on channel %s", StreamChannel)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-listener.Notify:
+			if n == nil {
+				// the connection was re-established, notifications may have been lost meanwhile
+				b.PublishAll()
+				continue
+			}
+			b.Publish(strfmt.UUID(n.Extra))
+		case <-time.After(listenerPingInterval):
+			go func() {
+				if err := listener.Ping(); err != nil {
+					log.WithError(err).Warn("Events stream listener ping failed")
+				}
+			}()
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	streamBatchSize         = 500
+	streamKeepAliveInterval = 30 * time.Second
+)
+
+// streamResponder writes the events of a cluster as Server-Sent Events, starting after the last
+// event that the client has seen, until the client disconnects. Every event carries its ID so that
+// the client can resume the stream from it.
+type streamResponder struct {
+	ctx         context.Context
+	log         logrus.FieldLogger
+	handler     Handler
+	broadcaster *Broadcaster
+	filter      Filter
+}
+
+func (s *streamResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		s.log.Error("events stream is not supported by the response writer")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var wakeUp <-chan struct{}
+	if s.broadcaster != nil {
+		var unsubscribe func()
+		wakeUp, unsubscribe = s.broadcaster.Subscribe(s.filter.ClusterID)
+		defer unsubscribe()
+	}
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		if err := s.writeNewEvents(rw); err != nil {
+			s.log.WithError(err).Warnf("failed to stream events of cluster %s", s.filter.ClusterID)
+			return
+		}
+		flusher.Flush()
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-wakeUp:
+		case <-keepAlive.C:
+			// the comment keeps proxies from closing an idle connection, and the events are
+			// queried again in case a notification was missed
+			if _, err := fmt.Fprint(rw, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *streamResponder) writeNewEvents(rw http.ResponseWriter) error {
+	for {
+		evs, _, err := s.handler.QueryEvents(&s.filter)
+		if err != nil {
+			return err
+		}
+		for _, ev := range evs {
+			data, err := json.Marshal(toModel(ev))
+			if err != nil {
+				return err
+			}
+			if _, err = fmt.Fprintf(rw, "id: %d\nevent: event\ndata: %s\n\n", ev.ID, data); err != nil {
+				return err
+			}
+			id := ev.ID
+			s.filter.AfterID = &id
+		}
+		if int64(len(evs)) < *s.filter.Limit {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package events_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Broadcaster", func() {
+	var (
+		broadcaster *events.Broadcaster
+		cluster1    = strfmt.UUID("46a8d745-dfce-4fd8-9df0-549ee8eabb3d")
+		cluster2    = strfmt.UUID("60415d9c-7c44-4978-89f5-53d510b03a47")
+	)
+
+	BeforeEach(func() {
+		broadcaster = events.NewBroadcaster()
+	})
+
+	It("wakes up only the subscribers of the cluster", func() {
+		ch1, unsubscribe1 := broadcaster.Subscribe(cluster1)
+		defer unsubscribe1()
+		ch2, unsubscribe2 := broadcaster.Subscribe(cluster2)
+		defer unsubscribe2()
+
+		broadcaster.Publish(cluster1)
+		broadcaster.Publish(cluster1)
+		Eventually(ch1).Should(Receive())
+		Consistently(ch1, 100*time.Millisecond).ShouldNot(Receive())
+		Consistently(ch2, 100*time.Millisecond).ShouldNot(Receive())
+
+		broadcaster.PublishAll()
+		Eventually(ch1).Should(Receive())
+		Eventually(ch2).Should(Receive())
+	})
+
+	It("stops waking up unsubscribed subscribers", func() {
+		ch, unsubscribe := broadcaster.Subscribe(cluster1)
+		unsubscribe()
+		broadcaster.Publish(cluster1)
+		Consistently(ch, 100*time.Millisecond).ShouldNot(Receive())
+	})
+})
+
+var _ = Describe("StreamEvents", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockHandler *events.MockHandler
+		broadcaster *events.Broadcaster
+		api         *events.Api
+		db          *gorm.DB
+		dbName      string
+		clusterID   = strfmt.UUID("46a8d745-dfce-4fd8-9df0-549ee8eabb3d")
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockHandler = events.NewMockHandler(ctrl)
+		broadcaster = events.NewBroadcaster()
+		api = events.NewApi(mockHandler, broadcaster, logrus.New(), db)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	newEvent := func(id uint, msg string) *common.Event {
+		return &common.Event{
+			Model: gorm.Model{ID: id},
+			Event: models.Event{ClusterID: &clusterID, Severity: swag.String(models.EventSeverityInfo), Message: swag.String(msg)},
+		}
+	}
+
+	It("resumes after the last event and pushes new events", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var afterIDs []uint
+		queried := make(chan struct{}, 10)
+		results := [][]*common.Event{{newEvent(6, "e6"), newEvent(7, "e7")}, {newEvent(8, "e8")}}
+		mockHandler.EXPECT().QueryEvents(gomock.Any()).DoAndReturn(func(filter *events.Filter) ([]*common.Event, int64, error) {
+			Expect(filter.ClusterID).Should(Equal(clusterID))
+			afterIDs = append(afterIDs, *filter.AfterID)
+			var ret []*common.Event
+			if len(results) > 0 {
+				ret, results = results[0], results[1:]
+			}
+			queried <- struct{}{}
+			return ret, int64(len(ret)), nil
+		}).AnyTimes()
+
+		responder := api.StreamEvents(ctx, operations.StreamEventsParams{ClusterID: clusterID, LastEventID: swag.Int64(5)})
+		rec := httptest.NewRecorder()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			responder.WriteResponse(rec, nil)
+		}()
+
+		Eventually(queried).Should(Receive())
+		broadcaster.Publish(clusterID)
+		Eventually(queried).Should(Receive())
+		cancel()
+		Eventually(done).Should(BeClosed())
+
+		Expect(rec.Code).Should(Equal(http.StatusOK))
+		Expect(rec.Header().Get("Content-Type")).Should(Equal("text/event-stream"))
+		Expect(afterIDs[:2]).Should(Equal([]uint{5, 7}))
+		body := rec.Body.String()
+		Expect(strings.Count(body, "event: event\n")).Should(Equal(3))
+		Expect(body).Should(ContainSubstring("id: 6\nevent: event\ndata: {"))
+		Expect(body).Should(ContainSubstring(`"message":"e8"`))
+	})
+
+	It("fails for an unknown cluster", func() {
+		responder := api.StreamEvents(context.Background(), operations.StreamEventsParams{ClusterID: strfmt.UUID("60415d9c-7c44-4978-89f5-53d510b03a47")})
+		Expect(responder).Should(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(responder.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusNotFound)))
+	})
+})
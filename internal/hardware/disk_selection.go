@@ -0,0 +1,96 @@
+package hardware
+
+import (
+	"math"
+	"regexp"
+	"sort"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// ValidateDiskSelectionPolicy checks that the rules of the policy can be applied
+func ValidateDiskSelectionPolicy(policy *models.DiskSelectionPolicy) error {
+	_, err := diskMatcher(policy)
+	return err
+}
+
+// diskMatcher returns the function that tells whether a disk matches the pattern of the policy, or nil if
+// the policy doesn't match disks by any attribute
+func diskMatcher(policy *models.DiskSelectionPolicy) (func(disk *models.Disk) bool, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	var attribute func(disk *models.Disk) string
+	switch swag.StringValue(policy.MatchBy) {
+	case "", models.DiskSelectionPolicyMatchByNone:
+		return nil, nil
+	case models.DiskSelectionPolicyMatchByByPath:
+		attribute = func(disk *models.Disk) string { return disk.ByPath }
+	case models.DiskSelectionPolicyMatchByWwn:
+		attribute = func(disk *models.Disk) string { return disk.Wwn }
+	case models.DiskSelectionPolicyMatchByModel:
+		attribute = func(disk *models.Disk) string { return disk.Model }
+	case models.DiskSelectionPolicyMatchBySerial:
+		attribute = func(disk *models.Disk) string { return disk.Serial }
+	default:
+		return nil, errors.Errorf("unsupported match by %s", swag.StringValue(policy.MatchBy))
+	}
+	if policy.MatchPattern == "" {
+		return nil, errors.Errorf("match pattern must be set to match disks by %s", swag.StringValue(policy.MatchBy))
+	}
+	re, err := regexp.Compile(policy.MatchPattern)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid disk match pattern %s", policy.MatchPattern)
+	}
+	return func(disk *models.Disk) bool {
+		value := attribute(disk)
+		return value != "" && re.MatchString(value)
+	}, nil
+}
+
+// SortDisksByPolicy returns the eligible disks ordered by the preferences of the disk selection policy, the
+// first disk being the one to install on. Disks that the policy doesn't tell apart keep their order, so
+// without a policy the disks are returned as ListEligibleDisks ordered them.
+func SortDisksByPolicy(disks []*models.Disk, policy *models.DiskSelectionPolicy) []*models.Disk {
+	if policy == nil {
+		return disks
+	}
+	// The policy is validated when it is set, an invalid pattern only disables matching
+	matches, _ := diskMatcher(policy)
+	sorted := append(make([]*models.Disk, 0, len(disks)), disks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if matches != nil && matches(a) != matches(b) {
+			return matches(a)
+		}
+		if policy.AvoidPartitionedDisks && a.Bootable != b.Bootable {
+			return !a.Bootable
+		}
+		if policy.PreferSsd && isSolidState(a) != isSolidState(b) {
+			return isSolidState(a)
+		}
+		if policy.PreferFastest && syncDuration(a) != syncDuration(b) {
+			return syncDuration(a) < syncDuration(b)
+		}
+		if policy.PreferSmallest && a.SizeBytes != b.SizeBytes {
+			return a.SizeBytes < b.SizeBytes
+		}
+		return false
+	})
+	return sorted
+}
+
+func isSolidState(disk *models.Disk) bool {
+	return disk.DriveType == "SSD" || isNvme(disk.Name)
+}
+
+// syncDuration returns the sync duration that the agent measured on the disk, disks that weren't measured
+// being the slowest
+func syncDuration(disk *models.Disk) int64 {
+	if disk.IoPerf == nil || disk.IoPerf.SyncDuration <= 0 {
+		return math.MaxInt64
+	}
+	return disk.IoPerf.SyncDuration
+}
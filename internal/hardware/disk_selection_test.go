@@ -0,0 +1,63 @@
+package hardware
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+)
+
+var _ = Describe("Disk selection policy", func() {
+	var (
+		hdd       = &models.Disk{Name: "sda", DriveType: "HDD", SizeBytes: conversions.GibToBytes(500), ByPath: "pci-0000:00:1f.2-ata-1", Bootable: true}
+		smallSsd  = &models.Disk{Name: "sdb", DriveType: "SSD", SizeBytes: conversions.GibToBytes(200), Model: "PERC H730", IoPerf: &models.IoPerf{SyncDuration: 20}}
+		largeSsd  = &models.Disk{Name: "sdc", DriveType: "SSD", SizeBytes: conversions.GibToBytes(800), Wwn: "0x5000c500a0b1c2d3", IoPerf: &models.IoPerf{SyncDuration: 5}}
+		nvme      = &models.Disk{Name: "nvme0n1", DriveType: "SSD", SizeBytes: conversions.GibToBytes(400), Serial: "NVME-42"}
+		eligibles = []*models.Disk{hdd, smallSsd, largeSsd, nvme}
+	)
+
+	It("validates the policy", func() {
+		Expect(ValidateDiskSelectionPolicy(nil)).To(Succeed())
+		Expect(ValidateDiskSelectionPolicy(&models.DiskSelectionPolicy{MatchBy: swag.String(models.DiskSelectionPolicyMatchByNone)})).To(Succeed())
+		Expect(ValidateDiskSelectionPolicy(&models.DiskSelectionPolicy{MatchBy: swag.String(models.DiskSelectionPolicyMatchByWwn)})).ToNot(Succeed())
+		Expect(ValidateDiskSelectionPolicy(&models.DiskSelectionPolicy{
+			MatchBy:      swag.String(models.DiskSelectionPolicyMatchByModel),
+			MatchPattern: "^PERC (",
+		})).ToNot(Succeed())
+		Expect(ValidateDiskSelectionPolicy(&models.DiskSelectionPolicy{
+			MatchBy:      swag.String(models.DiskSelectionPolicyMatchByModel),
+			MatchPattern: "^PERC",
+		})).To(Succeed())
+	})
+
+	It("keeps the order of the eligible disks without a policy", func() {
+		Expect(SortDisksByPolicy(eligibles, nil)).To(Equal(eligibles))
+		Expect(SortDisksByPolicy(eligibles, &models.DiskSelectionPolicy{})).To(Equal(eligibles))
+	})
+
+	table.DescribeTable("picks the installation disk",
+		func(policy *models.DiskSelectionPolicy, expected *models.Disk) {
+			sorted := SortDisksByPolicy(eligibles, policy)
+			Expect(sorted).To(HaveLen(len(eligibles)))
+			Expect(sorted[0]).To(Equal(expected))
+		},
+		table.Entry("by path", &models.DiskSelectionPolicy{MatchBy: swag.String(models.DiskSelectionPolicyMatchByByPath), MatchPattern: "ata-1$"}, hdd),
+		table.Entry("by WWN", &models.DiskSelectionPolicy{MatchBy: swag.String(models.DiskSelectionPolicyMatchByWwn), MatchPattern: "^0x5000c500"}, largeSsd),
+		table.Entry("by model", &models.DiskSelectionPolicy{MatchBy: swag.String(models.DiskSelectionPolicyMatchByModel), MatchPattern: "^PERC"}, smallSsd),
+		table.Entry("by serial", &models.DiskSelectionPolicy{MatchBy: swag.String(models.DiskSelectionPolicyMatchBySerial), MatchPattern: "^NVME-"}, nvme),
+		table.Entry("no matching disk falls back to the eligible disks order",
+			&models.DiskSelectionPolicy{MatchBy: swag.String(models.DiskSelectionPolicyMatchBySerial), MatchPattern: "^SAN-"}, hdd),
+		table.Entry("avoiding partitioned disks", &models.DiskSelectionPolicy{AvoidPartitionedDisks: true}, smallSsd),
+		table.Entry("the fastest disk", &models.DiskSelectionPolicy{PreferFastest: true}, largeSsd),
+		table.Entry("the smallest SSD", &models.DiskSelectionPolicy{PreferSsd: true, PreferSmallest: true}, smallSsd),
+		table.Entry("a matching disk before a faster one",
+			&models.DiskSelectionPolicy{MatchBy: swag.String(models.DiskSelectionPolicyMatchBySerial), MatchPattern: "^NVME-", PreferFastest: true}, nvme),
+	)
+
+	It("doesn't modify the eligible disks", func() {
+		SortDisksByPolicy(eligibles, &models.DiskSelectionPolicy{PreferSmallest: true})
+		Expect(eligibles).To(Equal([]*models.Disk{hdd, smallSsd, largeSsd, nvme}))
+	})
+})
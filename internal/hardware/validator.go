@@ -63,6 +63,8 @@ type ValidatorCfg struct {
 	MinDiskSizeGb                    int64                        `envconfig:"HW_VALIDATOR_MIN_DISK_SIZE_GIB" default:"120"` // Env variable is GIB to not break infra
 	MaximumAllowedTimeDiffMinutes    int64                        `envconfig:"HW_VALIDATOR_MAX_TIME_DIFF_MINUTES" default:"4"`
 	InstallationDiskSpeedThresholdMs int64                        `envconfig:"HW_INSTALLATION_DISK_SPEED_THRESHOLD_MS" default:"10"`
+	MinVarLibContainersDiskSizeGb    int64                        `envconfig:"HW_VALIDATOR_MIN_VAR_LIB_CONTAINERS_DISK_SIZE_GB" default:"200"`
+	MinVarLibEtcdDiskSizeGb          int64                        `envconfig:"HW_VALIDATOR_MIN_VAR_LIB_ETCD_DISK_SIZE_GB" default:"120"`
 	VersionedRequirements            VersionedRequirementsDecoder `envconfig:"HW_VALIDATOR_REQUIREMENTS" default:"[]"`
 }
 
@@ -0,0 +1,97 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/history"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.HistoryAPI = &Api{}
+
+type Api struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:  db,
+		log: log,
+	}
+}
+
+func (a *Api) ListClusterStatusHistory(ctx context.Context, params operations.ListClusterStatusHistoryParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	if err := a.db.First(&common.Cluster{}, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return toApiError(err)
+	}
+
+	entries, err := a.list(params.ClusterID, "")
+	if err != nil {
+		log.WithError(err).Errorf("failed to get status history of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewListClusterStatusHistoryOK().WithPayload(entries)
+}
+
+func (a *Api) ListHostStatusHistory(ctx context.Context, params operations.ListHostStatusHistoryParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	if err := a.db.First(&common.Cluster{}, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return toApiError(err)
+	}
+	if err := a.db.First(&common.Host{}, "id = ? and cluster_id = ?", params.HostID.String(), params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get host %s in cluster %s", params.HostID, params.ClusterID)
+		return toApiError(err)
+	}
+
+	entries, err := a.list(params.ClusterID, params.HostID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get status history of host %s in cluster %s", params.HostID, params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewListHostStatusHistoryOK().WithPayload(entries)
+}
+
+// list returns the status history of the cluster, or of one of its hosts, in the order it was recorded
+func (a *Api) list(clusterID, hostID strfmt.UUID) (models.StatusHistory, error) {
+	var records []*common.StatusHistory
+	if err := a.db.Order("created_at").Order("id").
+		Find(&records, "cluster_id = ? and host_id = ?", clusterID.String(), hostID.String()).Error; err != nil {
+		return nil, err
+	}
+
+	ret := make(models.StatusHistory, 0, len(records))
+	for _, r := range records {
+		entry := r.StatusHistoryEntry
+		if r.FailedValidationsInfo != "" {
+			if err := json.Unmarshal([]byte(r.FailedValidationsInfo), &entry.FailedValidations); err != nil {
+				return nil, errors.Wrapf(err, "failed to unmarshal failed validations of status history entry %d", r.ID)
+			}
+		}
+		ret = append(ret, &entry)
+	}
+	return ret, nil
+}
+
+func toApiError(err error) *common.ApiErrorResponse {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.NewApiError(http.StatusNotFound, err)
+	}
+	return common.NewApiError(http.StatusInternalServerError, err)
+}
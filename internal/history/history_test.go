@@ -0,0 +1,98 @@
+package history_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/history"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/history"
+)
+
+func TestHistory(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "History test Suite")
+}
+
+var _ = Describe("Status history", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		dbName    string
+		api       *history.Api
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		cluster   *models.Cluster
+		host      *models.Host
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		api = history.NewApi(db, common.GetTestLog())
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		cluster = &models.Cluster{ID: &clusterID, Status: swag.String(models.ClusterStatusInsufficient), StatusInfo: swag.String("not enough hosts")}
+		Expect(db.Create(&common.Cluster{Cluster: *cluster}).Error).ShouldNot(HaveOccurred())
+		host = &models.Host{ID: &hostID, ClusterID: clusterID, Status: swag.String(models.HostStatusKnown), StatusInfo: swag.String("ready")}
+		Expect(db.Create(host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("lists the status changes of a host in the order they occurred", func() {
+		Expect(history.RecordHostStatusChange(db, host, models.HostStatusDiscovering, "RefreshHost", []string{"has-min-cpu-cores"})).ShouldNot(HaveOccurred())
+		host.Status = swag.String(models.HostStatusInsufficient)
+		Expect(history.RecordHostStatusChange(db, host, models.HostStatusKnown, "RefreshHost", nil)).ShouldNot(HaveOccurred())
+		Expect(history.RecordClusterStatusChange(db, cluster, models.ClusterStatusPendingForInput, "RefreshStatus", nil)).ShouldNot(HaveOccurred())
+
+		reply := api.ListHostStatusHistory(ctx, operations.ListHostStatusHistoryParams{ClusterID: clusterID, HostID: hostID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListHostStatusHistoryOK()))
+		entries := reply.(*operations.ListHostStatusHistoryOK).Payload
+		Expect(entries).Should(HaveLen(2))
+		Expect(entries[0].PreviousStatus).Should(Equal(models.HostStatusDiscovering))
+		Expect(swag.StringValue(entries[0].Status)).Should(Equal(models.HostStatusKnown))
+		Expect(entries[0].StatusInfo).Should(Equal("ready"))
+		Expect(swag.StringValue(entries[0].Trigger)).Should(Equal("RefreshHost"))
+		Expect(entries[0].FailedValidations).Should(Equal([]string{"has-min-cpu-cores"}))
+		Expect(swag.StringValue(entries[1].Status)).Should(Equal(models.HostStatusInsufficient))
+		Expect(entries[1].FailedValidations).Should(BeEmpty())
+	})
+
+	It("lists the status changes of a cluster without those of its hosts", func() {
+		Expect(history.RecordHostStatusChange(db, host, models.HostStatusDiscovering, "RefreshHost", nil)).ShouldNot(HaveOccurred())
+		Expect(history.RecordClusterStatusChange(db, cluster, models.ClusterStatusPendingForInput, "RefreshStatus", []string{"sufficient-masters-count"})).ShouldNot(HaveOccurred())
+
+		reply := api.ListClusterStatusHistory(ctx, operations.ListClusterStatusHistoryParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListClusterStatusHistoryOK()))
+		entries := reply.(*operations.ListClusterStatusHistoryOK).Payload
+		Expect(entries).Should(HaveLen(1))
+		Expect(entries[0].HostID).Should(BeEmpty())
+		Expect(entries[0].PreviousStatus).Should(Equal(models.ClusterStatusPendingForInput))
+		Expect(swag.StringValue(entries[0].Status)).Should(Equal(models.ClusterStatusInsufficient))
+		Expect(entries[0].StatusInfo).Should(Equal("not enough hosts"))
+		Expect(entries[0].FailedValidations).Should(Equal([]string{"sufficient-masters-count"}))
+	})
+
+	It("fails for a host that is not in the cluster", func() {
+		reply := api.ListHostStatusHistory(ctx, operations.ListHostStatusHistoryParams{ClusterID: clusterID, HostID: strfmt.UUID(uuid.New().String())})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusNotFound)))
+	})
+
+	It("fails for a missing cluster", func() {
+		reply := api.ListClusterStatusHistory(ctx, operations.ListClusterStatusHistoryParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusNotFound)))
+	})
+})
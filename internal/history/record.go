@@ -0,0 +1,47 @@
+package history
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+// RecordHostStatusChange appends the status change of a host, triggered by the given state machine
+// transition, to the status history
+func RecordHostStatusChange(db *gorm.DB, host *models.Host, previousStatus, trigger string, failedValidations []string) error {
+	return add(db, host.ClusterID, *host.ID, previousStatus, swag.StringValue(host.Status), swag.StringValue(host.StatusInfo), trigger, failedValidations)
+}
+
+// RecordClusterStatusChange appends the status change of a cluster, triggered by the given state
+// machine transition, to the status history
+func RecordClusterStatusChange(db *gorm.DB, cluster *models.Cluster, previousStatus, trigger string, failedValidations []string) error {
+	return add(db, *cluster.ID, "", previousStatus, swag.StringValue(cluster.Status), swag.StringValue(cluster.StatusInfo), trigger, failedValidations)
+}
+
+func add(db *gorm.DB, clusterID, hostID strfmt.UUID, previousStatus, status, statusInfo, trigger string, failedValidations []string) error {
+	if failedValidations == nil {
+		failedValidations = []string{}
+	}
+	failedValidationsInfo, err := json.Marshal(failedValidations)
+	if err != nil {
+		return err
+	}
+	createdAt := strfmt.DateTime(time.Now())
+	return db.Create(&common.StatusHistory{
+		StatusHistoryEntry: models.StatusHistoryEntry{
+			ClusterID:      &clusterID,
+			HostID:         hostID,
+			PreviousStatus: previousStatus,
+			Status:         swag.String(status),
+			StatusInfo:     statusInfo,
+			Trigger:        swag.String(trigger),
+			CreatedAt:      &createdAt,
+		},
+		FailedValidationsInfo: string(failedValidationsInfo),
+	}).Error
+}
@@ -75,7 +75,7 @@ func indexOfStage(element models.HostStage, data []models.HostStage) int {
 }
 
 // update host role with an option to update only if the current role is srcRole to prevent races
-func updateRole(log logrus.FieldLogger, h *models.Host, role models.HostRole, db *gorm.DB, srcRole *string) error {
+func updateRole(log logrus.FieldLogger, h *models.Host, role models.HostRole, db *gorm.DB, srcRole *string, extra ...interface{}) error {
 	hostStatus := swag.StringValue(h.Status)
 	if !funk.ContainsString(hostStatusesBeforeInstallation[:], hostStatus) {
 		return common.NewApiError(http.StatusBadRequest,
@@ -88,6 +88,7 @@ func updateRole(log logrus.FieldLogger, h *models.Host, role models.HostRole, db
 	if hostutil.IsDay2Host(h) && (h.MachineConfigPoolName == "" || h.MachineConfigPoolName == *srcRole) {
 		extras = append(extras, "machine_config_pool_name", role)
 	}
+	extras = append(extras, extra...)
 
 	_, err := hostutil.UpdateHost(log, db, h.ClusterID, *h.ID, *h.Status, extras...)
 	return err
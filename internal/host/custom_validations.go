@@ -0,0 +1,49 @@
+package host
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/customvalidations"
+)
+
+// evaluateCustomValidations evaluates the custom validations of the cluster of the host, and tells whether
+// all the blocking ones succeeded
+func (r *refreshPreprocessor) evaluateCustomValidations(c *validationContext) ([]ValidationResult, bool, error) {
+	validations := r.customValidations
+	if c.cluster != nil {
+		var err error
+		if validations, err = customvalidations.ForCluster(&c.cluster.Cluster, r.customValidations); err != nil {
+			return nil, false, err
+		}
+	}
+
+	succeeded := true
+	results := make([]ValidationResult, 0, len(validations))
+	for _, v := range validations {
+		id := swag.StringValue(v.ID)
+		result := ValidationResult{ID: validationID(id)}
+		if c.inventory == nil {
+			result.Status = ValidationPending
+			result.Message = "Missing inventory"
+		} else if ok, err := customvalidations.Evaluate(v, c.host.Inventory); err != nil {
+			result.Status = ValidationFailure
+			result.Message = fmt.Sprintf("Custom validation %s could not be evaluated: %s", id, err.Error())
+		} else if ok {
+			result.Status = ValidationSuccess
+			result.Message = fmt.Sprintf("Custom validation %s passed", id)
+		} else {
+			result.Status = ValidationFailure
+			result.Message = v.Description
+			if result.Message == "" {
+				result.Message = fmt.Sprintf("Custom validation %s failed", id)
+			}
+		}
+		if result.Status != ValidationSuccess && customvalidations.IsBlocking(v) {
+			succeeded = false
+		}
+		results = append(results, result)
+	}
+	sortByValidationResultID(results)
+	return results, succeeded, nil
+}
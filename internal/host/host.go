@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"path"
 	reflect "reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,12 +17,15 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/customvalidations"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/notifications"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/timeline"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/leader"
 	logutil "github.com/openshift/assisted-service/pkg/log"
@@ -95,6 +100,11 @@ type Config struct {
 	ResetTimeout            time.Duration           `envconfig:"RESET_CLUSTER_TIMEOUT" default:"3m"`
 	MonitorBatchSize        int                     `envconfig:"HOST_MONITOR_BATCH_SIZE" default:"100"`
 	DisabledHostvalidations DisabledHostValidations `envconfig:"DISABLED_HOST_VALIDATIONS"` // Which host validations to disable (should not run in preprocess)
+	// A validation is flapping when its status changed at least FlappingValidationThreshold times within FlappingValidationWindow
+	FlappingValidationWindow    time.Duration `envconfig:"HOST_FLAPPING_VALIDATION_WINDOW" default:"30m"`
+	FlappingValidationThreshold int           `envconfig:"HOST_FLAPPING_VALIDATION_THRESHOLD" default:"4"`
+	// Evaluated for the hosts of clusters that don't have their own custom validations
+	CustomHostValidations customvalidations.Validations `envconfig:"DEFAULT_CUSTOM_HOST_VALIDATIONS"`
 }
 
 //go:generate mockgen -package=host -aux_files=github.com/openshift/assisted-service/internal/host/hostcommands=instruction_manager.go -destination=mock_host_api.go . API
@@ -127,6 +137,8 @@ type API interface {
 	IsValidMasterCandidate(h *models.Host, c *common.Cluster, db *gorm.DB, log logrus.FieldLogger) (bool, error)
 	SetUploadLogsAt(ctx context.Context, h *models.Host, db *gorm.DB) error
 	UpdateLogsProgress(ctx context.Context, h *models.Host, progress string) error
+	// Request the agent of the host to collect and upload its logs on its next steps, whatever the host status is
+	RequestLogsCollection(ctx context.Context, h *models.Host, params *models.HostLogsCollectionParams) error
 	PermanentHostsDeletion(olderThan strfmt.DateTime) error
 	ReportValidationFailedMetrics(ctx context.Context, h *models.Host, ocpVersion, emailDomain string) error
 
@@ -136,7 +148,14 @@ type API interface {
 	RefreshInventory(ctx context.Context, cluster *common.Cluster, h *models.Host, db *gorm.DB) error
 	UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*models.NtpSource, db *gorm.DB) error
 	UpdateMachineConfigPoolName(ctx context.Context, db *gorm.DB, h *models.Host, machineConfigPoolName string) error
+	UpdateRackLabel(ctx context.Context, db *gorm.DB, h *models.Host, rackLabel string) error
 	UpdateInstallationDisk(ctx context.Context, db *gorm.DB, h *models.Host, installationDiskId string) error
+	// SelectInstallationDisk picks the installation disk of the host again following the disk selection policy,
+	// unless the user picked it
+	SelectInstallationDisk(ctx context.Context, db *gorm.DB, h *models.Host, policy *models.DiskSelectionPolicy) error
+	// UpdateSecondaryDisks assigns disks of the host to the directories of the node that they are mounted on, or
+	// unassigns them if their role is none. Disks that aren't part of the disks config keep their role.
+	UpdateSecondaryDisks(ctx context.Context, db *gorm.DB, h *models.Host, disksConfig []*models.DiskConfigParams) error
 	GetHostValidDisks(role *models.Host) ([]*models.Disk, error)
 	UpdateImageStatus(ctx context.Context, h *models.Host, imageStatus *models.ContainerImageAvailability, db *gorm.DB) error
 	SetDiskSpeed(ctx context.Context, h *models.Host, path string, speedMs int64, exitCode int64, db *gorm.DB) error
@@ -156,7 +175,8 @@ type Manager struct {
 }
 
 func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handler, hwValidator hardware.Validator, instructionApi hostcommands.InstructionApi,
-	hwValidatorCfg *hardware.ValidatorCfg, metricApi metrics.API, config *Config, leaderElector leader.ElectorInterface, operatorsApi operators.API) *Manager {
+	hwValidatorCfg *hardware.ValidatorCfg, metricApi metrics.API, config *Config, leaderElector leader.ElectorInterface, operatorsApi operators.API, notifier notifications.Notifier,
+	ignitionDiffer IgnitionDiffer) *Manager {
 	th := &transitionHandler{
 		db:            db,
 		log:           log,
@@ -169,8 +189,8 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handle
 		instructionApi: instructionApi,
 		hwValidator:    hwValidator,
 		eventsHandler:  eventsHandler,
-		sm:             NewHostStateMachine(th),
-		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations),
+		sm:             newNotifyingStateMachine(newStatusHistoryStateMachine(NewHostStateMachine(th), th), notifier, th.transitionDB),
+		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, config.CustomHostValidations, ignitionDiffer),
 		metricApi:      metricApi,
 		Config:         *config,
 		leaderElector:  leaderElector,
@@ -320,8 +340,11 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 		return err
 	}
 
-	validDisks := m.hwValidator.ListEligibleDisks(inventory)
+	validDisks := hardware.SortDisksByPolicy(m.hwValidator.ListEligibleDisks(inventory), cluster.DiskSelectionPolicy)
 	installationDisk := hostutil.DetermineInstallationDisk(validDisks, hostutil.GetHostInstallationPath(h))
+	// the disk that the user picked is only kept while it is part of the inventory
+	h.InstallationDiskChosenByUser = h.InstallationDiskChosenByUser &&
+		hostutil.GetDiskByInstallationPath(validDisks, hostutil.GetHostInstallationPath(h)) != nil
 
 	if installationDisk == nil {
 		h.InstallationDiskPath = ""
@@ -332,9 +355,10 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 	}
 
 	return db.Model(h).Update(map[string]interface{}{
-		"inventory":              h.Inventory,
-		"installation_disk_path": h.InstallationDiskPath,
-		"installation_disk_id":   h.InstallationDiskID,
+		"inventory":                        h.Inventory,
+		"installation_disk_path":           h.InstallationDiskPath,
+		"installation_disk_id":             h.InstallationDiskID,
+		"installation_disk_chosen_by_user": h.InstallationDiskChosenByUser,
 	}).Error
 }
 
@@ -360,7 +384,8 @@ func (m *Manager) refreshStatusInternal(ctx context.Context, h *models.Host, c *
 	if err != nil {
 		return err
 	}
-	if m.didValidationChanged(ctx, newValidationRes, currentValidationRes) {
+	validationsChanged := m.didValidationChanged(ctx, newValidationRes, currentValidationRes)
+	if validationsChanged {
 		// Validation status changes are detected when new validations are different from the
 		// current validations in the DB.
 		// For changes to be detected and reported correctly, the comparison needs to be
@@ -371,6 +396,14 @@ func (m *Manager) refreshStatusInternal(ctx context.Context, h *models.Host, c *
 			return err
 		}
 	}
+	if validationsChanged || h.FlappingValidations != "" {
+		// flapping validations are also re-evaluated while the validations are stable, so that
+		// they stop being reported once their changes fall out of the window. They are only
+		// informative, so failing to update them doesn't fail the refresh.
+		if err = m.updateFlappingValidations(ctx, db, h); err != nil {
+			logutil.FromContext(ctx, m.log).WithError(err).Warnf("failed to update flapping validations of host %s", h.ID.String())
+		}
+	}
 
 	err = m.sm.Run(TransitionTypeRefresh, newStateHost(h), &TransitionArgsRefreshHost{
 		ctx:               ctx,
@@ -389,6 +422,10 @@ func (m *Manager) RefreshStatus(ctx context.Context, h *models.Host, db *gorm.DB
 	if db == nil {
 		db = m.db
 	}
+	// the suggested role is only informative until the installation, so failing to preview it doesn't fail the refresh
+	if err := m.previewRole(ctx, h, nil, db); err != nil {
+		logutil.FromContext(ctx, m.log).WithError(err).Warnf("failed to preview the role of host %s", h.ID.String())
+	}
 	return m.refreshStatusInternal(ctx, h, nil, db)
 }
 
@@ -454,10 +491,11 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 
 	statusInfo := string(progress.CurrentStage)
 
+	var updatedHost *common.Host
 	var err error
 	switch progress.CurrentStage {
 	case models.HostStageDone:
-		_, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
+		updatedHost, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
 			swag.StringValue(h.Status), models.HostStatusInstalled, statusInfo,
 			previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo)
 	case models.HostStageFailed:
@@ -467,21 +505,24 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 			statusInfo += fmt.Sprintf(" - %s", progress.ProgressInfo)
 		}
 
-		_, err = hostutil.UpdateHostStatus(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
+		updatedHost, err = hostutil.UpdateHostStatus(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
 			swag.StringValue(h.Status), models.HostStatusError, statusInfo)
 	case models.HostStageRebooting:
 		if swag.StringValue(h.Kind) == models.HostKindAddToExistingClusterHost {
-			_, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
+			updatedHost, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
 				swag.StringValue(h.Status), models.HostStatusAddedToExistingCluster, statusInfo,
 				h.Progress.CurrentStage, progress.CurrentStage, progress.ProgressInfo)
 			break
 		}
 		fallthrough
 	default:
-		_, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
+		updatedHost, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
 			swag.StringValue(h.Status), models.HostStatusInstallingInProgress, statusInfo,
 			previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo)
 	}
+	if err == nil {
+		recordStatusChange(logutil.FromContext(ctx, m.log), m.db, &updatedHost.Host, swag.StringValue(h.Status), statusTriggerUpdateInstallProgress)
+	}
 	m.reportInstallationMetrics(ctx, h, previousProgress, progress.CurrentStage)
 	return err
 }
@@ -503,6 +544,34 @@ func (m *Manager) UpdateLogsProgress(ctx context.Context, h *models.Host, progre
 	return err
 }
 
+func (m *Manager) RequestLogsCollection(ctx context.Context, h *models.Host, params *models.HostLogsCollectionParams) error {
+	log := logutil.FromContext(ctx, m.log)
+
+	if h.LogsCollectionRequest != "" {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("Logs collection was already requested for host %s", h.ID))
+	}
+	if params == nil {
+		params = &models.HostLogsCollectionParams{}
+	}
+	for _, dir := range params.Directories {
+		// the directories are passed to the logs sender as a comma separated list of paths
+		if !path.IsAbs(dir) || path.Clean(dir) != dir || strings.ContainsAny(dir, ":,") {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("Directory %s must be an absolute and clean path without ':' or ','", dir))
+		}
+	}
+	request, err := json.Marshal(params)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if _, err = hostutil.UpdateLogsProgress(ctx, log, m.db, m.eventsHandler, h.ClusterID, *h.ID, swag.StringValue(h.Status),
+		string(models.LogsStateRequested), "logs_collected_at", strfmt.DateTime(time.Time{}), "logs_collection_request", string(request)); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityInfo,
+		fmt.Sprintf("Logs collection was requested for host %s", hostutil.GetHostnameForMsg(h)), time.Now())
+	return nil
+}
+
 func (m *Manager) SetUploadLogsAt(ctx context.Context, h *models.Host, db *gorm.DB) error {
 	err := db.Model(h).Update("logs_collected_at", strfmt.DateTime(time.Now())).Error
 	if err != nil {
@@ -565,6 +634,22 @@ func (m *Manager) UpdateMachineConfigPoolName(ctx context.Context, db *gorm.DB,
 	return cdb.Model(h).Update("machine_config_pool_name", machineConfigPoolName).Error
 }
 
+func (m *Manager) UpdateRackLabel(ctx context.Context, db *gorm.DB, h *models.Host, rackLabel string) error {
+	hostStatus := swag.StringValue(h.Status)
+	if !funk.ContainsString(hostStatusesBeforeInstallation[:], hostStatus) {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host is in %s state, host rack label can be set only in one of %s states",
+				hostStatus, hostStatusesBeforeInstallation[:]))
+	}
+
+	h.RackLabel = rackLabel
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	return cdb.Model(h).Update("rack_label", rackLabel).Error
+}
+
 func (m *Manager) UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*models.NtpSource, db *gorm.DB) error {
 	bytes, err := json.Marshal(ntpSources)
 	if err != nil {
@@ -646,6 +731,34 @@ func (m *Manager) UpdateInstallationDisk(ctx context.Context, db *gorm.DB, h *mo
 
 	h.InstallationDiskPath = hostutil.GetDeviceFullName(matchedInstallationDisk)
 	h.InstallationDiskID = hostutil.GetDeviceIdentifier(matchedInstallationDisk)
+	h.InstallationDiskChosenByUser = true
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	return cdb.Model(h).Update(map[string]interface{}{
+		"installation_disk_path":           h.InstallationDiskPath,
+		"installation_disk_id":             h.InstallationDiskID,
+		"installation_disk_chosen_by_user": h.InstallationDiskChosenByUser,
+	}).Error
+}
+
+func (m *Manager) SelectInstallationDisk(ctx context.Context, db *gorm.DB, h *models.Host, policy *models.DiskSelectionPolicy) error {
+	if h.InstallationDiskChosenByUser || h.Inventory == "" ||
+		!funk.ContainsString(hostStatusesBeforeInstallation[:], swag.StringValue(h.Status)) {
+		return nil
+	}
+	inventory, err := hostutil.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		return err
+	}
+	installationDisk := hostutil.DetermineInstallationDisk(hardware.SortDisksByPolicy(m.hwValidator.ListEligibleDisks(inventory), policy), "")
+	if installationDisk == nil {
+		return nil
+	}
+
+	h.InstallationDiskPath = hostutil.GetDeviceFullName(installationDisk)
+	h.InstallationDiskID = hostutil.GetDeviceIdentifier(installationDisk)
 	cdb := m.db
 	if db != nil {
 		cdb = db
@@ -656,6 +769,60 @@ func (m *Manager) UpdateInstallationDisk(ctx context.Context, db *gorm.DB, h *mo
 	}).Error
 }
 
+func (m *Manager) UpdateSecondaryDisks(ctx context.Context, db *gorm.DB, h *models.Host, disksConfig []*models.DiskConfigParams) error {
+	hostStatus := swag.StringValue(h.Status)
+	if !funk.ContainsString(hostStatusesBeforeInstallation[:], hostStatus) {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host is in %s state, secondary disks can be set only in one of %s states",
+				hostStatus, hostStatusesBeforeInstallation[:]))
+	}
+
+	inventory, err := hostutil.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "failed to parse the inventory of host %s", h.ID))
+	}
+	secondaryDisks, err := hostutil.UnmarshalSecondaryDisks(h)
+	if err != nil {
+		return err
+	}
+
+	for _, diskConfig := range disksConfig {
+		if diskConfig.Role == models.DiskRoleInstall {
+			continue
+		}
+		disk := hostutil.GetDiskByInstallationPath(inventory.Disks, swag.StringValue(diskConfig.ID))
+		if disk == nil {
+			return common.NewApiError(http.StatusBadRequest,
+				errors.Errorf("Disk %s is not part of the inventory of host %s", swag.StringValue(diskConfig.ID), h.ID))
+		}
+		diskID := hostutil.GetDeviceIdentifier(disk)
+		unassign := diskConfig.Role == models.DiskRoleNone || diskConfig.Role == ""
+		if _, ok := hostutil.SecondaryDiskMountPoints[diskConfig.Role]; !ok && !unassign {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("Unsupported disk role %s", diskConfig.Role))
+		}
+		// A disk has a single role and a role is given to a single disk
+		secondaryDisks = funk.Filter(secondaryDisks, func(d *models.DiskConfigParams) bool {
+			return swag.StringValue(d.ID) != diskID && d.Role != diskConfig.Role
+		}).([]*models.DiskConfigParams)
+		if !unassign {
+			secondaryDisks = append(secondaryDisks, &models.DiskConfigParams{ID: swag.String(diskID), Role: diskConfig.Role})
+		}
+	}
+	sort.Slice(secondaryDisks, func(i, j int) bool {
+		return secondaryDisks[i].Role < secondaryDisks[j].Role
+	})
+
+	h.SecondaryDisks, err = hostutil.MarshalSecondaryDisks(secondaryDisks)
+	if err != nil {
+		return err
+	}
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	return cdb.Model(h).Update("secondary_disks", h.SecondaryDisks).Error
+}
+
 func (m *Manager) CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse {
 	eventSeverity := models.EventSeverityInfo
 	eventInfo := fmt.Sprintf("Installation cancelled for host %s", hostutil.GetHostnameForMsg(h))
@@ -826,6 +993,11 @@ func (m *Manager) reportValidationStatusChanged(ctx context.Context, vc *validat
 	for vCategory, vRes := range newValidationRes {
 		for _, v := range vRes {
 			if currentStatus, ok := m.getValidationStatus(currentValidationRes, vCategory, v.ID); ok {
+				if v.Status != currentStatus {
+					if err := timeline.RecordHostValidation(m.db, h.ClusterID, *h.ID, v.ID.String(), v.Status.String(), v.Message); err != nil {
+						m.log.WithError(err).Warnf("failed to record validation %s change of host %s in the timeline", v.ID, h.ID.String())
+					}
+				}
 				if v.Status == ValidationFailure && currentStatus == ValidationSuccess {
 					m.metricApi.HostValidationChanged(vc.cluster.OpenshiftVersion, vc.cluster.EmailDomain, models.HostValidationID(v.ID))
 					eventMsg := fmt.Sprintf("Host %s: validation '%s' that used to succeed is now failing", hostutil.GetHostnameForMsg(h), v.ID)
@@ -866,7 +1038,7 @@ func (m *Manager) updateValidationsInDB(ctx context.Context, db *gorm.DB, h *mod
 }
 
 func (m *Manager) AutoAssignRole(ctx context.Context, h *models.Host, db *gorm.DB) error {
-	// select role if needed
+	// select role if needed, following the role assignment policy of the cluster
 	if h.Role == models.HostRoleAutoAssign {
 		return m.autoRoleSelection(ctx, h, db)
 	}
@@ -879,59 +1051,241 @@ func (m *Manager) autoRoleSelection(ctx context.Context, h *models.Host, db *gor
 		return errors.Errorf("host %s from cluster %s don't have hardware info",
 			h.ID.String(), h.ClusterID.String())
 	}
-	role, err := m.selectRole(ctx, h, db)
+	cluster, err := common.GetClusterFromDBWithoutDisabledHosts(db, h.ClusterID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", h.ClusterID.String())
+		return err
+	}
+	role, reason, err := m.selectRole(ctx, h, newRoleSelection(cluster), db, false)
 	if err != nil {
 		return err
 	}
 	// use sourced role to prevent races with user role setting
-	if err := updateRole(m.log, h, role, db, swag.String(string(models.HostRoleAutoAssign))); err != nil {
+	if err := updateRole(m.log, h, role, db, swag.String(string(models.HostRoleAutoAssign)),
+		"suggested_role", role, "suggested_role_reason", reason); err != nil {
 		log.WithError(err).Errorf("failed to update role %s for host %s cluster %s",
 			role, h.ID.String(), h.ClusterID.String())
 	}
-	log.Infof("Auto selected role %s for host %s cluster %s", role, h.ID.String(), h.ClusterID.String())
+	log.Infof("Auto selected role %s for host %s cluster %s: %s", role, h.ID.String(), h.ClusterID.String(), reason)
 	// pointer was changed in selectRole or after the update - need to take the host again
 	return db.Model(&models.Host{}).
 		Take(h, "id = ? and cluster_id = ?", h.ID.String(), h.ClusterID.String()).Error
 }
 
-func (m *Manager) selectRole(ctx context.Context, h *models.Host, db *gorm.DB) (models.HostRole, error) {
-	var (
-		autoSelectedRole = models.HostRoleWorker
-		log              = logutil.FromContext(ctx, m.log)
-		err              error
-		vc               *validationContext
-	)
+// roleSelection is what the role selections of the hosts of a cluster share: the cluster with its hosts, and whether
+// each host meets the master requirements, which are the costly part of the selection since the validations of the
+// host run for them
+type roleSelection struct {
+	cluster            *common.Cluster
+	masterRequirements map[string]string
+}
+
+func newRoleSelection(cluster *common.Cluster) *roleSelection {
+	return &roleSelection{cluster: cluster, masterRequirements: make(map[string]string)}
+}
+
+// previewRoles stores the suggested roles of the hosts of the cluster, which selects the roles of all the hosts once
+func (m *Manager) previewRoles(ctx context.Context, c *common.Cluster, db *gorm.DB) {
+	rs := newRoleSelection(c)
+	for _, h := range c.Hosts {
+		// the suggested role is only informative until the installation, so failing to preview it doesn't fail the refresh
+		if err := m.previewRole(ctx, h, rs, db); err != nil {
+			logutil.FromContext(ctx, m.log).WithError(err).Warnf("failed to preview the role of host %s", h.ID.String())
+		}
+	}
+}
+
+// previewRole stores the role that the host would be assigned if the installation started now, and why, so that
+// the user can review it before the installation. The cluster of the host is loaded if no role selection is given.
+func (m *Manager) previewRole(ctx context.Context, h *models.Host, rs *roleSelection, db *gorm.DB) error {
+	if h.Role != models.HostRoleAutoAssign || h.Inventory == "" ||
+		!funk.ContainsString(hostStatusesBeforeInstallation[:], swag.StringValue(h.Status)) {
+		return nil
+	}
+	if rs == nil {
+		cluster, err := common.GetClusterFromDBWithoutDisabledHosts(db, h.ClusterID)
+		if err != nil {
+			return err
+		}
+		rs = newRoleSelection(cluster)
+	}
+	// selectRole may change the host, which is refreshed with its current role
+	candidate := *h
+	role, reason, err := m.selectRole(ctx, &candidate, rs, db, true)
+	if err != nil {
+		return err
+	}
+	if role == h.SuggestedRole && reason == h.SuggestedRoleReason {
+		return nil
+	}
+	if _, err = hostutil.UpdateHost(logutil.FromContext(ctx, m.log), db, h.ClusterID, *h.ID, *h.Status,
+		"suggested_role", role, "suggested_role_reason", reason); err != nil {
+		return err
+	}
+	h.SuggestedRole = role
+	h.SuggestedRoleReason = reason
+	return nil
+}
+
+// selectRole returns the role of the host and the reason for which it was picked. When previewing the role, the
+// hosts whose role is automatically assigned before the host at installation are considered as well.
+func (m *Manager) selectRole(ctx context.Context, h *models.Host, rs *roleSelection, db *gorm.DB, preview bool) (models.HostRole, string, error) {
+	autoSelectedRole := models.HostRoleWorker
 
 	if hostutil.IsDay2Host(h) {
-		return autoSelectedRole, nil
+		return autoSelectedRole, "Hosts that are added to an installed cluster are workers", nil
 	}
 
 	// count already existing masters
 	mastersCount := 0
-	if err = db.Model(&models.Host{}).Where("cluster_id = ? and status != ? and role = ?",
-		h.ClusterID, models.HostStatusDisabled, models.HostRoleMaster).Count(&mastersCount).Error; err != nil {
-		log.WithError(err).Errorf("failed to count masters in cluster %s", h.ClusterID.String())
-		return autoSelectedRole, err
+	for _, clusterHost := range rs.cluster.Hosts {
+		if clusterHost.Role == models.HostRoleMaster && swag.StringValue(clusterHost.Status) != models.HostStatusDisabled {
+			mastersCount++
+		}
+	}
+
+	if mastersCount >= common.MinMasterHostsNeededForInstallation {
+		return autoSelectedRole, fmt.Sprintf("Picked as worker: the cluster already has %d masters", mastersCount), nil
+	}
+
+	if !isDefaultRoleAssignmentPolicy(rs.cluster.RoleAssignmentPolicy) {
+		return m.selectRoleByPolicy(ctx, h, rs, common.MinMasterHostsNeededForInstallation-mastersCount, db)
+	}
+	if preview {
+		pendingMasters, err := m.mastersPickedBefore(ctx, h, rs, common.MinMasterHostsNeededForInstallation-mastersCount, db)
+		if err != nil {
+			return autoSelectedRole, "", err
+		}
+		if mastersCount+pendingMasters >= common.MinMasterHostsNeededForInstallation {
+			return autoSelectedRole, fmt.Sprintf("Picked as worker: %d other hosts are picked as masters first", pendingMasters), nil
+		}
+	}
+
+	reason, err := m.masterRequirementsFailure(ctx, h, rs, db)
+	if err != nil {
+		return autoSelectedRole, "", err
+	}
+	if reason == "" {
+		return models.HostRoleMaster, fmt.Sprintf("Picked as master: the cluster has fewer than %d masters and the host meets the master requirements",
+			common.MinMasterHostsNeededForInstallation), nil
+	}
+
+	return autoSelectedRole, "Picked as worker: the host doesn't meet the master requirements", nil
+}
+
+// selectRoleByPolicy picks the role of the host according to the role assignment policy of the cluster. All the
+// hosts whose role is automatically assigned are considered, so that the same masters are picked whatever the
+// order in which the roles of the hosts are assigned.
+func (m *Manager) selectRoleByPolicy(ctx context.Context, h *models.Host, rs *roleSelection, missingMasters int, db *gorm.DB) (models.HostRole, string, error) {
+	log := logutil.FromContext(ctx, m.log)
+	cluster := rs.cluster
+
+	strategies, err := roleAssignmentStrategies(cluster.RoleAssignmentPolicy)
+	if err != nil {
+		log.WithError(err).Errorf("invalid role assignment policy of cluster %s", cluster.ID)
+		return models.HostRoleWorker, "", err
 	}
 
-	if mastersCount < common.MinMasterHostsNeededForInstallation {
-		h.Role = models.HostRoleMaster
-		vc, err = newValidationContext(h, nil, db, m.hwValidator)
+	var hostCandidate *roleCandidate
+	candidates := make([]*roleCandidate, 0, len(cluster.Hosts))
+	masters := make([]*roleCandidate, 0, common.MinMasterHostsNeededForInstallation)
+	for _, clusterHost := range cluster.Hosts {
+		if clusterHost.ID.String() == h.ID.String() {
+			clusterHost = h
+		}
+		if clusterHost.Inventory == "" || hostutil.IsDay2Host(clusterHost) ||
+			(clusterHost.Role != models.HostRoleAutoAssign && clusterHost.Role != models.HostRoleMaster) {
+			continue
+		}
+		c, err := newRoleCandidate(clusterHost)
 		if err != nil {
-			log.WithError(err).Errorf("failed to create new validation context for host %s", h.ID.String())
-			return autoSelectedRole, err
+			log.WithError(err).Warnf("skipping host %s when picking the masters of cluster %s", clusterHost.ID, cluster.ID)
+			continue
+		}
+		if clusterHost.Role == models.HostRoleMaster {
+			masters = append(masters, c)
+			continue
+		}
+		if clusterHost == h {
+			hostCandidate = c
+		}
+		reason := excludedFromMasters(strategies, c)
+		if reason == "" {
+			if reason, err = m.masterRequirementsFailure(ctx, c.host, rs, db); err != nil {
+				return models.HostRoleWorker, "", err
+			}
+		}
+		if reason != "" {
+			if clusterHost == h {
+				return models.HostRoleWorker, "Picked as worker: " + reason, nil
+			}
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	if hostCandidate == nil {
+		return models.HostRoleWorker, "", errors.Errorf("host %s is not a master candidate of cluster %s", h.ID, cluster.ID)
+	}
+
+	for _, c := range pickMasters(strategies, candidates, masters, missingMasters) {
+		if c == hostCandidate {
+			return models.HostRoleMaster, "Picked as master: " + strings.Join(c.reasons, ", "), nil
+		}
+	}
+	reason := "other hosts are preferred as masters"
+	if len(hostCandidate.reasons) > 0 {
+		reason += ", " + strings.Join(hostCandidate.reasons, ", ")
+	}
+	return models.HostRoleWorker, "Picked as worker: " + reason, nil
+}
+
+// mastersPickedBefore returns how many of the hosts whose role is assigned before the role of the host at
+// installation are picked as masters, at most count of them
+func (m *Manager) mastersPickedBefore(ctx context.Context, h *models.Host, rs *roleSelection, count int, db *gorm.DB) (int, error) {
+	picked := 0
+	for _, clusterHost := range rs.cluster.Hosts {
+		if picked >= count || clusterHost.ID.String() == h.ID.String() {
+			break
 		}
-		conditions, _, err := m.rp.preprocess(vc)
+		if clusterHost.Role != models.HostRoleAutoAssign || clusterHost.Inventory == "" || hostutil.IsDay2Host(clusterHost) {
+			continue
+		}
+		reason, err := m.masterRequirementsFailure(ctx, clusterHost, rs, db)
 		if err != nil {
-			log.WithError(err).Errorf("failed to run validations on host %s", h.ID.String())
-			return autoSelectedRole, err
+			return 0, err
 		}
-		if m.canBeMaster(conditions) {
-			return models.HostRoleMaster, nil
+		if reason == "" {
+			picked++
 		}
 	}
+	return picked, nil
+}
 
-	return autoSelectedRole, nil
+// masterRequirementsFailure returns why the host doesn't meet the hardware requirements of a master, or an empty
+// string if it does. The result is kept for the other role selections of the hosts of the cluster.
+func (m *Manager) masterRequirementsFailure(ctx context.Context, h *models.Host, rs *roleSelection, db *gorm.DB) (string, error) {
+	if reason, ok := rs.masterRequirements[h.ID.String()]; ok {
+		return reason, nil
+	}
+	log := logutil.FromContext(ctx, m.log)
+	candidate := *h
+	candidate.Role = models.HostRoleMaster
+	vc, err := newValidationContext(&candidate, rs.cluster, db, m.hwValidator)
+	if err != nil {
+		log.WithError(err).Errorf("failed to create new validation context for host %s", h.ID.String())
+		return "", err
+	}
+	conditions, _, err := m.rp.preprocess(vc)
+	if err != nil {
+		log.WithError(err).Errorf("failed to run validations on host %s", h.ID.String())
+		return "", err
+	}
+	reason := ""
+	if !m.canBeMaster(conditions) {
+		reason = "it doesn't meet the master requirements"
+	}
+	rs.masterRequirements[h.ID.String()] = reason
+	return reason, nil
 }
 
 func (m *Manager) IsValidMasterCandidate(h *models.Host, c *common.Cluster, db *gorm.DB, log logrus.FieldLogger) (bool, error) {
@@ -26,6 +26,7 @@ import (
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/timeline"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
 	"github.com/openshift/assisted-service/pkg/leader"
@@ -57,7 +58,7 @@ var _ = Describe("update_role", func() {
 	BeforeEach(func() {
 		dummy := &leader.DummyElector{}
 		db, dbName = common.PrepareTestDB()
-		state = NewManager(common.GetTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil)
+		state = NewManager(common.GetTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil, nil, nil)
 		id = strfmt.UUID(uuid.New().String())
 		clusterID = strfmt.UUID(uuid.New().String())
 	})
@@ -281,7 +282,7 @@ var _ = Describe("update_progress", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
-		state = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, dummy, nil)
+		state = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, dummy, nil, nil, nil)
 		id := strfmt.UUID(uuid.New().String())
 		clusterId := strfmt.UUID(uuid.New().String())
 		host = hostutil.GenerateTestHost(id, clusterId, "")
@@ -357,6 +358,22 @@ var _ = Describe("update_progress", func() {
 				Expect(*hostFromDB.Status).Should(Equal(models.HostStatusInstalled))
 			})
 
+			It("done records the status history", func() {
+				progress.CurrentStage = models.HostStageDone
+				mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, models.EventSeverityInfo,
+					fmt.Sprintf("Host %s: updated status from \"installing\" to \"installed\" (Done)", host.ID.String()),
+					gomock.Any())
+				Expect(state.UpdateInstallProgress(ctx, &host, &progress)).ShouldNot(HaveOccurred())
+				hostFromDB = hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+
+				var entries []*common.StatusHistory
+				Expect(db.Find(&entries, "cluster_id = ? and host_id = ?", host.ClusterID.String(), host.ID.String()).Error).ShouldNot(HaveOccurred())
+				Expect(entries).Should(HaveLen(1))
+				Expect(entries[0].PreviousStatus).Should(Equal(models.HostStatusInstalling))
+				Expect(swag.StringValue(entries[0].Status)).Should(Equal(models.HostStatusInstalled))
+				Expect(swag.StringValue(entries[0].Trigger)).Should(Equal(statusTriggerUpdateInstallProgress))
+			})
+
 			AfterEach(func() {
 				Expect(*hostFromDB.StatusInfo).Should(Equal(string(progress.CurrentStage)))
 				Expect(hostFromDB.Progress.CurrentStage).Should(Equal(progress.CurrentStage))
@@ -505,7 +522,7 @@ var _ = Describe("cancel installation", func() {
 		db, dbName = common.PrepareTestDB()
 		eventsHandler = events.New(db, logrus.New())
 		dummy := &leader.DummyElector{}
-		state = NewManager(common.GetTestLog(), db, eventsHandler, nil, nil, nil, nil, defaultConfig, dummy, nil)
+		state = NewManager(common.GetTestLog(), db, eventsHandler, nil, nil, nil, nil, defaultConfig, dummy, nil, nil, nil)
 		id := strfmt.UUID(uuid.New().String())
 		clusterId := strfmt.UUID(uuid.New().String())
 		h = hostutil.GenerateTestHost(id, clusterId, models.HostStatusDiscovering)
@@ -589,7 +606,7 @@ var _ = Describe("reset host", func() {
 		eventsHandler = events.New(db, logrus.New())
 		config = *defaultConfig
 		dummy := &leader.DummyElector{}
-		state = NewManager(common.GetTestLog(), db, eventsHandler, nil, nil, nil, nil, &config, dummy, nil)
+		state = NewManager(common.GetTestLog(), db, eventsHandler, nil, nil, nil, nil, &config, dummy, nil, nil, nil)
 	})
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
@@ -714,7 +731,7 @@ var _ = Describe("register host", func() {
 		eventsHandler = events.NewMockHandler(ctrl)
 		config = *defaultConfig
 		dummy := &leader.DummyElector{}
-		state = NewManager(common.GetTestLog(), db, eventsHandler, nil, nil, nil, nil, &config, dummy, nil)
+		state = NewManager(common.GetTestLog(), db, eventsHandler, nil, nil, nil, nil, &config, dummy, nil, nil, nil)
 	})
 
 	BeforeEach(func() {
@@ -857,7 +874,7 @@ var _ = Describe("UpdateInventory", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockValidator = hardware.NewMockValidator(ctrl)
 		hapi = NewManager(common.GetTestLog(), db, nil, mockValidator,
-			nil, createValidatorCfg(), nil, defaultConfig, dummy, nil)
+			nil, createValidatorCfg(), nil, defaultConfig, dummy, nil, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 		cluster := hostutil.GenerateTestCluster(clusterId, "10.0.0.1/24")
@@ -1040,6 +1057,29 @@ var _ = Describe("UpdateInventory", func() {
 			Expect(h.InstallationDiskPath).To(Equal(diskPath))
 			Expect(h.InstallationDiskID).To(Equal(diskId))
 		})
+
+		It("Picks the installation disk by the disk selection policy of the cluster", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterId.String()).Updates(map[string]interface{}{
+				"disk_selection_match_by":      models.DiskSelectionPolicyMatchBySerial,
+				"disk_selection_match_pattern": "^BOOT-",
+			}).Error).ShouldNot(HaveOccurred())
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(
+				[]*models.Disk{{ID: diskId, Name: diskName}, {ID: "/dev/disk/by-id/SecondDisk", Name: "SecondDisk", Serial: "BOOT-1"}},
+			)
+			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+			h := hostutil.GetHostFromDB(hostId, clusterId, db)
+			Expect(h.InstallationDiskPath).To(Equal("/dev/SecondDisk"))
+			Expect(h.InstallationDiskID).To(Equal("/dev/disk/by-id/SecondDisk"))
+
+			By("keeping the installation disk that was already picked")
+			mockValidator.EXPECT().DiskIsEligible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(
+				[]*models.Disk{{ID: diskId, Name: diskName, Serial: "BOOT-0"}, {ID: "/dev/disk/by-id/SecondDisk", Name: "SecondDisk", Serial: "BOOT-1"}},
+			)
+			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+			h = hostutil.GetHostFromDB(hostId, clusterId, db)
+			Expect(h.InstallationDiskID).To(Equal("/dev/disk/by-id/SecondDisk"))
+		})
 	})
 
 	Context("enable host", func() {
@@ -1163,7 +1203,7 @@ var _ = Describe("Update hostname", func() {
 	BeforeEach(func() {
 		db, dbName = common.PrepareTestDB()
 		dummy := &leader.DummyElector{}
-		hapi = NewManager(common.GetTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil)
+		hapi = NewManager(common.GetTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -1281,7 +1321,7 @@ var _ = Describe("Update disk installation path", func() {
 		leader := &leader.DummyElector{}
 		mockValidator = hardware.NewMockValidator(ctrl)
 		logger := common.GetTestLog()
-		hapi = NewManager(logger, db, nil, mockValidator, nil, createValidatorCfg(), nil, defaultConfig, leader, nil)
+		hapi = NewManager(logger, db, nil, mockValidator, nil, createValidatorCfg(), nil, defaultConfig, leader, nil, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -1415,6 +1455,313 @@ var _ = Describe("Update disk installation path", func() {
 			})
 		}
 	})
+
+	Context("select the installation disk by policy", func() {
+		var (
+			hdd = &models.Disk{ID: "/dev/disk/by-id/sda", Name: "sda", DriveType: "HDD"}
+			ssd = &models.Disk{ID: "/dev/disk/by-id/sdb", Name: "sdb", DriveType: "SSD"}
+		)
+
+		BeforeEach(func() {
+			host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusKnown)
+			inventory, err := hostutil.MarshalInventory(&models.Inventory{Disks: []*models.Disk{hdd, ssd}})
+			Expect(err).ShouldNot(HaveOccurred())
+			host.Inventory = inventory
+			host.InstallationDiskID = hdd.ID
+			host.InstallationDiskPath = "/dev/sda"
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return([]*models.Disk{hdd, ssd}).AnyTimes()
+			mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return([]*models.Disk{hdd, ssd}, nil).AnyTimes()
+		})
+
+		It("picks the installation disk again", func() {
+			Expect(hapi.SelectInstallationDisk(ctx, db, &host, &models.DiskSelectionPolicy{PreferSsd: true})).ShouldNot(HaveOccurred())
+			h := hostutil.GetHostFromDB(hostId, clusterId, db)
+			Expect(h.InstallationDiskID).To(Equal(ssd.ID))
+			Expect(h.InstallationDiskPath).To(Equal("/dev/sdb"))
+		})
+
+		It("keeps the installation disk that the user picked", func() {
+			Expect(hapi.UpdateInstallationDisk(ctx, db, &host, hdd.ID)).ShouldNot(HaveOccurred())
+			Expect(hostutil.GetHostFromDB(hostId, clusterId, db).InstallationDiskChosenByUser).To(BeTrue())
+			Expect(hapi.SelectInstallationDisk(ctx, db, &host, &models.DiskSelectionPolicy{PreferSsd: true})).ShouldNot(HaveOccurred())
+			Expect(hostutil.GetHostFromDB(hostId, clusterId, db).InstallationDiskID).To(Equal(hdd.ID))
+		})
+	})
+})
+
+var _ = Describe("UpdateSecondaryDisks", func() {
+	var (
+		ctx               = context.Background()
+		hapi              API
+		db                *gorm.DB
+		ctrl              *gomock.Controller
+		hostId, clusterId strfmt.UUID
+		host              models.Host
+		dbName            string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		db, dbName = common.PrepareTestDB()
+		hapi = NewManager(common.GetTestLog(), db, nil, hardware.NewMockValidator(ctrl), nil, createValidatorCfg(), nil, defaultConfig,
+			&leader.DummyElector{}, nil, nil, nil)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusKnown)
+		inventory, err := hostutil.MarshalInventory(&models.Inventory{Disks: []*models.Disk{
+			{ID: "/dev/disk/by-id/sda", Name: "sda"},
+			{ID: "/dev/disk/by-id/sdb", Name: "sdb"},
+			{ID: "/dev/disk/by-id/sdc", Name: "sdc"},
+		}})
+		Expect(err).ShouldNot(HaveOccurred())
+		host.Inventory = inventory
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	secondaryDisks := func() []*models.DiskConfigParams {
+		h := hostutil.GetHostFromDB(hostId, clusterId, db)
+		disks, err := hostutil.UnmarshalSecondaryDisks(h)
+		Expect(err).ShouldNot(HaveOccurred())
+		return disks
+	}
+
+	It("assigns, moves and unassigns secondary disks", func() {
+		Expect(hapi.UpdateSecondaryDisks(ctx, db, &host, []*models.DiskConfigParams{
+			{ID: swag.String("/dev/disk/by-id/sda"), Role: models.DiskRoleInstall},
+			{ID: swag.String("/dev/sdb"), Role: models.DiskRoleVarLibEtcd},
+			{ID: swag.String("/dev/disk/by-id/sdc"), Role: models.DiskRoleVarLibContainers},
+		})).To(Succeed())
+		Expect(secondaryDisks()).To(Equal([]*models.DiskConfigParams{
+			{ID: swag.String("/dev/disk/by-id/sdc"), Role: models.DiskRoleVarLibContainers},
+			{ID: swag.String("/dev/disk/by-id/sdb"), Role: models.DiskRoleVarLibEtcd},
+		}))
+
+		By("giving the etcd role to another disk")
+		Expect(hapi.UpdateSecondaryDisks(ctx, db, &host, []*models.DiskConfigParams{
+			{ID: swag.String("/dev/disk/by-id/sdc"), Role: models.DiskRoleVarLibEtcd},
+		})).To(Succeed())
+		Expect(secondaryDisks()).To(Equal([]*models.DiskConfigParams{
+			{ID: swag.String("/dev/disk/by-id/sdc"), Role: models.DiskRoleVarLibEtcd},
+		}))
+
+		By("unassigning the disk")
+		Expect(hapi.UpdateSecondaryDisks(ctx, db, &host, []*models.DiskConfigParams{
+			{ID: swag.String("/dev/disk/by-id/sdc"), Role: models.DiskRoleNone},
+		})).To(Succeed())
+		Expect(hostutil.GetHostFromDB(hostId, clusterId, db).SecondaryDisks).To(BeEmpty())
+	})
+
+	It("rejects a disk that isn't part of the inventory", func() {
+		Expect(hapi.UpdateSecondaryDisks(ctx, db, &host, []*models.DiskConfigParams{
+			{ID: swag.String("/dev/disk/by-id/sdz"), Role: models.DiskRoleVarLibContainers},
+		})).ToNot(Succeed())
+		Expect(secondaryDisks()).To(BeEmpty())
+	})
+
+	It("rejects hosts that are installing", func() {
+		host.Status = swag.String(models.HostStatusInstalling)
+		Expect(hapi.UpdateSecondaryDisks(ctx, db, &host, []*models.DiskConfigParams{
+			{ID: swag.String("/dev/disk/by-id/sdc"), Role: models.DiskRoleVarLibContainers},
+		})).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Secondary disks validation", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockValidator *hardware.MockValidator
+		v             *validator
+		c             *validationContext
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockValidator = hardware.NewMockValidator(ctrl)
+		v = &validator{
+			log:            common.GetTestLog(),
+			hwValidatorCfg: &hardware.ValidatorCfg{MinVarLibContainersDiskSizeGb: 200, MinVarLibEtcdDiskSizeGb: 120},
+			hwValidator:    mockValidator,
+		}
+		disks := []*models.Disk{
+			{ID: "/dev/disk/by-id/sda", Name: "sda", SizeBytes: conversions.GbToBytes(500)},
+			{ID: "/dev/disk/by-id/sdb", Name: "sdb", SizeBytes: conversions.GbToBytes(150)},
+			{ID: "/dev/disk/by-id/sdc", Name: "sdc", SizeBytes: conversions.GbToBytes(500),
+				InstallationEligibility: models.DiskInstallationEligibility{NotEligibleReasons: []string{"Disk is removable"}}},
+		}
+		mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(disks[:2]).AnyTimes()
+		c = &validationContext{
+			host:      &models.Host{Role: models.HostRoleWorker, InstallationDiskID: "/dev/disk/by-id/sda"},
+			inventory: &models.Inventory{Disks: disks},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	validate := func(secondaryDisks string) (ValidationStatus, string) {
+		c.host.SecondaryDisks = secondaryDisks
+		status := v.secondaryDisksValid(c)
+		return status, v.printSecondaryDisksValid(c, status)
+	}
+
+	It("succeeds without secondary disks", func() {
+		status, message := validate("")
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("No secondary disks are assigned"))
+	})
+
+	It("checks the size, the eligibility and the role of the host", func() {
+		status, _ := validate(`[{"id": "/dev/disk/by-id/sdb", "role": "var-lib-etcd"}]`)
+		Expect(status).To(Equal(ValidationFailure))
+		c.host.Role = models.HostRoleMaster
+		status, message := validate(`[{"id": "/dev/disk/by-id/sdb", "role": "var-lib-etcd"}]`)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("Secondary disks are valid"))
+
+		_, message = validate(`[{"id": "/dev/disk/by-id/sdb", "role": "var-lib-containers"}]`)
+		Expect(message).To(Equal("Disk /dev/disk/by-id/sdb for /var/lib/containers is 150 GB, it must be at least 200 GB"))
+		_, message = validate(`[{"id": "/dev/disk/by-id/sdc", "role": "var-lib-containers"}]`)
+		Expect(message).To(Equal("Disk /dev/disk/by-id/sdc for /var/lib/containers is not eligible: Disk is removable"))
+		_, message = validate(`[{"id": "/dev/disk/by-id/sda", "role": "var-lib-containers"}]`)
+		Expect(message).To(Equal("Disk /dev/disk/by-id/sda for /var/lib/containers is the installation disk"))
+		_, message = validate(`[{"id": "/dev/disk/by-id/sdz", "role": "var-lib-containers"}]`)
+		Expect(message).To(Equal("Disk /dev/disk/by-id/sdz for /var/lib/containers is no longer part of the inventory"))
+	})
+
+	It("leaves the role check to the installation when the role is automatically assigned", func() {
+		c.host.Role = models.HostRoleAutoAssign
+		c.host.SuggestedRole = models.HostRoleWorker
+		status, _ := validate(`[{"id": "/dev/disk/by-id/sdb", "role": "var-lib-etcd"}]`)
+		Expect(status).To(Equal(ValidationSuccess))
+	})
+})
+
+var _ = Describe("MTU validation", func() {
+	var (
+		v       *validator
+		cluster *common.Cluster
+		hosts   []*models.Host
+	)
+
+	newHost := func(mtu int64, ip string) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		inventory, err := hostutil.MarshalInventory(&models.Inventory{Interfaces: []*models.Interface{
+			{Name: "eth0", IPV4Addresses: []string{ip + "/24"}, Mtu: mtu},
+			{Name: "eth1", IPV4Addresses: []string{"10.0.0.1/24"}, Mtu: 1500},
+		}})
+		Expect(err).ShouldNot(HaveOccurred())
+		return &models.Host{ID: &id, Inventory: inventory, Status: swag.String(models.HostStatusKnown)}
+	}
+
+	newContext := func(host *models.Host) *validationContext {
+		inventory, err := hostutil.UnmarshalInventory(host.Inventory)
+		Expect(err).ShouldNot(HaveOccurred())
+		return &validationContext{host: host, cluster: cluster, inventory: inventory}
+	}
+
+	BeforeEach(func() {
+		v = &validator{log: common.GetTestLog()}
+		hosts = []*models.Host{newHost(9000, "1.2.3.4"), newHost(9000, "1.2.3.5"), newHost(1500, "1.2.3.6")}
+		cluster = &common.Cluster{Cluster: models.Cluster{MachineNetworkCidr: "1.2.3.0/24", Hosts: hosts}}
+	})
+
+	It("fails the hosts that don't have the MTU of most hosts", func() {
+		Expect(v.isMtuConsistent(newContext(hosts[0]))).To(Equal(ValidationSuccess))
+		Expect(v.isMtuConsistent(newContext(hosts[1]))).To(Equal(ValidationSuccess))
+		Expect(v.isMtuConsistent(newContext(hosts[2]))).To(Equal(ValidationFailure))
+		Expect(v.printMtuConsistent(newContext(hosts[2]), ValidationFailure)).To(Equal(
+			"The MTU of NIC eth0 is 1500, while the MTU of 2 other hosts in the machine network is 9000"))
+	})
+
+	It("fails the hosts with an MTU lower than the MTU of the cluster", func() {
+		cluster.Mtu = 9000
+		Expect(v.isMtuConsistent(newContext(hosts[0]))).To(Equal(ValidationSuccess))
+		Expect(v.isMtuConsistent(newContext(hosts[2]))).To(Equal(ValidationFailure))
+		Expect(v.printMtuConsistent(newContext(hosts[2]), ValidationFailure)).To(Equal(
+			"The MTU of NIC eth0 is 1500, lower than the MTU 9000 of the cluster"))
+	})
+
+	It("fails the hosts whose MTU probe failed in the machine network", func() {
+		cluster.Mtu = 1500
+		connectivity := func(remoteIP string) string {
+			b, err := json.Marshal(&models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+				L3Connectivity: []*models.L3Connectivity{{OutgoingNic: "eth0", RemoteIPAddress: remoteIP, Successful: true, MtuProbeSuccessful: swag.Bool(false)}},
+			}}})
+			Expect(err).ShouldNot(HaveOccurred())
+			return string(b)
+		}
+		hosts[0].Connectivity = connectivity("10.0.0.2")
+		Expect(v.isMtuConsistent(newContext(hosts[0]))).To(Equal(ValidationSuccess))
+		hosts[0].Connectivity = connectivity("1.2.3.5")
+		Expect(v.isMtuConsistent(newContext(hosts[0]))).To(Equal(ValidationFailure))
+		Expect(v.printMtuConsistent(newContext(hosts[0]), ValidationFailure)).To(Equal(
+			"The network path from NIC eth0 to 1.2.3.5 doesn't support the MTU of the machine network"))
+	})
+
+	It("is pending without a machine network and skipped with user managed networking", func() {
+		cluster.MachineNetworkCidr = ""
+		Expect(v.isMtuConsistent(newContext(hosts[2]))).To(Equal(ValidationPending))
+		cluster.UserManagedNetworking = swag.Bool(true)
+		Expect(v.isMtuConsistent(newContext(hosts[2]))).To(Equal(ValidationSuccess))
+	})
+})
+
+type ignitionDifferFunc func(host *models.Host) (*models.ConfigDiff, error)
+
+func (f ignitionDifferFunc) HostIgnitionDiff(host *models.Host) (*models.ConfigDiff, error) {
+	return f(host)
+}
+
+var _ = Describe("Ignition config overrides validation", func() {
+	var (
+		v    *validator
+		c    *validationContext
+		diff *models.ConfigDiff
+		err  error
+	)
+
+	BeforeEach(func() {
+		diff, err = &models.ConfigDiff{}, nil
+		v = &validator{log: common.GetTestLog(), ignitionDiffer: ignitionDifferFunc(func(*models.Host) (*models.ConfigDiff, error) {
+			return diff, err
+		})}
+		id := strfmt.UUID(uuid.New().String())
+		c = &validationContext{host: &models.Host{ID: &id}}
+	})
+
+	It("succeeds without overrides", func() {
+		Expect(v.areIgnitionConfigOverridesSafe(c)).To(Equal(ValidationSuccess))
+		Expect(v.printIgnitionConfigOverridesSafe(c, ValidationSuccess)).To(Equal("The ignition config is not overridden"))
+	})
+
+	It("fails when the overrides replace values set by the service", func() {
+		c.host.IgnitionConfigOverrides = `{"ignition": {"version": "3.1.0"}}`
+		diff.Operations = []*models.ConfigDiffOperation{
+			{Op: swag.String(models.ConfigDiffOperationOpAdd), Path: swag.String("/storage/files/1"), Value: "file"},
+		}
+		Expect(v.areIgnitionConfigOverridesSafe(c)).To(Equal(ValidationSuccess))
+		diff.Operations = append(diff.Operations, &models.ConfigDiffOperation{
+			Op: swag.String(models.ConfigDiffOperationOpReplace), Path: swag.String("/storage/files/0/contents/source"), BaseValue: "data:,master-0", Value: "data:,other",
+		})
+		Expect(v.areIgnitionConfigOverridesSafe(c)).To(Equal(ValidationFailure))
+		Expect(v.printIgnitionConfigOverridesSafe(c, ValidationFailure)).To(Equal(
+			"The ignition config overrides replace values set by the service: /storage/files/0/contents/source"))
+	})
+
+	It("errors when the differences can't be computed", func() {
+		c.host.IgnitionConfigOverrides = `{"ignition": {"version": "3.1.0"}}`
+		err = errors.New("failed to download the role ignition")
+		Expect(v.areIgnitionConfigOverridesSafe(c)).To(Equal(ValidationError))
+		Expect(v.printIgnitionConfigOverridesSafe(c, ValidationError)).To(Equal(
+			"Failed to compare the ignition config overrides with the values set by the service: failed to download the role ignition"))
+	})
 })
 
 var _ = Describe("SetBootstrap", func() {
@@ -1434,7 +1781,7 @@ var _ = Describe("SetBootstrap", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 
@@ -1495,7 +1842,7 @@ var _ = Describe("UpdateNTP", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 
@@ -1557,7 +1904,7 @@ var _ = Describe("UpdateMachineConfigPoolName", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -1639,7 +1986,7 @@ var _ = Describe("update logs_info", func() {
 		dummy := &leader.DummyElector{}
 		db, dbName = common.PrepareTestDB()
 		mockOperators := operators.NewMockAPI(ctrl)
-		hapi = NewManager(common.GetTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, mockOperators)
+		hapi = NewManager(common.GetTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, mockOperators, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 		host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusInstallingInProgress)
@@ -1696,6 +2043,61 @@ var _ = Describe("update logs_info", func() {
 	}
 })
 
+var _ = Describe("RequestLogsCollection", func() {
+	var (
+		ctx               = context.Background()
+		ctrl              *gomock.Controller
+		db                *gorm.DB
+		hapi              API
+		mockEvents        *events.MockHandler
+		host              models.Host
+		hostId, clusterId strfmt.UUID
+		dbName            string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, &leader.DummyElector{}, nil, nil, nil)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusInsufficient)
+		host.LogsCollectedAt = strfmt.DateTime(time.Now())
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("tracks the requested collection in the logs info of the host", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, &hostId, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+		Expect(hapi.RequestLogsCollection(ctx, &host, &models.HostLogsCollectionParams{JournalUnits: []string{"kubelet.service"}})).To(Succeed())
+		h := hostutil.GetHostFromDB(hostId, clusterId, db)
+		Expect(h.LogsInfo).To(Equal(models.LogsStateRequested))
+		Expect(h.LogsCollectedAt).To(Equal(strfmt.DateTime(time.Time{})))
+		Expect(h.LogsCollectionRequest).To(ContainSubstring("kubelet.service"))
+
+		By("rejecting another request until the first one is sent to the host")
+		err := hapi.RequestLogsCollection(ctx, h, nil)
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+	})
+
+	It("rejects relative directories", func() {
+		err := hapi.RequestLogsCollection(ctx, &host, &models.HostLogsCollectionParams{Directories: []string{"/var/log/../../etc"}})
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("rejects directories with separators of the directories list", func() {
+		for _, dir := range []string{"/var/log,/etc", "/var/log:/etc"} {
+			err := hapi.RequestLogsCollection(ctx, &host, &models.HostLogsCollectionParams{Directories: []string{dir}})
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		}
+	})
+})
+
 var _ = Describe("UpdateImageStatus", func() {
 	var (
 		ctx               = context.Background()
@@ -1715,7 +2117,7 @@ var _ = Describe("UpdateImageStatus", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, dummy, nil)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, dummy, nil, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 
@@ -1869,13 +2271,15 @@ var _ = Describe("AutoAssignRole", func() {
 			nil,
 			defaultConfig,
 			dummy,
-			mockOperators,
+			mockOperators, nil,
+			nil,
 		)
 		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterId}}).Error).ShouldNot(HaveOccurred())
 		mockOperators.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.HostValidationIDOcsRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDCnvRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.HostValidationIDSriovRequirementsSatisfied)},
 		}, nil)
 
 		mockHwValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirements, error) {
@@ -1980,6 +2384,130 @@ var _ = Describe("AutoAssignRole", func() {
 		Expect(hapi.AutoAssignRole(ctx, &h, db)).ShouldNot(HaveOccurred())
 		Expect(hostutil.GetHostFromDB(*h.ID, clusterId, db).Role).Should(Equal(models.HostRoleWorker))
 	})
+	Context("role assignment policy", func() {
+		createHosts := func(inventories ...string) []*models.Host {
+			hosts := make([]*models.Host, 0, len(inventories))
+			for _, inventory := range inventories {
+				h := hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), clusterId, models.HostStatusKnown)
+				h.Inventory = inventory
+				h.Role = models.HostRoleAutoAssign
+				Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+				hosts = append(hosts, &h)
+			}
+			return hosts
+		}
+
+		assignRoles := func(hosts []*models.Host) []*models.Host {
+			ret := make([]*models.Host, 0, len(hosts))
+			for _, h := range hosts {
+				Expect(hapi.AutoAssignRole(ctx, h, db)).ShouldNot(HaveOccurred())
+				ret = append(ret, hostutil.GetHostFromDB(*h.ID, clusterId, db))
+			}
+			return ret
+		}
+
+		setPolicy := func(policy *models.RoleAssignmentPolicy) {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterId.String()).Updates(map[string]interface{}{
+				"role_assignment_master_hostname_pattern":     policy.MasterHostnamePattern,
+				"role_assignment_exclude_virtual_masters":     policy.ExcludeVirtualMasters,
+				"role_assignment_prefer_most_resources":       policy.PreferMostResources,
+				"role_assignment_spread_by":                   swag.StringValue(policy.SpreadBy),
+				"role_assignment_serial_number_prefix_length": policy.SerialNumberPrefixLength,
+			}).Error).ShouldNot(HaveOccurred())
+		}
+
+		It("explains the default role assignment", func() {
+			hosts := assignRoles(createHosts(hostutil.GenerateMasterInventory(), workerInventory()))
+			Expect(hosts[0].Role).To(Equal(models.HostRoleMaster))
+			Expect(hosts[0].SuggestedRole).To(Equal(models.HostRoleMaster))
+			Expect(hosts[0].SuggestedRoleReason).To(Equal("Picked as master: the cluster has fewer than 3 masters and the host meets the master requirements"))
+			Expect(hosts[1].SuggestedRole).To(Equal(models.HostRoleWorker))
+			Expect(hosts[1].SuggestedRoleReason).To(Equal("Picked as worker: the host doesn't meet the master requirements"))
+		})
+
+		It("picks the hosts with the most resources whatever the order of the assignment", func() {
+			setPolicy(&models.RoleAssignmentPolicy{PreferMostResources: true})
+			hosts := assignRoles(createHosts(
+				hostutil.GenerateInventoryWithResources(8, 32, "host-32"),
+				hostutil.GenerateInventoryWithResources(8, 16, "host-16"),
+				hostutil.GenerateInventoryWithResources(8, 64, "host-64"),
+				hostutil.GenerateInventoryWithResources(8, 48, "host-48"),
+			))
+			Expect(hosts[0].Role).To(Equal(models.HostRoleMaster))
+			Expect(hosts[1].Role).To(Equal(models.HostRoleWorker))
+			Expect(hosts[1].SuggestedRoleReason).To(HavePrefix("Picked as worker: other hosts are preferred as masters"))
+			Expect(hosts[2].Role).To(Equal(models.HostRoleMaster))
+			Expect(hosts[2].SuggestedRoleReason).To(Equal("Picked as master: it has the 1st most resources of the 4 candidates (64 GiB of memory, 8 CPU cores)"))
+			Expect(hosts[3].Role).To(Equal(models.HostRoleMaster))
+		})
+
+		It("picks the hosts whose hostname matches the pattern", func() {
+			setPolicy(&models.RoleAssignmentPolicy{MasterHostnamePattern: "^cp-"})
+			hosts := assignRoles(createHosts(
+				hostutil.GenerateInventoryWithResources(8, 32, "worker-0"),
+				hostutil.GenerateInventoryWithResources(8, 32, "cp-0"),
+			))
+			Expect(hosts[0].Role).To(Equal(models.HostRoleWorker))
+			Expect(hosts[0].SuggestedRoleReason).To(Equal("Picked as worker: its hostname worker-0 doesn't match ^cp-"))
+			Expect(hosts[1].Role).To(Equal(models.HostRoleMaster))
+			Expect(hosts[1].SuggestedRoleReason).To(Equal("Picked as master: its hostname cp-0 matches ^cp-"))
+		})
+
+		It("spreads the masters across the racks", func() {
+			setPolicy(&models.RoleAssignmentPolicy{SpreadBy: swag.String(models.RoleAssignmentPolicySpreadByRackLabel)})
+			hosts := createHosts(
+				hostutil.GenerateInventoryWithResources(8, 32, "host-0"),
+				hostutil.GenerateInventoryWithResources(8, 32, "host-1"),
+				hostutil.GenerateInventoryWithResources(8, 32, "host-2"),
+				hostutil.GenerateInventoryWithResources(8, 32, "host-3"),
+			)
+			for i, rack := range []string{"rack-1", "rack-1", "rack-2", "rack-3"} {
+				Expect(hapi.UpdateRackLabel(ctx, db, hosts[i], rack)).ShouldNot(HaveOccurred())
+			}
+			hosts = assignRoles(hosts)
+			Expect([]models.HostRole{hosts[0].Role, hosts[1].Role}).To(ConsistOf(models.HostRoleMaster, models.HostRoleWorker))
+			worker := hosts[0]
+			if worker.Role != models.HostRoleWorker {
+				worker = hosts[1]
+			}
+			Expect(worker.SuggestedRoleReason).To(Equal(`Picked as worker: other hosts are preferred as masters, rack "rack-1" already has a master`))
+			Expect(hosts[2].Role).To(Equal(models.HostRoleMaster))
+			Expect(hosts[3].Role).To(Equal(models.HostRoleMaster))
+			Expect(hosts[3].SuggestedRoleReason).To(Equal(`Picked as master: rack "rack-3" has no other master`))
+		})
+
+		It("previews the roles without assigning them", func() {
+			hosts := createHosts(
+				hostutil.GenerateMasterInventory(),
+				hostutil.GenerateMasterInventory(),
+				workerInventory(),
+				hostutil.GenerateMasterInventory(),
+				hostutil.GenerateMasterInventory(),
+			)
+			cluster, err := common.GetClusterFromDBWithoutDisabledHosts(db, clusterId)
+			Expect(err).ShouldNot(HaveOccurred())
+			hapi.(*Manager).previewRoles(ctx, cluster, db)
+			for _, h := range hosts {
+				h = hostutil.GetHostFromDB(*h.ID, clusterId, db)
+				Expect(h.Role).To(Equal(models.HostRoleAutoAssign))
+			}
+			Expect(hostutil.GetHostFromDB(*hosts[0].ID, clusterId, db).SuggestedRole).To(Equal(models.HostRoleMaster))
+			Expect(hostutil.GetHostFromDB(*hosts[2].ID, clusterId, db).SuggestedRoleReason).To(Equal("Picked as worker: the host doesn't meet the master requirements"))
+			Expect(hostutil.GetHostFromDB(*hosts[3].ID, clusterId, db).SuggestedRole).To(Equal(models.HostRoleMaster))
+			h := hostutil.GetHostFromDB(*hosts[4].ID, clusterId, db)
+			Expect(h.SuggestedRole).To(Equal(models.HostRoleWorker))
+			Expect(h.SuggestedRoleReason).To(Equal("Picked as worker: 3 other hosts are picked as masters first"))
+		})
+
+		It("previews the role of a single host", func() {
+			hosts := createHosts(hostutil.GenerateMasterInventory(), workerInventory())
+			Expect(hapi.(*Manager).previewRole(ctx, hosts[1], nil, db)).ShouldNot(HaveOccurred())
+			Expect(hosts[1].SuggestedRole).To(Equal(models.HostRoleWorker))
+			h := hostutil.GetHostFromDB(*hosts[1].ID, clusterId, db)
+			Expect(h.Role).To(Equal(models.HostRoleAutoAssign))
+			Expect(h.SuggestedRoleReason).To(Equal("Picked as worker: the host doesn't meet the master requirements"))
+		})
+	})
 })
 
 var _ = Describe("IsValidMasterCandidate", func() {
@@ -2010,13 +2538,15 @@ var _ = Describe("IsValidMasterCandidate", func() {
 			nil,
 			defaultConfig,
 			dummy,
-			mockOperators,
+			mockOperators, nil,
+			nil,
 		)
 		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterId}}).Error).ShouldNot(HaveOccurred())
 		mockOperators.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.HostValidationIDOcsRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDCnvRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.HostValidationIDSriovRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -2159,7 +2689,7 @@ var _ = Describe("Validation metrics and events", func() {
 		mockHwValidator = hardware.NewMockValidator(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		validatorCfg = createValidatorCfg()
-		m = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, mockMetric, defaultConfig, nil, nil)
+		m = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, mockMetric, defaultConfig, nil, nil, nil, nil)
 		h = registerTestHostWithValidations(strfmt.UUID(uuid.New().String()))
 	})
 
@@ -2194,6 +2724,57 @@ var _ = Describe("Validation metrics and events", func() {
 		newValidationRes = generateTestValidationResult(ValidationFailure)
 		m.reportValidationStatusChanged(ctx, vc, h, newValidationRes, currentValidationRes)
 	})
+
+	Context("flapping validations", func() {
+		recordChanges := func(validationID validationID, count int) {
+			for i := 0; i < count; i++ {
+				status := ValidationSuccess
+				if i%2 == 1 {
+					status = ValidationFailure
+				}
+				Expect(timeline.RecordHostValidation(db, h.ClusterID, *h.ID, validationID.String(), status.String(), "")).ToNot(HaveOccurred())
+			}
+		}
+
+		BeforeEach(func() {
+			m.Config.FlappingValidationWindow = 30 * time.Minute
+			m.Config.FlappingValidationThreshold = 3
+		})
+
+		It("reports validations that started flapping once", func() {
+			recordChanges(IsNTPSynced, 3)
+			recordChanges(HasMinCPUCores, 2)
+			Expect(timeline.RecordHostValidation(db, h.ClusterID, *h.ID, BelongsToMajorityGroup.String(), ValidationPending.String(), "")).ToNot(HaveOccurred())
+			mockEvents.EXPECT().AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning,
+				fmt.Sprintf("Host %s: validation '%s' is flapping, its status changed 3 times in the last 30m0s, which usually indicates an unstable network",
+					hostutil.GetHostnameForMsg(h), IsNTPSynced), gomock.Any())
+
+			Expect(m.updateFlappingValidations(ctx, db, h)).ToNot(HaveOccurred())
+			Expect(m.updateFlappingValidations(ctx, db, h)).ToNot(HaveOccurred())
+			flapping, err := GetFlappingValidations(hostutil.GetHostFromDB(*h.ID, h.ClusterID, db))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(flapping).To(Equal([]string{IsNTPSynced.String()}))
+		})
+
+		It("reports validations that stopped flapping", func() {
+			recordChanges(IsNTPSynced, 3)
+			mockEvents.EXPECT().AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning, gomock.Any(), gomock.Any())
+			Expect(m.updateFlappingValidations(ctx, db, h)).ToNot(HaveOccurred())
+
+			m.Config.FlappingValidationThreshold = 4
+			mockEvents.EXPECT().AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityInfo,
+				fmt.Sprintf("Host %s: validation '%s' is no longer flapping", hostutil.GetHostnameForMsg(h), IsNTPSynced), gomock.Any())
+			Expect(m.updateFlappingValidations(ctx, db, h)).ToNot(HaveOccurred())
+			Expect(hostutil.GetHostFromDB(*h.ID, h.ClusterID, db).FlappingValidations).To(BeEmpty())
+		})
+
+		It("does nothing when detection is disabled", func() {
+			recordChanges(IsNTPSynced, 3)
+			m.Config.FlappingValidationThreshold = 0
+			Expect(m.updateFlappingValidations(ctx, db, h)).ToNot(HaveOccurred())
+			Expect(hostutil.GetHostFromDB(*h.ID, h.ClusterID, db).FlappingValidations).To(BeEmpty())
+		})
+	})
 })
 
 var _ = Describe("SetDiskSpeed", func() {
@@ -2227,7 +2808,7 @@ var _ = Describe("SetDiskSpeed", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockHwValidator = hardware.NewMockValidator(ctrl)
 		validatorCfg = createValidatorCfg()
-		m = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, nil)
+		m = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, nil, nil, nil)
 		h = registerTestHost(strfmt.UUID(uuid.New().String()))
 	})
 
@@ -0,0 +1,84 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// collectLogsCmd sends the logs collection that the user requested for the host, whatever its status is
+type collectLogsCmd struct {
+	baseCmd
+	instructionConfig InstructionConfig
+	db                *gorm.DB
+}
+
+func NewCollectLogsCmd(log logrus.FieldLogger, db *gorm.DB, instructionConfig InstructionConfig) *collectLogsCmd {
+	return &collectLogsCmd{
+		baseCmd:           baseCmd{log: log},
+		instructionConfig: instructionConfig,
+		db:                db,
+	}
+}
+
+func (c *collectLogsCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	if host.LogsCollectionRequest == "" {
+		return nil, nil
+	}
+	var request models.HostLogsCollectionParams
+	if err := json.Unmarshal([]byte(host.LogsCollectionRequest), &request); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal logs collection request of host %s", host.ID)
+	}
+
+	if (len(request.JournalUnits) > 0 || len(request.Directories) > 0) &&
+		!supportsLogsFilters(c.instructionConfig.AgentImage, c.instructionConfig.LogsFiltersMinAgentVersion) {
+		c.log.Warnf("Agent image %s can't collect the requested journal units and directories of host %s, collecting the default logs",
+			c.instructionConfig.AgentImage, host.ID)
+		request.JournalUnits = nil
+		request.Directories = nil
+	}
+
+	logsCommand, err := createUploadLogsCmd(host, c.instructionConfig.ServiceBaseURL, c.instructionConfig.AgentImage, "",
+		c.instructionConfig.SkipCertVerification, false, false, request.JournalUnits, request.Directories)
+	if err != nil {
+		return nil, err
+	}
+
+	// The request is sent once, its progress is tracked in the logs info of the host from now on
+	if err = c.db.Model(&models.Host{}).Where("id = ? and cluster_id = ?", host.ID.String(), host.ClusterID.String()).
+		UpdateColumn("logs_collection_request", "").Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to clear logs collection request of host %s", host.ID)
+	}
+
+	logsCommandAsArgs := strings.Fields(logsCommand)
+	step := &models.Step{
+		StepType: models.StepTypeExecute,
+		Command:  logsCommandAsArgs[0],
+		Args:     logsCommandAsArgs[1:],
+	}
+	return []*models.Step{step}, nil
+}
+
+// supportsLogsFilters tells whether the logs sender of the agent image accepts the -journal-units and -directories
+// flags. Images that aren't tagged with a version, e.g. latest, are expected to be recent enough.
+func supportsLogsFilters(agentImage, minAgentVersion string) bool {
+	if minAgentVersion == "" {
+		return true
+	}
+	minVersion, err := version.NewVersion(minAgentVersion)
+	if err != nil {
+		return true
+	}
+	parts := strings.Split(agentImage, ":")
+	agentVersion, err := version.NewVersion(parts[len(parts)-1])
+	if err != nil {
+		return true
+	}
+	return agentVersion.GreaterThanOrEqual(minVersion)
+}
@@ -0,0 +1,69 @@
+package hostcommands
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("collect_logs", func() {
+	ctx := context.Background()
+	var host models.Host
+	var db *gorm.DB
+	var collectLogsCmd *collectLogsCmd
+	var dbName string
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		collectLogsCmd = NewCollectLogsCmd(common.GetTestLog(), db, DefaultInstructionConfig)
+
+		host = hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), models.HostStatusInsufficient)
+		host.LogsCollectionRequest = `{"journal_units": ["kubelet.service", "crio.service"], "directories": ["/etc/containers"]}`
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("collects the requested units and directories once", func() {
+		stepReply, stepErr := collectLogsCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		Expect(stepReply[0].StepType).To(Equal(models.StepTypeExecute))
+		Expect(stepReply[0].Args).Should(ContainElement("-journal-units=kubelet.service,crio.service"))
+		Expect(stepReply[0].Args).Should(ContainElement("-directories=/etc/containers"))
+		Expect(stepReply[0].Args).Should(ContainElement("/etc/containers:/etc/containers:ro"))
+
+		h := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+		Expect(h.LogsCollectionRequest).To(BeEmpty())
+	})
+
+	It("collects the default logs with agent images that are older than the logs filters", func() {
+		config := DefaultInstructionConfig
+		config.AgentImage = "quay.io/ocpmetal/assisted-installer-agent:v1.0.1"
+		config.LogsFiltersMinAgentVersion = "v1.0.2"
+		collectLogsCmd = NewCollectLogsCmd(common.GetTestLog(), db, config)
+		stepReply, stepErr := collectLogsCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		for _, arg := range stepReply[0].Args {
+			Expect(arg).ShouldNot(HavePrefix("-journal-units"))
+			Expect(arg).ShouldNot(HavePrefix("-directories"))
+		}
+	})
+
+	It("does nothing when no logs were requested", func() {
+		host.LogsCollectionRequest = ""
+		stepReply, stepErr := collectLogsCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeEmpty())
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
@@ -33,7 +34,17 @@ func (c *connectivityCheckCmd) GetSteps(ctx context.Context, host *models.Host)
 		return nil, err
 	}
 
-	hostsData, err := convertHostsToConnectivityCheckParams(host.ID, hosts, c.connectivityValidator)
+	var clusters []*common.Cluster
+	if err := c.db.Select("mtu").Find(&clusters, "id = ?", host.ClusterID.String()).Error; err != nil {
+		c.log.WithError(err).Errorf("failed to get MTU of cluster %s", host.ClusterID)
+		return nil, err
+	}
+	var mtu int64
+	if len(clusters) > 0 {
+		mtu = clusters[0].Mtu
+	}
+
+	hostsData, err := convertHostsToConnectivityCheckParams(host.ID, hosts, c.connectivityValidator, mtu)
 	if err != nil {
 		c.log.WithError(err).Errorf("failed to convert hosts to connectivity params for host %s cluster %s", host.ID, host.ClusterID)
 		return nil, err
@@ -11,7 +11,9 @@ import (
 	"github.com/thoas/go-funk"
 )
 
-func convertHostsToConnectivityCheckParams(currentHostId *strfmt.UUID, hosts []*models.Host, connectivityValidator connectivity.Validator) (string, error) {
+// convertHostsToConnectivityCheckParams lists the NICs of the other hosts for the connectivity check. The path to every
+// NIC is probed with the MTU of the cluster if it's set, or with the MTU of the NIC otherwise.
+func convertHostsToConnectivityCheckParams(currentHostId *strfmt.UUID, hosts []*models.Host, connectivityValidator connectivity.Validator, mtu int64) (string, error) {
 	var connectivityCheckHosts models.ConnectivityCheckParams
 	for i := range hosts {
 		// We don't need to check if host is in some certain states:
@@ -26,7 +28,7 @@ func convertHostsToConnectivityCheckParams(currentHostId *strfmt.UUID, hosts []*
 			if err != nil {
 				return "", err
 			}
-			connectivityCheckHosts = append(connectivityCheckHosts, convertInterfacesToConnectivityCheckHost(hosts[i].ID, interfaces))
+			connectivityCheckHosts = append(connectivityCheckHosts, convertInterfacesToConnectivityCheckHost(hosts[i].ID, interfaces, mtu))
 		}
 	}
 	if len(connectivityCheckHosts) == 0 {
@@ -36,7 +38,7 @@ func convertHostsToConnectivityCheckParams(currentHostId *strfmt.UUID, hosts []*
 	return string(jsonData), err
 }
 
-func convertInterfacesToConnectivityCheckHost(hostId *strfmt.UUID, interfaces []*models.Interface) *models.ConnectivityCheckHost {
+func convertInterfacesToConnectivityCheckHost(hostId *strfmt.UUID, interfaces []*models.Interface, mtu int64) *models.ConnectivityCheckHost {
 	var connectivityHost models.ConnectivityCheckHost
 	connectivityHost.HostID = *hostId
 	for _, hostInterface := range interfaces {
@@ -44,6 +46,10 @@ func convertInterfacesToConnectivityCheckHost(hostId *strfmt.UUID, interfaces []
 		var ipAddresses []string
 		connectivityNic.Mac = hostInterface.MacAddress
 		connectivityNic.Name = hostInterface.Name
+		connectivityNic.Mtu = hostInterface.Mtu
+		if mtu > 0 {
+			connectivityNic.Mtu = mtu
+		}
 
 		for _, ip := range hostInterface.IPV4Addresses {
 			ipAddresses = append(ipAddresses, strings.Split(ip, "/")[0])
@@ -42,6 +42,7 @@ var _ = Describe("connectivitycheckconvertor", func() {
 				Name: "eth0", MacAddress: "44:85:00:80:12:a4",
 				IPV4Addresses: []string{"10.0.0.1/24", "10.0.0.2", "10.0.0.3/24"},
 				IPV6Addresses: []string{"2001:db8::4/120", "2001:db8::a"},
+				Mtu:           1500,
 			},
 			{
 				Name: "eth1", MacAddress: "45:85:00:80:12:a4",
@@ -52,16 +53,25 @@ var _ = Describe("connectivitycheckconvertor", func() {
 	})
 
 	It("convertNicsToConnectivityParamsHost_success", func() {
-		connectivityParamsHost := convertInterfacesToConnectivityCheckHost(&currentHostId, interfaces)
+		connectivityParamsHost := convertInterfacesToConnectivityCheckHost(&currentHostId, interfaces, 0)
 		Expect(connectivityParamsHost.HostID.String()).To(Equal(currentHostId.String()))
 		Expect(connectivityParamsHost.Nics).To(HaveLen(2))
 		Expect(connectivityParamsHost.Nics[0].IPAddresses).To(HaveLen(5))
 		Expect(connectivityParamsHost.Nics[1].IPAddresses).To(HaveLen(7))
+		Expect(connectivityParamsHost.Nics[0].Mtu).To(BeEquivalentTo(1500))
+		Expect(connectivityParamsHost.Nics[1].Mtu).To(BeZero())
+	})
+
+	It("convertNicsToConnectivityParamsHost_cluster_mtu", func() {
+		connectivityParamsHost := convertInterfacesToConnectivityCheckHost(&currentHostId, interfaces, 9000)
+		Expect(connectivityParamsHost.Nics).To(HaveLen(2))
+		Expect(connectivityParamsHost.Nics[0].Mtu).To(BeEquivalentTo(9000))
+		Expect(connectivityParamsHost.Nics[1].Mtu).To(BeEquivalentTo(9000))
 	})
 
 	It("convertHostsToConnectivityParamsHosts_success", func() {
 		mockValidator.EXPECT().GetHostValidInterfaces(gomock.Any()).Return(interfaces, nil).AnyTimes()
-		jsonData, err := convertHostsToConnectivityCheckParams(&currentHostId, hosts, mockValidator)
+		jsonData, err := convertHostsToConnectivityCheckParams(&currentHostId, hosts, mockValidator, 0)
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(jsonData).ShouldNot(Equal(""))
 		Expect(strings.Contains(jsonData, currentHostId.String())).To(Equal(false))
@@ -72,7 +82,7 @@ var _ = Describe("connectivitycheckconvertor", func() {
 	It("convertHostsToConnectivityParamsHosts_no_hosts", func() {
 		mockValidator.EXPECT().GetHostValidInterfaces(gomock.Any()).Return(interfaces, nil).AnyTimes()
 		var no_hosts []*models.Host
-		jsonData, err := convertHostsToConnectivityCheckParams(&currentHostId, no_hosts, mockValidator)
+		jsonData, err := convertHostsToConnectivityCheckParams(&currentHostId, no_hosts, mockValidator, 0)
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(jsonData).Should(Equal(""))
 	})
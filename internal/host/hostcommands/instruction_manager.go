@@ -18,6 +18,7 @@ import (
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
 )
 
 //go:generate mockgen -package=hostcommands -destination=mock_instruction_api.go . InstructionApi
@@ -42,6 +43,8 @@ type InstructionManager struct {
 	db                            *gorm.DB
 	installingClusterStateToSteps stateToStepsMap
 	addHostsClusterToSteps        stateToStepsMap
+	logsCmd                       CommandGetter
+	collectLogsCmd                CommandGetter
 }
 
 type InstructionConfig struct {
@@ -55,8 +58,11 @@ type InstructionConfig struct {
 	SupportL2            bool          `envconfig:"SUPPORT_L2" default:"true"`
 	InstallationTimeout  uint          `envconfig:"INSTALLATION_TIMEOUT" default:"0"`
 	DiskCheckTimeout     time.Duration `envconfig:"DISK_CHECK_TIMEOUT" default:"8m"`
-	ReleaseImageMirror   string
-	CheckClusterVersion  bool
+	// The first agent version whose logs sender collects the requested journal units and directories. Agent images
+	// that are tagged with an older version collect the default logs instead.
+	LogsFiltersMinAgentVersion string `envconfig:"LOGS_FILTERS_MIN_AGENT_VERSION" default:""`
+	ReleaseImageMirror         string
+	CheckClusterVersion        bool
 }
 
 func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, ocRelease oc.Release,
@@ -75,8 +81,10 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 	imageAvailabilityCmd := NewImageAvailabilityCmd(log, db, ocRelease, versionHandler, instructionConfig)
 
 	return &InstructionManager{
-		log: log,
-		db:  db,
+		log:            log,
+		db:             db,
+		logsCmd:        logsCmd,
+		collectLogsCmd: NewCollectLogsCmd(log, db, instructionConfig),
 		installingClusterStateToSteps: stateToStepsMap{
 			models.HostStatusKnown:                    {[]CommandGetter{connectivityCmd, freeAddressesCmd, dhcpAllocateCmd, inventoryCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec},
 			models.HostStatusInsufficient:             {[]CommandGetter{inventoryCmd, connectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec},
@@ -122,30 +130,40 @@ func (i *InstructionManager) GetNextSteps(ctx context.Context, host *models.Host
 	}
 
 	returnSteps.PostStepAction = swag.String(models.StepsPostStepActionContinue)
+	var commands []CommandGetter
 	if cmdsMap, ok := stateToSteps[hostStatus]; ok {
-		//need to add the step id
 		returnSteps.NextInstructionSeconds = cmdsMap.NextStepInSec
-		for _, cmd := range cmdsMap.Commands {
-			steps, err := cmd.GetSteps(ctx, host)
-			if err != nil {
-				// Allow to return additional steps if the current one failed
-				log.WithError(err).Warn("Failed to generate steps for command")
-				continue
-			}
-			if steps == nil {
-				continue
-			}
-			for _, step := range steps {
-				if step.StepID == "" {
-					step.StepID = createStepID(step.StepType)
-				}
-			}
-
-			returnSteps.Instructions = append(returnSteps.Instructions, steps...)
-		}
+		commands = cmdsMap.Commands
 	} else {
 		returnSteps.NextInstructionSeconds = defaultNextInstructionInSec
 	}
+	if host.LogsCollectionRequest != "" {
+		// The logs that the user requested replace those that are collected because of the status of the host
+		if idx := funk.IndexOf(commands, i.logsCmd); idx >= 0 {
+			commands = append(append(append(make([]CommandGetter, 0, len(commands)), commands[:idx]...), i.collectLogsCmd), commands[idx+1:]...)
+		} else {
+			commands = append(append(make([]CommandGetter, 0, len(commands)+1), commands...), i.collectLogsCmd)
+		}
+	}
+	for _, cmd := range commands {
+		//need to add the step id
+		steps, err := cmd.GetSteps(ctx, host)
+		if err != nil {
+			// Allow to return additional steps if the current one failed
+			log.WithError(err).Warn("Failed to generate steps for command")
+			continue
+		}
+		if steps == nil {
+			continue
+		}
+		for _, step := range steps {
+			if step.StepID == "" {
+				step.StepID = createStepID(step.StepType)
+			}
+		}
+
+		returnSteps.Instructions = append(returnSteps.Instructions, steps...)
+	}
 	logSteps(returnSteps, ClusterID, hostID, log)
 	return returnSteps, nil
 }
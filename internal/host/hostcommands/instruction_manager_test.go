@@ -121,6 +121,22 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeExecute, models.StepTypeExecute,
 				})
 			})
+			It("insufficient with requested logs", func() {
+				host.LogsCollectionRequest = "{}"
+				db.Save(&host)
+				checkStep(models.HostStatusInsufficient, []models.StepType{
+					models.StepTypeInventory, models.StepTypeConnectivityCheck,
+					models.StepTypeFreeNetworkAddresses, models.StepTypeNtpSynchronizer, models.StepTypeExecute,
+				})
+			})
+			It("error with requested logs", func() {
+				host.LogsCollectedAt = strfmt.DateTime(time.Now())
+				host.LogsCollectionRequest = "{}"
+				db.Save(&host)
+				checkStep(models.HostStatusError, []models.StepType{
+					models.StepTypeExecute, models.StepTypeExecute,
+				})
+			})
 			It("installing", func() {
 				checkStep(models.HostStatusInstalling, []models.StepType{
 					models.StepTypeInstall,
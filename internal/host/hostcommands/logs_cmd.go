@@ -51,7 +51,7 @@ func (i *logsCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.St
 
 	logsCommand, err := createUploadLogsCmd(host, i.instructionConfig.ServiceBaseURL,
 		i.instructionConfig.AgentImage, strings.Join(mastersIPs, ","),
-		i.instructionConfig.SkipCertVerification, false, true)
+		i.instructionConfig.SkipCertVerification, false, true, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -66,14 +66,14 @@ func (i *logsCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.St
 }
 
 func createUploadLogsCmd(host *models.Host, baseURL, agentImage, mastersIPs string, skipCertVerification, preservePreviousCommandReturnCode,
-	withInstallerGatherLogging bool) (string, error) {
+	withInstallerGatherLogging bool, journalUnits, directories []string) (string, error) {
 
 	cmdArgsTmpl := ""
 	if preservePreviousCommandReturnCode {
 		cmdArgsTmpl = "( returnCode=$?; "
 	}
 
-	data := map[string]string{
+	data := map[string]interface{}{
 		"BASE_URL":               strings.TrimSpace(baseURL),
 		"CLUSTER_ID":             string(host.ClusterID),
 		"HOST_ID":                string(*host.ID),
@@ -82,19 +82,24 @@ func createUploadLogsCmd(host *models.Host, baseURL, agentImage, mastersIPs stri
 		"BOOTSTRAP":              strconv.FormatBool(host.Bootstrap),
 		"INSTALLER_GATHER":       strconv.FormatBool(withInstallerGatherLogging),
 		"MASTERS_IPS":            mastersIPs,
+		"JOURNAL_UNITS":          strings.Join(journalUnits, ","),
+		"DIRECTORIES":            directories,
 	}
 	cmdArgsTmpl += "timeout 1h podman run --rm --privileged --net=host " +
 		"-v /run/systemd/journal/socket:/run/systemd/journal/socket -v /var/log:/var/log " +
 		"{{if .BOOTSTRAP}} -v /root/.ssh:/root/.ssh -v /tmp:/tmp {{end}}" +
+		"{{range .DIRECTORIES}} -v {{.}}:{{.}}:ro {{end}}" +
 		"--env PULL_SECRET_TOKEN --name logs-sender --pid=host {{.AGENT_IMAGE}} logs_sender " +
 		"-url {{.BASE_URL}} -cluster-id {{.CLUSTER_ID}} -host-id {{.HOST_ID}} " +
 		"--insecure={{.SKIP_CERT_VERIFICATION}} -bootstrap={{.BOOTSTRAP}} -with-installer-gather-logging={{.INSTALLER_GATHER}}" +
-		"{{if .MASTERS_IPS}} -masters-ips={{.MASTERS_IPS}} {{end}}"
+		"{{if .MASTERS_IPS}} -masters-ips={{.MASTERS_IPS}} {{end}}" +
+		"{{if .JOURNAL_UNITS}} -journal-units={{.JOURNAL_UNITS}} {{end}}" +
+		"{{if .DIRECTORIES}} -directories={{join .DIRECTORIES \",\"}} {{end}}"
 
 	if preservePreviousCommandReturnCode {
 		cmdArgsTmpl = cmdArgsTmpl + "; exit $returnCode; )"
 	}
-	t, err := template.New("cmd").Parse(cmdArgsTmpl)
+	t, err := template.New("cmd").Funcs(template.FuncMap{"join": strings.Join}).Parse(cmdArgsTmpl)
 	if err != nil {
 		return "", err
 	}
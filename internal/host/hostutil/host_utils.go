@@ -158,6 +158,37 @@ func GetDiskByInstallationPath(disks []*models.Disk, installationPath string) *m
 	return result.(*models.Disk)
 }
 
+// SecondaryDiskMountPoints are the directories of the node that the secondary disks of a host are mounted on,
+// by disk role
+var SecondaryDiskMountPoints = map[models.DiskRole]string{
+	models.DiskRoleVarLibContainers: "/var/lib/containers",
+	models.DiskRoleVarLibEtcd:       "/var/lib/etcd",
+}
+
+// UnmarshalSecondaryDisks returns the secondary disks of the host, the ID of each one being the device identifier
+// of the disk
+func UnmarshalSecondaryDisks(host *models.Host) ([]*models.DiskConfigParams, error) {
+	secondaryDisks := make([]*models.DiskConfigParams, 0)
+	if host.SecondaryDisks == "" {
+		return secondaryDisks, nil
+	}
+	if err := json.Unmarshal([]byte(host.SecondaryDisks), &secondaryDisks); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal the secondary disks of host %s", host.ID)
+	}
+	return secondaryDisks, nil
+}
+
+func MarshalSecondaryDisks(secondaryDisks []*models.DiskConfigParams) (string, error) {
+	if len(secondaryDisks) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(secondaryDisks)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 func IgnitionFileName(host *models.Host) string {
 	return fmt.Sprintf("%s-%s.ign", host.Role, host.ID)
 }
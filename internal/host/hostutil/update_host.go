@@ -10,6 +10,7 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/timeline"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -28,7 +29,17 @@ func UpdateHostProgress(ctx context.Context, log logrus.FieldLogger, db *gorm.DB
 		extra = append(extra, "progress_stage_started_at", strfmt.DateTime(time.Now()))
 	}
 
-	return UpdateHostStatus(ctx, log, db, eventsHandler, clusterId, hostId, srcStatus, newStatus, statusInfo, extra...)
+	host, err := UpdateHostStatus(ctx, log, db, eventsHandler, clusterId, hostId, srcStatus, newStatus, statusInfo, extra...)
+	if err != nil {
+		return nil, err
+	}
+
+	if newStage != srcStage {
+		if err = timeline.RecordHostStage(db, clusterId, hostId, newStage, progressInfo); err != nil {
+			log.WithError(err).Warnf("failed to record stage %s of host %s in the installation timeline", newStage, hostId)
+		}
+	}
+	return host, nil
 }
 
 func UpdateLogsProgress(_ context.Context, log logrus.FieldLogger, db *gorm.DB, _ events.Handler, clusterId strfmt.UUID, hostId strfmt.UUID, srcStatus string, progress string, extra ...interface{}) (*common.Host, error) {
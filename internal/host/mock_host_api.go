@@ -305,6 +305,20 @@ func (mr *MockAPIMockRecorder) ReportValidationFailedMetrics(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportValidationFailedMetrics", reflect.TypeOf((*MockAPI)(nil).ReportValidationFailedMetrics), arg0, arg1, arg2, arg3)
 }
 
+// RequestLogsCollection mocks base method
+func (m *MockAPI) RequestLogsCollection(arg0 context.Context, arg1 *models.Host, arg2 *models.HostLogsCollectionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestLogsCollection", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestLogsCollection indicates an expected call of RequestLogsCollection
+func (mr *MockAPIMockRecorder) RequestLogsCollection(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestLogsCollection", reflect.TypeOf((*MockAPI)(nil).RequestLogsCollection), arg0, arg1, arg2)
+}
+
 // ResetHost mocks base method
 func (m *MockAPI) ResetHost(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 *gorm.DB) *common.ApiErrorResponse {
 	m.ctrl.T.Helper()
@@ -333,6 +347,20 @@ func (mr *MockAPIMockRecorder) ResetPendingUserAction(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPendingUserAction", reflect.TypeOf((*MockAPI)(nil).ResetPendingUserAction), arg0, arg1, arg2)
 }
 
+// SelectInstallationDisk mocks base method
+func (m *MockAPI) SelectInstallationDisk(arg0 context.Context, arg1 *gorm.DB, arg2 *models.Host, arg3 *models.DiskSelectionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectInstallationDisk", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectInstallationDisk indicates an expected call of SelectInstallationDisk
+func (mr *MockAPIMockRecorder) SelectInstallationDisk(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectInstallationDisk", reflect.TypeOf((*MockAPI)(nil).SelectInstallationDisk), arg0, arg1, arg2, arg3)
+}
+
 // SetBootstrap mocks base method
 func (m *MockAPI) SetBootstrap(arg0 context.Context, arg1 *models.Host, arg2 bool, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -515,6 +543,20 @@ func (mr *MockAPIMockRecorder) UpdateNTP(arg0, arg1, arg2, arg3 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNTP", reflect.TypeOf((*MockAPI)(nil).UpdateNTP), arg0, arg1, arg2, arg3)
 }
 
+// UpdateRackLabel mocks base method
+func (m *MockAPI) UpdateRackLabel(arg0 context.Context, arg1 *gorm.DB, arg2 *models.Host, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRackLabel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRackLabel indicates an expected call of UpdateRackLabel
+func (mr *MockAPIMockRecorder) UpdateRackLabel(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRackLabel", reflect.TypeOf((*MockAPI)(nil).UpdateRackLabel), arg0, arg1, arg2, arg3)
+}
+
 // UpdateRole mocks base method
 func (m *MockAPI) UpdateRole(arg0 context.Context, arg1 *models.Host, arg2 models.HostRole, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -528,3 +570,17 @@ func (mr *MockAPIMockRecorder) UpdateRole(arg0, arg1, arg2, arg3 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockAPI)(nil).UpdateRole), arg0, arg1, arg2, arg3)
 }
+
+// UpdateSecondaryDisks mocks base method
+func (m *MockAPI) UpdateSecondaryDisks(arg0 context.Context, arg1 *gorm.DB, arg2 *models.Host, arg3 []*models.DiskConfigParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecondaryDisks", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecondaryDisks indicates an expected call of UpdateSecondaryDisks
+func (mr *MockAPIMockRecorder) UpdateSecondaryDisks(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecondaryDisks", reflect.TypeOf((*MockAPI)(nil).UpdateSecondaryDisks), arg0, arg1, arg2, arg3)
+}
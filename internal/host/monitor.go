@@ -62,6 +62,7 @@ func (m *Manager) HostMonitoring() {
 			break
 		}
 		for _, c := range clusters {
+			m.previewRoles(ctx, c, m.db)
 			for _, host := range c.Hosts {
 				if !m.leaderElector.IsLeader() {
 					m.log.Debugf("Not a leader, exiting HostMonitoring")
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/openshift/assisted-service/internal/customvalidations"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
@@ -35,14 +36,17 @@ type refreshPreprocessor struct {
 	conditions              []condition
 	operatorsApi            operators.API
 	disabledHostValidations DisabledHostValidations
+	customValidations       customvalidations.Validations
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator, operatorsApi operators.API, disabledHostValidations DisabledHostValidations) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator, operatorsApi operators.API, disabledHostValidations DisabledHostValidations,
+	customValidations customvalidations.Validations, ignitionDiffer IgnitionDiffer) *refreshPreprocessor {
 	v := &validator{
 		log:            log,
 		hwValidatorCfg: hwValidatorCfg,
 		hwValidator:    hwValidator,
 		operatorsAPI:   operatorsApi,
+		ignitionDiffer: ignitionDiffer,
 	}
 	return &refreshPreprocessor{
 		log:                     log,
@@ -50,6 +54,7 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.Val
 		conditions:              newConditions(v),
 		operatorsApi:            operatorsApi,
 		disabledHostValidations: disabledHostValidations,
+		customValidations:       customValidations,
 	}
 }
 
@@ -97,14 +102,13 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool,
 	if err != nil {
 		return nil, nil, err
 	}
+	operatorsSatisfied := true
 	for _, result := range results {
 		id := validationID(result.ValidationId)
 		conditions[id.String()] = result.Status == api.Success
-		category, err := id.category()
-		if err != nil {
-			logrus.WithError(err).Warn("id.category()")
-			return nil, nil, err
-		}
+		operatorsSatisfied = operatorsSatisfied && result.Status == api.Success
+		// Operator plugins have validation IDs that are not known in advance
+		category := operatorsCategory
 
 		status := ValidationStatus(result.Status)
 
@@ -115,6 +119,17 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool,
 		})
 		sortByValidationResultID(validationsOutput[category])
 	}
+	conditions[OperatorsRequirementsSatisfied.String()] = operatorsSatisfied
+
+	// Validate the user-defined rules
+	customResults, succeeded, err := r.evaluateCustomValidations(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	conditions[CustomValidationsSucceeded.String()] = succeeded
+	if len(customResults) > 0 {
+		validationsOutput[customvalidations.Category] = customResults
+	}
 
 	return conditions, validationsOutput, nil
 }
@@ -194,6 +209,16 @@ func newValidations(v *validator) []validation {
 			condition: v.belongsToMajorityGroup,
 			formatter: v.printBelongsToMajorityGroup,
 		},
+		{
+			id:        IsMtuConsistent,
+			condition: v.isMtuConsistent,
+			formatter: v.printMtuConsistent,
+		},
+		{
+			id:        AreIgnitionConfigOverridesSafe,
+			condition: v.areIgnitionConfigOverridesSafe,
+			formatter: v.printIgnitionConfigOverridesSafe,
+		},
 		{
 			id:        IsPlatformValid,
 			condition: v.isValidPlatform,
@@ -215,6 +240,11 @@ func newValidations(v *validator) []validation {
 			condition: v.sufficientOrUnknownInstallationDiskSpeed,
 			formatter: v.printSufficientOrUnknownInstallationDiskSpeed,
 		},
+		{
+			id:        AreSecondaryDisksValid,
+			condition: v.secondaryDisksValid,
+			formatter: v.printSecondaryDisksValid,
+		},
 	}
 }
 
@@ -0,0 +1,251 @@
+package host
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/pkg/errors"
+)
+
+// roleCandidate is a host of the cluster that the role assignment strategies look at, with the reasons for
+// which the strategies prefer it as a master
+type roleCandidate struct {
+	host      *models.Host
+	inventory *models.Inventory
+	hostname  string
+	reasons   []string
+}
+
+// roleAssignmentStrategy is a rule of the role assignment policy of a cluster. Strategies either exclude hosts
+// from being masters, or order the master candidates by preference, or both.
+type roleAssignmentStrategy interface {
+	// exclude returns why the candidate must not be a master, or an empty string if it may be one
+	exclude(c *roleCandidate) string
+	// order returns the candidates ordered by preference, given the hosts that are already masters
+	order(candidates, masters []*roleCandidate) []*roleCandidate
+}
+
+// ValidateRoleAssignmentPolicy checks that the rules of the policy can be applied
+func ValidateRoleAssignmentPolicy(policy *models.RoleAssignmentPolicy) error {
+	_, err := roleAssignmentStrategies(policy)
+	return err
+}
+
+// isDefaultRoleAssignmentPolicy tells whether the policy doesn't set any rule, in which case the first hosts
+// that meet the master requirements are picked as masters
+func isDefaultRoleAssignmentPolicy(policy *models.RoleAssignmentPolicy) bool {
+	return policy == nil || (policy.MasterHostnamePattern == "" && !policy.ExcludeVirtualMasters && !policy.PreferMostResources &&
+		(swag.StringValue(policy.SpreadBy) == "" || swag.StringValue(policy.SpreadBy) == models.RoleAssignmentPolicySpreadByNone))
+}
+
+// roleAssignmentStrategies returns the strategies of the policy in the order in which they are applied: the
+// hosts are ordered by resources first, so that spreading the masters picks the strongest host of every group
+func roleAssignmentStrategies(policy *models.RoleAssignmentPolicy) ([]roleAssignmentStrategy, error) {
+	strategies := make([]roleAssignmentStrategy, 0)
+	if policy == nil {
+		return strategies, nil
+	}
+	if policy.MasterHostnamePattern != "" {
+		re, err := regexp.Compile(policy.MasterHostnamePattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid master hostname pattern %s", policy.MasterHostnamePattern)
+		}
+		strategies = append(strategies, &hostnamePatternStrategy{pattern: re})
+	}
+	if policy.ExcludeVirtualMasters {
+		strategies = append(strategies, &physicalMastersStrategy{})
+	}
+	if policy.PreferMostResources {
+		strategies = append(strategies, &mostResourcesStrategy{})
+	}
+	switch swag.StringValue(policy.SpreadBy) {
+	case models.RoleAssignmentPolicySpreadBySerialNumberPrefix:
+		if policy.SerialNumberPrefixLength < 1 {
+			return nil, errors.New("serial number prefix length must be set to spread the masters by serial number prefix")
+		}
+		prefixLength := int(policy.SerialNumberPrefixLength)
+		strategies = append(strategies, &spreadStrategy{name: "serial number prefix", group: func(c *roleCandidate) string {
+			return serialNumberPrefix(c, prefixLength)
+		}})
+	case models.RoleAssignmentPolicySpreadByRackLabel:
+		strategies = append(strategies, &spreadStrategy{name: "rack", group: func(c *roleCandidate) string {
+			return c.host.RackLabel
+		}})
+	case "", models.RoleAssignmentPolicySpreadByNone:
+	default:
+		return nil, errors.Errorf("unsupported spread by %s", swag.StringValue(policy.SpreadBy))
+	}
+	return strategies, nil
+}
+
+// pickMasters returns the candidates that the strategies pick as masters, at most count of them
+func pickMasters(strategies []roleAssignmentStrategy, candidates, masters []*roleCandidate, count int) []*roleCandidate {
+	// Start from a stable order, the roles of the hosts are picked one host at a time
+	ordered := append(make([]*roleCandidate, 0, len(candidates)), candidates...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].host.ID.String() < ordered[j].host.ID.String()
+	})
+	for _, strategy := range strategies {
+		ordered = strategy.order(ordered, masters)
+	}
+	if len(ordered) > count {
+		ordered = ordered[:count]
+	}
+	return ordered
+}
+
+// excludedFromMasters returns why the strategies don't let the candidate be a master, or an empty string
+func excludedFromMasters(strategies []roleAssignmentStrategy, c *roleCandidate) string {
+	for _, strategy := range strategies {
+		if reason := strategy.exclude(c); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
+type hostnamePatternStrategy struct {
+	pattern *regexp.Regexp
+}
+
+func (s *hostnamePatternStrategy) exclude(c *roleCandidate) string {
+	if !s.pattern.MatchString(c.hostname) {
+		return fmt.Sprintf("its hostname %s doesn't match %s", c.hostname, s.pattern)
+	}
+	c.reasons = append(c.reasons, fmt.Sprintf("its hostname %s matches %s", c.hostname, s.pattern))
+	return ""
+}
+
+func (s *hostnamePatternStrategy) order(candidates, _ []*roleCandidate) []*roleCandidate {
+	return candidates
+}
+
+type physicalMastersStrategy struct{}
+
+func (s *physicalMastersStrategy) exclude(c *roleCandidate) string {
+	if c.inventory.SystemVendor != nil && c.inventory.SystemVendor.Virtual {
+		return "it is a virtual host"
+	}
+	return ""
+}
+
+func (s *physicalMastersStrategy) order(candidates, _ []*roleCandidate) []*roleCandidate {
+	return candidates
+}
+
+type mostResourcesStrategy struct{}
+
+func (s *mostResourcesStrategy) exclude(_ *roleCandidate) string {
+	return ""
+}
+
+func (s *mostResourcesStrategy) order(candidates, _ []*roleCandidate) []*roleCandidate {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		memoryI, memoryJ := memoryBytes(candidates[i].inventory), memoryBytes(candidates[j].inventory)
+		if memoryI != memoryJ {
+			return memoryI > memoryJ
+		}
+		return cpuCount(candidates[i].inventory) > cpuCount(candidates[j].inventory)
+	})
+	for i, c := range candidates {
+		c.reasons = append(c.reasons, fmt.Sprintf("it has the %s most resources of the %d candidates (%d GiB of memory, %d CPU cores)",
+			ordinal(i+1), len(candidates), conversions.BytesToGiB(memoryBytes(c.inventory)), cpuCount(c.inventory)))
+	}
+	return candidates
+}
+
+func memoryBytes(inventory *models.Inventory) int64 {
+	if inventory.Memory == nil {
+		return 0
+	}
+	return inventory.Memory.PhysicalBytes
+}
+
+func cpuCount(inventory *models.Inventory) int64 {
+	if inventory.CPU == nil {
+		return 0
+	}
+	return inventory.CPU.Count
+}
+
+func ordinal(n int) string {
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		return fmt.Sprintf("%dth", n)
+	case n%10 == 1:
+		return fmt.Sprintf("%dst", n)
+	case n%10 == 2:
+		return fmt.Sprintf("%dnd", n)
+	case n%10 == 3:
+		return fmt.Sprintf("%drd", n)
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
+
+func serialNumberPrefix(c *roleCandidate, prefixLength int) string {
+	serialNumber := ""
+	if c.inventory.SystemVendor != nil {
+		serialNumber = strings.ToUpper(c.inventory.SystemVendor.SerialNumber)
+	}
+	if len(serialNumber) > prefixLength {
+		return serialNumber[:prefixLength]
+	}
+	return serialNumber
+}
+
+// spreadStrategy spreads the masters across the groups of the hosts, e.g. their serial number prefixes or their racks
+type spreadStrategy struct {
+	name  string
+	group func(c *roleCandidate) string
+}
+
+func (s *spreadStrategy) exclude(_ *roleCandidate) string {
+	return ""
+}
+
+// order moves first, keeping their order, the candidates of the groups that don't have a master yet, one
+// candidate per group. Candidates that don't belong to any group are moved last.
+func (s *spreadStrategy) order(candidates, masters []*roleCandidate) []*roleCandidate {
+	used := make(map[string]bool)
+	for _, m := range masters {
+		used[s.group(m)] = true
+	}
+	spread := make([]*roleCandidate, 0, len(candidates))
+	rest := make([]*roleCandidate, 0, len(candidates))
+	unknown := make([]*roleCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		group := s.group(c)
+		switch {
+		case group == "":
+			c.reasons = append(c.reasons, fmt.Sprintf("its %s is unknown", s.name))
+			unknown = append(unknown, c)
+		case used[group]:
+			c.reasons = append(c.reasons, fmt.Sprintf("%s %q already has a master", s.name, group))
+			rest = append(rest, c)
+		default:
+			used[group] = true
+			c.reasons = append(c.reasons, fmt.Sprintf("%s %q has no other master", s.name, group))
+			spread = append(spread, c)
+		}
+	}
+	return append(append(spread, rest...), unknown...)
+}
+
+func newRoleCandidate(h *models.Host) (*roleCandidate, error) {
+	inventory, err := hostutil.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the inventory of host %s", h.ID)
+	}
+	hostname := h.RequestedHostname
+	if hostname == "" {
+		hostname = inventory.Hostname
+	}
+	return &roleCandidate{host: h, inventory: inventory, hostname: hostname}, nil
+}
@@ -0,0 +1,104 @@
+package host
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+)
+
+func newTestRoleCandidate(hostname string, memoryGib int64, serialNumber string, virtual bool) *roleCandidate {
+	id := strfmt.UUID(uuid.New().String())
+	return &roleCandidate{
+		host:     &models.Host{ID: &id},
+		hostname: hostname,
+		inventory: &models.Inventory{
+			CPU:          &models.CPU{Count: 8},
+			Memory:       &models.Memory{PhysicalBytes: conversions.GibToBytes(memoryGib)},
+			SystemVendor: &models.SystemVendor{SerialNumber: serialNumber, Virtual: virtual},
+		},
+	}
+}
+
+var _ = Describe("role assignment strategies", func() {
+	It("rejects invalid policies", func() {
+		Expect(ValidateRoleAssignmentPolicy(&models.RoleAssignmentPolicy{MasterHostnamePattern: "^cp-("})).ToNot(Succeed())
+		Expect(ValidateRoleAssignmentPolicy(&models.RoleAssignmentPolicy{
+			SpreadBy: swag.String(models.RoleAssignmentPolicySpreadBySerialNumberPrefix),
+		})).ToNot(Succeed())
+		Expect(ValidateRoleAssignmentPolicy(&models.RoleAssignmentPolicy{
+			SpreadBy:                 swag.String(models.RoleAssignmentPolicySpreadBySerialNumberPrefix),
+			SerialNumberPrefixLength: 3,
+		})).To(Succeed())
+	})
+
+	It("tells default policies apart", func() {
+		Expect(isDefaultRoleAssignmentPolicy(nil)).To(BeTrue())
+		Expect(isDefaultRoleAssignmentPolicy(&models.RoleAssignmentPolicy{SpreadBy: swag.String(models.RoleAssignmentPolicySpreadByNone)})).To(BeTrue())
+		Expect(isDefaultRoleAssignmentPolicy(&models.RoleAssignmentPolicy{PreferMostResources: true})).To(BeFalse())
+	})
+
+	It("excludes hosts by hostname and virtualization", func() {
+		strategies, err := roleAssignmentStrategies(&models.RoleAssignmentPolicy{MasterHostnamePattern: "^cp-", ExcludeVirtualMasters: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(excludedFromMasters(strategies, newTestRoleCandidate("cp-0", 32, "A1", false))).To(BeEmpty())
+		Expect(excludedFromMasters(strategies, newTestRoleCandidate("worker-0", 32, "A1", false))).To(Equal("its hostname worker-0 doesn't match ^cp-"))
+		Expect(excludedFromMasters(strategies, newTestRoleCandidate("cp-1", 32, "A1", true))).To(Equal("it is a virtual host"))
+	})
+
+	It("prefers the hosts with the most resources", func() {
+		strategies, err := roleAssignmentStrategies(&models.RoleAssignmentPolicy{PreferMostResources: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		small := newTestRoleCandidate("small", 16, "", false)
+		large := newTestRoleCandidate("large", 64, "", false)
+		medium := newTestRoleCandidate("medium", 32, "", false)
+
+		picked := pickMasters(strategies, []*roleCandidate{small, large, medium}, nil, 2)
+		Expect(picked).To(Equal([]*roleCandidate{large, medium}))
+		Expect(large.reasons).To(Equal([]string{"it has the 1st most resources of the 3 candidates (64 GiB of memory, 8 CPU cores)"}))
+	})
+
+	It("spreads the masters by serial number prefix, strongest host of each group first", func() {
+		strategies, err := roleAssignmentStrategies(&models.RoleAssignmentPolicy{
+			PreferMostResources:      true,
+			SpreadBy:                 swag.String(models.RoleAssignmentPolicySpreadBySerialNumberPrefix),
+			SerialNumberPrefixLength: 3,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		master := newTestRoleCandidate("master", 32, "RK1-001", false)
+		rack1 := newTestRoleCandidate("rack1", 128, "rk1-002", false)
+		rack2Small := newTestRoleCandidate("rack2-small", 16, "RK2-001", false)
+		rack2Large := newTestRoleCandidate("rack2-large", 64, "RK2-002", false)
+		rack3 := newTestRoleCandidate("rack3", 32, "RK3-001", false)
+
+		picked := pickMasters(strategies, []*roleCandidate{rack1, rack2Small, rack2Large, rack3}, []*roleCandidate{master}, 2)
+		Expect(picked).To(Equal([]*roleCandidate{rack2Large, rack3}))
+		Expect(rack1.reasons).To(ContainElement(`serial number prefix "RK1" already has a master`))
+
+		By("picking hosts of groups that already have a master when there are not enough groups")
+		picked = pickMasters(strategies, []*roleCandidate{rack1, rack2Small}, []*roleCandidate{master}, 2)
+		Expect(picked).To(Equal([]*roleCandidate{rack2Small, rack1}))
+	})
+
+	It("spreads the masters by rack label, hosts without a rack last", func() {
+		strategies, err := roleAssignmentStrategies(&models.RoleAssignmentPolicy{SpreadBy: swag.String(models.RoleAssignmentPolicySpreadByRackLabel)})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(isDefaultRoleAssignmentPolicy(&models.RoleAssignmentPolicy{SpreadBy: swag.String(models.RoleAssignmentPolicySpreadByRackLabel)})).To(BeFalse())
+		unlabeled := newTestRoleCandidate("unlabeled", 32, "", false)
+		rack1 := newTestRoleCandidate("rack1", 32, "", false)
+		rack1.host.RackLabel = "rack-1"
+		rack1Other := newTestRoleCandidate("rack1-other", 32, "", false)
+		rack1Other.host.RackLabel = "rack-1"
+		rack2 := newTestRoleCandidate("rack2", 32, "", false)
+		rack2.host.RackLabel = "rack-2"
+
+		picked := pickMasters(strategies, []*roleCandidate{unlabeled, rack1, rack1Other, rack2}, nil, 3)
+		Expect(picked).To(ConsistOf(rack1, rack2, rack1Other))
+		Expect(picked[2].reasons).To(Equal([]string{`rack "rack-1" already has a master`}))
+		Expect(unlabeled.reasons).To(Equal([]string{"its rack is unknown"}))
+		Expect(rack2.reasons).To(Equal([]string{`rack "rack-2" has no other master`}))
+	})
+})
@@ -14,6 +14,7 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
@@ -32,6 +33,94 @@ type transitionHandler struct {
 var resetFields = [...]interface{}{"inventory", "", "bootstrap", false, "ntp_sources", ""}
 var resetLogsField = []interface{}{"logs_info", "", "logs_started_at", strfmt.DateTime(time.Time{}), "logs_collected_at", strfmt.DateTime(time.Time{})}
 
+// transitionDB returns the DB that the transition updates the host with, the transaction of the caller when it
+// passes one
+func (th *transitionHandler) transitionDB(args stateswitch.TransitionArgs) *gorm.DB {
+	var db *gorm.DB
+	switch params := args.(type) {
+	case *TransitionArgsRegisterHost:
+		db = params.db
+	case *TransitionArgsRegisterInstalledHost:
+		db = params.db
+	case *TransitionArgsCancelInstallation:
+		db = params.db
+	case *TransitionArgsResetHost:
+		db = params.db
+	case *TransitionArgsInstallHost:
+		db = params.db
+	case *TransitionArgsDisableHost:
+		db = params.db
+	case *TransitionArgsEnableHost:
+		db = params.db
+	case *TransitionArgsRefreshHost:
+		db = params.db
+	}
+	if db == nil {
+		return th.db
+	}
+	return db
+}
+
+// statusHistoryStateMachine records the transitions of the state machine in the status history
+type statusHistoryStateMachine struct {
+	stateswitch.StateMachine
+	th *transitionHandler
+}
+
+func newStatusHistoryStateMachine(sm stateswitch.StateMachine, th *transitionHandler) stateswitch.StateMachine {
+	return &statusHistoryStateMachine{StateMachine: sm, th: th}
+}
+
+func (s *statusHistoryStateMachine) Run(transitionType stateswitch.TransitionType, stateSwitch stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	if err := s.StateMachine.Run(transitionType, stateSwitch, args); err != nil {
+		return err
+	}
+	if sHost, ok := stateSwitch.(*stateHost); ok {
+		s.th.recordStatusHistory(sHost, transitionType, args)
+	}
+	return nil
+}
+
+// recordStatusHistory appends a transition that changed the status of the host, together with the validations that
+// were failing at that moment, to the status history. The entry is written with the DB of the transition, so that it
+// is committed or rolled back together with the status.
+func (th *transitionHandler) recordStatusHistory(sHost *stateHost, transitionType stateswitch.TransitionType, args stateswitch.TransitionArgs) {
+	recordStatusChange(th.log, th.transitionDB(args), sHost.host, sHost.srcState, string(transitionType))
+}
+
+// The triggers of the status changes that are not transitions of the state machine
+const (
+	statusTriggerUpdateInstallProgress = "update-install-progress"
+)
+
+// recordStatusChange appends the status change of the host, together with the validations that were failing at that
+// moment, to the status history
+func recordStatusChange(log logrus.FieldLogger, db *gorm.DB, host *models.Host, srcStatus, trigger string) {
+	if srcStatus == swag.StringValue(host.Status) {
+		return
+	}
+	if err := history.RecordHostStatusChange(db, host, srcStatus, trigger, failedValidations(host)); err != nil {
+		log.WithError(err).Warnf("failed to record status change of host %s in the status history", host.ID.String())
+	}
+}
+
+func failedValidations(h *models.Host) []string {
+	validations, err := GetValidations(h)
+	if err != nil {
+		return nil
+	}
+	ret := make([]string, 0)
+	for _, results := range validations {
+		for _, v := range results {
+			if v.Status == ValidationFailure {
+				ret = append(ret, v.ID.String())
+			}
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 ////////////////////////////////////////////////////////////////////////////
 // RegisterHost
 ////////////////////////////////////////////////////////////////////////////
@@ -17,6 +17,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/customvalidations"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
@@ -60,7 +61,7 @@ var _ = Describe("RegisterHost", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{})
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -72,6 +73,16 @@ var _ = Describe("RegisterHost", func() {
 		Expect(h.DiscoveryAgentVersion).To(Equal("v1.0.1"))
 	})
 
+	It("register_new records the status history", func() {
+		Expect(hapi.RegisterHost(ctx, &models.Host{ID: &hostId, ClusterID: clusterId, DiscoveryAgentVersion: "v1.0.1"}, db)).ShouldNot(HaveOccurred())
+		var entries []*common.StatusHistory
+		Expect(db.Find(&entries, "cluster_id = ? and host_id = ?", clusterId.String(), hostId.String()).Error).ShouldNot(HaveOccurred())
+		Expect(entries).Should(HaveLen(1))
+		Expect(entries[0].PreviousStatus).Should(BeEmpty())
+		Expect(swag.StringValue(entries[0].Status)).Should(Equal(models.HostStatusDiscovering))
+		Expect(swag.StringValue(entries[0].Trigger)).Should(Equal(TransitionTypeRegisterHost))
+	})
+
 	Context("register during installation", func() {
 		tests := []struct {
 			name                  string
@@ -443,7 +454,7 @@ var _ = Describe("HostInstallationFailed", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{})
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), mockMetric, defaultConfig, nil, operatorsManager)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), mockMetric, defaultConfig, nil, operatorsManager, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 		host = hostutil.GenerateTestHost(hostId, clusterId, "")
@@ -487,7 +498,7 @@ var _ = Describe("RegisterInstalledOCPHost", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{})
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), mockMetric, defaultConfig, nil, operatorsManager)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), mockMetric, defaultConfig, nil, operatorsManager, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 		host = hostutil.GenerateTestHost(hostId, clusterId, "")
@@ -522,7 +533,7 @@ var _ = Describe("Cancel host installation", func() {
 		mockEventsHandler = events.NewMockHandler(ctrl)
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{})
-		hapi = NewManager(common.GetTestLog(), db, mockEventsHandler, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager)
+		hapi = NewManager(common.GetTestLog(), db, mockEventsHandler, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager, nil, nil)
 	})
 
 	tests := []struct {
@@ -614,7 +625,7 @@ var _ = Describe("Reset host", func() {
 		mockEventsHandler = events.NewMockHandler(ctrl)
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{})
-		hapi = NewManager(common.GetTestLog(), db, mockEventsHandler, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager)
+		hapi = NewManager(common.GetTestLog(), db, mockEventsHandler, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager, nil, nil)
 	})
 
 	tests := []struct {
@@ -708,7 +719,7 @@ var _ = Describe("Install", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockHwValidator = hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{})
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -875,7 +886,7 @@ var _ = Describe("Disable", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{})
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -1008,7 +1019,7 @@ var _ = Describe("Enable", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{})
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -1253,7 +1264,7 @@ var _ = Describe("Refresh Host", func() {
 		}
 		operatorsManager = operators.NewManager(common.GetTestLog(), nil, operatorsOptions)
 		mockHwValidator.EXPECT().GetHostInstallationPath(gomock.Any()).Return("/dev/sda").AnyTimes()
-		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager)
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager, nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -3898,7 +3909,7 @@ var _ = Describe("Refresh Host", func() {
 			t := tests[i]
 			It(t.name, func() {
 				defaultConfig.DisabledHostvalidations = t.disabledValidations
-				hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager)
+				hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager, nil, nil)
 
 				err := hapi.RefreshStatus(ctx, &host, db)
 				Expect(err).ToNot(HaveOccurred())
@@ -3922,6 +3933,101 @@ var _ = Describe("Refresh Host", func() {
 		}
 	})
 
+	Context("custom host validations", func() {
+		var disabledValidations = DisabledHostValidations{
+			string(models.HostValidationIDBelongsToMajorityGroup):   struct{}{},
+			string(models.HostValidationIDContainerImagesAvailable): struct{}{},
+		}
+
+		BeforeEach(func() {
+			mockDefaultClusterHostRequirements(mockHwValidator)
+			defaultNTPSourcesInBytes, err := json.Marshal(defaultNTPSources)
+			Expect(err).NotTo(HaveOccurred())
+			cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusDiscovering)
+			host.Inventory = hostutil.GenerateInventoryWithResourcesWithBytes(4, conversions.GibToBytes(16), "master")
+			host.Role = models.HostRoleMaster
+			host.NtpSources = string(defaultNTPSourcesInBytes)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID,
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				AnyTimes()
+		})
+
+		newCustomValidation := func(id, expression string, blocking bool) *models.CustomHostValidation {
+			return &models.CustomHostValidation{
+				ID:          swag.String(id),
+				Description: fmt.Sprintf("Host does not satisfy %s", id),
+				Expression:  swag.String(expression),
+				Blocking:    swag.Bool(blocking),
+			}
+		}
+
+		tests := []struct {
+			name               string
+			defaults           customvalidations.Validations
+			clusterValidations customvalidations.Validations
+			dstState           string
+			customStatus       ValidationStatus
+		}{
+			{
+				name:         "Host is known when the custom validation succeeds",
+				defaults:     customvalidations.Validations{newCustomValidation("enough-cpus", "inventory.cpu.count >= 4", true)},
+				dstState:     models.HostStatusKnown,
+				customStatus: ValidationSuccess,
+			},
+			{
+				name:         "Host is insufficient when a blocking custom validation fails",
+				defaults:     customvalidations.Validations{newCustomValidation("enough-cpus", "inventory.cpu.count >= 8", true)},
+				dstState:     models.HostStatusInsufficient,
+				customStatus: ValidationFailure,
+			},
+			{
+				name:         "Host is known when a non-blocking custom validation fails",
+				defaults:     customvalidations.Validations{newCustomValidation("enough-cpus", "inventory.cpu.count >= 8", false)},
+				dstState:     models.HostStatusKnown,
+				customStatus: ValidationFailure,
+			},
+			{
+				name:               "The custom validations of the cluster replace the defaults",
+				defaults:           customvalidations.Validations{newCustomValidation("enough-cpus", "inventory.cpu.count >= 8", true)},
+				clusterValidations: customvalidations.Validations{newCustomValidation("enough-cpus", "inventory.cpu.count >= 2", true)},
+				dstState:           models.HostStatusKnown,
+				customStatus:       ValidationSuccess,
+			},
+		}
+
+		for i := range tests {
+			t := tests[i]
+			It(t.name, func() {
+				if t.clusterValidations != nil {
+					b, err := json.Marshal(t.clusterValidations)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(db.Model(&cluster).Update("custom_host_validations", string(b)).Error).ToNot(HaveOccurred())
+				}
+				cfg := *defaultConfig
+				cfg.DisabledHostvalidations = disabledValidations
+				cfg.CustomHostValidations = t.defaults
+				hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, &cfg, nil, operatorsManager, nil, nil)
+
+				Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+
+				var resultHost models.Host
+				Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId, clusterId.String()).Error).ToNot(HaveOccurred())
+				Expect(swag.StringValue(resultHost.Status)).To(Equal(t.dstState))
+				validationRes := ValidationsStatus{}
+				Expect(json.Unmarshal([]byte(resultHost.ValidationsInfo), &validationRes)).ToNot(HaveOccurred())
+				Expect(validationRes[customvalidations.Category]).To(HaveLen(1))
+				Expect(validationRes[customvalidations.Category][0].ID.String()).To(Equal("enough-cpus"))
+				Expect(validationRes[customvalidations.Category][0].Status).To(Equal(t.customStatus))
+				if t.customStatus == ValidationFailure {
+					Expect(validationRes[customvalidations.Category][0].Message).To(Equal("Host does not satisfy enough-cpus"))
+				}
+			})
+		}
+	})
+
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
 		ctrl.Finish()
@@ -0,0 +1,88 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/timeline"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// updateFlappingValidations detects the validations of the host that keep changing between success and
+// failure, based on the validation changes recorded in the installation timeline, and reports the ones
+// that started or stopped flapping. Detection is disabled when no threshold is configured.
+func (m *Manager) updateFlappingValidations(ctx context.Context, db *gorm.DB, h *models.Host) error {
+	if m.Config.FlappingValidationThreshold <= 0 {
+		return nil
+	}
+	log := logutil.FromContext(ctx, m.log)
+
+	changes, err := timeline.CountHostValidationChanges(m.db, h.ClusterID, *h.ID, time.Now().Add(-m.Config.FlappingValidationWindow),
+		ValidationSuccess.String(), ValidationFailure.String())
+	if err != nil {
+		return errors.Wrapf(err, "failed to count validation changes of host %s", h.ID.String())
+	}
+	flapping := make([]string, 0)
+	for validationID, count := range changes {
+		if count >= m.Config.FlappingValidationThreshold {
+			flapping = append(flapping, validationID)
+		}
+	}
+	sort.Strings(flapping)
+
+	current, err := GetFlappingValidations(h)
+	if err != nil {
+		return err
+	}
+	if strings.Join(current, ",") == strings.Join(flapping, ",") {
+		return nil
+	}
+
+	var flappingValidations string
+	if len(flapping) > 0 {
+		b, err := json.Marshal(flapping)
+		if err != nil {
+			return err
+		}
+		flappingValidations = string(b)
+	}
+	if _, err = hostutil.UpdateHost(log, db, h.ClusterID, *h.ID, *h.Status, "flapping_validations", flappingValidations); err != nil {
+		return errors.Wrapf(err, "failed to update flapping validations of host %s", h.ID.String())
+	}
+	h.FlappingValidations = flappingValidations
+
+	for _, validationID := range flapping {
+		if !funk.ContainsString(current, validationID) {
+			eventMsg := fmt.Sprintf("Host %s: validation '%s' is flapping, its status changed %d times in the last %s, which usually indicates an unstable network",
+				hostutil.GetHostnameForMsg(h), validationID, changes[validationID], m.Config.FlappingValidationWindow)
+			m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning, eventMsg, time.Now())
+		}
+	}
+	for _, validationID := range current {
+		if !funk.ContainsString(flapping, validationID) {
+			eventMsg := fmt.Sprintf("Host %s: validation '%s' is no longer flapping", hostutil.GetHostnameForMsg(h), validationID)
+			m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityInfo, eventMsg, time.Now())
+		}
+	}
+	return nil
+}
+
+// GetFlappingValidations returns the IDs of the validations of the host that are currently flapping
+func GetFlappingValidations(h *models.Host) ([]string, error) {
+	var ret []string
+	if h.FlappingValidations != "" {
+		if err := json.Unmarshal([]byte(h.FlappingValidations), &ret); err != nil {
+			return nil, errors.Wrapf(err, "Failed to unmarshal flapping validations from host %s in cluster %s", h.ID, h.ClusterID)
+		}
+	}
+	return ret, nil
+}
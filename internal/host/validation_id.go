@@ -30,19 +30,28 @@ const (
 	AreLsoRequirementsSatisfied                    = validationID(models.HostValidationIDLsoRequirementsSatisfied)
 	AreOcsRequirementsSatisfied                    = validationID(models.HostValidationIDOcsRequirementsSatisfied)
 	AreCnvRequirementsSatisfied                    = validationID(models.HostValidationIDCnvRequirementsSatisfied)
+	AreSriovRequirementsSatisfied                  = validationID(models.HostValidationIDSriovRequirementsSatisfied)
 	SufficientOrUnknownInstallationDiskSpeed       = validationID(models.HostValidationIDSufficientOrUnknownInstallationDiskSpeed)
+	AreSecondaryDisksValid                         = validationID(models.HostValidationIDSecondaryDisksValid)
+	IsMtuConsistent                                = validationID(models.HostValidationIDMtuConsistent)
+	AreIgnitionConfigOverridesSafe                 = validationID(models.HostValidationIDIgnitionConfigOverridesSafe)
 )
 
+// operatorsCategory is the category of the validations of all the OLM operators, including plugins
+const operatorsCategory = "operators"
+
 func (v validationID) category() (string, error) {
 	switch v {
 	case IsConnected, IsMachineCidrDefined, BelongsToMachineCidr,
-		IsAPIVipConnected, BelongsToMajorityGroup, IsNTPSynced, SucessfullOrUnknownContainerImagesAvailability:
+		IsAPIVipConnected, BelongsToMajorityGroup, IsMtuConsistent, IsNTPSynced, SucessfullOrUnknownContainerImagesAvailability:
 		return "network", nil
-	case HasInventory, HasMinCPUCores, HasMinValidDisks, HasMinMemory, SufficientOrUnknownInstallationDiskSpeed,
+	case HasInventory, HasMinCPUCores, HasMinValidDisks, HasMinMemory, SufficientOrUnknownInstallationDiskSpeed, AreSecondaryDisksValid,
 		HasCPUCoresForRole, HasMemoryForRole, IsHostnameUnique, IsHostnameValid, IsPlatformValid:
 		return "hardware", nil
-	case AreLsoRequirementsSatisfied, AreOcsRequirementsSatisfied, AreCnvRequirementsSatisfied:
-		return "operators", nil
+	case AreIgnitionConfigOverridesSafe:
+		return "configuration", nil
+	case AreLsoRequirementsSatisfied, AreOcsRequirementsSatisfied, AreCnvRequirementsSatisfied, AreSriovRequirementsSatisfied:
+		return operatorsCategory, nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected validation id %s", string(v)))
 }
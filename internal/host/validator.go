@@ -8,10 +8,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/configdiff"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/network"
@@ -158,11 +160,18 @@ func boolValue(b bool) ValidationStatus {
 	}
 }
 
+// IgnitionDiffer returns the differences that the ignition config overrides of a host make to the ignition config
+// that the service generates for it
+type IgnitionDiffer interface {
+	HostIgnitionDiff(host *models.Host) (*models.ConfigDiff, error)
+}
+
 type validator struct {
 	log            logrus.FieldLogger
 	hwValidatorCfg *hardware.ValidatorCfg
 	hwValidator    hardware.Validator
 	operatorsAPI   operators.API
+	ignitionDiffer IgnitionDiffer
 }
 
 func (v *validator) isConnected(c *validationContext) ValidationStatus {
@@ -259,6 +268,76 @@ func (v *validator) printHasMinValidDisks(c *validationContext, status Validatio
 	}
 }
 
+func (v *validator) secondaryDisksValid(c *validationContext) ValidationStatus {
+	if c.host.SecondaryDisks == "" {
+		return ValidationSuccess
+	}
+	if c.inventory == nil {
+		return ValidationPending
+	}
+	failure, err := v.secondaryDisksFailure(c)
+	if err != nil {
+		return ValidationError
+	}
+	return boolValue(failure == "")
+}
+
+// secondaryDisksFailure returns why the secondary disks of the host can't be used, or an empty string if they can
+func (v *validator) secondaryDisksFailure(c *validationContext) (string, error) {
+	secondaryDisks, err := hostutil.UnmarshalSecondaryDisks(c.host)
+	if err != nil {
+		return "", err
+	}
+	minSizesGb := map[models.DiskRole]int64{
+		models.DiskRoleVarLibContainers: v.hwValidatorCfg.MinVarLibContainersDiskSizeGb,
+		models.DiskRoleVarLibEtcd:       v.hwValidatorCfg.MinVarLibEtcdDiskSizeGb,
+	}
+	// the role of a host whose role is automatically assigned is only known when the installation starts, when
+	// the hosts are validated again once their roles are assigned
+	role := c.host.Role
+	eligibleDisks := v.hwValidator.ListEligibleDisks(c.inventory)
+	for _, secondaryDisk := range secondaryDisks {
+		diskID := swag.StringValue(secondaryDisk.ID)
+		mountPoint := hostutil.SecondaryDiskMountPoints[secondaryDisk.Role]
+		disk := hostutil.GetDiskByInstallationPath(c.inventory.Disks, diskID)
+		switch {
+		case disk == nil:
+			return fmt.Sprintf("Disk %s for %s is no longer part of the inventory", diskID, mountPoint), nil
+		case hostutil.GetDiskByInstallationPath(eligibleDisks, diskID) == nil:
+			return fmt.Sprintf("Disk %s for %s is not eligible: %s", diskID, mountPoint,
+				strings.Join(disk.InstallationEligibility.NotEligibleReasons, ", ")), nil
+		case diskID == hostutil.GetHostInstallationPath(c.host) || hostutil.GetDeviceFullName(disk) == hostutil.GetHostInstallationPath(c.host):
+			return fmt.Sprintf("Disk %s for %s is the installation disk", diskID, mountPoint), nil
+		case disk.SizeBytes < conversions.GbToBytes(minSizesGb[secondaryDisk.Role]):
+			return fmt.Sprintf("Disk %s for %s is %s, it must be at least %d GB", diskID, mountPoint,
+				humanize.Bytes(uint64(disk.SizeBytes)), minSizesGb[secondaryDisk.Role]), nil
+		case secondaryDisk.Role == models.DiskRoleVarLibEtcd && role != models.HostRoleMaster && role != models.HostRoleBootstrap &&
+			role != models.HostRoleAutoAssign:
+			return fmt.Sprintf("Disk %s for %s requires the host to be a master", diskID, mountPoint), nil
+		}
+	}
+	return "", nil
+}
+
+func (v *validator) printSecondaryDisksValid(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if c.host.SecondaryDisks == "" {
+			return "No secondary disks are assigned"
+		}
+		return "Secondary disks are valid"
+	case ValidationFailure:
+		failure, _ := v.secondaryDisksFailure(c)
+		return failure
+	case ValidationPending:
+		return "Missing inventory"
+	case ValidationError:
+		return "Failed to parse the secondary disks of the host"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
 func (v *validator) isMachineCidrDefined(c *validationContext) ValidationStatus {
 	return boolValue(swag.BoolValue(c.cluster.UserManagedNetworking) || swag.StringValue(c.cluster.Kind) == models.ClusterKindAddHostsCluster || c.cluster.MachineNetworkCidr != "")
 }
@@ -362,9 +441,9 @@ func (v *validator) printBelongsToMachineCidr(c *validationContext, status Valid
 		if swag.BoolValue(c.cluster.UserManagedNetworking) {
 			return "No machine network CIDR validation needed: User Managed Networking"
 		}
-		return fmt.Sprintf("Host belongs to machine network CIDR %s", c.cluster.MachineNetworkCidr)
+		return fmt.Sprintf("Host belongs to machine network CIDR %s", strings.Join(network.MachineNetworkCidrs(c.cluster), ", "))
 	case ValidationFailure:
-		return fmt.Sprintf("Host does not belong to machine network CIDR %s", c.cluster.MachineNetworkCidr)
+		return fmt.Sprintf("Host does not belong to machine network CIDR %s", strings.Join(network.MachineNetworkCidrs(c.cluster), ", "))
 	case ValidationPending:
 		return "Missing inventory or machine network CIDR"
 	default:
@@ -486,7 +565,12 @@ func (v *validator) belongsToMajorityGroup(c *validationContext) ValidationStatu
 		v.log.WithError(err).Warn("Parse majority group")
 		return ValidationError
 	}
-	if funk.Contains(majorityGroups[c.cluster.MachineNetworkCidr], *c.host.ID) {
+	// The connectivity of a dual-stack cluster is checked in the machine network of every IP address family
+	inMajorityGroups := true
+	for _, cidr := range network.MachineNetworkCidrs(c.cluster) {
+		inMajorityGroups = inMajorityGroups && funk.Contains(majorityGroups[cidr], *c.host.ID)
+	}
+	if inMajorityGroups {
 		return ValidationSuccess
 	} else if getNumEnabledHosts(c.cluster.Hosts) < 3 {
 		// The minimum non disabled hosts for connectivity check is 3
@@ -522,6 +606,119 @@ func (v *validator) printBelongsToMajorityGroup(c *validationContext, status Val
 	}
 }
 
+func (v *validator) isMtuConsistent(c *validationContext) ValidationStatus {
+	if hostutil.IsDay2Host(c.host) || (swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsSingleNodeCluster(c.cluster)) {
+		return ValidationSuccess
+	}
+	if c.inventory == nil || c.cluster.MachineNetworkCidr == "" {
+		return ValidationPending
+	}
+	failure, err := v.mtuFailure(c)
+	if err != nil {
+		return ValidationError
+	}
+	return boolValue(failure == "")
+}
+
+// machineNetworkInterface returns the interface of the host with an address in the machine networks, with the lowest MTU
+func machineNetworkInterface(inventory *models.Inventory, machineNetworkCidrs []string) *models.Interface {
+	var ret *models.Interface
+	for _, intf := range network.LogicalInterfaces(inventory.Interfaces) {
+		for _, addr := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+			ip := strings.Split(addr, "/")[0]
+			for _, cidr := range machineNetworkCidrs {
+				if in, err := network.IpInCidr(ip, cidr); err == nil && in && (ret == nil || intf.Mtu < ret.Mtu) {
+					ret = intf
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// mtuFailure returns why the MTU of the host doesn't fit the machine network, or an empty string if it does. The
+// MTU must be at least the configured MTU of the cluster, or the MTU of the most hosts when it isn't configured, and
+// the connectivity check must not have lost do-not-fragment packets of that MTU in the machine network.
+func (v *validator) mtuFailure(c *validationContext) (string, error) {
+	machineNetworkCidrs := network.MachineNetworkCidrs(c.cluster)
+	intf := machineNetworkInterface(c.inventory, machineNetworkCidrs)
+	if intf == nil {
+		// The host doesn't belong to the machine network, which another validation reports
+		return "", nil
+	}
+
+	if c.cluster.Mtu > 0 {
+		if intf.Mtu < c.cluster.Mtu {
+			return fmt.Sprintf("The MTU of NIC %s is %d, lower than the MTU %d of the cluster", intf.Name, intf.Mtu, c.cluster.Mtu), nil
+		}
+	} else {
+		hostsByMtu := make(map[int64]int)
+		for _, h := range c.cluster.Hosts {
+			if h.ID.String() == c.host.ID.String() || swag.StringValue(h.Status) == models.HostStatusDisabled || h.Inventory == "" {
+				continue
+			}
+			inventory, err := hostutil.UnmarshalInventory(h.Inventory)
+			if err != nil {
+				v.log.WithError(err).Warnf("Parse inventory of host %s", h.ID)
+				continue
+			}
+			if peerIntf := machineNetworkInterface(inventory, machineNetworkCidrs); peerIntf != nil {
+				hostsByMtu[peerIntf.Mtu]++
+			}
+		}
+		// The MTU of the host is inconsistent if no fewer hosts share another MTU than the MTU of the host
+		for mtu, count := range hostsByMtu {
+			if mtu != intf.Mtu && count >= hostsByMtu[intf.Mtu]+1 {
+				return fmt.Sprintf("The MTU of NIC %s is %d, while the MTU of %d other hosts in the machine network is %d", intf.Name, intf.Mtu, count, mtu), nil
+			}
+		}
+	}
+
+	if c.host.Connectivity == "" {
+		return "", nil
+	}
+	var report models.ConnectivityReport
+	if err := json.Unmarshal([]byte(c.host.Connectivity), &report); err != nil {
+		v.log.WithError(err).Warn("Parse connectivity report")
+		return "", err
+	}
+	for _, remoteHost := range report.RemoteHosts {
+		for _, l3 := range remoteHost.L3Connectivity {
+			if l3.MtuProbeSuccessful == nil || *l3.MtuProbeSuccessful {
+				continue
+			}
+			for _, cidr := range machineNetworkCidrs {
+				if in, err := network.IpInCidr(l3.RemoteIPAddress, cidr); err == nil && in {
+					return fmt.Sprintf("The network path from NIC %s to %s doesn't support the MTU of the machine network", l3.OutgoingNic, l3.RemoteIPAddress), nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+func (v *validator) printMtuConsistent(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if hostutil.IsDay2Host(c.host) {
+			return "Day2 host MTU is not compared to the other hosts in the cluster"
+		}
+		if swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsSingleNodeCluster(c.cluster) {
+			return "No MTU validation needed: User Managed Networking"
+		}
+		return "The MTU of the host is consistent with the machine network"
+	case ValidationFailure:
+		failure, _ := v.mtuFailure(c)
+		return failure
+	case ValidationPending:
+		return "Missing inventory or machine network CIDR"
+	case ValidationError:
+		return "Parse error for connectivity report"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
 func (v *validator) isNTPSynced(c *validationContext) ValidationStatus {
 	var sources []*models.NtpSource
 
@@ -646,3 +843,44 @@ func (v *validator) printSufficientOrUnknownInstallationDiskSpeed(c *validationC
 		return fmt.Sprintf("Unexpected status %s", status.String())
 	}
 }
+
+// overriddenIgnitionPaths returns the paths of the values set by the service that the ignition config overrides of
+// the host replace or remove
+func (v *validator) overriddenIgnitionPaths(c *validationContext) ([]string, error) {
+	if c.host.IgnitionConfigOverrides == "" || v.ignitionDiffer == nil {
+		return nil, nil
+	}
+	diff, err := v.ignitionDiffer.HostIgnitionDiff(c.host)
+	if err != nil {
+		return nil, err
+	}
+	return configdiff.OverriddenPaths(diff), nil
+}
+
+// areIgnitionConfigOverridesSafe warns about ignition config overrides that replace values set by the service. It
+// doesn't block the installation, since the overrides may replace the values on purpose.
+func (v *validator) areIgnitionConfigOverridesSafe(c *validationContext) ValidationStatus {
+	paths, err := v.overriddenIgnitionPaths(c)
+	if err != nil {
+		return ValidationError
+	}
+	return boolValue(len(paths) == 0)
+}
+
+func (v *validator) printIgnitionConfigOverridesSafe(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if c.host.IgnitionConfigOverrides == "" {
+			return "The ignition config is not overridden"
+		}
+		return "The ignition config overrides don't replace values set by the service"
+	case ValidationFailure:
+		paths, _ := v.overriddenIgnitionPaths(c)
+		return fmt.Sprintf("The ignition config overrides replace values set by the service: %s", strings.Join(paths, ", "))
+	case ValidationError:
+		_, err := v.overriddenIgnitionPaths(c)
+		return fmt.Sprintf("Failed to compare the ignition config overrides with the values set by the service: %v", err)
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
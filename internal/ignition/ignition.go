@@ -9,6 +9,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net"
+	"net/http"
 	"net/url"
 	"os"
 	"os/exec"
@@ -29,7 +30,9 @@ import (
 	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/configdiff"
 	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/installercache"
 	"github.com/openshift/assisted-service/internal/manifests"
@@ -864,7 +867,8 @@ func (g *installerGenerator) updateIgnitions() error {
 	if err != nil {
 		return err
 	}
-	if ipv6Only {
+	// The hosts of dual-stack clusters get their IPv6 addresses from DHCPv6 as well
+	if ipv6Only || network.IsDualStack(g.cluster) {
 		for _, ignition := range []string{masterIgn, workerIgn} {
 			if err = g.addIpv6FileInIgnition(ignition); err != nil {
 				return err
@@ -1059,31 +1063,17 @@ func writeHostFiles(hosts []*models.Host, baseFile string, workDir string) error
 	for i := range hosts {
 		host := hosts[i]
 		g.Go(func() error {
-			config, err := parseIgnitionFile(filepath.Join(workDir, baseFile))
+			path := filepath.Join(workDir, baseFile)
+			roleConfigBytes, err := ioutil.ReadFile(path)
 			if err != nil {
-				return err
-			}
-
-			hostname, err := hostutil.GetCurrentHostName(host)
-			if err != nil {
-				return errors.Wrapf(err, "failed to get hostname for host %s", host.ID)
+				return errors.Errorf("error reading file %s: %v", path, err)
 			}
 
-			setFileInIgnition(config, "/etc/hostname", fmt.Sprintf("data:,%s", hostname), false, 420)
-
-			configBytes, err := json.Marshal(config)
+			configBytes, err := HostIgnition(roleConfigBytes, host, true)
 			if err != nil {
 				return err
 			}
 
-			if host.IgnitionConfigOverrides != "" {
-				merged, mergeErr := MergeIgnitionConfig(configBytes, []byte(host.IgnitionConfigOverrides))
-				if mergeErr != nil {
-					return errors.Wrapf(mergeErr, "failed to apply ignition config overrides for host %s", host.ID)
-				}
-				configBytes = []byte(merged)
-			}
-
 			err = ioutil.WriteFile(filepath.Join(workDir, hostutil.IgnitionFileName(host)), configBytes, 0600)
 			if err != nil {
 				return errors.Wrapf(err, "failed to write ignition for host %s", host.ID)
@@ -1096,6 +1086,104 @@ func writeHostFiles(hosts []*models.Host, baseFile string, workDir string) error
 	return g.Wait()
 }
 
+// HostIgnition returns the ignition config of the host, based on the ignition config generated for its role,
+// with or without the ignition config overrides of the host
+func HostIgnition(roleConfig []byte, host *models.Host, withOverrides bool) ([]byte, error) {
+	config, err := ParseToLatest(roleConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, err := hostutil.GetCurrentHostName(host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get hostname for host %s", host.ID)
+	}
+
+	setFileInIgnition(config, "/etc/hostname", fmt.Sprintf("data:,%s", hostname), false, 420)
+
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if withOverrides && host.IgnitionConfigOverrides != "" {
+		merged, mergeErr := MergeIgnitionConfig(configBytes, []byte(host.IgnitionConfigOverrides))
+		if mergeErr != nil {
+			return nil, errors.Wrapf(mergeErr, "failed to apply ignition config overrides for host %s", host.ID)
+		}
+		configBytes = []byte(merged)
+	}
+	return configBytes, nil
+}
+
+// EmptyRoleIgnitionConfig stands for the ignition config of a host role before the installation assets are generated
+const EmptyRoleIgnitionConfig = `{"ignition": {"version": "3.1.0"}}`
+
+// sensitiveIgnitionPaths are the paths of the ignition config whose values the differences don't show
+var sensitiveIgnitionPaths = []string{
+	"/ignition/config/merge/*/httpHeaders",
+	"/ignition/config/replace/httpHeaders",
+	"/passwd/users/*/passwordHash",
+}
+
+// RoleIgnition returns the ignition config generated for the role of the host. Until the installation assets of the
+// cluster are generated, it returns an empty ignition config.
+func RoleIgnition(ctx context.Context, objectHandler s3wrapper.API, host *models.Host) ([]byte, error) {
+	if host.Role != models.HostRoleMaster && host.Role != models.HostRoleWorker {
+		return []byte(EmptyRoleIgnitionConfig), nil
+	}
+	objectName := fmt.Sprintf("%s/%s.ign", host.ClusterID, host.Role)
+	exists, err := objectHandler.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to check if %s exists", objectName)
+	}
+	if !exists {
+		return []byte(EmptyRoleIgnitionConfig), nil
+	}
+	respBody, _, err := objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download %s", objectName)
+	}
+	defer respBody.Close()
+	return ioutil.ReadAll(respBody)
+}
+
+// HostIgnitionDiff returns the differences between the ignition config generated for the host and the one the host
+// gets after its ignition config overrides, without the sensitive values
+func HostIgnitionDiff(roleConfig []byte, host *models.Host) (*models.ConfigDiff, error) {
+	base, err := HostIgnition(roleConfig, host, false)
+	if err != nil {
+		return nil, err
+	}
+	effective, err := HostIgnition(roleConfig, host, true)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	diff, err := configdiff.Diff(base, effective)
+	if err != nil {
+		return nil, err
+	}
+	configdiff.Redact(diff, sensitiveIgnitionPaths...)
+	return diff, nil
+}
+
+type hostIgnitionDiffer struct {
+	objectHandler s3wrapper.API
+}
+
+// NewHostIgnitionDiffer returns the differences of the ignition config overrides of the hosts, for their validations
+func NewHostIgnitionDiffer(objectHandler s3wrapper.API) host.IgnitionDiffer {
+	return &hostIgnitionDiffer{objectHandler: objectHandler}
+}
+
+func (d *hostIgnitionDiffer) HostIgnitionDiff(h *models.Host) (*models.ConfigDiff, error) {
+	roleConfig, err := RoleIgnition(context.Background(), d.objectHandler, h)
+	if err != nil {
+		return nil, err
+	}
+	return HostIgnitionDiff(roleConfig, h)
+}
+
 // createHostIgnitions builds an ignition file for each host in the cluster based on the generated <role>.ign file
 func (g *installerGenerator) createHostIgnitions() error {
 	masters, workers := sortHosts(g.cluster.Hosts)
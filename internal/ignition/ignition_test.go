@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/configdiff"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/models"
@@ -772,6 +773,49 @@ var _ = Describe("ParseToLatest", func() {
 	})
 })
 
+var _ = Describe("HostIgnitionDiff", func() {
+	var host *models.Host
+
+	BeforeEach(func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &hostID, ClusterID: *cluster.ID, Role: models.HostRoleMaster, RequestedHostname: "master-0"}
+	})
+
+	It("returns the values of the service that the overrides replace", func() {
+		host.IgnitionConfigOverrides = `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/etc/hostname", "contents": {"source": "data:,other"}}]}}`
+		diff, err := HostIgnitionDiff([]byte(EmptyRoleIgnitionConfig), host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Operations).To(HaveLen(1))
+		Expect(*diff.Operations[0].Path).To(Equal("/storage/files/0/contents/source"))
+		Expect(diff.Operations[0].BaseValue).To(Equal("data:,master-0"))
+		Expect(diff.Operations[0].Value).To(Equal("data:,other"))
+	})
+
+	It("hides the sensitive values", func() {
+		roleConfig := `{"ignition": {"version": "3.1.0"}, "passwd": {"users": [{"name": "core", "passwordHash": "hash"}]}}`
+		host.IgnitionConfigOverrides = `{"ignition": {"version": "3.1.0"}, "passwd": {"users": [{"name": "core", "passwordHash": "other"}]}}`
+		diff, err := HostIgnitionDiff([]byte(roleConfig), host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Operations).To(HaveLen(1))
+		Expect(*diff.Operations[0].Path).To(Equal("/passwd/users/0/passwordHash"))
+		Expect(diff.Operations[0].BaseValue).To(Equal(configdiff.Redacted))
+		Expect(diff.Operations[0].Value).To(Equal(configdiff.Redacted))
+	})
+
+	It("compares with the generated ignition of the host role", func() {
+		mockS3Client := s3wrapper.NewMockAPI(ctrl)
+		masterIgnition := `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/etc/motd", "contents": {"source": "data:,hello"}}]}}`
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/master.ign", cluster.ID)).Return(true, nil).Times(1)
+		mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/master.ign", cluster.ID)).
+			Return(ioutil.NopCloser(strings.NewReader(masterIgnition)), int64(len(masterIgnition)), nil).Times(1)
+		host.IgnitionConfigOverrides = `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/etc/motd", "contents": {"source": "data:,bye"}}]}}`
+		diff, err := NewHostIgnitionDiffer(mockS3Client).HostIgnitionDiff(host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Operations).To(HaveLen(1))
+		Expect(diff.Operations[0].BaseValue).To(Equal("data:,hello"))
+	})
+})
+
 var _ = AfterEach(func() {
 	os.RemoveAll("manifests")
 })
@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/configdiff"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
@@ -19,6 +20,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
 	"gopkg.in/yaml.v2"
+	k8syaml "sigs.k8s.io/yaml"
 )
 
 type host struct {
@@ -58,20 +60,24 @@ type imageContentSource struct {
 	Source  string   `yaml:"source"`
 }
 
+type clusterNetwork struct {
+	Cidr       string `yaml:"cidr"`
+	HostPrefix int    `yaml:"hostPrefix"`
+}
+
+type machineNetwork struct {
+	Cidr string `yaml:"cidr"`
+}
+
 type InstallerConfigBaremetal struct {
 	APIVersion string `yaml:"apiVersion"`
 	BaseDomain string `yaml:"baseDomain"`
 	Proxy      *proxy `yaml:"proxy,omitempty"`
 	Networking struct {
-		NetworkType    string `yaml:"networkType"`
-		ClusterNetwork []struct {
-			Cidr       string `yaml:"cidr"`
-			HostPrefix int    `yaml:"hostPrefix"`
-		} `yaml:"clusterNetwork"`
-		MachineNetwork []struct {
-			Cidr string `yaml:"cidr"`
-		} `yaml:"machineNetwork,omitempty"`
-		ServiceNetwork []string `yaml:"serviceNetwork"`
+		NetworkType    string           `yaml:"networkType"`
+		ClusterNetwork []clusterNetwork `yaml:"clusterNetwork"`
+		MachineNetwork []machineNetwork `yaml:"machineNetwork,omitempty"`
+		ServiceNetwork []string         `yaml:"serviceNetwork"`
 	} `yaml:"networking"`
 	Metadata struct {
 		Name string `yaml:"name"`
@@ -122,8 +128,14 @@ func (c *InstallerConfigBaremetal) Validate() error {
 type InstallConfigBuilder interface {
 	GetInstallConfig(cluster *common.Cluster, addRhCa bool, ca string) ([]byte, error)
 	ValidateInstallConfigPatch(cluster *common.Cluster, patch string) error
+	// GetInstallConfigDiff returns the differences between the install config generated for the cluster and
+	// the effective install config after the install config overrides of the cluster
+	GetInstallConfigDiff(cluster *common.Cluster) (*models.ConfigDiff, error)
 }
 
+// sensitiveInstallConfigPaths are the paths of the install config whose values the differences don't show
+var sensitiveInstallConfigPaths = []string{"/pullSecret"}
+
 type installConfigBuilder struct {
 	log                     logrus.FieldLogger
 	mirrorRegistriesBuilder mirrorregistries.MirrorRegistriesConfigBuilder
@@ -157,7 +169,7 @@ func (i *installConfigBuilder) getBMHName(host *models.Host, masterIdx, workerId
 
 func (i *installConfigBuilder) getNetworkType(cluster *common.Cluster) string {
 	networkType := "OpenShiftSDN"
-	if network.IsIPv6CIDR(cluster.ClusterNetworkCidr) || network.IsIPv6CIDR(cluster.MachineNetworkCidr) || network.IsIPv6CIDR(cluster.ServiceNetworkCidr) {
+	if network.HasIPv6Network(cluster) {
 		networkType = "OVNKubernetes"
 	}
 	return networkType
@@ -175,7 +187,24 @@ func (i *installConfigBuilder) generateNoProxy(cluster *common.Cluster) string {
 	}
 	// Add internal OCP DNS domain
 	internalDnsDomain := "." + cluster.Name + "." + cluster.BaseDNSDomain
-	return strings.Join(append(splitNoProxy, internalDnsDomain, cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr), ",")
+	splitNoProxy = append(splitNoProxy, internalDnsDomain, cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr)
+	// Add the networks of the other IP address family of dual-stack clusters
+	for _, cidr := range network.MachineNetworkCidrs(cluster) {
+		if cidr != cluster.MachineNetworkCidr {
+			splitNoProxy = append(splitNoProxy, cidr)
+		}
+	}
+	for _, n := range network.ClusterNetworks(cluster) {
+		if n.Cidr != cluster.ClusterNetworkCidr {
+			splitNoProxy = append(splitNoProxy, n.Cidr)
+		}
+	}
+	for _, n := range network.ServiceNetworks(cluster) {
+		if n.Cidr != cluster.ServiceNetworkCidr {
+			splitNoProxy = append(splitNoProxy, n.Cidr)
+		}
+	}
+	return strings.Join(splitNoProxy, ",")
 }
 
 func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*InstallerConfigBaremetal, error) {
@@ -185,26 +214,16 @@ func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*
 		APIVersion: "v1",
 		BaseDomain: cluster.BaseDNSDomain,
 		Networking: struct {
-			NetworkType    string `yaml:"networkType"`
-			ClusterNetwork []struct {
-				Cidr       string `yaml:"cidr"`
-				HostPrefix int    `yaml:"hostPrefix"`
-			} `yaml:"clusterNetwork"`
-			MachineNetwork []struct {
-				Cidr string `yaml:"cidr"`
-			} `yaml:"machineNetwork,omitempty"`
-			ServiceNetwork []string `yaml:"serviceNetwork"`
+			NetworkType    string           `yaml:"networkType"`
+			ClusterNetwork []clusterNetwork `yaml:"clusterNetwork"`
+			MachineNetwork []machineNetwork `yaml:"machineNetwork,omitempty"`
+			ServiceNetwork []string         `yaml:"serviceNetwork"`
 		}{
 			NetworkType: networkType,
-			ClusterNetwork: []struct {
-				Cidr       string `yaml:"cidr"`
-				HostPrefix int    `yaml:"hostPrefix"`
-			}{
+			ClusterNetwork: []clusterNetwork{
 				{Cidr: cluster.ClusterNetworkCidr, HostPrefix: int(cluster.ClusterNetworkHostPrefix)},
 			},
-			MachineNetwork: []struct {
-				Cidr string `yaml:"cidr"`
-			}{
+			MachineNetwork: []machineNetwork{
 				{Cidr: cluster.MachineNetworkCidr},
 			},
 			ServiceNetwork: []string{cluster.ServiceNetworkCidr},
@@ -238,6 +257,10 @@ func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*
 		SSHKey:     cluster.SSHPublicKey,
 	}
 
+	if network.IsDualStack(cluster) {
+		setDualStackNetworking(cfg, cluster)
+	}
+
 	if cluster.HTTPProxy != "" || cluster.HTTPSProxy != "" {
 		cfg.Proxy = &proxy{
 			HTTPProxy:  cluster.HTTPProxy,
@@ -256,6 +279,22 @@ func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*
 	return cfg, nil
 }
 
+// setDualStackNetworking lists the networks of both IP address families, the ones of the primary family first
+func setDualStackNetworking(cfg *InstallerConfigBaremetal, cluster *common.Cluster) {
+	cfg.Networking.ClusterNetwork = make([]clusterNetwork, 0)
+	for _, n := range network.ClusterNetworks(cluster) {
+		cfg.Networking.ClusterNetwork = append(cfg.Networking.ClusterNetwork, clusterNetwork{Cidr: n.Cidr, HostPrefix: int(n.HostPrefix)})
+	}
+	cfg.Networking.MachineNetwork = make([]machineNetwork, 0)
+	for _, cidr := range network.MachineNetworkCidrs(cluster) {
+		cfg.Networking.MachineNetwork = append(cfg.Networking.MachineNetwork, machineNetwork{Cidr: cidr})
+	}
+	cfg.Networking.ServiceNetwork = make([]string, 0)
+	for _, n := range network.ServiceNetworks(cluster) {
+		cfg.Networking.ServiceNetwork = append(cfg.Networking.ServiceNetwork, n.Cidr)
+	}
+}
+
 func (i *installConfigBuilder) setImageContentSources(cfg *InstallerConfigBaremetal) error {
 	mirrorRegistriesConfigs, err := i.mirrorRegistriesBuilder.ExtractLocationMirrorDataFromRegistries()
 	if err != nil {
@@ -358,13 +397,14 @@ func (i *installConfigBuilder) getInstallConfig(cluster *common.Cluster, addRhCa
 		bootstrapCidr := network.GetMachineCidrForUserManagedNetwork(cluster, i.log)
 		if bootstrapCidr != "" {
 			i.log.Infof("None-Platform: Selected bootstrap machine network CIDR %s for cluster %s", bootstrapCidr, cluster.ID.String())
-			cfg.Networking.MachineNetwork = []struct {
-				Cidr string `yaml:"cidr"`
-			}{
+			cfg.Networking.MachineNetwork = []machineNetwork{
 				{Cidr: bootstrapCidr},
 			}
 			cluster.MachineNetworkCidr = bootstrapCidr
 			cfg.Networking.NetworkType = i.getNetworkType(cluster)
+			if network.IsDualStack(cluster) {
+				setDualStackNetworking(cfg, cluster)
+			}
 
 		} else {
 			cfg.Networking.MachineNetwork = nil
@@ -419,6 +459,34 @@ func (i *installConfigBuilder) ValidateInstallConfigPatch(cluster *common.Cluste
 	return config.Validate()
 }
 
+func (i *installConfigBuilder) GetInstallConfigDiff(cluster *common.Cluster) (*models.ConfigDiff, error) {
+	baseCluster := *cluster
+	baseCluster.InstallConfigOverrides = ""
+	base, err := i.getInstallConfigJSON(&baseCluster)
+	if err != nil {
+		return nil, err
+	}
+	effective, err := i.getInstallConfigJSON(cluster)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := configdiff.Diff(base, effective)
+	if err != nil {
+		return nil, err
+	}
+	configdiff.Redact(diff, sensitiveInstallConfigPaths...)
+	return diff, nil
+}
+
+// getInstallConfigJSON returns the install config as JSON, with the field names of the install config YAML
+func (i *installConfigBuilder) getInstallConfigJSON(cluster *common.Cluster) ([]byte, error) {
+	cfg, err := i.GetInstallConfig(cluster, false, "")
+	if err != nil {
+		return nil, err
+	}
+	return k8syaml.YAMLToJSON(cfg)
+}
+
 func (i *installConfigBuilder) getHypethreadingConfiguration(cluster *common.Cluster, machineType string) string {
 	switch cluster.Hyperthreading {
 	case models.ClusterHyperthreadingAll:
@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/configdiff"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"gopkg.in/yaml.v2"
@@ -178,6 +179,41 @@ var _ = Describe("installcfg", func() {
 		Expect(result.Networking.NetworkType).Should(Equal("OpenShiftSDN"))
 	})
 
+	It("returns the differences caused by the cluster overrides", func() {
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(4)
+		result, err := installConfig.GetInstallConfigDiff(&cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Operations).To(HaveLen(2))
+		Expect(*result.Operations[0].Path).To(Equal("/fips"))
+		Expect(result.Operations[0].Value).To(Equal(true))
+		Expect(*result.Operations[1].Path).To(Equal("/networking/networkType"))
+		Expect(*result.Operations[1].Op).To(Equal(models.ConfigDiffOperationOpReplace))
+		Expect(result.Operations[1].BaseValue).To(Equal("OpenShiftSDN"))
+		Expect(result.Operations[1].Value).To(Equal(OvnKubernetes))
+		Expect(result.Warnings).To(HaveLen(2))
+	})
+
+	It("hides the pull secret in the differences", func() {
+		cluster.PullSecret = "secret"
+		cluster.InstallConfigOverrides = `{"pullSecret": "other"}`
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(4)
+		result, err := installConfig.GetInstallConfigDiff(&cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Operations).To(HaveLen(1))
+		Expect(*result.Operations[0].Path).To(Equal("/pullSecret"))
+		Expect(result.Operations[0].BaseValue).To(Equal(configdiff.Redacted))
+		Expect(result.Operations[0].Value).To(Equal(configdiff.Redacted))
+	})
+
+	It("returns no differences with empty overrides", func() {
+		cluster.InstallConfigOverrides = ""
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(4)
+		result, err := installConfig.GetInstallConfigDiff(&cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Operations).To(BeEmpty())
+		Expect(result.Warnings).To(BeEmpty())
+	})
+
 	It("doesn't fail with empty overrides, IPv6 machine CIDR", func() {
 		var result InstallerConfigBaremetal
 		cluster.InstallConfigOverrides = ""
@@ -312,6 +348,31 @@ var _ = Describe("installcfg", func() {
 		Expect(result.Networking.NetworkType).Should(Equal(OvnKubernetes))
 	})
 
+	It("Dual-stack networking", func() {
+		var result InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = ""
+		cluster.ClusterNetworkCidr = "10.128.0.0/14"
+		cluster.ClusterNetworkHostPrefix = 23
+		cluster.ServiceNetworkCidr = "172.30.0.0/16"
+		cluster.MachineNetworks = `[{"cidr":"1001:db8::/120"}]`
+		cluster.ClusterNetworks = `[{"cidr":"fd01::/48","host_prefix":64}]`
+		cluster.ServiceNetworks = `[{"cidr":"fd02::/112"}]`
+		cluster.HTTPProxy = "http://proxy.example.com:3128"
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+		data, err := installConfig.GetInstallConfig(&cluster, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Networking.NetworkType).Should(Equal(OvnKubernetes))
+		Expect(result.Networking.MachineNetwork).Should(Equal([]machineNetwork{{Cidr: cluster.MachineNetworkCidr}, {Cidr: "1001:db8::/120"}}))
+		Expect(result.Networking.ClusterNetwork).Should(Equal([]clusterNetwork{
+			{Cidr: cluster.ClusterNetworkCidr, HostPrefix: int(cluster.ClusterNetworkHostPrefix)},
+			{Cidr: "fd01::/48", HostPrefix: 64},
+		}))
+		Expect(result.Networking.ServiceNetwork).Should(Equal([]string{cluster.ServiceNetworkCidr, "fd02::/112"}))
+		Expect(result.Proxy.NoProxy).Should(HaveSuffix(",10.128.0.0/14,172.30.0.0/16,1001:db8::/120,fd01::/48,fd02::/112"))
+	})
+
 	It("UserManagedNetworking BareMetal", func() {
 		var result InstallerConfigBaremetal
 		cluster.InstallConfigOverrides = ""
@@ -7,6 +7,7 @@ package installcfg
 import (
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	models "github.com/openshift/assisted-service/models"
 	reflect "reflect"
 )
 
@@ -61,3 +62,18 @@ func (mr *MockInstallConfigBuilderMockRecorder) ValidateInstallConfigPatch(clust
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateInstallConfigPatch", reflect.TypeOf((*MockInstallConfigBuilder)(nil).ValidateInstallConfigPatch), cluster, patch)
 }
+
+// GetInstallConfigDiff mocks base method
+func (m *MockInstallConfigBuilder) GetInstallConfigDiff(cluster *common.Cluster) (*models.ConfigDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstallConfigDiff", cluster)
+	ret0, _ := ret[0].(*models.ConfigDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstallConfigDiff indicates an expected call of GetInstallConfigDiff
+func (mr *MockInstallConfigBuilderMockRecorder) GetInstallConfigDiff(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstallConfigDiff", reflect.TypeOf((*MockInstallConfigBuilder)(nil).GetInstallConfigDiff), cluster)
+}
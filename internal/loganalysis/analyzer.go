@@ -0,0 +1,193 @@
+package loganalysis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockgen -source=analyzer.go -package=loganalysis -destination=mock_analyzer.go
+
+type Analyzer interface {
+	// AnalyzeLogs queues an uploaded logs archive to be searched for known failures in the background. The
+	// findings replace those of the previous logs of the same type that were uploaded from the same host.
+	// The logs are not analyzed when the queue is full.
+	AnalyzeLogs(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, logsType models.LogsType, objectName string)
+	// Start starts the workers that analyze the queued logs
+	Start()
+	// Stop cancels the running analyses and waits for the workers to exit
+	Stop()
+}
+
+type Config struct {
+	Enabled   bool          `envconfig:"LOG_ANALYSIS_ENABLED" default:"true"`
+	Timeout   time.Duration `envconfig:"LOG_ANALYSIS_TIMEOUT" default:"10m"`
+	Workers   int           `envconfig:"LOG_ANALYSIS_WORKERS" default:"2"`
+	QueueSize int           `envconfig:"LOG_ANALYSIS_QUEUE_SIZE" default:"100"`
+}
+
+type analysisJob struct {
+	requestID  string
+	clusterID  strfmt.UUID
+	hostID     *strfmt.UUID
+	logsType   models.LogsType
+	objectName string
+}
+
+type analyzer struct {
+	config        Config
+	db            *gorm.DB
+	log           logrus.FieldLogger
+	objectHandler s3wrapper.API
+	eventsHandler events.Handler
+	rules         []*Rule
+	queue         chan *analysisJob
+	ctx           context.Context
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+func NewAnalyzer(cfg Config, db *gorm.DB, log logrus.FieldLogger, objectHandler s3wrapper.API, eventsHandler events.Handler) Analyzer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &analyzer{
+		config:        cfg,
+		db:            db,
+		log:           log,
+		objectHandler: objectHandler,
+		eventsHandler: eventsHandler,
+		rules:         DefaultRules,
+		queue:         make(chan *analysisJob, cfg.QueueSize),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+func (a *analyzer) AnalyzeLogs(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, logsType models.LogsType, objectName string) {
+	if !a.config.Enabled {
+		return
+	}
+	job := &analysisJob{
+		requestID:  requestid.FromContext(ctx),
+		clusterID:  clusterID,
+		hostID:     hostID,
+		logsType:   logsType,
+		objectName: objectName,
+	}
+	if a.ctx.Err() != nil {
+		logutil.FromContext(ctx, a.log).Warnf("Log analysis is stopped, not analyzing logs %s of cluster %s", objectName, clusterID)
+		return
+	}
+	select {
+	case a.queue <- job:
+	default:
+		logutil.FromContext(ctx, a.log).Warnf("Log analysis queue is full, not analyzing logs %s of cluster %s", objectName, clusterID)
+	}
+}
+
+func (a *analyzer) Start() {
+	if !a.config.Enabled {
+		return
+	}
+	workers := a.config.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	a.log.Infof("Starting %d log analysis workers", workers)
+	for i := 0; i < workers; i++ {
+		a.wg.Add(1)
+		go a.work()
+	}
+}
+
+func (a *analyzer) Stop() {
+	a.cancel()
+	a.wg.Wait()
+	a.log.Info("Stopped log analysis workers")
+}
+
+func (a *analyzer) work() {
+	defer a.wg.Done()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case job := <-a.queue:
+			ctx := requestid.ToContext(a.ctx, job.requestID)
+			if err := a.analyze(ctx, job.clusterID, job.hostID, job.logsType, job.objectName); err != nil {
+				logutil.FromContext(ctx, a.log).WithError(err).Warnf("Failed to analyze logs %s of cluster %s", job.objectName, job.clusterID)
+			}
+		}
+	}
+}
+
+func (a *analyzer) analyze(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, logsType models.LogsType, objectName string) error {
+	log := logutil.FromContext(ctx, a.log)
+	ctx, cancel := context.WithTimeout(ctx, a.config.Timeout)
+	defer cancel()
+
+	reader, _, err := a.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download %s", objectName)
+	}
+	defer reader.Close()
+	findings, err := Scan(reader, a.rules)
+	if err != nil {
+		return err
+	}
+
+	now := strfmt.DateTime(time.Now())
+	for _, finding := range findings {
+		id := strfmt.UUID(uuid.New().String())
+		finding.ID = &id
+		finding.ClusterID = &clusterID
+		finding.HostID = uuidValue(hostID)
+		finding.LogsType = logsType
+		finding.CreatedAt = &now
+	}
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		if err = tx.Where("cluster_id = ? and host_id = ? and logs_type = ?", clusterID.String(), uuidValue(hostID).String(), logsType).
+			Delete(&models.LogFinding{}).Error; err != nil {
+			return err
+		}
+		for _, finding := range findings {
+			if err = tx.Create(finding).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to store the analysis of logs %s", objectName)
+	}
+	log.Infof("Found %d known failures in logs %s of cluster %s", len(findings), objectName, clusterID)
+
+	for _, finding := range findings {
+		if finding.Severity != models.LogFindingSeverityCritical {
+			continue
+		}
+		a.eventsHandler.AddEvent(ctx, clusterID, hostID, models.EventSeverityCritical,
+			fmt.Sprintf("Known failure found in the %s logs: %s (%d matching lines in %s)",
+				logsType, swag.StringValue(finding.Title), finding.Matches, finding.File), time.Now())
+	}
+	return nil
+}
+
+func uuidValue(id *strfmt.UUID) strfmt.UUID {
+	if id == nil {
+		return ""
+	}
+	return *id
+}
@@ -0,0 +1,53 @@
+package loganalysis
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/logs"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.LogsAPI = &Api{}
+
+type Api struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:  db,
+		log: log,
+	}
+}
+
+func (a *Api) GetClusterLogsAnalysis(ctx context.Context, params operations.GetClusterLogsAnalysisParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	if err := a.db.First(&common.Cluster{}, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	query := a.db.Where("cluster_id = ?", params.ClusterID.String())
+	if params.HostID != nil {
+		query = query.Where("host_id = ?", params.HostID.String())
+	}
+	if len(params.Severities) > 0 {
+		query = query.Where("severity in (?)", params.Severities)
+	}
+	findings := models.LogFindings{}
+	if err := query.Order("created_at").Order("rule_id").Find(&findings).Error; err != nil {
+		log.WithError(err).Errorf("failed to get logs analysis of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewGetClusterLogsAnalysisOK().WithPayload(findings)
+}
@@ -0,0 +1,263 @@
+package loganalysis
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	operations "github.com/openshift/assisted-service/restapi/operations/logs"
+)
+
+func TestLogAnalysis(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Log analysis test Suite")
+}
+
+// archive returns a tar archive of the files, gzipped if requested
+func archive(files map[string][]byte, gzipped bool) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for name, content := range files {
+		Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write(content)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	if !gzipped {
+		return buf.Bytes()
+	}
+	return gzipBytes(buf.Bytes())
+}
+
+func gzipBytes(content []byte) []byte {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	_, err := gw.Write(content)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(gw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func ruleIDs(findings []*models.LogFinding) []string {
+	ret := make([]string, 0, len(findings))
+	for _, f := range findings {
+		ret = append(ret, *f.RuleID)
+	}
+	return ret
+}
+
+var _ = Describe("Scan", func() {
+	It("finds nothing in clean logs", func() {
+		findings, err := Scan(bytes.NewReader(archive(map[string][]byte{
+			"journal.log": []byte("Started Assisted Installer Agent.\nHost is ready\n"),
+		}, true)), DefaultRules)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("matches the known failures in plain, gzipped and nested files", func() {
+		nested := archive(map[string][]byte{
+			"bootstrap/journals/bootkube.log": []byte("bootkube.sh[2212]: Error: error while checking pod status: timed out waiting for the condition\n"),
+		}, true)
+		findings, err := Scan(bytes.NewReader(archive(map[string][]byte{
+			"etcd.log": []byte(strings.Repeat("etcdserver: request timed out\n", 3)),
+			"crio.log.gz": gzipBytes([]byte(`Error pulling image "quay.io/openshift-release-dev/ocp-release": ` +
+				"reading manifest 4.8: unauthorized: authentication required\n")),
+			"log-bundle.tar.gz": nested,
+			"ignition.log":      []byte("ignition[789]: GET error: Get \"https://10.0.0.5:22623/config/master\": dial tcp 10.0.0.5:22623: i/o timeout\n"),
+			"installer.log":     []byte("wipefs: error: /dev/sda: probing initialization failed: Device or resource busy\n"),
+		}, true)), DefaultRules)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleIDs(findings)).To(ConsistOf("etcd-quorum-lost", "image-pull-unauthorized", "bootkube-stuck", "ignition-fetch-timeout", "disk-wipe-failed"))
+		for _, f := range findings {
+			Expect(f.Severity).To(Equal(models.LogFindingSeverityCritical))
+			switch *f.RuleID {
+			case "etcd-quorum-lost":
+				Expect(f.Matches).To(Equal(int64(3)))
+				Expect(f.File).To(Equal("etcd.log"))
+				Expect(f.Excerpt).To(Equal("etcdserver: request timed out"))
+			case "image-pull-unauthorized":
+				Expect(f.File).To(Equal("crio.log"))
+			case "bootkube-stuck":
+				Expect(f.File).To(Equal("bootstrap/journals/bootkube.log"))
+			}
+		}
+	})
+
+	It("accepts uncompressed archives", func() {
+		findings, err := Scan(bytes.NewReader(archive(map[string][]byte{
+			"journal.log": []byte("write error: No space left on device\n"),
+		}, false)), DefaultRules)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleIDs(findings)).To(ConsistOf("no-space-left"))
+		Expect(findings[0].Severity).To(Equal(models.LogFindingSeverityWarning))
+	})
+
+	It("only searches the files of rules that are limited to some files", func() {
+		rules := []*Rule{{ID: "kubelet", Severity: models.LogFindingSeverityInfo, Title: "kubelet", Files: []string{"kubelet*.log"},
+			Pattern: DefaultRules[len(DefaultRules)-1].Pattern}}
+		findings, err := Scan(bytes.NewReader(archive(map[string][]byte{
+			"journal.log":       []byte("No space left on device\n"),
+			"nodes/kubelet.log": []byte("No space left on device\n"),
+		}, true)), rules)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(findings[0].File).To(Equal("nodes/kubelet.log"))
+	})
+
+	It("skips the lines that are too long and matches the following lines", func() {
+		findings, err := Scan(bytes.NewReader(archive(map[string][]byte{
+			"journal.log": []byte(strings.Repeat("x", 2*maxLineLength) + " No space left on device\n" +
+				"write error: No space left on device\n"),
+		}, true)), DefaultRules)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleIDs(findings)).To(ConsistOf("no-space-left"))
+		Expect(findings[0].Matches).To(Equal(int64(1)))
+		Expect(findings[0].Excerpt).To(Equal("write error: No space left on device"))
+	})
+
+	It("truncates the excerpt on a rune boundary", func() {
+		findings, err := Scan(bytes.NewReader(archive(map[string][]byte{
+			"journal.log": []byte("No space left on device: " + strings.Repeat("é", maxExcerptLength) + "\n"),
+		}, true)), DefaultRules)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(utf8.ValidString(findings[0].Excerpt)).To(BeTrue())
+		Expect(len(findings[0].Excerpt)).To(Equal(maxExcerptLength - 1))
+	})
+
+	It("fails for content that is not an archive", func() {
+		_, err := Scan(strings.NewReader("not an archive at all, but long enough to have a tar header...."+strings.Repeat(".", 512)), DefaultRules)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Analyzer", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockS3        *s3wrapper.MockAPI
+		mockEvents    *events.MockHandler
+		a             *analyzer
+		api           *Api
+		clusterID     strfmt.UUID
+		hostID        strfmt.UUID
+		objectName    string
+		criticalLogs  []byte
+		getFindings   func(params operations.GetClusterLogsAnalysisParams) models.LogFindings
+		expectOneScan func(content []byte)
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockS3 = s3wrapper.NewMockAPI(ctrl)
+		mockEvents = events.NewMockHandler(ctrl)
+		a = NewAnalyzer(Config{Enabled: true, Timeout: time.Minute}, db, common.GetTestLog(), mockS3, mockEvents).(*analyzer)
+		api = NewApi(db, common.GetTestLog())
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		objectName = clusterID.String() + "/logs/" + hostID.String() + "/logs.tar.gz"
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		criticalLogs = archive(map[string][]byte{
+			"etcd.log":    []byte("etcdserver: no leader\n"),
+			"journal.log": []byte("x509: certificate has expired or is not yet valid\n"),
+		}, true)
+
+		getFindings = func(params operations.GetClusterLogsAnalysisParams) models.LogFindings {
+			params.ClusterID = clusterID
+			reply := api.GetClusterLogsAnalysis(ctx, params)
+			Expect(reply).Should(BeAssignableToTypeOf(operations.NewGetClusterLogsAnalysisOK()))
+			return reply.(*operations.GetClusterLogsAnalysisOK).Payload
+		}
+		expectOneScan = func(content []byte) {
+			mockS3.EXPECT().Download(gomock.Any(), objectName).Return(ioutil.NopCloser(bytes.NewReader(content)), int64(len(content)), nil).Times(1)
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("stores the findings and raises events for the critical ones", func() {
+		expectOneScan(criticalLogs)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityCritical,
+			"Known failure found in the host logs: Etcd lost its quorum (1 matching lines in etcd.log)", gomock.Any()).Times(1)
+		Expect(a.analyze(ctx, clusterID, &hostID, models.LogsTypeHost, objectName)).To(Succeed())
+
+		findings := getFindings(operations.GetClusterLogsAnalysisParams{})
+		Expect(ruleIDs(findings)).To(ConsistOf("etcd-quorum-lost", "x509-certificate-invalid"))
+		for _, f := range findings {
+			Expect(*f.ClusterID).To(Equal(clusterID))
+			Expect(f.HostID).To(Equal(hostID))
+			Expect(f.LogsType).To(Equal(models.LogsTypeHost))
+		}
+
+		findings = getFindings(operations.GetClusterLogsAnalysisParams{Severities: []string{string(models.LogFindingSeverityWarning)}})
+		Expect(ruleIDs(findings)).To(ConsistOf("x509-certificate-invalid"))
+
+		otherHostID := strfmt.UUID(uuid.New().String())
+		Expect(getFindings(operations.GetClusterLogsAnalysisParams{HostID: &otherHostID})).To(BeEmpty())
+	})
+
+	It("replaces the findings of the previous logs of the host", func() {
+		expectOneScan(criticalLogs)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityCritical, gomock.Any(), gomock.Any()).Times(1)
+		Expect(a.analyze(ctx, clusterID, &hostID, models.LogsTypeHost, objectName)).To(Succeed())
+
+		expectOneScan(archive(map[string][]byte{"journal.log": []byte("all good\n")}, true))
+		Expect(a.analyze(ctx, clusterID, &hostID, models.LogsTypeHost, objectName)).To(Succeed())
+		Expect(getFindings(operations.GetClusterLogsAnalysisParams{})).To(BeEmpty())
+	})
+
+	It("analyzes the queued logs in the workers until it is stopped", func() {
+		a = NewAnalyzer(Config{Enabled: true, Timeout: time.Minute, Workers: 1, QueueSize: 1}, db, common.GetTestLog(), mockS3, mockEvents).(*analyzer)
+		expectOneScan(criticalLogs)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityCritical, gomock.Any(), gomock.Any()).Times(1)
+		a.Start()
+		a.AnalyzeLogs(ctx, clusterID, &hostID, models.LogsTypeHost, objectName)
+		Eventually(func() int {
+			return len(getFindings(operations.GetClusterLogsAnalysisParams{}))
+		}).Should(Equal(2))
+		a.Stop()
+
+		a.AnalyzeLogs(ctx, clusterID, &hostID, models.LogsTypeHost, objectName)
+		Expect(a.queue).To(BeEmpty())
+	})
+
+	It("skips the logs when the queue is full", func() {
+		a = NewAnalyzer(Config{Enabled: true, Timeout: time.Minute, Workers: 1, QueueSize: 1}, db, common.GetTestLog(), mockS3, mockEvents).(*analyzer)
+		a.AnalyzeLogs(ctx, clusterID, &hostID, models.LogsTypeHost, objectName)
+		a.AnalyzeLogs(ctx, clusterID, &hostID, models.LogsTypeController, objectName)
+		Expect(a.queue).To(HaveLen(1))
+	})
+
+	It("fails for a missing cluster", func() {
+		missingID := strfmt.UUID(uuid.New().String())
+		reply := api.GetClusterLogsAnalysis(ctx, operations.GetClusterLogsAnalysisParams{ClusterID: missingID})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+})
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: analyzer.go
+
+// Package loganalysis is a generated GoMock package.
+package loganalysis
+
+import (
+	context "context"
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
+	reflect "reflect"
+)
+
+// MockAnalyzer is a mock of Analyzer interface
+type MockAnalyzer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyzerMockRecorder
+}
+
+// MockAnalyzerMockRecorder is the mock recorder for MockAnalyzer
+type MockAnalyzerMockRecorder struct {
+	mock *MockAnalyzer
+}
+
+// NewMockAnalyzer creates a new mock instance
+func NewMockAnalyzer(ctrl *gomock.Controller) *MockAnalyzer {
+	mock := &MockAnalyzer{ctrl: ctrl}
+	mock.recorder = &MockAnalyzerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAnalyzer) EXPECT() *MockAnalyzerMockRecorder {
+	return m.recorder
+}
+
+// AnalyzeLogs mocks base method
+func (m *MockAnalyzer) AnalyzeLogs(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, logsType models.LogsType, objectName string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AnalyzeLogs", ctx, clusterID, hostID, logsType, objectName)
+}
+
+// AnalyzeLogs indicates an expected call of AnalyzeLogs
+func (mr *MockAnalyzerMockRecorder) AnalyzeLogs(ctx, clusterID, hostID, logsType, objectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeLogs", reflect.TypeOf((*MockAnalyzer)(nil).AnalyzeLogs), ctx, clusterID, hostID, logsType, objectName)
+}
+
+// Start mocks base method
+func (m *MockAnalyzer) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start
+func (mr *MockAnalyzerMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockAnalyzer)(nil).Start))
+}
+
+// Stop mocks base method
+func (m *MockAnalyzer) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockAnalyzerMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockAnalyzer)(nil).Stop))
+}
@@ -0,0 +1,87 @@
+package loganalysis
+
+import (
+	"path"
+	"regexp"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// Rule is a signature of a known installation failure that is searched for in the uploaded logs
+type Rule struct {
+	ID          string
+	Severity    models.LogFindingSeverity
+	Title       string
+	Remediation string
+	// Files limits the rule to the files whose base name matches one of the glob patterns. All the files
+	// are searched when it is empty.
+	Files []string
+	// Pattern is matched against every line of the searched files
+	Pattern *regexp.Regexp
+}
+
+func (r *Rule) matchesFile(name string) bool {
+	if len(r.Files) == 0 {
+		return true
+	}
+	base := path.Base(name)
+	for _, pattern := range r.Files {
+		if ok, _ := path.Match(pattern, base); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultRules is the catalogue of the known failures
+var DefaultRules = []*Rule{
+	{
+		ID:          "bootkube-stuck",
+		Severity:    models.LogFindingSeverityCritical,
+		Title:       "Bootkube failed to bring up the control plane on the bootstrap host",
+		Remediation: "Check the connectivity between the masters and the API VIP, and that the masters were able to pull the release images.",
+		Pattern:     regexp.MustCompile(`(?i)bootkube.*(timed out waiting for the condition|failed with result|main process exited, code=exited)`),
+	},
+	{
+		ID:          "etcd-quorum-lost",
+		Severity:    models.LogFindingSeverityCritical,
+		Title:       "Etcd lost its quorum",
+		Remediation: "Check the network latency between the masters and the speed of their installation disks.",
+		Pattern:     regexp.MustCompile(`(?i)(etcdserver: (no leader|request timed out|leader changed)|lost quorum|etcd cluster is unavailable or misconfigured)`),
+	},
+	{
+		ID:          "image-pull-unauthorized",
+		Severity:    models.LogFindingSeverityCritical,
+		Title:       "The registry rejected the credentials of the pull secret",
+		Remediation: "Make sure that the pull secret of the cluster has valid credentials for all the registries of the release images.",
+		Pattern:     regexp.MustCompile(`(?i)(pull|manifest|registry|image).*(401 unauthorized|unauthorized: authentication required|unauthorized: access to the requested resource is not authorized)`),
+	},
+	{
+		ID:          "ignition-fetch-timeout",
+		Severity:    models.LogFindingSeverityCritical,
+		Title:       "Fetching the ignition configuration timed out",
+		Remediation: "Check that the host can reach the machine config server on the API VIP, port 22623, or the assisted service.",
+		Pattern:     regexp.MustCompile(`(?i)ignition.*(GET error|i/o timeout|context deadline exceeded|connection timed out)`),
+	},
+	{
+		ID:          "disk-wipe-failed",
+		Severity:    models.LogFindingSeverityCritical,
+		Title:       "The installation disk could not be wiped",
+		Remediation: "Make sure that the installation disk is not in use, e.g. by an LVM volume group or a software RAID, or wipe it manually.",
+		Pattern:     regexp.MustCompile(`(?i)(failed to (wipe|clean|erase|format) (the )?(installation )?(disk|device|partition)|wipefs:.*(error|failed|probing initialization failed)|sgdisk:.*(error|failed))`),
+	},
+	{
+		ID:          "x509-certificate-invalid",
+		Severity:    models.LogFindingSeverityWarning,
+		Title:       "A certificate was rejected",
+		Remediation: "Check the clock of the hosts and that custom CA certificates are trusted by the hosts.",
+		Pattern:     regexp.MustCompile(`x509: certificate (has expired or is not yet valid|signed by unknown authority)`),
+	},
+	{
+		ID:          "no-space-left",
+		Severity:    models.LogFindingSeverityWarning,
+		Title:       "A host ran out of disk space",
+		Remediation: "Use a larger installation disk.",
+		Pattern:     regexp.MustCompile(`(?i)no space left on device`),
+	},
+}
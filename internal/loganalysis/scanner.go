@@ -0,0 +1,188 @@
+package loganalysis
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxNestingDepth is how deep archives that are nested in the logs archive are unpacked
+	maxNestingDepth = 3
+	// maxLineLength is the longest line that is matched against the rules, longer lines are skipped
+	maxLineLength = 1024 * 1024
+	// maxExcerptLength is the longest excerpt that is stored for a finding
+	maxExcerptLength = 512
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// scanner matches the files of a logs archive against the rules and aggregates a finding per matching rule
+type scanner struct {
+	rules    []*Rule
+	findings map[string]*models.LogFinding
+	order    []string
+}
+
+// Scan unpacks a logs archive, which may be gzipped and may contain nested gzipped archives and files,
+// and returns a finding for every rule that matched any of its lines
+func Scan(r io.Reader, rules []*Rule) ([]*models.LogFinding, error) {
+	s := &scanner{rules: rules, findings: make(map[string]*models.LogFinding)}
+	if err := s.scanArchive(r, 0); err != nil {
+		return nil, err
+	}
+	ret := make([]*models.LogFinding, 0, len(s.order))
+	for _, id := range s.order {
+		ret = append(ret, s.findings[id])
+	}
+	return ret, nil
+}
+
+func (s *scanner) scanArchive(r io.Reader, depth int) error {
+	r, err := decompress(r)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to read logs archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err = s.scanEntry(header.Name, tr, depth); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *scanner) scanEntry(name string, r io.Reader, depth int) error {
+	if isArchive(name) {
+		if depth >= maxNestingDepth {
+			return nil
+		}
+		// A nested archive that can't be read doesn't invalidate the rest of the logs
+		_ = s.scanArchive(r, depth+1)
+		return nil
+	}
+	if strings.HasSuffix(name, ".gz") {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil
+		}
+		defer gz.Close()
+		r = gz
+		name = strings.TrimSuffix(name, ".gz")
+	}
+	return s.scanFile(name, r)
+}
+
+func (s *scanner) scanFile(name string, r io.Reader) error {
+	var rules []*Rule
+	for _, rule := range s.rules {
+		if rule.matchesFile(name) {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	lines := bufio.NewReaderSize(r, 64*1024)
+	for {
+		line, err := readLine(lines)
+		if line != nil {
+			for _, rule := range rules {
+				if rule.Pattern.Match(line) {
+					s.addMatch(rule, name, string(line))
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", name)
+		}
+	}
+}
+
+// readLine returns the next line without its line ending. A line that is longer than maxLineLength is
+// read to its end and skipped, which is reported by a nil line.
+func readLine(r *bufio.Reader) ([]byte, error) {
+	var line []byte
+	tooLong := false
+	for {
+		chunk, err := r.ReadSlice('\n')
+		if !tooLong && len(line)+len(chunk) > maxLineLength+len("\r\n") {
+			tooLong = true
+			line = nil
+		}
+		if !tooLong {
+			line = append(line, chunk...)
+		}
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if tooLong || (err == io.EOF && len(line) == 0) {
+			return nil, err
+		}
+		line = bytes.TrimSuffix(line, []byte("\n"))
+		return bytes.TrimSuffix(line, []byte("\r")), err
+	}
+}
+
+func (s *scanner) addMatch(rule *Rule, file string, line string) {
+	if finding, ok := s.findings[rule.ID]; ok {
+		finding.Matches++
+		return
+	}
+	excerpt := strings.TrimSpace(line)
+	if len(excerpt) > maxExcerptLength {
+		// Drops the rune that the truncation splits
+		excerpt = strings.ToValidUTF8(excerpt[:maxExcerptLength], "")
+	}
+	s.findings[rule.ID] = &models.LogFinding{
+		RuleID:      swag.String(rule.ID),
+		Severity:    rule.Severity,
+		Title:       swag.String(rule.Title),
+		Remediation: rule.Remediation,
+		File:        file,
+		Excerpt:     excerpt,
+		Matches:     1,
+	}
+	s.order = append(s.order, rule.ID)
+}
+
+func isArchive(name string) bool {
+	return strings.HasSuffix(name, ".tar") || strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".tgz")
+}
+
+// decompress returns a reader of the uncompressed content of a stream that may be gzipped
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "failed to read logs archive")
+	}
+	if !bytes.Equal(magic, gzipMagic) {
+		return br, nil
+	}
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decompress logs archive")
+	}
+	return gz, nil
+}
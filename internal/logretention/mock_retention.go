@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: retention.go
+
+// Package logretention is a generated GoMock package.
+package logretention
+
+import (
+	context "context"
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+	common "github.com/openshift/assisted-service/internal/common"
+	models "github.com/openshift/assisted-service/models"
+	io "io"
+	reflect "reflect"
+)
+
+// MockAPI is a mock of API interface
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// CheckQuota mocks base method
+func (m *MockAPI) CheckQuota(ctx context.Context, cluster *common.Cluster, objectName string, uploadSize int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckQuota", ctx, cluster, objectName, uploadSize)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckQuota indicates an expected call of CheckQuota
+func (mr *MockAPIMockRecorder) CheckQuota(ctx, cluster, objectName, uploadSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckQuota", reflect.TypeOf((*MockAPI)(nil).CheckQuota), ctx, cluster, objectName, uploadSize)
+}
+
+// LimitUpload mocks base method
+func (m *MockAPI) LimitUpload(ctx context.Context, cluster *common.Cluster, objectName string, upload io.Reader) (*UploadReader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitUpload", ctx, cluster, objectName, upload)
+	ret0, _ := ret[0].(*UploadReader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LimitUpload indicates an expected call of LimitUpload
+func (mr *MockAPIMockRecorder) LimitUpload(ctx, cluster, objectName, upload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitUpload", reflect.TypeOf((*MockAPI)(nil).LimitUpload), ctx, cluster, objectName, upload)
+}
+
+// RecordUpload mocks base method
+func (m *MockAPI) RecordUpload(ctx context.Context, cluster *common.Cluster, hostID *strfmt.UUID, logsType models.LogsType, objectName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUpload", ctx, cluster, hostID, logsType, objectName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordUpload indicates an expected call of RecordUpload
+func (mr *MockAPIMockRecorder) RecordUpload(ctx, cluster, hostID, logsType, objectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUpload", reflect.TypeOf((*MockAPI)(nil).RecordUpload), ctx, cluster, hostID, logsType, objectName)
+}
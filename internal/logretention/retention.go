@@ -0,0 +1,304 @@
+package logretention
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockgen -source=retention.go -package=logretention -destination=mock_retention.go
+
+type API interface {
+	// CheckQuota fails with a 413 API error if uploading the logs object would exceed the log storage
+	// quota of the cluster or of its organization. The size of a previous object with the same name is
+	// not counted, since the upload replaces it.
+	CheckQuota(ctx context.Context, cluster *common.Cluster, objectName string, uploadSize int64) error
+	// LimitUpload returns a reader of the uploaded logs object that counts the streamed bytes and fails
+	// with a 413 API error once they exceed the log storage quota of the cluster or of its organization.
+	// It enforces the quota when the size of the upload isn't known in advance.
+	LimitUpload(ctx context.Context, cluster *common.Cluster, objectName string, upload io.Reader) (*UploadReader, error)
+	// RecordUpload tracks the size of an uploaded logs object and updates the logs size of the cluster
+	// and of the host it was uploaded from
+	RecordUpload(ctx context.Context, cluster *common.Cluster, hostID *strfmt.UUID, logsType models.LogsType, objectName string) error
+}
+
+// Config of the log retention policies. A zero value disables the respective policy.
+type Config struct {
+	MaxAge          time.Duration `envconfig:"LOGS_MAX_AGE" default:"0"`
+	MaxClusterBytes int64         `envconfig:"LOGS_MAX_CLUSTER_BYTES" default:"0"`
+	MaxOrgBytes     int64         `envconfig:"LOGS_MAX_ORG_BYTES" default:"0"`
+	Interval        time.Duration `envconfig:"LOGS_RETENTION_INTERVAL" default:"1h"`
+}
+
+type Manager struct {
+	config        Config
+	db            *gorm.DB
+	log           logrus.FieldLogger
+	objectHandler s3wrapper.API
+	eventsHandler events.Handler
+	leaderElector leader.Leader
+}
+
+func NewManager(cfg Config, db *gorm.DB, log logrus.FieldLogger, objectHandler s3wrapper.API, eventsHandler events.Handler,
+	leaderElector leader.Leader) *Manager {
+	return &Manager{
+		config:        cfg,
+		db:            db,
+		log:           log,
+		objectHandler: objectHandler,
+		eventsHandler: eventsHandler,
+		leaderElector: leaderElector,
+	}
+}
+
+func (m *Manager) CheckQuota(ctx context.Context, cluster *common.Cluster, objectName string, uploadSize int64) error {
+	log := logutil.FromContext(ctx, m.log)
+
+	if m.config.MaxClusterBytes > 0 {
+		used, err := m.usedBytes("cluster_id = ? and object_name <> ?", cluster.ID.String(), objectName)
+		if err != nil {
+			log.WithError(err).Errorf("failed to get the size of the logs of cluster %s", cluster.ID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if used+uploadSize > m.config.MaxClusterBytes {
+			return common.NewApiError(http.StatusRequestEntityTooLarge, errors.Errorf(
+				"Uploading %d bytes of logs would exceed the log storage quota of cluster %s: %d of %d bytes are in use",
+				uploadSize, cluster.ID, used, m.config.MaxClusterBytes))
+		}
+	}
+	if m.config.MaxOrgBytes > 0 && cluster.OrgID != "" {
+		used, err := m.usedBytes("org_id = ? and object_name <> ?", cluster.OrgID, objectName)
+		if err != nil {
+			log.WithError(err).Errorf("failed to get the size of the logs of organization %s", cluster.OrgID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if used+uploadSize > m.config.MaxOrgBytes {
+			return common.NewApiError(http.StatusRequestEntityTooLarge, errors.Errorf(
+				"Uploading %d bytes of logs would exceed the log storage quota of the organization: %d of %d bytes are in use",
+				uploadSize, used, m.config.MaxOrgBytes))
+		}
+	}
+	return nil
+}
+
+func (m *Manager) LimitUpload(ctx context.Context, cluster *common.Cluster, objectName string, upload io.Reader) (*UploadReader, error) {
+	log := logutil.FromContext(ctx, m.log)
+
+	limit := Unlimited
+	if m.config.MaxClusterBytes > 0 {
+		used, err := m.usedBytes("cluster_id = ? and object_name <> ?", cluster.ID.String(), objectName)
+		if err != nil {
+			log.WithError(err).Errorf("failed to get the size of the logs of cluster %s", cluster.ID)
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		limit = availableBytes(m.config.MaxClusterBytes, used)
+	}
+	if m.config.MaxOrgBytes > 0 && cluster.OrgID != "" {
+		used, err := m.usedBytes("org_id = ? and object_name <> ?", cluster.OrgID, objectName)
+		if err != nil {
+			log.WithError(err).Errorf("failed to get the size of the logs of organization %s", cluster.OrgID)
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if available := availableBytes(m.config.MaxOrgBytes, used); limit == Unlimited || available < limit {
+			limit = available
+		}
+	}
+	return NewUploadReader(upload, limit), nil
+}
+
+func availableBytes(quota, used int64) int64 {
+	if used >= quota {
+		return 0
+	}
+	return quota - used
+}
+
+func (m *Manager) RecordUpload(ctx context.Context, cluster *common.Cluster, hostID *strfmt.UUID, logsType models.LogsType, objectName string) error {
+	size, err := m.objectHandler.GetObjectSizeBytes(ctx, objectName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the size of %s", objectName)
+	}
+	object := common.LogObject{
+		ObjectName: objectName,
+		ClusterID:  *cluster.ID,
+		OrgID:      cluster.OrgID,
+		LogsType:   string(logsType),
+		SizeBytes:  size,
+		UploadedAt: time.Now(),
+	}
+	if hostID != nil {
+		object.HostID = *hostID
+	}
+	if err = m.db.Save(&object).Error; err != nil {
+		return errors.Wrapf(err, "failed to record logs object %s", objectName)
+	}
+	return m.updateLogsSize(&object)
+}
+
+// EnforceRetention deletes the logs that are older than the maximal age, and then the oldest logs of the
+// clusters and organizations that exceed their quota, until they are within it
+func (m *Manager) EnforceRetention() {
+	if !m.leaderElector.IsLeader() {
+		m.log.Debugf("Not a leader, exiting periodic logs retention enforcement")
+		return
+	}
+	ctx := context.Background()
+
+	m.resolveUnknownSizes(ctx)
+	if m.config.MaxAge > 0 {
+		var objects []*common.LogObject
+		if err := m.db.Where("uploaded_at < ?", time.Now().Add(-m.config.MaxAge)).Find(&objects).Error; err != nil {
+			m.log.WithError(err).Error("failed to get expired logs")
+			return
+		}
+		for _, object := range objects {
+			m.prune(ctx, object, fmt.Sprintf("they were uploaded more than %s ago", m.config.MaxAge))
+		}
+	}
+	if m.config.MaxClusterBytes > 0 {
+		m.enforceQuota(ctx, "cluster_id", m.config.MaxClusterBytes,
+			fmt.Sprintf("the cluster exceeded its log storage quota of %d bytes", m.config.MaxClusterBytes))
+	}
+	if m.config.MaxOrgBytes > 0 {
+		m.enforceQuota(ctx, "org_id", m.config.MaxOrgBytes,
+			fmt.Sprintf("the organization exceeded its log storage quota of %d bytes", m.config.MaxOrgBytes))
+	}
+}
+
+// resolveUnknownSizes gets the size of the objects that were tracked without it, i.e. the logs that were uploaded
+// before the objects were tracked, and stops tracking those that don't exist
+func (m *Manager) resolveUnknownSizes(ctx context.Context) {
+	var objects []*common.LogObject
+	if err := m.db.Where("size_bytes = ?", common.LogObjectSizeUnknown).Find(&objects).Error; err != nil {
+		m.log.WithError(err).Error("failed to get the logs of unknown size")
+		return
+	}
+	for _, object := range objects {
+		exists, err := m.objectHandler.DoesObjectExist(ctx, object.ObjectName)
+		if err != nil {
+			m.log.WithError(err).Warnf("failed to check if logs %s exist", object.ObjectName)
+			continue
+		}
+		if !exists {
+			if err = m.db.Delete(object).Error; err != nil {
+				m.log.WithError(err).Errorf("failed to delete the record of logs %s", object.ObjectName)
+			}
+			continue
+		}
+		if object.SizeBytes, err = m.objectHandler.GetObjectSizeBytes(ctx, object.ObjectName); err != nil {
+			m.log.WithError(err).Warnf("failed to get the size of %s", object.ObjectName)
+			continue
+		}
+		if err = m.db.Model(object).UpdateColumn("size_bytes", object.SizeBytes).Error; err != nil {
+			m.log.WithError(err).Errorf("failed to record the size of logs %s", object.ObjectName)
+			continue
+		}
+		if err = m.updateLogsSize(object); err != nil {
+			m.log.WithError(err).Warnf("failed to update the logs size of cluster %s", object.ClusterID)
+		}
+	}
+}
+
+// enforceQuota deletes the oldest logs of every owner, i.e. a cluster or an organization, that exceeds the quota
+func (m *Manager) enforceQuota(ctx context.Context, owner string, quota int64, reason string) {
+	var usages []struct {
+		Owner string
+		Total int64
+	}
+	if err := m.db.Model(&common.LogObject{}).Select(fmt.Sprintf("%s as owner, sum(greatest(size_bytes, 0)) as total", owner)).
+		Where(fmt.Sprintf("%s <> ''", owner)).Group(owner).Having("sum(size_bytes) > ?", quota).Scan(&usages).Error; err != nil {
+		m.log.WithError(err).Errorf("failed to get the size of the logs by %s", owner)
+		return
+	}
+	for _, usage := range usages {
+		var objects []*common.LogObject
+		if err := m.db.Where(fmt.Sprintf("%s = ?", owner), usage.Owner).Order("uploaded_at").Find(&objects).Error; err != nil {
+			m.log.WithError(err).Errorf("failed to get the logs of %s %s", owner, usage.Owner)
+			continue
+		}
+		total := usage.Total
+		for _, object := range objects {
+			if total <= quota {
+				break
+			}
+			if m.prune(ctx, object, reason) {
+				total -= object.SizeBytes
+			}
+		}
+	}
+}
+
+func (m *Manager) prune(ctx context.Context, object *common.LogObject, reason string) bool {
+	log := logutil.FromContext(ctx, m.log)
+
+	if _, err := m.objectHandler.DeleteObject(ctx, object.ObjectName); err != nil {
+		log.WithError(err).Errorf("failed to delete logs %s", object.ObjectName)
+		return false
+	}
+	if err := m.db.Delete(object).Error; err != nil {
+		log.WithError(err).Errorf("failed to delete the record of logs %s", object.ObjectName)
+		return false
+	}
+	if err := m.updateLogsSize(object); err != nil {
+		log.WithError(err).Warnf("failed to update the logs size of cluster %s", object.ClusterID)
+	}
+	// The downloadable archive of all the logs of the cluster is created from the deleted logs as well
+	if _, err := m.objectHandler.DeleteObject(ctx, fmt.Sprintf("%s/logs/cluster_logs.tar", object.ClusterID)); err != nil {
+		log.WithError(err).Warnf("failed to delete the logs archive of cluster %s", object.ClusterID)
+	}
+
+	log.Infof("Deleted logs %s of %d bytes because %s", object.ObjectName, object.SizeBytes, reason)
+	var hostID *strfmt.UUID
+	if object.HostID != "" {
+		hostID = &object.HostID
+	}
+	m.eventsHandler.AddEvent(ctx, object.ClusterID, hostID, models.EventSeverityInfo,
+		fmt.Sprintf("The %s logs were deleted because %s", object.LogsType, reason), time.Now())
+	return true
+}
+
+func (m *Manager) usedBytes(where ...interface{}) (int64, error) {
+	var usage struct {
+		Total int64
+	}
+	if err := m.db.Model(&common.LogObject{}).Select("coalesce(sum(greatest(size_bytes, 0)), 0) as total").
+		Where(where[0], where[1:]...).Scan(&usage).Error; err != nil {
+		return 0, err
+	}
+	return usage.Total, nil
+}
+
+// updateLogsSize sets the logs size of the cluster to the total size of its logs, and that of the host
+// the object was uploaded from to the size of its logs
+func (m *Manager) updateLogsSize(object *common.LogObject) error {
+	total, err := m.usedBytes("cluster_id = ?", object.ClusterID.String())
+	if err != nil {
+		return err
+	}
+	if err = m.db.Model(&common.Cluster{}).Where("id = ?", object.ClusterID.String()).
+		UpdateColumn("logs_size_bytes", total).Error; err != nil {
+		return errors.Wrapf(err, "failed to update the logs size of cluster %s", object.ClusterID)
+	}
+	if object.HostID == "" {
+		return nil
+	}
+	hostTotal, err := m.usedBytes("cluster_id = ? and host_id = ?", object.ClusterID.String(), object.HostID.String())
+	if err != nil {
+		return err
+	}
+	return m.db.Model(&common.Host{}).Where("id = ? and cluster_id = ?", object.HostID.String(), object.ClusterID.String()).
+		UpdateColumn("logs_size_bytes", hostTotal).Error
+}
@@ -0,0 +1,229 @@
+package logretention
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+)
+
+func TestLogRetention(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Log retention test Suite")
+}
+
+var _ = Describe("Log retention", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockS3Client  *s3wrapper.MockAPI
+		mockEvents    *events.MockHandler
+		clusterID     strfmt.UUID
+		hostID        strfmt.UUID
+		cluster       *common.Cluster
+		newManager    func(cfg Config) *Manager
+		addLogsObject func(clusterID strfmt.UUID, orgID, logID string, size int64, uploadedAt time.Time) string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		mockEvents = events.NewMockHandler(ctrl)
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: "org"}}
+		Expect(db.Create(cluster).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Host{Host: models.Host{ID: &hostID, ClusterID: clusterID}}).Error).ShouldNot(HaveOccurred())
+
+		newManager = func(cfg Config) *Manager {
+			return NewManager(cfg, db, common.GetTestLog(), mockS3Client, mockEvents, &leader.DummyElector{})
+		}
+		addLogsObject = func(clusterID strfmt.UUID, orgID, logID string, size int64, uploadedAt time.Time) string {
+			object := common.LogObject{
+				ObjectName: fmt.Sprintf("%s/logs/%s/logs.tar.gz", clusterID, logID),
+				ClusterID:  clusterID,
+				OrgID:      orgID,
+				LogsType:   string(models.LogsTypeController),
+				SizeBytes:  size,
+				UploadedAt: uploadedAt,
+			}
+			Expect(db.Create(&object).Error).ShouldNot(HaveOccurred())
+			return object.ObjectName
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	logsSize := func() (int64, int64) {
+		c, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(c.Hosts).To(HaveLen(1))
+		return c.LogsSizeBytes, c.Hosts[0].LogsSizeBytes
+	}
+
+	Context("RecordUpload", func() {
+		It("updates the logs size of the cluster and of the host", func() {
+			addLogsObject(clusterID, "org", "controller", 100, time.Now())
+			objectName := fmt.Sprintf("%s/logs/%s/logs.tar.gz", clusterID, hostID)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), objectName).Return(int64(50), nil).Times(2)
+
+			m := newManager(Config{})
+			Expect(m.RecordUpload(ctx, cluster, &hostID, models.LogsTypeHost, objectName)).To(Succeed())
+			clusterSize, hostSize := logsSize()
+			Expect(clusterSize).To(Equal(int64(150)))
+			Expect(hostSize).To(Equal(int64(50)))
+
+			By("replacing the previous logs of the host")
+			Expect(m.RecordUpload(ctx, cluster, &hostID, models.LogsTypeHost, objectName)).To(Succeed())
+			clusterSize, _ = logsSize()
+			Expect(clusterSize).To(Equal(int64(150)))
+		})
+	})
+
+	Context("CheckQuota", func() {
+		It("accepts uploads when no quota is set", func() {
+			addLogsObject(clusterID, "org", "controller", 100, time.Now())
+			Expect(newManager(Config{}).CheckQuota(ctx, cluster, "new", 1000)).To(Succeed())
+		})
+
+		It("rejects uploads over the cluster quota", func() {
+			objectName := addLogsObject(clusterID, "org", "controller", 100, time.Now())
+			m := newManager(Config{MaxClusterBytes: 150})
+			Expect(m.CheckQuota(ctx, cluster, "new", 50)).To(Succeed())
+			err := m.CheckQuota(ctx, cluster, "new", 51)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusRequestEntityTooLarge)))
+
+			By("not counting the object that is replaced")
+			Expect(m.CheckQuota(ctx, cluster, objectName, 150)).To(Succeed())
+		})
+
+		It("rejects uploads over the organization quota", func() {
+			addLogsObject(strfmt.UUID(uuid.New().String()), "org", "controller", 100, time.Now())
+			addLogsObject(strfmt.UUID(uuid.New().String()), "other", "controller", 100, time.Now())
+			m := newManager(Config{MaxOrgBytes: 150})
+			Expect(m.CheckQuota(ctx, cluster, "new", 50)).To(Succeed())
+			err := m.CheckQuota(ctx, cluster, "new", 51)
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusRequestEntityTooLarge)))
+		})
+	})
+
+	Context("LimitUpload", func() {
+		read := func(upload *UploadReader) error {
+			_, err := ioutil.ReadAll(upload)
+			return err
+		}
+
+		It("doesn't limit uploads when no quota is set", func() {
+			upload, err := newManager(Config{}).LimitUpload(ctx, cluster, "new", strings.NewReader(strings.Repeat("x", 1000)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(read(upload)).To(Succeed())
+			Expect(upload.QuotaErr()).ToNot(HaveOccurred())
+		})
+
+		It("fails uploads that stream more than the smallest quota allows", func() {
+			addLogsObject(clusterID, "org", "controller", 100, time.Now())
+			addLogsObject(strfmt.UUID(uuid.New().String()), "org", "controller", 100, time.Now())
+			m := newManager(Config{MaxClusterBytes: 150, MaxOrgBytes: 260})
+
+			upload, err := m.LimitUpload(ctx, cluster, "new", strings.NewReader(strings.Repeat("x", 50)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(read(upload)).To(Succeed())
+
+			upload, err = m.LimitUpload(ctx, cluster, "new", strings.NewReader(strings.Repeat("x", 51)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(read(upload)).To(HaveOccurred())
+			Expect(upload.QuotaErr().(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusRequestEntityTooLarge)))
+
+			By("applying the organization quota when it is smaller")
+			m = newManager(Config{MaxClusterBytes: 150, MaxOrgBytes: 240})
+			upload, err = m.LimitUpload(ctx, cluster, "new", strings.NewReader(strings.Repeat("x", 41)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(read(upload)).To(HaveOccurred())
+		})
+	})
+
+	Context("EnforceRetention", func() {
+		expectPruned := func(objectName string) {
+			mockS3Client.EXPECT().DeleteObject(gomock.Any(), objectName).Return(true, nil).Times(1)
+			archive := strings.Split(objectName, "/")[0] + "/logs/cluster_logs.tar"
+			mockS3Client.EXPECT().DeleteObject(gomock.Any(), archive).Return(false, nil).Times(1)
+		}
+
+		It("deletes the logs that are older than the maximal age", func() {
+			old := addLogsObject(clusterID, "org", "controller", 100, time.Now().Add(-2*time.Hour))
+			addLogsObject(clusterID, "org", hostID.String(), 100, time.Now())
+			expectPruned(old)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+
+			newManager(Config{MaxAge: time.Hour}).EnforceRetention()
+			var count int
+			Expect(db.Model(&common.LogObject{}).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("deletes the oldest logs of a cluster over its quota", func() {
+			oldest := addLogsObject(clusterID, "org", "controller", 100, time.Now().Add(-2*time.Hour))
+			addLogsObject(clusterID, "org", hostID.String(), 100, time.Now().Add(-time.Hour))
+			addLogsObject(strfmt.UUID(uuid.New().String()), "org", "controller", 150, time.Now().Add(-3*time.Hour))
+			expectPruned(oldest)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+
+			newManager(Config{MaxClusterBytes: 150}).EnforceRetention()
+			clusterSize, _ := logsSize()
+			Expect(clusterSize).To(Equal(int64(100)))
+		})
+
+		It("deletes the oldest logs of an organization over its quota", func() {
+			otherCluster := strfmt.UUID(uuid.New().String())
+			oldest := addLogsObject(otherCluster, "org", "controller", 100, time.Now().Add(-2*time.Hour))
+			addLogsObject(clusterID, "org", "controller", 100, time.Now().Add(-time.Hour))
+			addLogsObject(strfmt.UUID(uuid.New().String()), "other", "controller", 150, time.Now().Add(-3*time.Hour))
+			expectPruned(oldest)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), otherCluster, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+
+			newManager(Config{MaxOrgBytes: 150}).EnforceRetention()
+		})
+
+		It("gets the size of the logs that were uploaded before they were tracked", func() {
+			uploaded := addLogsObject(clusterID, "org", hostID.String(), common.LogObjectSizeUnknown, time.Now())
+			missing := addLogsObject(clusterID, "org", "controller", common.LogObjectSizeUnknown, time.Now())
+			mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), uploaded).Return(true, nil).Times(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), uploaded).Return(int64(100), nil).Times(1)
+			mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), missing).Return(false, nil).Times(1)
+
+			newManager(Config{}).EnforceRetention()
+			var objects []*common.LogObject
+			Expect(db.Find(&objects).Error).ShouldNot(HaveOccurred())
+			Expect(objects).To(HaveLen(1))
+			Expect(objects[0].ObjectName).To(Equal(uploaded))
+			Expect(objects[0].SizeBytes).To(Equal(int64(100)))
+			clusterSize, _ := logsSize()
+			Expect(clusterSize).To(Equal(int64(100)))
+		})
+	})
+})
@@ -0,0 +1,45 @@
+package logretention
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+)
+
+// Unlimited is the limit of an upload that no quota applies to
+const Unlimited int64 = -1
+
+// UploadReader counts the bytes of a logs upload as they are streamed, and fails the upload once they
+// exceed its limit
+type UploadReader struct {
+	reader io.Reader
+	limit  int64
+	size   int64
+	err    error
+}
+
+func NewUploadReader(reader io.Reader, limit int64) *UploadReader {
+	return &UploadReader{reader: reader, limit: limit}
+}
+
+func (u *UploadReader) Read(p []byte) (int, error) {
+	if u.err != nil {
+		return 0, u.err
+	}
+	n, err := u.reader.Read(p)
+	u.size += int64(n)
+	if u.limit != Unlimited && u.size > u.limit {
+		u.err = common.NewApiError(http.StatusRequestEntityTooLarge, errors.Errorf(
+			"The uploaded logs exceed the log storage quota, which allows %d more bytes", u.limit))
+		return n, u.err
+	}
+	return n, err
+}
+
+// QuotaErr returns the 413 API error of an upload that exceeded its limit, since the storage may wrap
+// the errors of the reader
+func (u *UploadReader) QuotaErr() error {
+	return u.err
+}
@@ -38,6 +38,7 @@ type ClusterManifestsInternals interface {
 	CreateClusterManifestInternal(ctx context.Context, params operations.CreateClusterManifestParams) (*models.Manifest, error)
 	ListClusterManifestsInternal(ctx context.Context, params operations.ListClusterManifestsParams) (models.ListManifests, error)
 	DeleteClusterManifestInternal(ctx context.Context, params operations.DeleteClusterManifestParams) error
+	AddSecondaryDisksManifests(ctx context.Context, cluster *common.Cluster) error
 }
 
 // NewManifestsAPI returns manifests API
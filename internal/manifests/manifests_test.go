@@ -22,6 +22,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"sigs.k8s.io/yaml"
 )
 
 func TestValidator(t *testing.T) {
@@ -572,3 +573,64 @@ func (VoidReadCloser) Close() error {
 func encodeToBase64(s string) string {
 	return base64.StdEncoding.EncodeToString([]byte(s))
 }
+
+var _ = Describe("SecondaryDisksMachineConfigs", func() {
+	It("doesn't create MachineConfigs without secondary disks", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		machineConfigs, err := manifests.SecondaryDisksMachineConfigs(&common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{{ID: &hostID, RequestedHostname: "master-0", Role: models.HostRoleMaster}},
+		}})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(machineConfigs).To(BeEmpty())
+	})
+
+	It("lists the secondary disks of every host in the MachineConfigs of both roles", func() {
+		masterID := strfmt.UUID(uuid.New().String())
+		workerID := strfmt.UUID(uuid.New().String())
+		machineConfigs, err := manifests.SecondaryDisksMachineConfigs(&common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{
+				{ID: &masterID, RequestedHostname: "master-0", Role: models.HostRoleMaster,
+					SecondaryDisks: `[{"id": "/dev/disk/by-id/wwn-0x1", "role": "var-lib-etcd"}]`},
+				{ID: &workerID, RequestedHostname: "worker-0", Role: models.HostRoleWorker,
+					SecondaryDisks: `[{"id": "/dev/sdc", "role": "var-lib-containers"}]`},
+			},
+		}})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(machineConfigs).To(HaveLen(2))
+		Expect(string(machineConfigs["99-worker-secondary-disks.yaml"])).To(ContainSubstring("name: 99-worker-secondary-disks"))
+		disks := base64.StdEncoding.EncodeToString([]byte("master-0 /dev/disk/by-id/wwn-0x1 ai-etcd /var/lib/etcd\n" +
+			"worker-0 /dev/sdc ai-container /var/lib/containers\n"))
+		for _, content := range machineConfigs {
+			Expect(string(content)).To(ContainSubstring("base64," + disks))
+			Expect(string(content)).To(ContainSubstring("ExecStart=/usr/local/bin/format-secondary-disks.sh"))
+			Expect(string(content)).To(ContainSubstring("After=network-online.target"))
+			Expect(string(content)).To(ContainSubstring("- name: var-lib-containers.mount"))
+			Expect(string(content)).To(ContainSubstring("What=/dev/disk/by-label/ai-container"))
+			Expect(string(content)).To(ContainSubstring("- name: var-lib-etcd.mount"))
+			Expect(string(content)).To(ContainSubstring("Where=/var/lib/etcd"))
+
+			var machineConfig struct {
+				Spec struct {
+					Config struct {
+						Systemd struct {
+							Units []struct {
+								Name     string
+								Contents string
+							}
+						}
+					}
+				}
+			}
+			Expect(yaml.Unmarshal(content, &machineConfig)).To(Succeed())
+			Expect(machineConfig.Spec.Config.Systemd.Units).To(HaveLen(3))
+		}
+	})
+
+	It("fails on an unsupported disk role", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		_, err := manifests.SecondaryDisksMachineConfigs(&common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{{ID: &hostID, RequestedHostname: "master-0", SecondaryDisks: `[{"id": "/dev/sdc", "role": "var-log"}]`}},
+		}})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -7,6 +7,7 @@ package manifests
 import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
+	common "github.com/openshift/assisted-service/internal/common"
 	models "github.com/openshift/assisted-service/models"
 	manifests "github.com/openshift/assisted-service/restapi/operations/manifests"
 	reflect "reflect"
@@ -35,6 +36,20 @@ func (m *MockClusterManifestsInternals) EXPECT() *MockClusterManifestsInternalsM
 	return m.recorder
 }
 
+// AddSecondaryDisksManifests mocks base method
+func (m *MockClusterManifestsInternals) AddSecondaryDisksManifests(arg0 context.Context, arg1 *common.Cluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecondaryDisksManifests", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecondaryDisksManifests indicates an expected call of AddSecondaryDisksManifests
+func (mr *MockClusterManifestsInternalsMockRecorder) AddSecondaryDisksManifests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecondaryDisksManifests", reflect.TypeOf((*MockClusterManifestsInternals)(nil).AddSecondaryDisksManifests), arg0, arg1)
+}
+
 // CreateClusterManifestInternal mocks base method
 func (m *MockClusterManifestsInternals) CreateClusterManifestInternal(arg0 context.Context, arg1 manifests.CreateClusterManifestParams) (*models.Manifest, error) {
 	m.ctrl.T.Helper()
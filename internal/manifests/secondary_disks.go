@@ -0,0 +1,234 @@
+package manifests
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+)
+
+// secondaryDisksConfigPath lists the secondary disks of every host of the cluster, a line per disk with the
+// hostname of the host, the device of the disk, the label of its filesystem and the directory that it is mounted on
+const secondaryDisksConfigPath = "/etc/assisted/secondary-disks"
+
+// secondaryDiskLabels are the labels of the filesystems of the secondary disks, by the directory that they are
+// mounted on. The mount units of a role are the same for all its hosts, so they find the disks by label. XFS
+// labels are at most 12 characters long.
+var secondaryDiskLabels = map[models.DiskRole]string{
+	models.DiskRoleVarLibContainers: "ai-container",
+	models.DiskRoleVarLibEtcd:       "ai-etcd",
+}
+
+// secondaryDisksScript formats the secondary disks of the host that don't have a filesystem yet. The MachineConfig
+// applies to all the hosts of a role, so every host picks its own disks by hostname, which is only final once the
+// network is online.
+const secondaryDisksScript = `#!/bin/bash
+set -euo pipefail
+
+host=$(hostname)
+for _ in $(seq 60); do
+  case "$host" in
+    ""|localhost|localhost.localdomain) ;;
+    *) break ;;
+  esac
+  sleep 5
+  host=$(hostname)
+done
+case "$host" in
+  ""|localhost|localhost.localdomain)
+    echo "the hostname of the host is not set" >&2
+    exit 1
+    ;;
+esac
+
+while read -r name device label mountpoint; do
+  if [ "$name" != "$host" ]; then
+    continue
+  fi
+  if [ -n "$(blkid -o value -s TYPE "$device" || true)" ]; then
+    continue
+  fi
+  mkfs.xfs -L "$label" "$device"
+  mkdir -p "$mountpoint"
+  mount "$device" "$mountpoint"
+  restorecon "$mountpoint"
+  umount "$mountpoint"
+done < ` + secondaryDisksConfigPath + `
+udevadm settle
+`
+
+const secondaryDisksMachineConfigManifest = `
+apiVersion: machineconfiguration.openshift.io/v1
+kind: MachineConfig
+metadata:
+  labels:
+    machineconfiguration.openshift.io/role: {{.ROLE}}
+  name: 99-{{.ROLE}}-secondary-disks
+spec:
+  config:
+    ignition:
+      config: {}
+      security:
+        tls: {}
+      timeouts: {}
+      version: 2.2.0
+    networkd: {}
+    passwd: {}
+    storage:
+      files:
+      - contents:
+          source: data:text/plain;charset=utf-8;base64,{{.SECONDARY_DISKS}}
+          verification: {}
+        filesystem: root
+        mode: 420
+        path: ` + secondaryDisksConfigPath + `
+      - contents:
+          source: data:text/plain;charset=utf-8;base64,{{.SECONDARY_DISKS_SCRIPT}}
+          verification: {}
+        filesystem: root
+        mode: 493
+        path: /usr/local/bin/format-secondary-disks.sh
+    systemd:
+      units:
+      - name: format-secondary-disks.service
+        enabled: true
+        contents: |
+         [Unit]
+         Description=Format the secondary disks of the host
+         Wants=network-online.target systemd-udev-settle.service
+         After=network-online.target systemd-udev-settle.service
+
+         [Service]
+         Type=oneshot
+         RemainAfterExit=yes
+         ExecStart=/usr/local/bin/format-secondary-disks.sh
+
+         [Install]
+         WantedBy=multi-user.target
+{{- range .MOUNTS}}
+      - name: {{.UNIT}}
+        enabled: true
+        contents: |
+         [Unit]
+         Description=Mount the secondary disk of {{.WHERE}}
+         DefaultDependencies=no
+         Requires=format-secondary-disks.service
+         After=format-secondary-disks.service
+         ConditionPathExists=/dev/disk/by-label/{{.LABEL}}
+         Conflicts=umount.target
+         Before=crio.service kubelet.service umount.target
+
+         [Mount]
+         What=/dev/disk/by-label/{{.LABEL}}
+         Where={{.WHERE}}
+         Type=xfs
+         Options=defaults,prjquota
+
+         [Install]
+         WantedBy=multi-user.target
+{{- end}}
+`
+
+// secondaryDiskMount is a mount unit of the secondary disks of a role
+type secondaryDiskMount struct {
+	UNIT  string
+	WHERE string
+	LABEL string
+}
+
+// SecondaryDisksMachineConfigs returns the MachineConfigs that format the secondary disks of the hosts of the
+// cluster and mount them on the directories of their role, by manifest file name. There are none if no host has
+// secondary disks.
+//
+// The disks are formatted by a script rather than by the filesystems of the ignition config, since those would
+// be created on the same device of every host of the role.
+func SecondaryDisksMachineConfigs(cluster *common.Cluster) (map[string][]byte, error) {
+	lines := make([]string, 0)
+	mounts := make(map[string]secondaryDiskMount)
+	for _, h := range cluster.Hosts {
+		secondaryDisks, err := hostutil.UnmarshalSecondaryDisks(h)
+		if err != nil {
+			return nil, err
+		}
+		if len(secondaryDisks) == 0 {
+			continue
+		}
+		hostname, err := hostutil.GetCurrentHostName(h)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get hostname for host %s", h.ID)
+		}
+		for _, secondaryDisk := range secondaryDisks {
+			mountPoint, ok := hostutil.SecondaryDiskMountPoints[secondaryDisk.Role]
+			if !ok {
+				return nil, errors.Errorf("unsupported role %s of secondary disk %s of host %s", secondaryDisk.Role, swag.StringValue(secondaryDisk.ID), h.ID)
+			}
+			label := secondaryDiskLabels[secondaryDisk.Role]
+			lines = append(lines, fmt.Sprintf("%s %s %s %s", hostname, swag.StringValue(secondaryDisk.ID), label, mountPoint))
+			mounts[mountPoint] = secondaryDiskMount{
+				UNIT:  strings.ReplaceAll(strings.TrimPrefix(mountPoint, "/"), "/", "-") + ".mount",
+				WHERE: mountPoint,
+				LABEL: label,
+			}
+		}
+	}
+	ret := make(map[string][]byte)
+	if len(lines) == 0 {
+		return ret, nil
+	}
+	sort.Strings(lines)
+	sortedMounts := make([]secondaryDiskMount, 0, len(mounts))
+	for _, mount := range mounts {
+		sortedMounts = append(sortedMounts, mount)
+	}
+	sort.Slice(sortedMounts, func(i, j int) bool { return sortedMounts[i].UNIT < sortedMounts[j].UNIT })
+
+	tmpl, err := template.New("secondary-disks").Parse(secondaryDisksMachineConfigManifest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the secondary disks template")
+	}
+	for _, role := range []models.HostRole{models.HostRoleMaster, models.HostRoleWorker} {
+		buf := &bytes.Buffer{}
+		if err = tmpl.Execute(buf, map[string]interface{}{
+			"ROLE":                   string(role),
+			"SECONDARY_DISKS":        base64.StdEncoding.EncodeToString([]byte(strings.Join(lines, "\n") + "\n")),
+			"SECONDARY_DISKS_SCRIPT": base64.StdEncoding.EncodeToString([]byte(secondaryDisksScript)),
+			"MOUNTS":                 sortedMounts,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to create the secondary disks manifest of the %ss", role)
+		}
+		ret[fmt.Sprintf("99-%s-secondary-disks.yaml", role)] = buf.Bytes()
+	}
+	return ret, nil
+}
+
+// AddSecondaryDisksManifests adds the MachineConfigs of the secondary disks of the hosts to the manifests of the
+// cluster
+func (m *Manifests) AddSecondaryDisksManifests(ctx context.Context, cluster *common.Cluster) error {
+	machineConfigs, err := SecondaryDisksMachineConfigs(cluster)
+	if err != nil {
+		return err
+	}
+	for fileName, content := range machineConfigs {
+		if _, err = m.CreateClusterManifestInternal(ctx, operations.CreateClusterManifestParams{
+			ClusterID: *cluster.ID,
+			CreateManifestParams: &models.CreateManifestParams{
+				Content:  swag.String(base64.StdEncoding.EncodeToString(content)),
+				FileName: swag.String(fileName),
+				Folder:   swag.String(models.ManifestFolderOpenshift),
+			},
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create manifest %s", fileName)
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/alecthomas/units"
 	"github.com/go-openapi/strfmt"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/prometheus/client_golang/prometheus"
@@ -488,16 +489,17 @@ func (m *MetricsManager) reportHostMetricsOnInstallationComplete(ctx context.Con
 		m.serviceLogicClusterHostDiskGb.WithLabelValues(diskTypeStr, roleStr, installationStageStr,
 			clusterVersion, clusterID.String(), emailDomain).Observe(float64(bytesToGib(disk.SizeBytes)))
 	}
-	//report NIC's speed. role for each NIC
-	for _, inter := range hwInfo.Interfaces {
+	//report NIC's speed. role for each logical interface, so that the members of a bond aren't reported on their own
+	for _, inter := range network.LogicalInterfaces(hwInfo.Interfaces) {
+		speedMbps := network.InterfaceSpeedMbps(hwInfo.Interfaces, inter)
 		log.Infof("service Logic Cluster Host NicGb role %s, result %s SpeedMbps %f",
-			roleStr, installationStageStr, float64(inter.SpeedMbps))
+			roleStr, installationStageStr, float64(speedMbps))
 		m.handler.AddMetricsEvent(ctx, clusterID, h.ID, models.EventSeverityInfo, "nic.speed", time.Now(),
-			"stage", installationStageStr, "host_role", roleStr, "nic_speed", inter.SpeedMbps)
+			"stage", installationStageStr, "host_role", roleStr, "nic_speed", speedMbps)
 
 		//MGMT-4526 TODO: remove this scrap after ELK dashboards are verified
 		m.serviceLogicClusterHostNicGb.WithLabelValues(roleStr, installationStageStr,
-			clusterVersion, clusterID.String(), emailDomain).Observe(float64(inter.SpeedMbps))
+			clusterVersion, clusterID.String(), emailDomain).Observe(float64(speedMbps))
 	}
 }
 
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+const (
+	eventsClusterTimeIndex     = "idx_events_cluster_id_event_time"
+	eventsClusterHostTimeIndex = "idx_events_cluster_id_host_id_event_time"
+)
+
+// addEventsQueryIndexes adds the indexes that are used to filter and page the events of a cluster or a host by time
+func addEventsQueryIndexes() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		if err := tx.Model(&common.Event{}).AddIndex(eventsClusterTimeIndex, "cluster_id", "event_time").Error; err != nil {
+			return err
+		}
+		return tx.Model(&common.Event{}).AddIndex(eventsClusterHostTimeIndex, "cluster_id", "host_id", "event_time").Error
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		if err := tx.Model(&common.Event{}).RemoveIndex(eventsClusterHostTimeIndex).Error; err != nil {
+			return err
+		}
+		return tx.Model(&common.Event{}).RemoveIndex(eventsClusterTimeIndex).Error
+	}
+
+	return &gormigrate.Migration{
+		ID:       "20210412120000",
+		Migrate:  migrate,
+		Rollback: rollback,
+	}
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"gopkg.in/gormigrate.v1"
+)
+
+var _ = Describe("AddEventsQueryIndexes", func() {
+	var (
+		db     *gorm.DB
+		dbName string
+		gm     *gormigrate.Gormigrate
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		gm = gormigrate.New(db, gormigrate.DefaultOptions, all())
+		err := gm.MigrateTo("20210412120000")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	expectIndexes := func(exist bool) {
+		tableName := db.NewScope(&common.Event{}).TableName()
+		Expect(db.Dialect().HasIndex(tableName, eventsClusterTimeIndex)).To(Equal(exist))
+		Expect(db.Dialect().HasIndex(tableName, eventsClusterHostTimeIndex)).To(Equal(exist))
+	}
+
+	It("Migrates down and up", func() {
+		expectIndexes(true)
+
+		err := gm.RollbackMigration(addEventsQueryIndexes())
+		Expect(err).ToNot(HaveOccurred())
+		expectIndexes(false)
+
+		err = gm.MigrateTo("20210412120000")
+		Expect(err).ToNot(HaveOccurred())
+		expectIndexes(true)
+	})
+})
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+// backfillLogObjects tracks the logs that may have been uploaded before the log objects were tracked. Their size
+// is unknown, so the log retention gets it from the object storage, and drops the objects that were never uploaded.
+func backfillLogObjects() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		if err := tx.Exec(`INSERT INTO log_objects (object_name, cluster_id, host_id, org_id, logs_type, size_bytes, uploaded_at)
+			SELECT clusters.id || '/logs/' || ? || '/logs.tar.gz', clusters.id, '', clusters.org_id, ?, ?, now()
+			FROM clusters WHERE clusters.deleted_at IS NULL
+			ON CONFLICT (object_name) DO NOTHING`,
+			models.LogsTypeController, models.LogsTypeController, common.LogObjectSizeUnknown).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`INSERT INTO log_objects (object_name, cluster_id, host_id, org_id, logs_type, size_bytes, uploaded_at)
+			SELECT hosts.cluster_id || '/logs/' || hosts.id || '/logs.tar.gz', hosts.cluster_id, hosts.id, clusters.org_id, ?, ?, now()
+			FROM hosts JOIN clusters ON clusters.id = hosts.cluster_id WHERE clusters.deleted_at IS NULL AND hosts.deleted_at IS NULL
+			ON CONFLICT (object_name) DO NOTHING`,
+			models.LogsTypeHost, common.LogObjectSizeUnknown).Error
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		return tx.Where("size_bytes = ?", common.LogObjectSizeUnknown).Delete(&common.LogObject{}).Error
+	}
+
+	return &gormigrate.Migration{
+		ID:       "20210501120000",
+		Migrate:  migrate,
+		Rollback: rollback,
+	}
+}
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gopkg.in/gormigrate.v1"
+)
+
+var _ = Describe("BackfillLogObjects", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		gm        *gormigrate.Gormigrate
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		tracked   string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: "org"}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Host{Host: models.Host{ID: &hostID, ClusterID: clusterID}}).Error).ShouldNot(HaveOccurred())
+		tracked = fmt.Sprintf("%s/logs/%s/logs.tar.gz", clusterID, hostID)
+		Expect(db.Create(&common.LogObject{ObjectName: tracked, ClusterID: clusterID, HostID: hostID, OrgID: "org",
+			LogsType: string(models.LogsTypeHost), SizeBytes: 100}).Error).ShouldNot(HaveOccurred())
+
+		gm = gormigrate.New(db, gormigrate.DefaultOptions, all())
+		err := gm.MigrateTo("20210501120000")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	expectLogObjects := func(sizes map[string]int64) {
+		var objects []*common.LogObject
+		Expect(db.Find(&objects).Error).ShouldNot(HaveOccurred())
+		Expect(objects).To(HaveLen(len(sizes)))
+		for _, object := range objects {
+			Expect(sizes).To(HaveKeyWithValue(object.ObjectName, object.SizeBytes))
+			Expect(object.OrgID).To(Equal("org"))
+		}
+	}
+
+	It("Migrates down and up", func() {
+		controller := fmt.Sprintf("%s/logs/controller/logs.tar.gz", clusterID)
+		expectLogObjects(map[string]int64{tracked: 100, controller: common.LogObjectSizeUnknown})
+
+		err := gm.RollbackMigration(backfillLogObjects())
+		Expect(err).ToNot(HaveOccurred())
+		expectLogObjects(map[string]int64{tracked: 100})
+
+		err = gm.MigrateTo("20210501120000")
+		Expect(err).ToNot(HaveOccurred())
+		expectLogObjects(map[string]int64{tracked: 100, controller: common.LogObjectSizeUnknown})
+	})
+})
@@ -17,6 +17,8 @@ func all() []*gormigrate.Migration {
 		changeImageSSHKeyToText(),
 		changeClusterValidationsInfoToText(),
 		changeHostValidationsInfoToText(),
+		addEventsQueryIndexes(),
+		backfillLogObjects(),
 	}
 
 	sort.SliceStable(allMigrations, func(i, j int) bool { return allMigrations[i].ID < allMigrations[j].ID })
@@ -270,12 +270,10 @@ func createMachineCidrConnectivityMap(cidr string, hosts []*models.Host, idToInd
 }
 
 /*
- * Crate majority for a cidr.  A majority group is a the largest group of hosts in a cluster that all of them have full mesh
- * to the other group members.
- * It is done by taking a sorted connectivity group list according to the group size, and from this group take the
- * largest one
+ * Create the connectivity groups for a cidr.  A connectivity group is a group of at least 3 hosts that all of them have
+ * full mesh connectivity to the other group members.  The groups are sorted according to the group size, the largest first
  */
-func CreateMajorityGroup(cidr string, hosts []*models.Host) ([]strfmt.UUID, error) {
+func CreateConnectivityGroups(cidr string, hosts []*models.Host) ([][]strfmt.UUID, error) {
 	idToIndex := make(map[strfmt.UUID]int)
 	for i, h := range hosts {
 		idToIndex[*h.ID] = i
@@ -291,9 +289,25 @@ func CreateMajorityGroup(cidr string, hosts []*models.Host) ([]strfmt.UUID, erro
 			candidates = append(candidates, candidate)
 		}
 	}
-	groups := createConnectivityGroups(candidates)
+	ret := make([][]strfmt.UUID, 0)
+	for _, group := range createConnectivityGroups(candidates) {
+		ret = append(ret, group.toList(hosts))
+	}
+	return ret, nil
+}
+
+/*
+ * Crate majority for a cidr.  A majority group is a the largest group of hosts in a cluster that all of them have full mesh
+ * to the other group members.
+ * It is done by taking the largest group of the connectivity groups of the cidr
+ */
+func CreateMajorityGroup(cidr string, hosts []*models.Host) ([]strfmt.UUID, error) {
+	groups, err := CreateConnectivityGroups(cidr, hosts)
+	if err != nil {
+		return nil, err
+	}
 	if len(groups) > 0 {
-		return groups[0].toList(hosts), nil
+		return groups[0], nil
 	}
 	return make([]strfmt.UUID, 0), nil
 }
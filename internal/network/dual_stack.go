@@ -0,0 +1,256 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+/*
+ * A dual-stack cluster has a machine, a cluster and a service network per IP address family, the IPv4 ones first.
+ * The networks of the first family are kept in the single network fields of the cluster (machine_network_cidr,
+ * cluster_network_cidr, cluster_network_host_prefix and service_network_cidr), which the VIPs and the machine network
+ * calculation keep using. The JSON lists of the cluster hold the networks of the other family. A network of the
+ * first family that a list holds is ignored in favor of the single field, so that updating the single field, e.g.
+ * when the machine network CIDR is calculated from the VIPs, can't leave the list stale.
+ */
+
+const (
+	IPv4 = "IPv4"
+	IPv6 = "IPv6"
+)
+
+func cidrFamily(cidr string) string {
+	if IsIPV4CIDR(cidr) {
+		return IPv4
+	}
+	return IPv6
+}
+
+// MachineNetworks returns the machine networks of the cluster, the one of the machine network CIDR first
+func MachineNetworks(cluster *common.Cluster) []*models.MachineNetwork {
+	ret := make([]*models.MachineNetwork, 0)
+	families := make(map[string]bool)
+	if cluster.MachineNetworkCidr != "" {
+		ret = append(ret, &models.MachineNetwork{Cidr: cluster.MachineNetworkCidr})
+		families[cidrFamily(cluster.MachineNetworkCidr)] = true
+	}
+	var stored []*models.MachineNetwork
+	if cluster.MachineNetworks != "" && json.Unmarshal([]byte(cluster.MachineNetworks), &stored) == nil {
+		for _, n := range stored {
+			if n != nil && n.Cidr != "" && !families[cidrFamily(n.Cidr)] {
+				families[cidrFamily(n.Cidr)] = true
+				ret = append(ret, n)
+			}
+		}
+	}
+	return ret
+}
+
+// ClusterNetworks returns the cluster networks of the cluster, the one of the cluster network CIDR first
+func ClusterNetworks(cluster *common.Cluster) []*models.ClusterNetwork {
+	ret := make([]*models.ClusterNetwork, 0)
+	families := make(map[string]bool)
+	if cluster.ClusterNetworkCidr != "" {
+		ret = append(ret, &models.ClusterNetwork{Cidr: cluster.ClusterNetworkCidr, HostPrefix: cluster.ClusterNetworkHostPrefix})
+		families[cidrFamily(cluster.ClusterNetworkCidr)] = true
+	}
+	var stored []*models.ClusterNetwork
+	if cluster.ClusterNetworks != "" && json.Unmarshal([]byte(cluster.ClusterNetworks), &stored) == nil {
+		for _, n := range stored {
+			if n != nil && n.Cidr != "" && !families[cidrFamily(n.Cidr)] {
+				families[cidrFamily(n.Cidr)] = true
+				ret = append(ret, n)
+			}
+		}
+	}
+	return ret
+}
+
+// ServiceNetworks returns the service networks of the cluster, the one of the service network CIDR first
+func ServiceNetworks(cluster *common.Cluster) []*models.ServiceNetwork {
+	ret := make([]*models.ServiceNetwork, 0)
+	families := make(map[string]bool)
+	if cluster.ServiceNetworkCidr != "" {
+		ret = append(ret, &models.ServiceNetwork{Cidr: cluster.ServiceNetworkCidr})
+		families[cidrFamily(cluster.ServiceNetworkCidr)] = true
+	}
+	var stored []*models.ServiceNetwork
+	if cluster.ServiceNetworks != "" && json.Unmarshal([]byte(cluster.ServiceNetworks), &stored) == nil {
+		for _, n := range stored {
+			if n != nil && n.Cidr != "" && !families[cidrFamily(n.Cidr)] {
+				families[cidrFamily(n.Cidr)] = true
+				ret = append(ret, n)
+			}
+		}
+	}
+	return ret
+}
+
+// MachineNetworkCidrs returns the CIDRs of the machine networks of the cluster
+func MachineNetworkCidrs(cluster *common.Cluster) []string {
+	ret := make([]string, 0)
+	for _, n := range MachineNetworks(cluster) {
+		ret = append(ret, n.Cidr)
+	}
+	return ret
+}
+
+// IsDualStack tells whether the cluster has networks of both IP address families
+func IsDualStack(cluster *common.Cluster) bool {
+	return len(ClusterNetworks(cluster)) > 1 || len(ServiceNetworks(cluster)) > 1 || len(MachineNetworks(cluster)) > 1
+}
+
+// HasIPv6Network tells whether any of the networks of the cluster is an IPv6 one
+func HasIPv6Network(cluster *common.Cluster) bool {
+	cidrs := MachineNetworkCidrs(cluster)
+	for _, n := range ClusterNetworks(cluster) {
+		cidrs = append(cidrs, n.Cidr)
+	}
+	for _, n := range ServiceNetworks(cluster) {
+		cidrs = append(cidrs, n.Cidr)
+	}
+	for _, cidr := range cidrs {
+		if IsIPv6CIDR(cidr) {
+			return true
+		}
+	}
+	return false
+}
+
+func verifyFamilies(name string, cidrs []string) ([]string, error) {
+	families := make([]string, 0, len(cidrs))
+	for i, cidr := range cidrs {
+		family := cidrFamily(cidr)
+		if i > 0 && family == families[0] {
+			return nil, errors.Errorf("%s networks %s and %s are of the same IP address family", name, cidrs[0], cidr)
+		}
+		if i > 0 && family == IPv4 {
+			return nil, errors.Errorf("%s network %s must be listed before %s", name, cidr, cidrs[0])
+		}
+		families = append(families, family)
+	}
+	return families, nil
+}
+
+// VerifyNetworkFamilies checks that the networks are of distinct IP address families, the IPv4 one first
+func VerifyNetworkFamilies(name string, cidrs []string) error {
+	_, err := verifyFamilies(name, cidrs)
+	return err
+}
+
+func sameFamilies(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyDualStackNetworks checks that the cluster has at most one machine, cluster and service network per IP address
+// family, the IPv4 ones first, that its cluster and service networks are of the same families, and that its machine
+// networks are of these families as well. The machine networks may miss a family until the machine network CIDR is
+// calculated, which the cluster validations check.
+func VerifyDualStackNetworks(cluster *common.Cluster, numberOfHosts int) error {
+	var machineCidrs, clusterCidrs, serviceCidrs []string
+	for _, n := range MachineNetworks(cluster) {
+		if err := VerifyMachineCIDR(n.Cidr); err != nil {
+			return errors.Wrapf(err, "Machine network CIDR %s", n.Cidr)
+		}
+		machineCidrs = append(machineCidrs, n.Cidr)
+	}
+	for _, n := range ClusterNetworks(cluster) {
+		if err := VerifyClusterOrServiceCIDR(n.Cidr); err != nil {
+			return errors.Wrapf(err, "Cluster network CIDR %s", n.Cidr)
+		}
+		if err := VerifyNetworkHostPrefix(n.HostPrefix); err != nil {
+			return err
+		}
+		if err := VerifyClusterCidrSize(int(n.HostPrefix), n.Cidr, numberOfHosts); err != nil {
+			return err
+		}
+		clusterCidrs = append(clusterCidrs, n.Cidr)
+	}
+	for _, n := range ServiceNetworks(cluster) {
+		if err := VerifyClusterOrServiceCIDR(n.Cidr); err != nil {
+			return errors.Wrapf(err, "Service network CIDR %s", n.Cidr)
+		}
+		serviceCidrs = append(serviceCidrs, n.Cidr)
+	}
+
+	machineFamilies, err := verifyFamilies("Machine", machineCidrs)
+	if err != nil {
+		return err
+	}
+	clusterFamilies, err := verifyFamilies("Cluster", clusterCidrs)
+	if err != nil {
+		return err
+	}
+	serviceFamilies, err := verifyFamilies("Service", serviceCidrs)
+	if err != nil {
+		return err
+	}
+	if !sameFamilies(clusterFamilies, serviceFamilies) {
+		return errors.Errorf("Cluster networks %v and service networks %v must be of the same IP address families", clusterCidrs, serviceCidrs)
+	}
+	for _, family := range machineFamilies {
+		if !funk.ContainsString(clusterFamilies, family) {
+			return errors.Errorf("Machine networks %v must be of the IP address families of the cluster networks %v", machineCidrs, clusterCidrs)
+		}
+	}
+	return VerifyDualStackCIDRsNotOverlap(cluster)
+}
+
+// VerifyDualStackCIDRsNotOverlap checks that the machine, cluster and service networks of every IP address family of
+// the cluster don't overlap
+func VerifyDualStackCIDRsNotOverlap(cluster *common.Cluster) error {
+	cidrs := func(family string) (machineCidr, clusterCidr, serviceCidr string) {
+		for _, n := range MachineNetworks(cluster) {
+			if cidrFamily(n.Cidr) == family {
+				machineCidr = n.Cidr
+			}
+		}
+		for _, n := range ClusterNetworks(cluster) {
+			if cidrFamily(n.Cidr) == family {
+				clusterCidr = n.Cidr
+			}
+		}
+		for _, n := range ServiceNetworks(cluster) {
+			if cidrFamily(n.Cidr) == family {
+				serviceCidr = n.Cidr
+			}
+		}
+		return
+	}
+	userManagedNetworking := cluster.UserManagedNetworking != nil && *cluster.UserManagedNetworking
+	for _, family := range []string{IPv4, IPv6} {
+		machineCidr, clusterCidr, serviceCidr := cidrs(family)
+		if err := VerifyClusterCIDRsNotOverlap(machineCidr, clusterCidr, serviceCidr, userManagedNetworking); err != nil {
+			return errors.Wrapf(err, "%s networks", family)
+		}
+	}
+	return nil
+}
+
+// MissingMachineNetworkFamilies returns the IP address families of the cluster networks of the cluster that it has
+// no machine network of
+func MissingMachineNetworkFamilies(cluster *common.Cluster) []string {
+	machineFamilies := make([]string, 0)
+	for _, n := range MachineNetworks(cluster) {
+		machineFamilies = append(machineFamilies, cidrFamily(n.Cidr))
+	}
+	ret := make([]string, 0)
+	for _, n := range ClusterNetworks(cluster) {
+		if family := cidrFamily(n.Cidr); !funk.ContainsString(machineFamilies, family) {
+			ret = append(ret, family)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,86 @@
+package network
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Dual-stack networks", func() {
+	newCluster := func() *common.Cluster {
+		return &common.Cluster{Cluster: models.Cluster{
+			MachineNetworkCidr:       "192.168.127.0/24",
+			ClusterNetworkCidr:       "10.128.0.0/14",
+			ClusterNetworkHostPrefix: 23,
+			ServiceNetworkCidr:       "172.30.0.0/16",
+			MachineNetworks:          `[{"cidr":"192.168.127.0/24"},{"cidr":"1001:db8::/120"}]`,
+			ClusterNetworks:          `[{"cidr":"10.128.0.0/14","host_prefix":23},{"cidr":"fd01::/48","host_prefix":64}]`,
+			ServiceNetworks:          `[{"cidr":"172.30.0.0/16"},{"cidr":"fd02::/112"}]`,
+		}}
+	}
+
+	It("lists the networks of both families, the single network fields first", func() {
+		cluster := newCluster()
+		cluster.MachineNetworkCidr = "192.168.128.0/24"
+		Expect(MachineNetworkCidrs(cluster)).To(Equal([]string{"192.168.128.0/24", "1001:db8::/120"}))
+		Expect(ClusterNetworks(cluster)).To(Equal([]*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}, {Cidr: "fd01::/48", HostPrefix: 64}}))
+		Expect(ServiceNetworks(cluster)).To(Equal([]*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}, {Cidr: "fd02::/112"}}))
+		Expect(IsDualStack(cluster)).To(BeTrue())
+		Expect(HasIPv6Network(cluster)).To(BeTrue())
+	})
+
+	It("falls back to the single network fields", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{ClusterNetworkCidr: "10.128.0.0/14", ClusterNetworkHostPrefix: 23, ServiceNetworkCidr: "172.30.0.0/16"}}
+		Expect(MachineNetworks(cluster)).To(BeEmpty())
+		Expect(ClusterNetworks(cluster)).To(Equal([]*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}}))
+		Expect(IsDualStack(cluster)).To(BeFalse())
+		Expect(HasIPv6Network(cluster)).To(BeFalse())
+		Expect(VerifyDualStackNetworks(cluster, 3)).To(Succeed())
+	})
+
+	It("accepts valid dual-stack networks", func() {
+		Expect(VerifyDualStackNetworks(newCluster(), 3)).To(Succeed())
+	})
+
+	It("rejects networks of the same family", func() {
+		Expect(VerifyNetworkFamilies("Cluster", []string{"10.128.0.0/14", "10.132.0.0/14"})).ToNot(Succeed())
+		Expect(VerifyNetworkFamilies("Cluster", []string{"fd01::/48", "10.128.0.0/14"})).ToNot(Succeed())
+		Expect(VerifyNetworkFamilies("Cluster", []string{"10.128.0.0/14", "fd01::/48"})).To(Succeed())
+	})
+
+	It("rejects cluster and service networks of different families", func() {
+		cluster := newCluster()
+		cluster.ServiceNetworks = ""
+		Expect(VerifyDualStackNetworks(cluster, 3)).ToNot(Succeed())
+	})
+
+	It("rejects an IPv6 cluster network that is too small", func() {
+		cluster := newCluster()
+		cluster.ClusterNetworks = `[{"cidr":"fd01::/64","host_prefix":64}]`
+		Expect(VerifyDualStackNetworks(cluster, 3)).ToNot(Succeed())
+	})
+
+	It("rejects overlapping networks of the second family", func() {
+		cluster := newCluster()
+		cluster.ServiceNetworks = `[{"cidr":"fd01::/112"}]`
+		err := VerifyDualStackNetworks(cluster, 3)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("IPv6 networks"))
+
+		By("ignoring the machine networks with user managed networking")
+		cluster = newCluster()
+		cluster.MachineNetworks = `[{"cidr":"fd01::/120"}]`
+		Expect(VerifyDualStackCIDRsNotOverlap(cluster)).ToNot(Succeed())
+		cluster.UserManagedNetworking = swag.Bool(true)
+		Expect(VerifyDualStackCIDRsNotOverlap(cluster)).To(Succeed())
+	})
+
+	It("tells the families that miss a machine network", func() {
+		cluster := newCluster()
+		Expect(MissingMachineNetworkFamilies(cluster)).To(BeEmpty())
+		cluster.MachineNetworks = ""
+		Expect(MissingMachineNetworkFamilies(cluster)).To(Equal([]string{IPv6}))
+	})
+})
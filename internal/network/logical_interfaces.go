@@ -0,0 +1,79 @@
+package network
+
+import (
+	"github.com/openshift/assisted-service/models"
+	"github.com/thoas/go-funk"
+)
+
+/*
+ * The inventory lists the physical NICs of a host together with the bonds, VLANs and bridges that are configured on
+ * them, e.g. by the static network config. The members of a bond or a bridge don't carry addresses of their own, and
+ * they share the MAC address of the bond, so the network calculations use the logical interfaces instead: the bonds,
+ * VLANs and bridges, and the physical NICs that aren't members of a bond or a bridge. A VLAN doesn't take over its
+ * base interface, which may carry addresses of the untagged network.
+ */
+
+func isAggregate(intf *models.Interface) bool {
+	return intf.Type == models.InterfaceTypeBond || intf.Type == models.InterfaceTypeBridge
+}
+
+// LogicalInterfaces returns the interfaces that carry the addresses of the host, leaving out the members of bonds and
+// bridges
+func LogicalInterfaces(interfaces []*models.Interface) []*models.Interface {
+	members := make([]string, 0)
+	for _, intf := range interfaces {
+		if isAggregate(intf) {
+			members = append(members, intf.Members...)
+		}
+	}
+	ret := make([]*models.Interface, 0, len(interfaces))
+	for _, intf := range interfaces {
+		if !funk.ContainsString(members, intf.Name) {
+			ret = append(ret, intf)
+		}
+	}
+	return ret
+}
+
+func isPhysical(intf *models.Interface) bool {
+	return intf.Type == "" || intf.Type == models.InterfaceTypePhysical
+}
+
+// memberInterfaces returns the members of the interface that weren't visited yet, which guards against inventories
+// with member loops
+func memberInterfaces(interfaces []*models.Interface, intf *models.Interface, visited map[string]bool) []*models.Interface {
+	visited[intf.Name] = true
+	ret := make([]*models.Interface, 0, len(intf.Members))
+	for _, name := range intf.Members {
+		for _, member := range interfaces {
+			if member.Name == name && !visited[name] {
+				ret = append(ret, member)
+			}
+		}
+	}
+	return ret
+}
+
+// InterfaceSpeedMbps returns the speed of the interface. Unless the inventory reports it, the speed of a bond is the
+// total speed of its members, and the speed of a VLAN or a bridge is the speed of its fastest member.
+func InterfaceSpeedMbps(interfaces []*models.Interface, intf *models.Interface) int64 {
+	return interfaceSpeedMbps(interfaces, intf, make(map[string]bool))
+}
+
+func interfaceSpeedMbps(interfaces []*models.Interface, intf *models.Interface, visited map[string]bool) int64 {
+	if intf.SpeedMbps > 0 || isPhysical(intf) {
+		return intf.SpeedMbps
+	}
+	var total, fastest int64
+	for _, member := range memberInterfaces(interfaces, intf, visited) {
+		speed := interfaceSpeedMbps(interfaces, member, visited)
+		total += speed
+		if speed > fastest {
+			fastest = speed
+		}
+	}
+	if intf.Type == models.InterfaceTypeBond {
+		return total
+	}
+	return fastest
+}
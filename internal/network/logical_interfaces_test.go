@@ -0,0 +1,56 @@
+package network
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("logical interfaces", func() {
+	var (
+		eth0, eth1, eth2, bond0, vlan100, br0 *models.Interface
+		interfaces                            []*models.Interface
+	)
+
+	BeforeEach(func() {
+		eth0 = &models.Interface{Name: "eth0", MacAddress: "f8:75:a4:a4:00:fe", SpeedMbps: 1000, IPV4Addresses: []string{"10.0.0.5/24"}}
+		eth1 = &models.Interface{Name: "eth1", Type: models.InterfaceTypePhysical, MacAddress: "f8:75:a4:a4:00:ff", SpeedMbps: 10000}
+		eth2 = &models.Interface{Name: "eth2", Type: models.InterfaceTypePhysical, MacAddress: "f8:75:a4:a4:01:00", SpeedMbps: 10000}
+		bond0 = &models.Interface{Name: "bond0", Type: models.InterfaceTypeBond, MacAddress: "f8:75:a4:a4:00:ff", Members: []string{"eth1", "eth2"},
+			IPV4Addresses: []string{"192.168.126.10/24"}}
+		vlan100 = &models.Interface{Name: "bond0.100", Type: models.InterfaceTypeVlan, MacAddress: "f8:75:a4:a4:00:ff", Members: []string{"bond0"},
+			VlanID: 100, IPV4Addresses: []string{"192.168.100.10/24"}}
+		br0 = &models.Interface{Name: "br0", Type: models.InterfaceTypeBridge, Members: []string{"eth0"}}
+		interfaces = []*models.Interface{eth0, eth1, eth2, bond0, vlan100}
+	})
+
+	It("leaves out the members of bonds and bridges", func() {
+		Expect(LogicalInterfaces(interfaces)).To(Equal([]*models.Interface{eth0, bond0, vlan100}))
+		Expect(LogicalInterfaces(append(interfaces, br0))).To(Equal([]*models.Interface{bond0, vlan100, br0}))
+	})
+
+	It("calculates the speed of logical interfaces", func() {
+		Expect(InterfaceSpeedMbps(interfaces, eth0)).To(BeEquivalentTo(1000))
+		Expect(InterfaceSpeedMbps(interfaces, bond0)).To(BeEquivalentTo(20000))
+		Expect(InterfaceSpeedMbps(interfaces, vlan100)).To(BeEquivalentTo(20000))
+		Expect(InterfaceSpeedMbps(append(interfaces, br0), br0)).To(BeEquivalentTo(1000))
+
+		By("preferring the speed that the inventory reports")
+		bond0.SpeedMbps = 10000
+		Expect(InterfaceSpeedMbps(interfaces, bond0)).To(BeEquivalentTo(10000))
+	})
+
+	It("survives member loops", func() {
+		loop := &models.Interface{Name: "bond1", Type: models.InterfaceTypeBond, Members: []string{"bond1"}}
+		Expect(InterfaceSpeedMbps([]*models.Interface{loop}, loop)).To(BeZero())
+	})
+
+	It("matches the machine network on the logical interface", func() {
+		host := &models.Host{Inventory: createInventory(eth0, eth1, eth2, bond0, vlan100)}
+		cluster := createCluster("", "192.168.126.0/24")
+		Expect(GetMachineCIDRInterface(host, cluster)).To(Equal("bond0"))
+		cluster.MachineNetworkCidr = "192.168.100.0/24"
+		Expect(GetMachineCIDRInterface(host, cluster)).To(Equal("bond0.100"))
+		Expect(GetMachineCIDRIP(host, cluster)).To(Equal("192.168.100.10"))
+	})
+})
@@ -55,7 +55,7 @@ func CalculateMachineNetworkCIDR(apiVip string, ingressVip string, hosts []*mode
 		if err != nil {
 			continue
 		}
-		for _, intf := range inventory.Interfaces {
+		for _, intf := range LogicalInterfaces(inventory.Interfaces) {
 			var ipnet *net.IPNet
 			if isIPv4 {
 				ipnet = getVIPInterfaceNetwork(parsedVipAddr, intf.IPV4Addresses)
@@ -148,7 +148,7 @@ func getMachineCIDRObj(host *models.Host, machineNetworkCidr string, obj string)
 	if err != nil {
 		return "", err
 	}
-	for _, intf := range inventory.Interfaces {
+	for _, intf := range LogicalInterfaces(inventory.Interfaces) {
 		found, addr := findMatchingIP(ipNet, intf, isIPv4)
 		if found {
 			switch obj {
@@ -192,7 +192,7 @@ func belongsToNetwork(log logrus.FieldLogger, h *models.Host, machineIpnet *net.
 		return false
 	}
 	isIPv4 := IsIPV4CIDR(machineIpnet.String())
-	for _, intf := range inventory.Interfaces {
+	for _, intf := range LogicalInterfaces(inventory.Interfaces) {
 		if found, _ := findMatchingIP(machineIpnet, intf, isIPv4); found {
 			return true
 		}
@@ -271,7 +271,7 @@ func GetClusterNetworks(hosts []*models.Host, log logrus.FieldLogger) []string {
 				log.WithError(err).Warnf("Unmarshal inventory %s", h.Inventory)
 				continue
 			}
-			for _, inf := range inventory.Interfaces {
+			for _, inf := range LogicalInterfaces(inventory.Interfaces) {
 
 				for _, ipv4 := range inf.IPV4Addresses {
 					_, cidr, err := net.ParseCIDR(ipv4)
@@ -300,12 +300,20 @@ func GetClusterNetworks(hosts []*models.Host, log logrus.FieldLogger) []string {
 	return ret
 }
 
+// IsHostInMachineNetCidr tells whether the host belongs to the machine networks of the cluster, the one of every IP
+// address family for dual-stack clusters
 func IsHostInMachineNetCidr(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host) bool {
-	_, machineIpnet, err := net.ParseCIDR(cluster.MachineNetworkCidr)
-	if err != nil {
+	cidrs := MachineNetworkCidrs(cluster)
+	if len(cidrs) == 0 {
 		return false
 	}
-	return belongsToNetwork(log, host, machineIpnet)
+	for _, cidr := range cidrs {
+		_, machineIpnet, err := net.ParseCIDR(cidr)
+		if err != nil || !belongsToNetwork(log, host, machineIpnet) {
+			return false
+		}
+	}
+	return true
 }
 
 type IPSet map[strfmt.IPv4]struct{}
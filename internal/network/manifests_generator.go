@@ -24,6 +24,7 @@ type ManifestsGeneratorAPI interface {
 	AddChronyManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	AddDnsmasqForSingleNode(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	AddDisableVmwareTunnelOffloading(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
+	AddNetworkMtuManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 }
 
 type ManifestsGenerator struct {
@@ -192,13 +193,17 @@ func (m *ManifestsGenerator) AddChronyManifest(ctx context.Context, log logrus.F
 }
 
 func (m *ManifestsGenerator) createManifests(ctx context.Context, cluster *common.Cluster, filename string, content []byte) error {
+	return m.createManifestsInFolder(ctx, cluster, models.ManifestFolderOpenshift, filename, content)
+}
+
+func (m *ManifestsGenerator) createManifestsInFolder(ctx context.Context, cluster *common.Cluster, folder, filename string, content []byte) error {
 	// all relevant logs of creating manifest will be inside CreateClusterManifest
 	response := m.manifestsApi.CreateClusterManifest(ctx, operations.CreateClusterManifestParams{
 		ClusterID: *cluster.ID,
 		CreateManifestParams: &models.CreateManifestParams{
 			Content:  swag.String(base64.StdEncoding.EncodeToString(content)),
 			FileName: &filename,
-			Folder:   swag.String(models.ManifestFolderOpenshift),
+			Folder:   swag.String(folder),
 		},
 	})
 
@@ -340,3 +345,65 @@ func (m *ManifestsGenerator) AddDisableVmwareTunnelOffloading(ctx context.Contex
 	}
 	return nil
 }
+
+const networkMtuManifest = `
+apiVersion: operator.openshift.io/v1
+kind: Network
+metadata:
+  name: cluster
+spec:
+  defaultNetwork:
+    type: {{.NETWORK_TYPE}}
+    {{.NETWORK_CONFIG}}:
+      mtu: {{.MTU}}
+`
+
+const (
+	ovnKubernetesOverhead = 100
+	openShiftSDNOverhead  = 50
+)
+
+// clusterNetworkType returns the network type that the install config of the cluster selects, OVNKubernetes for IPv6
+// networks and OpenShiftSDN otherwise, unless the install config overrides set another one
+func clusterNetworkType(cluster *common.Cluster) string {
+	networkType := "OpenShiftSDN"
+	if HasIPv6Network(cluster) {
+		networkType = "OVNKubernetes"
+	}
+	if cluster.InstallConfigOverrides != "" {
+		var overrides struct {
+			Networking struct {
+				NetworkType string `json:"networkType"`
+			} `json:"networking"`
+		}
+		if err := json.Unmarshal([]byte(cluster.InstallConfigOverrides), &overrides); err == nil && overrides.Networking.NetworkType != "" {
+			networkType = overrides.Networking.NetworkType
+		}
+	}
+	return networkType
+}
+
+// createNetworkMtuManifestContent sets the MTU of the cluster network to the MTU of the cluster minus the overhead of
+// the encapsulation of the network type
+func createNetworkMtuManifestContent(cluster *common.Cluster, log logrus.FieldLogger) ([]byte, error) {
+	networkType := clusterNetworkType(cluster)
+	var manifestParams = map[string]string{
+		"NETWORK_TYPE":   networkType,
+		"NETWORK_CONFIG": "openshiftSDNConfig",
+		"MTU":            fmt.Sprint(cluster.Mtu - openShiftSDNOverhead),
+	}
+	if networkType == "OVNKubernetes" {
+		manifestParams["NETWORK_CONFIG"] = "ovnKubernetesConfig"
+		manifestParams["MTU"] = fmt.Sprint(cluster.Mtu - ovnKubernetesOverhead)
+	}
+	return fillTemplate(manifestParams, networkMtuManifest, log)
+}
+
+func (m *ManifestsGenerator) AddNetworkMtuManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error {
+	content, err := createNetworkMtuManifestContent(c, log)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to create network MTU manifest")
+		return err
+	}
+	return m.createManifestsInFolder(ctx, c, models.ManifestFolderManifests, "cluster-network-03-config.yml", content)
+}
@@ -305,3 +305,27 @@ var _ = Describe("dnsmasq manifest", func() {
 	})
 
 })
+
+var _ = Describe("network MTU manifest", func() {
+	It("subtracts the overhead of OpenShiftSDN", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{Mtu: 9000, ClusterNetworkCidr: "10.128.0.0/14", ServiceNetworkCidr: "172.30.0.0/16"}}
+		content, err := createNetworkMtuManifestContent(cluster, logrus.New())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("type: OpenShiftSDN\n    openshiftSDNConfig:\n      mtu: 8950\n"))
+	})
+
+	It("subtracts the overhead of OVNKubernetes for IPv6 networks", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{Mtu: 9000, ClusterNetworkCidr: "fd01::/48", ServiceNetworkCidr: "fd02::/112"}}
+		content, err := createNetworkMtuManifestContent(cluster, logrus.New())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("type: OVNKubernetes\n    ovnKubernetesConfig:\n      mtu: 8900\n"))
+	})
+
+	It("follows the network type of the install config overrides", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{Mtu: 1500, ClusterNetworkCidr: "10.128.0.0/14",
+			InstallConfigOverrides: `{"networking":{"networkType":"OVNKubernetes"}}`}}
+		content, err := createNetworkMtuManifestContent(cluster, logrus.New())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("ovnKubernetesConfig:\n      mtu: 1400\n"))
+	})
+})
@@ -76,3 +76,17 @@ func (mr *MockManifestsGeneratorAPIMockRecorder) AddDisableVmwareTunnelOffloadin
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDisableVmwareTunnelOffloading", reflect.TypeOf((*MockManifestsGeneratorAPI)(nil).AddDisableVmwareTunnelOffloading), ctx, log, c)
 }
+
+// AddNetworkMtuManifest mocks base method
+func (m *MockManifestsGeneratorAPI) AddNetworkMtuManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetworkMtuManifest", ctx, log, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNetworkMtuManifest indicates an expected call of AddNetworkMtuManifest
+func (mr *MockManifestsGeneratorAPIMockRecorder) AddNetworkMtuManifest(ctx, log, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkMtuManifest", reflect.TypeOf((*MockManifestsGeneratorAPI)(nil).AddNetworkMtuManifest), ctx, log, c)
+}
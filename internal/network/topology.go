@@ -0,0 +1,279 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+/*
+ * The network topology of a cluster is the graph of its enabled hosts, as the inventories and the connectivity reports
+ * of the hosts see it: the NICs of every host, the subnets of their addresses, the L2 and L3 connectivity of every host
+ * to every address of the other hosts, and the groups of hosts with full mesh connectivity in every subnet.
+ */
+
+func topologyHostname(host *models.Host, inventory *models.Inventory) string {
+	if host.RequestedHostname != "" {
+		return host.RequestedHostname
+	}
+	if inventory != nil && inventory.Hostname != "" {
+		return inventory.Hostname
+	}
+	return host.ID.String()
+}
+
+func topologyNic(intf *models.Interface) *models.NetworkTopologyNic {
+	nic := &models.NetworkTopologyNic{
+		Name:        intf.Name,
+		MacAddress:  intf.MacAddress,
+		IPAddresses: make([]string, 0),
+		Subnets:     make([]string, 0),
+	}
+	for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+		ip, cidr, err := net.ParseCIDR(address)
+		if err != nil {
+			continue
+		}
+		nic.IPAddresses = append(nic.IPAddresses, ip.String())
+		if !funk.ContainsString(nic.Subnets, cidr.String()) {
+			nic.Subnets = append(nic.Subnets, cidr.String())
+		}
+	}
+	return nic
+}
+
+func subnetOf(ipStr string, subnets []*models.NetworkTopologySubnet) string {
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return ""
+	}
+	for _, subnet := range subnets {
+		if _, cidr, err := net.ParseCIDR(subnet.Cidr); err == nil && cidr.Contains(ip) {
+			return subnet.Cidr
+		}
+	}
+	return ""
+}
+
+// topologyLinks merges the L2 and the L3 results that the connectivity report of the host has for every remote address
+func topologyLinks(host *models.Host, hostIds map[strfmt.UUID]bool, subnets []*models.NetworkTopologySubnet) ([]*models.NetworkTopologyLink, error) {
+	ret := make([]*models.NetworkTopologyLink, 0)
+	if host.Connectivity == "" {
+		return ret, nil
+	}
+	var report models.ConnectivityReport
+	if err := json.Unmarshal([]byte(host.Connectivity), &report); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the connectivity report of host %s", host.ID.String())
+	}
+	for _, remoteHost := range report.RemoteHosts {
+		if !hostIds[remoteHost.HostID] || remoteHost.HostID == *host.ID {
+			continue
+		}
+		links := make(map[string]*models.NetworkTopologyLink)
+		getLink := func(remoteIP string) *models.NetworkTopologyLink {
+			if parsed := net.ParseIP(remoteIP); parsed != nil {
+				remoteIP = parsed.String()
+			}
+			link, ok := links[remoteIP]
+			if !ok {
+				link = &models.NetworkTopologyLink{
+					HostID:          *host.ID,
+					RemoteHostID:    remoteHost.HostID,
+					RemoteIPAddress: remoteIP,
+					Subnet:          subnetOf(remoteIP, subnets),
+				}
+				links[remoteIP] = link
+				ret = append(ret, link)
+			}
+			return link
+		}
+		for _, l2 := range remoteHost.L2Connectivity {
+			link := getLink(l2.RemoteIPAddress)
+			// An address that is reachable through any of the NICs of the host is reachable
+			if link.L2Successful == nil || !*link.L2Successful {
+				link.L2Successful = swag.Bool(l2.Successful)
+				link.RemoteMac = l2.RemoteMac
+				link.OutgoingNic = l2.OutgoingNic
+			}
+		}
+		for _, l3 := range remoteHost.L3Connectivity {
+			link := getLink(l3.RemoteIPAddress)
+			if link.L3Successful == nil || !*link.L3Successful {
+				link.L3Successful = swag.Bool(l3.Successful)
+				link.AverageRttMs = l3.AverageRTTMs
+				link.PacketLossPercentage = l3.PacketLossPercentage
+				if link.OutgoingNic == "" {
+					link.OutgoingNic = l3.OutgoingNic
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+// BuildNetworkTopology builds the network topology of the cluster from the inventories and the connectivity reports of
+// its hosts
+func BuildNetworkTopology(cluster *common.Cluster, log logrus.FieldLogger) (*models.NetworkTopology, error) {
+	ret := &models.NetworkTopology{
+		Hosts:   make([]*models.NetworkTopologyHost, 0),
+		Subnets: make([]*models.NetworkTopologySubnet, 0),
+		Links:   make([]*models.NetworkTopologyLink, 0),
+	}
+	hosts := make([]*models.Host, 0, len(cluster.Hosts))
+	for _, h := range cluster.Hosts {
+		if swag.StringValue(h.Status) != models.HostStatusDisabled {
+			hosts = append(hosts, h)
+		}
+	}
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].ID.String() < hosts[j].ID.String() })
+
+	hostIds := make(map[strfmt.UUID]bool)
+	subnetHosts := make(map[string][]strfmt.UUID)
+	for _, h := range hosts {
+		hostIds[*h.ID] = true
+		var inventory *models.Inventory
+		if h.Inventory != "" {
+			inventory = &models.Inventory{}
+			if err := json.Unmarshal([]byte(h.Inventory), inventory); err != nil {
+				log.WithError(err).Warnf("Unmarshal inventory of host %s", h.ID.String())
+				inventory = nil
+			}
+		}
+		topologyHost := &models.NetworkTopologyHost{
+			HostID:   *h.ID,
+			Hostname: topologyHostname(h, inventory),
+			Nics:     make([]*models.NetworkTopologyNic, 0),
+		}
+		if inventory != nil {
+			for _, intf := range LogicalInterfaces(inventory.Interfaces) {
+				nic := topologyNic(intf)
+				topologyHost.Nics = append(topologyHost.Nics, nic)
+				for _, cidr := range nic.Subnets {
+					ids := subnetHosts[cidr]
+					if len(ids) == 0 || ids[len(ids)-1] != *h.ID {
+						subnetHosts[cidr] = append(ids, *h.ID)
+					}
+				}
+			}
+		}
+		ret.Hosts = append(ret.Hosts, topologyHost)
+	}
+
+	for cidr, ids := range subnetHosts {
+		groups, err := CreateConnectivityGroups(cidr, hosts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create the connectivity groups of subnet %s", cidr)
+		}
+		ret.Subnets = append(ret.Subnets, &models.NetworkTopologySubnet{Cidr: cidr, HostIds: ids, Groups: groups})
+	}
+	sort.Slice(ret.Subnets, func(i, j int) bool { return ret.Subnets[i].Cidr < ret.Subnets[j].Cidr })
+
+	for _, h := range hosts {
+		links, err := topologyLinks(h, hostIds, ret.Subnets)
+		if err != nil {
+			return nil, err
+		}
+		ret.Links = append(ret.Links, links...)
+	}
+	sort.SliceStable(ret.Links, func(i, j int) bool {
+		first, second := ret.Links[i], ret.Links[j]
+		if first.HostID != second.HostID {
+			return first.HostID < second.HostID
+		}
+		if first.RemoteHostID != second.RemoteHostID {
+			return first.RemoteHostID < second.RemoteHostID
+		}
+		return first.RemoteIPAddress < second.RemoteIPAddress
+	})
+	return ret, nil
+}
+
+type topologyEdgeKey struct {
+	first, second strfmt.UUID
+	subnet        string
+}
+
+type topologyEdge struct {
+	l2Failed, l3Failed bool
+}
+
+func (e *topologyEdge) color() string {
+	switch {
+	case e.l2Failed && e.l3Failed:
+		return "red"
+	case e.l2Failed || e.l3Failed:
+		return "orange"
+	default:
+		return "green"
+	}
+}
+
+func (e *topologyEdge) failure() string {
+	switch {
+	case e.l2Failed && e.l3Failed:
+		return "L2 and L3 failed"
+	case e.l2Failed:
+		return "L2 failed"
+	case e.l3Failed:
+		return "L3 failed"
+	default:
+		return ""
+	}
+}
+
+// NetworkTopologyDOT renders the network topology as an undirected Graphviz graph, with an edge per pair of hosts and
+// subnet. The edge is green when all the checks between the hosts succeed, orange when either the L2 or the L3 checks
+// fail and red when both fail.
+func NetworkTopologyDOT(topology *models.NetworkTopology) string {
+	edges := make(map[topologyEdgeKey]*topologyEdge)
+	keys := make([]topologyEdgeKey, 0)
+	for _, link := range topology.Links {
+		key := topologyEdgeKey{first: link.HostID, second: link.RemoteHostID, subnet: link.Subnet}
+		if key.second < key.first {
+			key.first, key.second = key.second, key.first
+		}
+		edge, ok := edges[key]
+		if !ok {
+			edge = &topologyEdge{}
+			edges[key] = edge
+			keys = append(keys, key)
+		}
+		edge.l2Failed = edge.l2Failed || (link.L2Successful != nil && !*link.L2Successful)
+		edge.l3Failed = edge.l3Failed || (link.L3Successful != nil && !*link.L3Successful)
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		if keys[i].first != keys[j].first {
+			return keys[i].first < keys[j].first
+		}
+		if keys[i].second != keys[j].second {
+			return keys[i].second < keys[j].second
+		}
+		return keys[i].subnet < keys[j].subnet
+	})
+
+	var b strings.Builder
+	b.WriteString("graph \"network-topology\" {\n")
+	for _, host := range topology.Hosts {
+		fmt.Fprintf(&b, "  %q [label=%q];\n", host.HostID.String(), host.Hostname)
+	}
+	for _, key := range keys {
+		edge := edges[key]
+		label := key.subnet
+		if failure := edge.failure(); failure != "" {
+			label = strings.TrimSpace(label + " " + failure)
+		}
+		fmt.Fprintf(&b, "  %q -- %q [color=%s, label=%q];\n", key.first.String(), key.second.String(), edge.color(), label)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
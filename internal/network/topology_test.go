@@ -0,0 +1,115 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("network topology", func() {
+	var (
+		ids     []strfmt.UUID
+		cluster *common.Cluster
+	)
+
+	address := func(index int) string {
+		return []string{"10.0.0.10", "10.0.0.11", "10.0.0.12", "10.0.0.13"}[index]
+	}
+
+	connectivityReport := func(from int, l3Failures ...int) string {
+		report := models.ConnectivityReport{}
+		for to := range ids {
+			if to == from {
+				continue
+			}
+			l3Successful := true
+			for _, failure := range l3Failures {
+				l3Successful = l3Successful && failure != to
+			}
+			report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+				HostID:         ids[to],
+				L2Connectivity: []*models.L2Connectivity{{OutgoingNic: "eth0", RemoteIPAddress: address(to), RemoteMac: fmt.Sprintf("f8:75:a4:a4:00:%02d", to), Successful: true}},
+				L3Connectivity: []*models.L3Connectivity{{OutgoingNic: "eth0", RemoteIPAddress: address(to), Successful: l3Successful, AverageRTTMs: 0.5}},
+			})
+		}
+		b, err := json.Marshal(&report)
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	BeforeEach(func() {
+		ids = []strfmt.UUID{
+			"00000000-0000-0000-0000-000000000000",
+			"11111111-1111-1111-1111-111111111111",
+			"22222222-2222-2222-2222-222222222222",
+			"33333333-3333-3333-3333-333333333333",
+		}
+		cluster = &common.Cluster{}
+		for i := range ids {
+			eth0 := &models.Interface{Name: "eth0", MacAddress: fmt.Sprintf("f8:75:a4:a4:00:%02d", i), IPV4Addresses: []string{address(i) + "/24"}}
+			cluster.Hosts = append(cluster.Hosts, &models.Host{
+				ID:           &ids[i],
+				Status:       swag.String(models.HostStatusKnown),
+				Inventory:    createInventory(eth0),
+				Connectivity: connectivityReport(i),
+			})
+		}
+		cluster.Hosts[0].RequestedHostname = "master-0"
+		cluster.Hosts[3].Status = swag.String(models.HostStatusDisabled)
+	})
+
+	It("describes the NICs, the subnets and the groups of the enabled hosts", func() {
+		topology, err := BuildNetworkTopology(cluster, logrus.New())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(topology.Hosts).To(HaveLen(3))
+		Expect(topology.Hosts[0].Hostname).To(Equal("master-0"))
+		Expect(topology.Hosts[1].Hostname).To(Equal(ids[1].String()))
+		Expect(topology.Hosts[2].Nics).To(Equal([]*models.NetworkTopologyNic{
+			{Name: "eth0", MacAddress: "f8:75:a4:a4:00:02", IPAddresses: []string{"10.0.0.12"}, Subnets: []string{"10.0.0.0/24"}},
+		}))
+		Expect(topology.Subnets).To(Equal([]*models.NetworkTopologySubnet{
+			{Cidr: "10.0.0.0/24", HostIds: ids[:3], Groups: [][]strfmt.UUID{ids[:3]}},
+		}))
+	})
+
+	It("merges the L2 and the L3 results of every remote address", func() {
+		cluster.Hosts[2].Connectivity = connectivityReport(2, 0)
+		topology, err := BuildNetworkTopology(cluster, logrus.New())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(topology.Links).To(HaveLen(6))
+		link := topology.Links[4]
+		Expect(link.HostID).To(Equal(ids[2]))
+		Expect(link.RemoteHostID).To(Equal(ids[0]))
+		Expect(link.RemoteIPAddress).To(Equal("10.0.0.10"))
+		Expect(link.RemoteMac).To(Equal("f8:75:a4:a4:00:00"))
+		Expect(link.Subnet).To(Equal("10.0.0.0/24"))
+		Expect(swag.BoolValue(link.L2Successful)).To(BeTrue())
+		Expect(swag.BoolValue(link.L3Successful)).To(BeFalse())
+		Expect(link.AverageRttMs).To(Equal(0.5))
+	})
+
+	It("renders the failing host pairs in DOT", func() {
+		cluster.Hosts[2].Connectivity = connectivityReport(2, 0)
+		topology, err := BuildNetworkTopology(cluster, logrus.New())
+		Expect(err).ToNot(HaveOccurred())
+		dot := NetworkTopologyDOT(topology)
+		Expect(dot).To(HavePrefix("graph \"network-topology\" {\n"))
+		Expect(dot).To(ContainSubstring(`  "00000000-0000-0000-0000-000000000000" [label="master-0"];`))
+		Expect(dot).To(ContainSubstring(`  "00000000-0000-0000-0000-000000000000" -- "11111111-1111-1111-1111-111111111111" [color=green, label="10.0.0.0/24"];`))
+		Expect(dot).To(ContainSubstring(`  "00000000-0000-0000-0000-000000000000" -- "22222222-2222-2222-2222-222222222222" [color=orange, label="10.0.0.0/24 L3 failed"];`))
+		Expect(dot).ToNot(ContainSubstring("33333333-3333-3333-3333-333333333333"))
+	})
+
+	It("fails on an invalid connectivity report", func() {
+		cluster.Hosts[1].Connectivity = "{"
+		_, err := BuildNetworkTopology(cluster, logrus.New())
+		Expect(err).To(HaveOccurred())
+	})
+})
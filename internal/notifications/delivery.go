@@ -0,0 +1,158 @@
+package notifications
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SignatureHeader = "X-Assisted-Signature"
+	EventHeader     = "X-Assisted-Event"
+	DeliveryHeader  = "X-Assisted-Delivery"
+
+	maxLastErrorLength = 1024
+)
+
+// DeliveryWorker posts the pending notifications to the webhooks, retrying failed
+// deliveries with an exponential backoff until the maximum number of attempts is reached
+type DeliveryWorker struct {
+	config Config
+	db     *gorm.DB
+	log    logrus.FieldLogger
+	leader leader.Leader
+	client *http.Client
+}
+
+func NewDeliveryWorker(config Config, db *gorm.DB, log logrus.FieldLogger, leader leader.Leader) *DeliveryWorker {
+	return &DeliveryWorker{
+		config: config,
+		db:     db,
+		log:    log,
+		leader: leader,
+		client: newTargetGuard(config, log).httpClient(config.DeliveryTimeout),
+	}
+}
+
+// DeliverPendingNotifications is called periodically by the delivery thread
+func (w *DeliveryWorker) DeliverPendingNotifications() {
+	if !w.leader.IsLeader() {
+		w.log.Debugf("Not a leader, exiting DeliverPendingNotifications")
+		return
+	}
+	var deliveries []*common.WebhookDelivery
+	if err := w.db.Where("status = ? and next_attempt_at <= ?", models.WebhookDeliveryStatusPending, time.Now()).
+		Order("next_attempt_at").Limit(w.config.DeliveriesPerCycle).Find(&deliveries).Error; err != nil {
+		w.log.WithError(err).Error("failed to get pending webhook deliveries")
+		return
+	}
+	webhooks := make(map[strfmt.UUID]*common.Webhook)
+	for _, delivery := range deliveries {
+		webhookID := *delivery.WebhookID
+		webhook, ok := webhooks[webhookID]
+		if !ok {
+			webhook = &common.Webhook{}
+			if err := w.db.First(webhook, "id = ?", webhookID.String()).Error; err != nil {
+				w.log.WithError(err).Warnf("failed to get webhook %s of delivery %s", webhookID, delivery.ID)
+				continue
+			}
+			webhooks[webhookID] = webhook
+		}
+		w.deliver(webhook, delivery)
+	}
+}
+
+func (w *DeliveryWorker) deliver(webhook *common.Webhook, delivery *common.WebhookDelivery) {
+	log := w.log.WithField("webhook_id", webhook.ID.String()).WithField("delivery_id", delivery.ID.String())
+	now := time.Now()
+	statusCode, err := w.post(webhook, delivery)
+	updates := map[string]interface{}{
+		"attempts":        delivery.Attempts + 1,
+		"last_attempt_at": strfmt.DateTime(now),
+		"response_code":   statusCode,
+	}
+	switch {
+	case err == nil:
+		log.Debugf("Delivered %s notification to %s", delivery.EventType, swag.StringValue(webhook.URL))
+		updates["status"] = models.WebhookDeliveryStatusDelivered
+		updates["last_error"] = ""
+	case delivery.Attempts+1 >= w.config.MaxAttempts:
+		log.WithError(err).Warnf("Giving up on %s notification to %s after %d attempts", delivery.EventType, swag.StringValue(webhook.URL), delivery.Attempts+1)
+		updates["status"] = models.WebhookDeliveryStatusFailed
+		updates["last_error"] = truncate(err.Error())
+	default:
+		log.WithError(err).Infof("Failed to deliver %s notification to %s, will retry", delivery.EventType, swag.StringValue(webhook.URL))
+		updates["next_attempt_at"] = strfmt.DateTime(now.Add(w.backoff(delivery.Attempts + 1)))
+		updates["last_error"] = truncate(err.Error())
+	}
+	if dbErr := w.db.Model(&common.WebhookDelivery{}).Where("id = ?", delivery.ID.String()).Updates(updates).Error; dbErr != nil {
+		log.WithError(dbErr).Error("failed to update webhook delivery")
+	}
+}
+
+func (w *DeliveryWorker) post(webhook *common.Webhook, delivery *common.WebhookDelivery) (int, error) {
+	payload := []byte(delivery.Payload)
+	req, err := http.NewRequest(http.MethodPost, swag.StringValue(webhook.URL), bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(delivery.EventType))
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	if webhook.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(webhook.Secret, payload))
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxLastErrorLength))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, errors.Errorf("webhook responded with %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay before the next attempt, doubling the base backoff after each attempt
+func (w *DeliveryWorker) backoff(attempts int64) time.Duration {
+	ret := w.config.RetryBackoff
+	for i := int64(1); i < attempts && ret < w.config.MaxRetryBackoff; i++ {
+		ret *= 2
+	}
+	if ret > w.config.MaxRetryBackoff {
+		ret = w.config.MaxRetryBackoff
+	}
+	return ret
+}
+
+// Sign returns the value of the signature header, which receivers can use to verify
+// that the payload was sent by the service and was not tampered with
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return fmt.Sprintf("sha256=%s", hex.EncodeToString(mac.Sum(nil)))
+}
+
+func truncate(msg string) string {
+	if len(msg) > maxLastErrorLength {
+		return msg[:maxLastErrorLength]
+	}
+	return msg
+}
@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notifier.go
+
+// Package notifications is a generated GoMock package.
+package notifications
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	gorm "github.com/jinzhu/gorm"
+	models "github.com/openshift/assisted-service/models"
+	reflect "reflect"
+)
+
+// MockNotifier is a mock of Notifier interface
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyEvent mocks base method
+func (m *MockNotifier) NotifyEvent(ctx context.Context, db *gorm.DB, event *models.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyEvent", ctx, db, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyEvent indicates an expected call of NotifyEvent
+func (mr *MockNotifierMockRecorder) NotifyEvent(ctx, db, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyEvent", reflect.TypeOf((*MockNotifier)(nil).NotifyEvent), ctx, db, event)
+}
+
+// NotifyHostStatusChange mocks base method
+func (m *MockNotifier) NotifyHostStatusChange(db *gorm.DB, host *models.Host, srcStatus, transition string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyHostStatusChange", db, host, srcStatus, transition)
+}
+
+// NotifyHostStatusChange indicates an expected call of NotifyHostStatusChange
+func (mr *MockNotifierMockRecorder) NotifyHostStatusChange(db, host, srcStatus, transition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyHostStatusChange", reflect.TypeOf((*MockNotifier)(nil).NotifyHostStatusChange), db, host, srcStatus, transition)
+}
+
+// NotifyClusterStatusChange mocks base method
+func (m *MockNotifier) NotifyClusterStatusChange(db *gorm.DB, cluster *models.Cluster, srcStatus, transition string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyClusterStatusChange", db, cluster, srcStatus, transition)
+}
+
+// NotifyClusterStatusChange indicates an expected call of NotifyClusterStatusChange
+func (mr *MockNotifierMockRecorder) NotifyClusterStatusChange(db, cluster, srcStatus, transition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyClusterStatusChange", reflect.TypeOf((*MockNotifier)(nil).NotifyClusterStatusChange), db, cluster, srcStatus, transition)
+}
@@ -0,0 +1,261 @@
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/notifications"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	operations "github.com/openshift/assisted-service/restapi/operations/webhooks"
+)
+
+func TestNotifications(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Notifications test Suite")
+}
+
+var _ = Describe("Webhooks", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		dbName    string
+		manager   *notifications.Manager
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		manager = notifications.NewManager(db, common.GetTestLog(), notifications.Config{AllowedPrivateNetworks: []string{"127.0.0.0/8"}})
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	register := func(params *models.WebhookCreateParams) *models.Webhook {
+		reply := manager.RegisterWebhook(ctx, operations.RegisterWebhookParams{ClusterID: clusterID, NewWebhookParams: params})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewRegisterWebhookCreated()))
+		return reply.(*operations.RegisterWebhookCreated).Payload
+	}
+
+	deliveries := func(webhookID strfmt.UUID) models.WebhookDeliveryList {
+		reply := manager.ListWebhookDeliveries(ctx, operations.ListWebhookDeliveriesParams{ClusterID: clusterID, WebhookID: webhookID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListWebhookDeliveriesOK()))
+		return reply.(*operations.ListWebhookDeliveriesOK).Payload
+	}
+
+	event := func(severity, category string) *models.Event {
+		return &models.Event{
+			ClusterID: &clusterID,
+			Severity:  swag.String(severity),
+			Category:  category,
+			Message:   swag.String("message"),
+		}
+	}
+
+	notifyEvent := func(e *models.Event) {
+		Expect(manager.NotifyEvent(ctx, db, e)).To(Succeed())
+	}
+
+	Context("API", func() {
+		It("register and list webhooks without exposing the secret", func() {
+			webhook := register(&models.WebhookCreateParams{
+				URL:        swag.String("https://example.com/hook"),
+				Secret:     "top-secret",
+				Severities: []string{models.EventSeverityError},
+			})
+			Expect(*webhook.ClusterID).Should(Equal(clusterID))
+			Expect(webhook.Severities).Should(Equal([]string{models.EventSeverityError}))
+
+			reply := manager.ListWebhooks(ctx, operations.ListWebhooksParams{ClusterID: clusterID})
+			Expect(reply).Should(BeAssignableToTypeOf(operations.NewListWebhooksOK()))
+			webhooks := reply.(*operations.ListWebhooksOK).Payload
+			Expect(webhooks).Should(HaveLen(1))
+			Expect(*webhooks[0].ID).Should(Equal(*webhook.ID))
+			b, err := json.Marshal(webhooks)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(b)).ShouldNot(ContainSubstring("top-secret"))
+		})
+
+		It("register webhook for a missing cluster", func() {
+			reply := manager.RegisterWebhook(ctx, operations.RegisterWebhookParams{
+				ClusterID:        strfmt.UUID(uuid.New().String()),
+				NewWebhookParams: &models.WebhookCreateParams{URL: swag.String("https://example.com/hook")},
+			})
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusNotFound)))
+		})
+
+		It("rejects webhooks of restricted addresses", func() {
+			for _, url := range []string{"http://169.254.169.254/latest/meta-data", "https://10.0.0.1/hook", "http://[::1]:8080/hook", "http://[fe80::1]/hook", "ftp://example.com/hook"} {
+				reply := manager.RegisterWebhook(ctx, operations.RegisterWebhookParams{
+					ClusterID:        clusterID,
+					NewWebhookParams: &models.WebhookCreateParams{URL: swag.String(url)},
+				})
+				Expect(reply).Should(BeAssignableToTypeOf(&common.ApiErrorResponse{}), url)
+				Expect(reply.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusBadRequest)), url)
+			}
+			register(&models.WebhookCreateParams{URL: swag.String("http://127.0.0.1:8080/hook")})
+		})
+
+		It("deregister webhook deletes its deliveries", func() {
+			webhook := register(&models.WebhookCreateParams{URL: swag.String("https://example.com/hook")})
+			notifyEvent(event(models.EventSeverityInfo, models.EventCategoryUser))
+			Expect(deliveries(*webhook.ID)).Should(HaveLen(1))
+
+			reply := manager.DeregisterWebhook(ctx, operations.DeregisterWebhookParams{ClusterID: clusterID, WebhookID: *webhook.ID})
+			Expect(reply).Should(BeAssignableToTypeOf(operations.NewDeregisterWebhookNoContent()))
+			var count int
+			Expect(db.Model(&common.WebhookDelivery{}).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(0))
+
+			reply = manager.DeregisterWebhook(ctx, operations.DeregisterWebhookParams{ClusterID: clusterID, WebhookID: *webhook.ID})
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusNotFound)))
+		})
+	})
+
+	Context("Transactions", func() {
+		It("rolls back the notifications with the transaction that raised them", func() {
+			webhook := register(&models.WebhookCreateParams{URL: swag.String("https://example.com/hook")})
+			tx := db.Begin()
+			manager.NotifyClusterStatusChange(tx, &models.Cluster{ID: &clusterID, Status: swag.String(models.ClusterStatusReady)},
+				models.ClusterStatusInsufficient, "RefreshStatus")
+			Expect(tx.Rollback().Error).ShouldNot(HaveOccurred())
+			Expect(deliveries(*webhook.ID)).Should(BeEmpty())
+		})
+
+		It("queues the notifications of the events that are saved", func() {
+			webhook := register(&models.WebhookCreateParams{URL: swag.String("https://example.com/hook")})
+			eventsHandler := events.New(db, common.GetTestLog(), manager)
+			eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityInfo, "msg", time.Now())
+			eventsHandler.AddMetricsEvent(ctx, clusterID, nil, models.EventSeverityInfo, "metrics", time.Now())
+			ds := deliveries(*webhook.ID)
+			Expect(ds).Should(HaveLen(1))
+			Expect(ds[0].EventType).Should(Equal(models.WebhookEventTypeEvent))
+		})
+	})
+
+	Context("Filters", func() {
+		It("no filters - all user events and status changes", func() {
+			webhook := register(&models.WebhookCreateParams{URL: swag.String("https://example.com/hook")})
+			notifyEvent(event(models.EventSeverityInfo, models.EventCategoryUser))
+			notifyEvent(event(models.EventSeverityInfo, models.EventCategoryMetrics))
+			manager.NotifyHostStatusChange(db, &models.Host{ID: &hostID, ClusterID: clusterID, Status: swag.String(models.HostStatusKnown)},
+				models.HostStatusInsufficient, "RefreshHost")
+			manager.NotifyClusterStatusChange(db, &models.Cluster{ID: &clusterID, Status: swag.String(models.ClusterStatusReady)},
+				models.ClusterStatusInsufficient, "RefreshStatus")
+			Expect(deliveries(*webhook.ID)).Should(HaveLen(3))
+		})
+
+		It("severity and event type filters", func() {
+			webhook := register(&models.WebhookCreateParams{
+				URL:        swag.String("https://example.com/hook"),
+				Severities: []string{models.EventSeverityError, models.EventSeverityCritical},
+				EventTypes: []models.WebhookEventType{models.WebhookEventTypeEvent},
+			})
+			notifyEvent(event(models.EventSeverityInfo, models.EventCategoryUser))
+			notifyEvent(event(models.EventSeverityError, models.EventCategoryUser))
+			manager.NotifyClusterStatusChange(db, &models.Cluster{ID: &clusterID, Status: swag.String(models.ClusterStatusError)},
+				models.ClusterStatusInstalling, "RefreshStatus")
+			ds := deliveries(*webhook.ID)
+			Expect(ds).Should(HaveLen(1))
+			Expect(ds[0].EventType).Should(Equal(models.WebhookEventTypeEvent))
+			Expect(*ds[0].Status).Should(Equal(models.WebhookDeliveryStatusPending))
+		})
+	})
+
+	Context("Delivery", func() {
+		var (
+			worker   *notifications.DeliveryWorker
+			server   *httptest.Server
+			status   int
+			requests []*http.Request
+			bodies   [][]byte
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			requests = nil
+			bodies = nil
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				requests = append(requests, r)
+				bodies = append(bodies, body)
+				w.WriteHeader(status)
+			}))
+			worker = notifications.NewDeliveryWorker(notifications.Config{
+				DeliveryTimeout:        time.Second,
+				MaxAttempts:            2,
+				RetryBackoff:           0,
+				MaxRetryBackoff:        0,
+				DeliveriesPerCycle:     10,
+				AllowedPrivateNetworks: []string{"127.0.0.0/8"},
+			}, db, common.GetTestLog(), &leader.DummyElector{})
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("posts a signed notification", func() {
+			webhook := register(&models.WebhookCreateParams{URL: swag.String(server.URL), Secret: "secret"})
+			manager.NotifyHostStatusChange(db, &models.Host{ID: &hostID, ClusterID: clusterID, Status: swag.String(models.HostStatusKnown)},
+				models.HostStatusInsufficient, "RefreshHost")
+			worker.DeliverPendingNotifications()
+
+			Expect(requests).Should(HaveLen(1))
+			Expect(requests[0].Header.Get(notifications.EventHeader)).Should(Equal(string(models.WebhookEventTypeHostStatusChanged)))
+			Expect(requests[0].Header.Get(notifications.SignatureHeader)).Should(Equal(notifications.Sign("secret", bodies[0])))
+			var n notifications.Notification
+			Expect(json.Unmarshal(bodies[0], &n)).ShouldNot(HaveOccurred())
+			Expect(*n.HostID).Should(Equal(hostID))
+			Expect(n.StatusChange.SrcStatus).Should(Equal(models.HostStatusInsufficient))
+			Expect(n.StatusChange.DstStatus).Should(Equal(models.HostStatusKnown))
+
+			ds := deliveries(*webhook.ID)
+			Expect(*ds[0].Status).Should(Equal(models.WebhookDeliveryStatusDelivered))
+			Expect(ds[0].ResponseCode).Should(Equal(int64(http.StatusOK)))
+
+			worker.DeliverPendingNotifications()
+			Expect(requests).Should(HaveLen(1))
+		})
+
+		It("retries until the maximum number of attempts", func() {
+			status = http.StatusServiceUnavailable
+			webhook := register(&models.WebhookCreateParams{URL: swag.String(server.URL)})
+			notifyEvent(event(models.EventSeverityInfo, models.EventCategoryUser))
+
+			worker.DeliverPendingNotifications()
+			ds := deliveries(*webhook.ID)
+			Expect(*ds[0].Status).Should(Equal(models.WebhookDeliveryStatusPending))
+			Expect(ds[0].Attempts).Should(Equal(int64(1)))
+
+			worker.DeliverPendingNotifications()
+			ds = deliveries(*webhook.ID)
+			Expect(*ds[0].Status).Should(Equal(models.WebhookDeliveryStatusFailed))
+			Expect(ds[0].Attempts).Should(Equal(int64(2)))
+			Expect(ds[0].LastError).Should(ContainSubstring("503"))
+			Expect(requests).Should(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,226 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+//go:generate mockgen -source=notifier.go -package=notifications -destination=mock_notifier.go
+
+// The notifications are queued with the DB of the caller, so that they are rolled back together with the
+// transaction that raised them
+type Notifier interface {
+	// NotifyEvent queues a notification about the given event to all the matching webhooks of its cluster
+	NotifyEvent(ctx context.Context, db *gorm.DB, event *models.Event) error
+	// NotifyHostStatusChange queues a notification about a host that moved from srcStatus to its current status
+	NotifyHostStatusChange(db *gorm.DB, host *models.Host, srcStatus string, transition string)
+	// NotifyClusterStatusChange queues a notification about a cluster that moved from srcStatus to its current status
+	NotifyClusterStatusChange(db *gorm.DB, cluster *models.Cluster, srcStatus string, transition string)
+}
+
+type Config struct {
+	DeliveryInterval   time.Duration `envconfig:"WEBHOOK_DELIVERY_INTERVAL" default:"10s"`
+	DeliveryTimeout    time.Duration `envconfig:"WEBHOOK_DELIVERY_TIMEOUT" default:"10s"`
+	MaxAttempts        int64         `envconfig:"WEBHOOK_MAX_DELIVERY_ATTEMPTS" default:"8"`
+	RetryBackoff       time.Duration `envconfig:"WEBHOOK_RETRY_BACKOFF" default:"30s"`
+	MaxRetryBackoff    time.Duration `envconfig:"WEBHOOK_MAX_RETRY_BACKOFF" default:"1h"`
+	DeliveriesPerCycle int           `envconfig:"WEBHOOK_DELIVERIES_PER_CYCLE" default:"100"`
+	// Loopback, link-local and private addresses may only be targeted by webhooks in these networks
+	AllowedPrivateNetworks []string `envconfig:"WEBHOOK_ALLOWED_PRIVATE_NETWORKS"`
+}
+
+// Notification is the JSON document that is posted to the webhook
+type Notification struct {
+	ID           strfmt.UUID             `json:"id"`
+	Type         models.WebhookEventType `json:"type"`
+	ClusterID    strfmt.UUID             `json:"cluster_id"`
+	HostID       *strfmt.UUID            `json:"host_id,omitempty"`
+	Severity     string                  `json:"severity,omitempty"`
+	Category     string                  `json:"category,omitempty"`
+	Time         strfmt.DateTime         `json:"time"`
+	Event        *models.Event           `json:"event,omitempty"`
+	StatusChange *StatusChange           `json:"status_change,omitempty"`
+}
+
+type StatusChange struct {
+	Transition string `json:"transition,omitempty"`
+	SrcStatus  string `json:"src_status"`
+	DstStatus  string `json:"dst_status"`
+	StatusInfo string `json:"status_info,omitempty"`
+}
+
+// filters are stored as a JSON document in the webhook record
+type filters struct {
+	Severities []string                  `json:"severities,omitempty"`
+	Categories []string                  `json:"categories,omitempty"`
+	EventTypes []models.WebhookEventType `json:"event_types,omitempty"`
+}
+
+func (f *filters) match(n *Notification) bool {
+	if len(f.EventTypes) > 0 && !funk.Contains(f.EventTypes, n.Type) {
+		return false
+	}
+	if n.Type != models.WebhookEventTypeEvent {
+		return true
+	}
+	if len(f.Severities) > 0 && !funk.ContainsString(f.Severities, n.Severity) {
+		return false
+	}
+	return len(f.Categories) == 0 || funk.ContainsString(f.Categories, n.Category)
+}
+
+var _ Notifier = &Manager{}
+var _ events.Listener = &Manager{}
+
+type Manager struct {
+	db    *gorm.DB
+	log   logrus.FieldLogger
+	guard *targetGuard
+}
+
+func NewManager(db *gorm.DB, log logrus.FieldLogger, config Config) *Manager {
+	return &Manager{
+		db:    db,
+		log:   log,
+		guard: newTargetGuard(config, log),
+	}
+}
+
+func (m *Manager) NotifyEvent(ctx context.Context, db *gorm.DB, event *models.Event) error {
+	// Metrics events are internal and are never sent to the webhooks
+	if event == nil || event.ClusterID == nil || event.Category != models.EventCategoryUser {
+		return nil
+	}
+	n := &Notification{
+		Type:      models.WebhookEventTypeEvent,
+		ClusterID: *event.ClusterID,
+		Severity:  swag.StringValue(event.Severity),
+		Category:  event.Category,
+		Event:     event,
+	}
+	if event.HostID != "" {
+		hostID := event.HostID
+		n.HostID = &hostID
+	}
+	if event.EventTime != nil {
+		n.Time = *event.EventTime
+	}
+	return m.enqueue(db, n)
+}
+
+func (m *Manager) NotifyHostStatusChange(db *gorm.DB, host *models.Host, srcStatus string, transition string) {
+	if host == nil || host.ClusterID == "" || host.ID == nil {
+		return
+	}
+	m.enqueueAndLog(db, &Notification{
+		Type:      models.WebhookEventTypeHostStatusChanged,
+		ClusterID: host.ClusterID,
+		HostID:    host.ID,
+		StatusChange: &StatusChange{
+			Transition: transition,
+			SrcStatus:  srcStatus,
+			DstStatus:  swag.StringValue(host.Status),
+			StatusInfo: swag.StringValue(host.StatusInfo),
+		},
+	})
+}
+
+func (m *Manager) NotifyClusterStatusChange(db *gorm.DB, cluster *models.Cluster, srcStatus string, transition string) {
+	if cluster == nil || cluster.ID == nil {
+		return
+	}
+	m.enqueueAndLog(db, &Notification{
+		Type:      models.WebhookEventTypeClusterStatusChanged,
+		ClusterID: *cluster.ID,
+		StatusChange: &StatusChange{
+			Transition: transition,
+			SrcStatus:  srcStatus,
+			DstStatus:  swag.StringValue(cluster.Status),
+			StatusInfo: swag.StringValue(cluster.StatusInfo),
+		},
+	})
+}
+
+func (m *Manager) enqueueAndLog(db *gorm.DB, n *Notification) {
+	if err := m.enqueue(db, n); err != nil {
+		m.log.WithError(err).Warnf("failed to queue %s notification of cluster %s", n.Type, n.ClusterID)
+	}
+}
+
+// enqueue stores a pending delivery for every webhook of the cluster that matches the notification.
+// The deliveries are posted asynchronously by the DeliveryWorker so that a slow or unavailable
+// receiver never blocks the flow that raised the notification.
+func (m *Manager) enqueue(db *gorm.DB, n *Notification) error {
+	var webhooks []*common.Webhook
+	if err := db.Find(&webhooks, "cluster_id = ?", n.ClusterID.String()).Error; err != nil {
+		return errors.Wrapf(err, "failed to get webhooks of cluster %s", n.ClusterID)
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+	if time.Time(n.Time).IsZero() {
+		n.Time = strfmt.DateTime(time.Now())
+	}
+	for _, webhook := range webhooks {
+		f, err := webhookFilters(webhook)
+		if err != nil {
+			m.log.WithError(err).Warnf("failed to parse filters of webhook %s", webhook.ID)
+			continue
+		}
+		if !f.match(n) {
+			continue
+		}
+		if err := createDelivery(db, webhook, n); err != nil {
+			return errors.Wrapf(err, "failed to queue %s notification of cluster %s to webhook %s", n.Type, n.ClusterID, webhook.ID)
+		}
+	}
+	return nil
+}
+
+func createDelivery(db *gorm.DB, webhook *common.Webhook, n *Notification) error {
+	id := strfmt.UUID(uuid.New().String())
+	notification := *n
+	notification.ID = id
+	payload, err := json.Marshal(&notification)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal notification")
+	}
+	now := strfmt.DateTime(time.Now())
+	clusterID := n.ClusterID
+	delivery := common.WebhookDelivery{
+		WebhookDelivery: models.WebhookDelivery{
+			ID:            &id,
+			WebhookID:     webhook.ID,
+			ClusterID:     &clusterID,
+			EventType:     n.Type,
+			Status:        swag.String(models.WebhookDeliveryStatusPending),
+			CreatedAt:     now,
+			NextAttemptAt: now,
+		},
+		Payload: string(payload),
+	}
+	return db.Create(&delivery).Error
+}
+
+func webhookFilters(webhook *common.Webhook) (*filters, error) {
+	var f filters
+	if webhook.Filters == "" {
+		return &f, nil
+	}
+	if err := json.Unmarshal([]byte(webhook.Filters), &f); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
@@ -0,0 +1,119 @@
+package notifications
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// The networks of the service itself and of its neighbours, which webhooks may not target unless they are
+// allowed by the configuration
+var restrictedNetworks = parseNetworks([]string{
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+}, logrus.StandardLogger())
+
+func parseNetworks(cidrs []string, log logrus.FieldLogger) []*net.IPNet {
+	ret := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			log.WithError(err).Warnf("Ignoring invalid webhook network %s", cidr)
+			continue
+		}
+		ret = append(ret, ipNet)
+	}
+	return ret
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range networks {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// targetGuard keeps the webhooks from reaching the internal endpoints of the service and of its network, e.g. the
+// metadata service of the cloud or the database
+type targetGuard struct {
+	allowed []*net.IPNet
+}
+
+func newTargetGuard(config Config, log logrus.FieldLogger) *targetGuard {
+	return &targetGuard{allowed: parseNetworks(config.AllowedPrivateNetworks, log)}
+}
+
+func (g *targetGuard) checkIP(ip net.IP) error {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() ||
+		containsIP(restrictedNetworks, ip) {
+		if !containsIP(g.allowed, ip) {
+			return errors.Errorf("webhook address %s is in a restricted network", ip)
+		}
+	}
+	return nil
+}
+
+// checkURL rejects webhook URLs that target a restricted address literally. Host names are checked when the
+// notifications are posted, since they may resolve to other addresses by then.
+func (g *targetGuard) checkURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid webhook URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("webhook URL scheme %s is not supported", u.Scheme)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return errors.New("webhook URL has no host")
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return g.checkIP(net.IPv4(127, 0, 0, 1))
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return g.checkIP(ip)
+	}
+	return nil
+}
+
+// control checks every address that the client connects to, after the host name is resolved
+func (g *targetGuard) control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("webhook address %s is not an IP address", host)
+	}
+	return g.checkIP(ip)
+}
+
+func (g *targetGuard) httpClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: g.control}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/webhooks"
+	"github.com/pkg/errors"
+)
+
+var _ restapi.WebhooksAPI = &Manager{}
+
+func (m *Manager) RegisterWebhook(ctx context.Context, params operations.RegisterWebhookParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	if _, err := m.getCluster(ctx, params.ClusterID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err := m.guard.checkURL(swag.StringValue(params.NewWebhookParams.URL)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	for _, eventType := range params.NewWebhookParams.EventTypes {
+		if err := eventType.Validate(strfmt.Default); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+	f, err := json.Marshal(&filters{
+		Severities: params.NewWebhookParams.Severities,
+		Categories: params.NewWebhookParams.Categories,
+		EventTypes: params.NewWebhookParams.EventTypes,
+	})
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	id := strfmt.UUID(uuid.New().String())
+	clusterID := params.ClusterID
+	webhook := common.Webhook{
+		Webhook: models.Webhook{
+			ID:        &id,
+			ClusterID: &clusterID,
+			URL:       params.NewWebhookParams.URL,
+			CreatedAt: strfmt.DateTime(time.Now()),
+		},
+		Secret:  params.NewWebhookParams.Secret,
+		Filters: string(f),
+	}
+	if err = m.db.Create(&webhook).Error; err != nil {
+		log.WithError(err).Errorf("failed to register webhook for cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Registered webhook %s for cluster %s", id, params.ClusterID)
+	ret, err := toModel(&webhook)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewRegisterWebhookCreated().WithPayload(ret)
+}
+
+func (m *Manager) ListWebhooks(ctx context.Context, params operations.ListWebhooksParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	if _, err := m.getCluster(ctx, params.ClusterID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	var webhooks []*common.Webhook
+	if err := m.db.Order("created_at").Find(&webhooks, "cluster_id = ?", params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get webhooks of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.WebhookList, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		w, err := toModel(webhook)
+		if err != nil {
+			log.WithError(err).Errorf("failed to parse webhook %s", webhook.ID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		ret = append(ret, w)
+	}
+	return operations.NewListWebhooksOK().WithPayload(ret)
+}
+
+func (m *Manager) DeregisterWebhook(ctx context.Context, params operations.DeregisterWebhookParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	if _, err := m.getCluster(ctx, params.ClusterID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if _, err := m.getWebhook(params.ClusterID, params.WebhookID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	txSuccess := false
+	tx := m.db.Begin()
+	defer func() {
+		if !txSuccess {
+			tx.Rollback()
+		}
+	}()
+	if err := tx.Delete(&common.WebhookDelivery{}, "webhook_id = ?", params.WebhookID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to delete deliveries of webhook %s", params.WebhookID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err := tx.Delete(&common.Webhook{}, "id = ?", params.WebhookID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to delete webhook %s", params.WebhookID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	txSuccess = true
+	log.Infof("Deregistered webhook %s of cluster %s", params.WebhookID, params.ClusterID)
+	return operations.NewDeregisterWebhookNoContent()
+}
+
+func (m *Manager) ListWebhookDeliveries(ctx context.Context, params operations.ListWebhookDeliveriesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	if _, err := m.getCluster(ctx, params.ClusterID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if _, err := m.getWebhook(params.ClusterID, params.WebhookID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	var deliveries []*common.WebhookDelivery
+	if err := m.db.Order("created_at").Find(&deliveries, "webhook_id = ?", params.WebhookID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get deliveries of webhook %s", params.WebhookID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.WebhookDeliveryList, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		d := delivery.WebhookDelivery
+		ret = append(ret, &d)
+	}
+	return operations.NewListWebhookDeliveriesOK().WithPayload(ret)
+}
+
+func (m *Manager) getCluster(ctx context.Context, clusterID strfmt.UUID) (*common.Cluster, error) {
+	var cluster common.Cluster
+	if err := m.db.Select("id").First(&cluster, identity.AddUserFilter(ctx, "id = ?"), clusterID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("cluster %s not found", clusterID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &cluster, nil
+}
+
+func (m *Manager) getWebhook(clusterID, webhookID strfmt.UUID) (*common.Webhook, error) {
+	var webhook common.Webhook
+	if err := m.db.First(&webhook, "id = ? and cluster_id = ?", webhookID.String(), clusterID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("webhook %s of cluster %s not found", webhookID, clusterID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &webhook, nil
+}
+
+// toModel returns the API representation of the webhook, without its secret
+func toModel(webhook *common.Webhook) (*models.Webhook, error) {
+	f, err := webhookFilters(webhook)
+	if err != nil {
+		return nil, err
+	}
+	ret := webhook.Webhook
+	ret.Severities = f.Severities
+	ret.Categories = f.Categories
+	ret.EventTypes = f.EventTypes
+	return &ret, nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/cnv"
 	"github.com/openshift/assisted-service/internal/operators/lso"
 	"github.com/openshift/assisted-service/internal/operators/ocs"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/sirupsen/logrus"
@@ -25,11 +26,16 @@ var OperatorConsole = models.MonitoredOperator{
 type Options struct {
 	CheckClusterVersion bool
 	CNVConfig           cnv.Config
+	SRIOVConfig         sriov.Config
+	// PluginsDir is a directory of declarative OLM operator definitions to load at startup
+	PluginsDir string `envconfig:"OPERATOR_PLUGINS_DIR" default:""`
 }
 
-// NewManager creates new instance of an Operator Manager
-func NewManager(log logrus.FieldLogger, manifestAPI restapi.ManifestsAPI, options Options) *Manager {
-	return NewManagerWithOperators(log, manifestAPI, options, lso.NewLSOperator(), ocs.NewOcsOperator(log), cnv.NewCNVOperator(log, options.CNVConfig))
+// NewManager creates new instance of an Operator Manager with the built-in operators and the given plugins
+func NewManager(log logrus.FieldLogger, manifestAPI restapi.ManifestsAPI, options Options, plugins ...api.Operator) *Manager {
+	olmOperators := append([]api.Operator{lso.NewLSOperator(), ocs.NewOcsOperator(log), cnv.NewCNVOperator(log, options.CNVConfig),
+		sriov.NewSRIOVOperator(log, options.SRIOVConfig)}, plugins...)
+	return NewManagerWithOperators(log, manifestAPI, options, olmOperators...)
 }
 
 // NewManagerWithOperators creates new instance of an Operator Manager and configures it with given operators
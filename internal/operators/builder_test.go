@@ -74,4 +74,18 @@ var _ = Describe("Operators manager builder", func() {
 		Expect(monitoredOperatorsList).To(HaveKeyWithValue(OperatorConsole.Name, &OperatorConsole))
 		Expect(monitoredOperatorsList).To(HaveKeyWithValue(OperatorCVO.Name, &OperatorCVO))
 	})
+
+	It("should add plugins to the built-in OLM operators", func() {
+		pluginName := "plugin"
+		monitoredPlugin := &models.MonitoredOperator{Name: pluginName}
+		operator1.EXPECT().GetName().AnyTimes().Return(pluginName)
+		operator1.EXPECT().GetMonitoredOperator().Return(monitoredPlugin)
+
+		manager := NewManager(log, nil, Options{}, operator1)
+
+		monitoredOperatorsList := manager.GetMonitoredOperatorsList()
+		Expect(monitoredOperatorsList).To(HaveLen(6))
+		Expect(monitoredOperatorsList).To(HaveKeyWithValue(pluginName, monitoredPlugin))
+		Expect(manager.GetOperatorByName(pluginName)).To(Equal(monitoredPlugin))
+	})
 })
@@ -0,0 +1,24 @@
+package common
+
+import (
+	"strings"
+)
+
+// DeviceIDDecoder is a set of PCI device IDs, in the vendor:device form, that is decoded from a comma
+// separated environment variable
+type DeviceIDDecoder map[string]bool
+
+func (d *DeviceIDDecoder) Decode(value string) error {
+	deviceIDSet := make(DeviceIDDecoder)
+	*d = deviceIDSet
+
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	devices := strings.Split(value, ",")
+
+	for _, device := range devices {
+		deviceIDSet[strings.ToLower(device)] = true
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package declarative
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDeclarative(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Declarative operators suite")
+}
@@ -0,0 +1,178 @@
+package declarative
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/cnv"
+	"github.com/openshift/assisted-service/internal/operators/lso"
+	"github.com/openshift/assisted-service/internal/operators/ocs"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	defaultSource          = "redhat-operators"
+	defaultSourceNamespace = "openshift-marketplace"
+	defaultTimeoutSeconds  = 60 * 60
+)
+
+var nameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// builtinOperators are the OLM operators that are compiled into the service. Their names can't be
+// reused by declarative operators, but declarative operators can depend on them.
+var builtinOperators = []string{lso.Operator.Name, ocs.Operator.Name, cnv.Operator.Name, sriov.Operator.Name}
+
+// Definition describes an OLM operator that is installed through its subscription and the manifests
+// rendered from its templates, without any operator-specific code
+type Definition struct {
+	// Name of the operator, used to enable it for a cluster
+	Name string `yaml:"name"`
+	// Namespace that the operator is installed in
+	Namespace string `yaml:"namespace"`
+	// SubscriptionName is the name of the subscription object, which is also used to monitor the operator
+	SubscriptionName string `yaml:"subscriptionName"`
+	// PackageName is the name of the operator package in the catalog source
+	PackageName string `yaml:"packageName"`
+	// Channel of the package to subscribe to
+	Channel string `yaml:"channel"`
+	// Source is the catalog source of the package, redhat-operators by default
+	Source string `yaml:"source"`
+	// SourceNamespace is the namespace of the catalog source, openshift-marketplace by default
+	SourceNamespace string `yaml:"sourceNamespace"`
+	// TimeoutSeconds is how long the installation of the operator may take, an hour by default
+	TimeoutSeconds int64 `yaml:"timeoutSeconds"`
+	// Dependencies are the names of the operators that must be installed along with this one
+	Dependencies []string     `yaml:"dependencies"`
+	Requirements Requirements `yaml:"requirements"`
+	// Manifests are rendered with the operator definition and the cluster and added to the installation
+	// after the namespace, operator group and subscription of the operator
+	Manifests []ManifestTemplate `yaml:"manifests"`
+}
+
+type Requirements struct {
+	Cluster ClusterRequirements `yaml:"cluster"`
+	Master  HostRequirements    `yaml:"master"`
+	Worker  HostRequirements    `yaml:"worker"`
+}
+
+type ClusterRequirements struct {
+	// MinHosts is the minimal number of hosts in the cluster
+	MinHosts int `yaml:"minHosts"`
+}
+
+type HostRequirements struct {
+	CPUCores   int64 `yaml:"cpuCores"`
+	RAMMib     int64 `yaml:"ramMib"`
+	DiskSizeGb int64 `yaml:"diskSizeGb"`
+	// Qualitative requirements are only described to the user
+	Qualitative []string `yaml:"qualitative"`
+}
+
+type ManifestTemplate struct {
+	FileName string `yaml:"fileName"`
+	// Template is a Go template of the manifest, rendered with .Operator and .Cluster
+	Template string `yaml:"template"`
+}
+
+// LoadOperators loads the operator definitions from the YAML files in the directory. No operators are
+// loaded when the directory is not set.
+func LoadOperators(log logrus.FieldLogger, dir string) ([]api.Operator, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	var files []string
+	for _, pattern := range []string{"*.yaml", "*.yml"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+
+	definitions := make([]*Definition, 0, len(files))
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read operator definition %s", file)
+		}
+		definition, err := Parse(content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid operator definition %s", file)
+		}
+		definitions = append(definitions, definition)
+	}
+	if err := validateDependencies(definitions); err != nil {
+		return nil, err
+	}
+
+	ret := make([]api.Operator, 0, len(definitions))
+	for _, definition := range definitions {
+		log.Infof("Loaded declarative operator %s from %s", definition.Name, dir)
+		ret = append(ret, NewOperator(log, definition))
+	}
+	return ret, nil
+}
+
+// Parse parses and validates a single operator definition, and fills in its defaults
+func Parse(content []byte) (*Definition, error) {
+	var definition Definition
+	if err := yaml.UnmarshalStrict(content, &definition); err != nil {
+		return nil, err
+	}
+	if !nameRegex.MatchString(definition.Name) {
+		return nil, errors.Errorf("operator name %q must consist of lower case alphanumeric characters or '-'", definition.Name)
+	}
+	for _, name := range builtinOperators {
+		if definition.Name == name {
+			return nil, errors.Errorf("operator %s is already built into the service", name)
+		}
+	}
+	if definition.Namespace == "" || definition.SubscriptionName == "" || definition.PackageName == "" || definition.Channel == "" {
+		return nil, errors.Errorf("operator %s must specify its namespace, subscriptionName, packageName and channel", definition.Name)
+	}
+	if definition.Source == "" {
+		definition.Source = defaultSource
+	}
+	if definition.SourceNamespace == "" {
+		definition.SourceNamespace = defaultSourceNamespace
+	}
+	if definition.TimeoutSeconds == 0 {
+		definition.TimeoutSeconds = defaultTimeoutSeconds
+	}
+	for _, manifest := range definition.Manifests {
+		if manifest.FileName == "" {
+			return nil, errors.Errorf("manifest of operator %s must have a file name", definition.Name)
+		}
+		if _, err := template.New(manifest.FileName).Parse(manifest.Template); err != nil {
+			return nil, errors.Wrapf(err, "invalid template of manifest %s", manifest.FileName)
+		}
+	}
+	return &definition, nil
+}
+
+func validateDependencies(definitions []*Definition) error {
+	known := make(map[string]bool)
+	for _, name := range builtinOperators {
+		known[name] = true
+	}
+	for _, definition := range definitions {
+		if known[definition.Name] {
+			return errors.Errorf("operator %s is defined more than once", definition.Name)
+		}
+		known[definition.Name] = true
+	}
+	for _, definition := range definitions {
+		for _, dependency := range definition.Dependencies {
+			if !known[dependency] {
+				return errors.Errorf("operator %s depends on unknown operator %s", definition.Name, dependency)
+			}
+		}
+	}
+	return nil
+}
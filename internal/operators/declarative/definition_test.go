@@ -0,0 +1,142 @@
+package declarative
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+const ptpDefinition = `
+name: ptp
+namespace: openshift-ptp
+subscriptionName: ptp-operator-subscription
+packageName: ptp-operator
+channel: "4.8"
+requirements:
+  cluster:
+    minHosts: 3
+  master:
+    cpuCores: 2
+    ramMib: 1024
+  worker:
+    cpuCores: 1
+    ramMib: 512
+    qualitative:
+    - PTP capable network interface
+manifests:
+- fileName: 50_openshift-ptp_config.yaml
+  template: |
+    apiVersion: ptp.openshift.io/v1
+    kind: PtpOperatorConfig
+    metadata:
+      name: default
+      namespace: {{.Operator.Namespace}}
+    spec:
+      daemonNodeSelector:
+        node-role.kubernetes.io/worker: ""
+`
+
+var _ = Describe("Parse", func() {
+	It("parses a definition and fills in the defaults", func() {
+		definition, err := Parse([]byte(ptpDefinition))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(definition.Name).To(Equal("ptp"))
+		Expect(definition.Channel).To(Equal("4.8"))
+		Expect(definition.Source).To(Equal(defaultSource))
+		Expect(definition.SourceNamespace).To(Equal(defaultSourceNamespace))
+		Expect(definition.TimeoutSeconds).To(Equal(int64(defaultTimeoutSeconds)))
+		Expect(definition.Requirements.Cluster.MinHosts).To(Equal(3))
+		Expect(definition.Requirements.Worker.Qualitative).To(ConsistOf("PTP capable network interface"))
+		Expect(definition.Manifests).To(HaveLen(1))
+	})
+
+	It("rejects unknown fields", func() {
+		_, err := Parse([]byte(ptpDefinition + "unknown: true\n"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects invalid names", func() {
+		_, err := Parse([]byte("name: Not_Valid\nnamespace: ns\nsubscriptionName: s\npackageName: p\nchannel: c\n"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects the names of built-in operators", func() {
+		_, err := Parse([]byte("name: lso\nnamespace: ns\nsubscriptionName: s\npackageName: p\nchannel: c\n"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("requires the subscription details", func() {
+		_, err := Parse([]byte("name: ptp\nnamespace: ns\n"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects invalid templates", func() {
+		_, err := Parse([]byte("name: ptp\nnamespace: ns\nsubscriptionName: s\npackageName: p\nchannel: c\n" +
+			"manifests:\n- fileName: broken.yaml\n  template: '{{.Operator'\n"))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("LoadOperators", func() {
+	var (
+		log = logrus.New()
+		dir string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "operators")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	write := func(name, content string) {
+		Expect(ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600)).To(Succeed())
+	}
+
+	It("loads nothing when the directory is not set", func() {
+		operators, err := LoadOperators(log, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operators).To(BeEmpty())
+	})
+
+	It("loads the definitions in the directory", func() {
+		write("ptp.yaml", ptpDefinition)
+		write("logging.yml", "name: logging\nnamespace: openshift-logging\nsubscriptionName: cluster-logging\n"+
+			"packageName: cluster-logging\nchannel: stable\ndependencies:\n- ptp\n- lso\n")
+		write("README.md", "not a definition")
+
+		operators, err := LoadOperators(log, dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operators).To(HaveLen(2))
+		names := []string{operators[0].GetName(), operators[1].GetName()}
+		Expect(names).To(ConsistOf("ptp", "logging"))
+	})
+
+	It("fails for unknown dependencies", func() {
+		write("logging.yaml", "name: logging\nnamespace: openshift-logging\nsubscriptionName: cluster-logging\n"+
+			"packageName: cluster-logging\nchannel: stable\ndependencies:\n- elasticsearch\n")
+		_, err := LoadOperators(log, dir)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails for operators that are defined twice", func() {
+		write("ptp.yaml", ptpDefinition)
+		write("ptp-copy.yaml", ptpDefinition)
+		_, err := LoadOperators(log, dir)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails for invalid definitions", func() {
+		write("ptp.yaml", "name: [")
+		_, err := LoadOperators(log, dir)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,234 @@
+package declarative
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/sirupsen/logrus"
+)
+
+// operator is an OLM operator plugin built from a Definition; it implements api.Operator
+type operator struct {
+	log        logrus.FieldLogger
+	definition *Definition
+	monitored  models.MonitoredOperator
+}
+
+// NewOperator creates new instance of an installation plugin of the defined operator
+func NewOperator(log logrus.FieldLogger, definition *Definition) *operator {
+	return &operator{
+		log:        log,
+		definition: definition,
+		monitored: models.MonitoredOperator{
+			Name:             definition.Name,
+			OperatorType:     models.OperatorTypeOlm,
+			Namespace:        definition.Namespace,
+			SubscriptionName: definition.SubscriptionName,
+			TimeoutSeconds:   definition.TimeoutSeconds,
+		},
+	}
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return o.definition.Name
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies() []string {
+	return append(make([]string, 0, len(o.definition.Dependencies)), o.definition.Dependencies...)
+}
+
+// GetClusterValidationID returns cluster validation ID for the Operator
+func (o *operator) GetClusterValidationID() string {
+	return fmt.Sprintf("%s-requirements-satisfied", o.definition.Name)
+}
+
+// GetHostValidationID returns host validation ID for the Operator
+func (o *operator) GetHostValidationID() string {
+	return fmt.Sprintf("%s-requirements-satisfied", o.definition.Name)
+}
+
+// ValidateCluster verifies that the cluster has enough hosts for the operator
+func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (api.ValidationResult, error) {
+	if minHosts := o.definition.Requirements.Cluster.MinHosts; len(cluster.Hosts) < minHosts {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(),
+			Reasons: []string{fmt.Sprintf("The cluster must have at least %d hosts to deploy %s", minHosts, o.GetName())}}, nil
+	}
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID()}, nil
+}
+
+// ValidateHost returns validationResult based on node type requirements such as memory, cpu and disk size
+func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, host *models.Host) (api.ValidationResult, error) {
+	if host.Inventory == "" {
+		return api.ValidationResult{Status: api.Pending, ValidationId: o.GetHostValidationID(), Reasons: []string{"Missing Inventory in some of the hosts"}}, nil
+	}
+	inventory, err := hostutil.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		o.log.Errorf("Failed to get inventory from host with id %s", host.ID)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
+	}
+
+	// If the Role is set to Auto-assign for a host, it is not possible to determine which requirements apply
+	if host.Role == models.HostRoleAutoAssign && *toDetails(o.definition.Requirements.Master) != *toDetails(o.definition.Requirements.Worker) {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(),
+			Reasons: []string{fmt.Sprintf("All host roles must be assigned to enable %s.", o.GetName())}}, nil
+	}
+	requirements, err := o.GetHostRequirements(ctx, cluster, host)
+	if err != nil {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{err.Error()}}, err
+	}
+
+	if inventory.CPU == nil || inventory.CPU.Count < requirements.CPUCores {
+		var count int64
+		if inventory.CPU != nil {
+			count = inventory.CPU.Count
+		}
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(),
+			Reasons: []string{fmt.Sprintf("Insufficient CPU to deploy %s. Required CPU count is %d but found %d", o.GetName(), requirements.CPUCores, count)}}, nil
+	}
+	if inventory.Memory == nil || inventory.Memory.UsableBytes < conversions.MibToBytes(requirements.RAMMib) {
+		var usableMemory int64
+		if inventory.Memory != nil {
+			usableMemory = conversions.BytesToMib(inventory.Memory.UsableBytes)
+		}
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(),
+			Reasons: []string{fmt.Sprintf("Insufficient memory to deploy %s. Required memory is %d MiB but found %d MiB", o.GetName(), requirements.RAMMib, usableMemory)}}, nil
+	}
+	if requirements.DiskSizeGb > 0 && !hasEligibleDisk(inventory, conversions.GbToBytes(requirements.DiskSizeGb)) {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(),
+			Reasons: []string{fmt.Sprintf("Insufficient disk size to deploy %s. Required an eligible disk of at least %d GB", o.GetName(), requirements.DiskSizeGb)}}, nil
+	}
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID()}, nil
+}
+
+// GenerateManifests generates the namespace, operator group and subscription of the operator, followed by
+// the manifests rendered from its templates
+func (o *operator) GenerateManifests(c *common.Cluster) (map[string][]byte, error) {
+	data := map[string]interface{}{
+		"Operator": o.definition,
+		"Cluster":  c,
+	}
+	prefix := fmt.Sprintf("99_openshift-%s", o.GetName())
+	manifests := make(map[string][]byte)
+	for fileName, tmpl := range map[string]string{
+		prefix + "_ns.yaml":             namespaceTemplate,
+		prefix + "_operator_group.yaml": operatorGroupTemplate,
+		prefix + "_subscription.yaml":   subscriptionTemplate,
+	} {
+		content, err := render(fileName, tmpl, data)
+		if err != nil {
+			return nil, err
+		}
+		manifests[fileName] = content
+	}
+	for _, manifest := range o.definition.Manifests {
+		content, err := render(manifest.FileName, manifest.Template, data)
+		if err != nil {
+			return nil, err
+		}
+		manifests[manifest.FileName] = content
+	}
+	return manifests, nil
+}
+
+// GetProperties provides description of operator properties: none required
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the defined operator
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &o.monitored
+}
+
+// GetHostRequirements provides operator's requirements towards the host
+func (o *operator) GetHostRequirements(_ context.Context, _ *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	switch host.Role {
+	case models.HostRoleMaster:
+		return toDetails(o.definition.Requirements.Master), nil
+	case models.HostRoleWorker, models.HostRoleAutoAssign:
+		return toDetails(o.definition.Requirements.Worker), nil
+	}
+	return nil, fmt.Errorf("unsupported role: %s", host.Role)
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(context.Context, *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: o.GetDependencies(),
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: &models.HostTypeHardwareRequirements{
+				Qualitative:  o.definition.Requirements.Master.Qualitative,
+				Quantitative: toDetails(o.definition.Requirements.Master),
+			},
+			Worker: &models.HostTypeHardwareRequirements{
+				Qualitative:  o.definition.Requirements.Worker.Qualitative,
+				Quantitative: toDetails(o.definition.Requirements.Worker),
+			},
+		},
+	}, nil
+}
+
+func hasEligibleDisk(inventory *models.Inventory, minSizeBytes int64) bool {
+	for _, disk := range inventory.Disks {
+		if disk.InstallationEligibility.Eligible && disk.SizeBytes >= minSizeBytes {
+			return true
+		}
+	}
+	return false
+}
+
+func toDetails(requirements HostRequirements) *models.ClusterHostRequirementsDetails {
+	return &models.ClusterHostRequirementsDetails{
+		CPUCores:   requirements.CPUCores,
+		RAMMib:     requirements.RAMMib,
+		DiskSizeGb: requirements.DiskSizeGb,
+	}
+}
+
+func render(name, text string, data interface{}) ([]byte, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err = tmpl.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+const namespaceTemplate = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: "{{.Operator.Namespace}}"`
+
+const operatorGroupTemplate = `apiVersion: operators.coreos.com/v1
+kind: OperatorGroup
+metadata:
+  name: "{{.Operator.Name}}"
+  namespace: "{{.Operator.Namespace}}"
+spec:
+  targetNamespaces:
+  - "{{.Operator.Namespace}}"`
+
+const subscriptionTemplate = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: "{{.Operator.SubscriptionName}}"
+  namespace: "{{.Operator.Namespace}}"
+spec:
+  source: "{{.Operator.Source}}"
+  sourceNamespace: "{{.Operator.SourceNamespace}}"
+  name: "{{.Operator.PackageName}}"
+  channel: "{{.Operator.Channel}}"
+  installPlanApproval: Automatic`
@@ -0,0 +1,162 @@
+package declarative
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Declarative operator", func() {
+	var (
+		ctx      = context.Background()
+		log      = logrus.New()
+		operator *operator
+		cluster  *common.Cluster
+	)
+
+	BeforeEach(func() {
+		definition, err := Parse([]byte(ptpDefinition))
+		Expect(err).ToNot(HaveOccurred())
+		operator = NewOperator(log, definition)
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{ID: &clusterID, OpenshiftVersion: "4.8"}}
+	})
+
+	newHost := func(role models.HostRole, cpus int64, ramMib int64) *models.Host {
+		b, err := json.Marshal(&models.Inventory{
+			CPU:    &models.CPU{Count: cpus},
+			Memory: &models.Memory{UsableBytes: conversions.MibToBytes(ramMib)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return &models.Host{Role: role, Inventory: string(b)}
+	}
+
+	It("is monitored through its subscription", func() {
+		Expect(operator.GetMonitoredOperator()).To(Equal(&models.MonitoredOperator{
+			Name:             "ptp",
+			OperatorType:     models.OperatorTypeOlm,
+			Namespace:        "openshift-ptp",
+			SubscriptionName: "ptp-operator-subscription",
+			TimeoutSeconds:   defaultTimeoutSeconds,
+		}))
+		Expect(operator.GetHostValidationID()).To(Equal("ptp-requirements-satisfied"))
+		Expect(operator.GetClusterValidationID()).To(Equal("ptp-requirements-satisfied"))
+	})
+
+	Context("ValidateCluster", func() {
+		It("fails when the cluster has too few hosts", func() {
+			cluster.Hosts = []*models.Host{{}, {}}
+			result, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf("The cluster must have at least 3 hosts to deploy ptp"))
+		})
+
+		It("succeeds when the cluster has enough hosts", func() {
+			cluster.Hosts = []*models.Host{{}, {}, {}}
+			result, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+	})
+
+	Context("ValidateHost", func() {
+		It("is pending without an inventory", func() {
+			result, err := operator.ValidateHost(ctx, cluster, &models.Host{Role: models.HostRoleMaster})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Pending))
+		})
+
+		It("validates the requirements of the host role", func() {
+			result, err := operator.ValidateHost(ctx, cluster, newHost(models.HostRoleWorker, 1, 512))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+
+			result, err = operator.ValidateHost(ctx, cluster, newHost(models.HostRoleMaster, 1, 1024))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf("Insufficient CPU to deploy ptp. Required CPU count is 2 but found 1"))
+
+			result, err = operator.ValidateHost(ctx, cluster, newHost(models.HostRoleMaster, 2, 512))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf("Insufficient memory to deploy ptp. Required memory is 1024 MiB but found 512 MiB"))
+		})
+
+		It("validates the disk size of the host role", func() {
+			operator.definition.Requirements.Worker.DiskSizeGb = 100
+			host := newHost(models.HostRoleWorker, 1, 512)
+			result, err := operator.ValidateHost(ctx, cluster, host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf("Insufficient disk size to deploy ptp. Required an eligible disk of at least 100 GB"))
+
+			b, err := json.Marshal(&models.Inventory{
+				CPU:    &models.CPU{Count: 1},
+				Memory: &models.Memory{UsableBytes: conversions.MibToBytes(512)},
+				Disks: []*models.Disk{
+					{Name: "sda", SizeBytes: conversions.GbToBytes(200)},
+					{Name: "sdb", SizeBytes: conversions.GbToBytes(50), InstallationEligibility: models.DiskInstallationEligibility{Eligible: true}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			host.Inventory = string(b)
+			result, err = operator.ValidateHost(ctx, cluster, host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+
+			b, err = json.Marshal(&models.Inventory{
+				CPU:    &models.CPU{Count: 1},
+				Memory: &models.Memory{UsableBytes: conversions.MibToBytes(512)},
+				Disks: []*models.Disk{
+					{Name: "sda", SizeBytes: conversions.GbToBytes(200), InstallationEligibility: models.DiskInstallationEligibility{Eligible: true}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			host.Inventory = string(b)
+			result, err = operator.ValidateHost(ctx, cluster, host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+
+		It("fails for auto-assign hosts when the requirements of the roles differ", func() {
+			result, err := operator.ValidateHost(ctx, cluster, newHost(models.HostRoleAutoAssign, 4, 4096))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+		})
+	})
+
+	It("reports its preflight requirements", func() {
+		requirements, err := operator.GetPreflightRequirements(ctx, cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requirements.OperatorName).To(Equal("ptp"))
+		Expect(requirements.Requirements.Master.Quantitative).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 2, RAMMib: 1024}))
+		Expect(requirements.Requirements.Worker.Qualitative).To(ConsistOf("PTP capable network interface"))
+	})
+
+	It("generates the subscription and the templated manifests", func() {
+		manifests, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(4))
+		Expect(manifests).To(HaveKey("99_openshift-ptp_ns.yaml"))
+		Expect(manifests).To(HaveKey("99_openshift-ptp_operator_group.yaml"))
+		Expect(string(manifests["99_openshift-ptp_subscription.yaml"])).To(ContainSubstring(`name: "ptp-operator"`))
+		Expect(string(manifests["99_openshift-ptp_subscription.yaml"])).To(ContainSubstring(`channel: "4.8"`))
+		Expect(string(manifests["50_openshift-ptp_config.yaml"])).To(ContainSubstring("namespace: openshift-ptp"))
+	})
+
+	It("fails to generate manifests that refer to missing data", func() {
+		operator.definition.Manifests = []ManifestTemplate{{FileName: "broken.yaml", Template: "{{.Operator.Missing}}"}}
+		_, err := operator.GenerateManifests(cluster)
+		Expect(err).To(HaveOccurred())
+	})
+})
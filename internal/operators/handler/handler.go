@@ -12,6 +12,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/timeline"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
@@ -130,6 +131,14 @@ func (h *Handler) UpdateMonitoredOperatorStatus(ctx context.Context, clusterID s
 		return err
 	}
 
+	if operator.Status != status || operator.StatusInfo != statusInfo {
+		if err = timeline.RecordOperatorStatus(tx, clusterID, operator.Name, status, statusInfo); err != nil {
+			err = errors.Wrapf(err, "failed to record status of operator %s of cluster %s in the timeline", operator.Name, clusterID)
+			log.Error(err)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
 	operator.Status = status
 	operator.StatusInfo = statusInfo
 	operator.StatusUpdatedAt = strfmt.DateTime(time.Now())
@@ -0,0 +1,10 @@
+package sriov
+
+import operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+
+type Config struct {
+	// List of supported SR-IOV NICs: https://docs.openshift.com/container-platform/4.7/networking/hardware_networks/about-sriov.html#supported-devices_about-sriov
+	SupportedNICs operatorscommon.DeviceIDDecoder `envconfig:"SRIOV_SUPPORTED_NICS" default:"8086:158b,8086:1592,8086:1593,8086:159b,15b3:1013,15b3:1015,15b3:1017,15b3:1019,15b3:101b,15b3:101d"`
+	// Channel of the subscription of the SR-IOV Network Operator
+	Channel string `envconfig:"SRIOV_CHANNEL" default:"stable"`
+}
@@ -0,0 +1,65 @@
+package sriov
+
+import (
+	"bytes"
+	"text/template"
+)
+
+// Manifests returns manifests needed to deploy the SR-IOV Network Operator from the given channel
+func Manifests(channel string) (map[string][]byte, error) {
+	sriovSubs, err := subscription(channel)
+	if err != nil {
+		return nil, err
+	}
+	manifests := make(map[string][]byte)
+	manifests["99_openshift-sriov_subscription.yaml"] = sriovSubs
+	manifests["99_openshift-sriov_ns.yaml"] = []byte(sriovNamespace)
+	manifests["99_openshift-sriov_operator_group.yaml"] = []byte(sriovGroup)
+	return manifests, nil
+}
+
+func subscription(channel string) ([]byte, error) {
+	data := map[string]string{
+		"OPERATOR_NAMESPACE":         Operator.Namespace,
+		"OPERATOR_SUBSCRIPTION_NAME": Operator.SubscriptionName,
+		"OPERATOR_CHANNEL":           channel,
+	}
+	tmpl, err := template.New("sriovSubscription").Parse(sriovSubscription)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+const sriovSubscription = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: "{{.OPERATOR_SUBSCRIPTION_NAME}}"
+  namespace: "{{.OPERATOR_NAMESPACE}}"
+spec:
+  source: redhat-operators
+  sourceNamespace: openshift-marketplace
+  name: sriov-network-operator
+  channel: "{{.OPERATOR_CHANNEL}}"
+  installPlanApproval: "Automatic"`
+
+const sriovNamespace = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: openshift-sriov-network-operator
+  annotations:
+    workload.openshift.io/allowed: management`
+
+const sriovGroup = `apiVersion: operators.coreos.com/v1
+kind: OperatorGroup
+metadata:
+  name: sriov-network-operators
+  namespace: openshift-sriov-network-operator
+spec:
+  targetNamespaces:
+  - openshift-sriov-network-operator`
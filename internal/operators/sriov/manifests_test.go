@@ -0,0 +1,33 @@
+package sriov
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("SR-IOV manifest generation", func() {
+	operator := NewSRIOVOperator(common.GetTestLog(), Config{Channel: "4.8"})
+	cluster := common.Cluster{Cluster: models.Cluster{
+		OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+	}}
+
+	Context("Create SR-IOV Manifest", func() {
+
+		manifests, err := operator.GenerateManifests(&cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(3))
+		Expect(manifests["99_openshift-sriov_ns.yaml"]).NotTo(HaveLen(0))
+		Expect(manifests["99_openshift-sriov_operator_group.yaml"]).NotTo(HaveLen(0))
+		Expect(manifests["99_openshift-sriov_subscription.yaml"]).NotTo(HaveLen(0))
+		Expect(string(manifests["99_openshift-sriov_subscription.yaml"])).To(ContainSubstring(`channel: "4.8"`))
+
+		for _, manifest := range manifests {
+			_, err := yaml.YAMLToJSON(manifest)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+	})
+
+})
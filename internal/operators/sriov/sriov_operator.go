@@ -0,0 +1,161 @@
+package sriov
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/virt"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+type operator struct {
+	log    logrus.FieldLogger
+	config Config
+}
+
+var Operator = models.MonitoredOperator{
+	Name:             "sriov",
+	OperatorType:     models.OperatorTypeOlm,
+	Namespace:        "openshift-sriov-network-operator",
+	SubscriptionName: "sriov-network-operator-subscription",
+	TimeoutSeconds:   60 * 60,
+}
+
+// NewSRIOVOperator creates new instance of a SR-IOV Network Operator installation plugin
+func NewSRIOVOperator(log logrus.FieldLogger, cfg Config) *operator {
+	log.WithField("config", cfg).Infof("Configuring SR-IOV Operator plugin")
+	return &operator{
+		log:    log,
+		config: cfg,
+	}
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return Operator.Name
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies() []string {
+	return make([]string, 0)
+}
+
+// GetClusterValidationID returns cluster validation ID for the Operator
+func (o *operator) GetClusterValidationID() string {
+	return string(models.ClusterValidationIDSriovRequirementsSatisfied)
+}
+
+// GetHostValidationID returns host validation ID for the Operator
+func (o *operator) GetHostValidationID() string {
+	return string(models.HostValidationIDSriovRequirementsSatisfied)
+}
+
+// ValidateCluster always return "valid" result
+func (o *operator) ValidateCluster(_ context.Context, _ *common.Cluster) (api.ValidationResult, error) {
+	// No need to validate cluster because it will be validate on per host basis
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID()}, nil
+}
+
+// ValidateHost returns validationResult based on the network interfaces and the virtualization support of the host
+func (o *operator) ValidateHost(_ context.Context, cluster *common.Cluster, host *models.Host) (api.ValidationResult, error) {
+	if host.Inventory == "" {
+		o.log.Info("Empty Inventory of host with hostID ", host.ID)
+		return api.ValidationResult{Status: api.Pending, ValidationId: o.GetHostValidationID(), Reasons: []string{"Missing Inventory in some of the hosts"}}, nil
+	}
+	inventory, err := hostutil.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		o.log.Errorf("Failed to get inventory from host with id %s", host.ID)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
+	}
+
+	// SR-IOV workloads are scheduled on the workers, so the masters need SR-IOV only when there are no workers
+	if host.Role == models.HostRoleMaster && hasWorkers(cluster) {
+		return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID()}, nil
+	}
+
+	// The virtual functions of the NICs are passed to the pods through the IOMMU, which needs virtualization support
+	if inventory.CPU == nil || !virt.IsVirtSupported(inventory) {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{"CPU does not have virtualization support required by SR-IOV"}}, nil
+	}
+
+	if o.getSupportedNicCount(inventory) == 0 {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{"No supported SR-IOV network interface was found"}}, nil
+	}
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID()}, nil
+}
+
+// GenerateManifests generates manifests for the operator
+func (o *operator) GenerateManifests(c *common.Cluster) (map[string][]byte, error) {
+	return Manifests(o.config.Channel)
+}
+
+// GetProperties provides description of operator properties: none required
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the SR-IOV Operator
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &Operator
+}
+
+// GetHostRequirements provides operator's requirements towards the host
+func (o *operator) GetHostRequirements(context.Context, *common.Cluster, *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	return &models.ClusterHostRequirementsDetails{}, nil
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(context.Context, *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	qualitativeRequirements := []string{
+		"At least one supported SR-IOV network interface on each worker, or on each master if there are no workers",
+		"CPU has virtualization flag (vmx or svm) and IOMMU is enabled in the BIOS",
+	}
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: o.GetDependencies(),
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: &models.HostTypeHardwareRequirements{
+				Qualitative:  qualitativeRequirements,
+				Quantitative: &models.ClusterHostRequirementsDetails{},
+			},
+			Worker: &models.HostTypeHardwareRequirements{
+				Qualitative:  qualitativeRequirements,
+				Quantitative: &models.ClusterHostRequirementsDetails{},
+			},
+		},
+	}, nil
+}
+
+func (o *operator) getSupportedNicCount(inventory *models.Inventory) int64 {
+	var count int64
+	for _, nic := range inventory.Interfaces {
+		if o.config.SupportedNICs[getDeviceKeyForInterface(nic)] {
+			count++
+		}
+	}
+	return count
+}
+
+func hasWorkers(cluster *common.Cluster) bool {
+	if cluster == nil {
+		return false
+	}
+	for _, h := range cluster.Hosts {
+		if h.Role == models.HostRoleWorker {
+			return true
+		}
+	}
+	return false
+}
+
+func getDeviceKeyForInterface(nic *models.Interface) string {
+	return sanitizeID(nic.Vendor) + ":" + sanitizeID(nic.Product)
+}
+
+func sanitizeID(id string) string {
+	return strings.TrimPrefix(strings.ToLower(id), "0x")
+}
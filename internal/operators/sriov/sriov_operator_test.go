@@ -0,0 +1,93 @@
+package sriov_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("SR-IOV operator", func() {
+	var (
+		log      = logrus.New()
+		operator api.Operator
+		cluster  *common.Cluster
+	)
+
+	BeforeEach(func() {
+		cfg := sriov.Config{
+			SupportedNICs: map[string]bool{
+				"8086:158b": true,
+				"15b3:1015": true,
+			}}
+		operator = sriov.NewSRIOVOperator(log, cfg)
+		cluster = &common.Cluster{}
+	})
+
+	supportedNIC := &models.Interface{Name: "ens1f0", Vendor: "0x8086", Product: "0x158b"}
+	unsupportedNIC := &models.Interface{Name: "eno1", Vendor: "0x8086", Product: "0x1521"}
+
+	Context("host validation", func() {
+		It("should be pending without an inventory", func() {
+			result, err := operator.ValidateHost(context.TODO(), cluster, &models.Host{Role: models.HostRoleWorker})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Pending))
+		})
+
+		table.DescribeTable("should validate the NICs and the virtualization support of workers",
+			func(flags []string, interfaces []*models.Interface, expectedStatus api.ValidationStatus) {
+				host := models.Host{Role: models.HostRoleWorker, Inventory: getInventory(flags, interfaces)}
+
+				result, err := operator.ValidateHost(context.TODO(), cluster, &host)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.ValidationId).To(Equal(string(models.HostValidationIDSriovRequirementsSatisfied)))
+				Expect(result.Status).To(Equal(expectedStatus))
+			},
+			table.Entry("with a supported NIC and vmx", []string{"vmx"}, []*models.Interface{unsupportedNIC, supportedNIC}, api.Success),
+			table.Entry("with a supported NIC and svm", []string{"svm"}, []*models.Interface{supportedNIC}, api.Success),
+			table.Entry("without virtualization support", []string{"sse"}, []*models.Interface{supportedNIC}, api.Failure),
+			table.Entry("without a supported NIC", []string{"vmx"}, []*models.Interface{unsupportedNIC}, api.Failure),
+		)
+
+		It("should not validate masters when the cluster has workers", func() {
+			cluster.Hosts = []*models.Host{{Role: models.HostRoleMaster}, {Role: models.HostRoleWorker}}
+			host := models.Host{Role: models.HostRoleMaster, Inventory: getInventory(nil, []*models.Interface{unsupportedNIC})}
+
+			result, err := operator.ValidateHost(context.TODO(), cluster, &host)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+
+		It("should validate masters when the cluster has no workers", func() {
+			cluster.Hosts = []*models.Host{{Role: models.HostRoleMaster}}
+			host := models.Host{Role: models.HostRoleMaster, Inventory: getInventory(nil, []*models.Interface{unsupportedNIC})}
+
+			result, err := operator.ValidateHost(context.TODO(), cluster, &host)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+		})
+	})
+
+	It("should be monitored through its subscription", func() {
+		Expect(operator.GetMonitoredOperator()).To(Equal(&sriov.Operator))
+		Expect(operator.GetMonitoredOperator().SubscriptionName).To(Equal("sriov-network-operator-subscription"))
+	})
+})
+
+func getInventory(flags []string, interfaces []*models.Interface) string {
+	inventory := models.Inventory{CPU: &models.CPU{Count: 8, Flags: flags}, Interfaces: interfaces}
+	inventoryJSON, err := hostutil.MarshalInventory(&inventory)
+	Expect(err).ToNot(HaveOccurred())
+	return inventoryJSON
+}
@@ -0,0 +1,150 @@
+package timeline
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/timeline"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+var _ restapi.TimelineAPI = &Api{}
+
+// clusterFinalStatuses and hostFinalStages are not followed by another status or stage,
+// so they are finished as soon as they are reached
+var (
+	clusterFinalStatuses = []string{models.ClusterStatusInstalled, models.ClusterStatusError, models.ClusterStatusCancelled}
+	hostFinalStages      = []models.HostStage{models.HostStageDone, models.HostStageFailed}
+)
+
+type Api struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:  db,
+		log: log,
+	}
+}
+
+func (a *Api) GetInstallationTimeline(ctx context.Context, params operations.GetInstallationTimelineParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	var cluster common.Cluster
+	if err := a.db.Preload("Hosts").First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var records []*common.TimelineRecord
+	if err := a.db.Order("created_at").Order("id").Find(&records, "cluster_id = ?", params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get timeline records of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// The statuses of the cluster are taken from its status history
+	var statuses []*common.StatusHistory
+	if err := a.db.Order("created_at").Order("id").Find(&statuses, "cluster_id = ? and host_id = ?", params.ClusterID.String(), "").Error; err != nil {
+		log.WithError(err).Errorf("failed to get status history of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	return operations.NewGetInstallationTimelineOK().WithPayload(buildTimeline(&cluster, statuses, records))
+}
+
+func buildTimeline(cluster *common.Cluster, statuses []*common.StatusHistory, records []*common.TimelineRecord) *models.InstallationTimeline {
+	ret := &models.InstallationTimeline{
+		ClusterID:       cluster.ID,
+		ClusterStatuses: make([]*models.TimelineClusterStatus, 0),
+		Hosts:           make([]*models.TimelineHost, 0, len(cluster.Hosts)),
+		Validations:     make([]*models.TimelineValidationChange, 0),
+		Operators:       make([]*models.TimelineOperatorChange, 0),
+	}
+	hosts := make(map[strfmt.UUID]*models.TimelineHost)
+	for _, h := range cluster.Hosts {
+		host := &models.TimelineHost{
+			HostID:   *h.ID,
+			Hostname: hostname(h),
+			Role:     h.Role,
+			Stages:   make([]*models.TimelineHostStage, 0),
+		}
+		hosts[*h.ID] = host
+		ret.Hosts = append(ret.Hosts, host)
+	}
+
+	for _, s := range statuses {
+		t := *s.CreatedAt
+		if n := len(ret.ClusterStatuses); n > 0 && ret.ClusterStatuses[n-1].FinishedAt == nil {
+			ret.ClusterStatuses[n-1].FinishedAt = &t
+		}
+		status := &models.TimelineClusterStatus{Status: swag.StringValue(s.Status), StatusInfo: s.StatusInfo, StartedAt: t}
+		if funk.ContainsString(clusterFinalStatuses, status.Status) {
+			status.FinishedAt = &t
+		}
+		ret.ClusterStatuses = append(ret.ClusterStatuses, status)
+	}
+
+	for _, r := range records {
+		t := strfmt.DateTime(r.CreatedAt)
+		switch r.Kind {
+		case KindHostStage:
+			host, ok := hosts[r.HostID]
+			if !ok {
+				// the host was deleted from the cluster
+				continue
+			}
+			if n := len(host.Stages); n > 0 && host.Stages[n-1].FinishedAt == nil {
+				host.Stages[n-1].FinishedAt = &t
+			}
+			stage := &models.TimelineHostStage{Stage: models.HostStage(r.Name), ProgressInfo: r.Info, StartedAt: t}
+			if funk.Contains(hostFinalStages, stage.Stage) {
+				stage.FinishedAt = &t
+			}
+			host.Stages = append(host.Stages, stage)
+		case KindHostValidation, KindClusterValidation:
+			ret.Validations = append(ret.Validations, &models.TimelineValidationChange{
+				HostID:       r.HostID,
+				ValidationID: r.Name,
+				Status:       r.Status,
+				Message:      r.Info,
+				Time:         t,
+			})
+		case KindOperator:
+			ret.Operators = append(ret.Operators, &models.TimelineOperatorChange{
+				OperatorName: r.Name,
+				Status:       models.OperatorStatus(r.Status),
+				StatusInfo:   r.Info,
+				Time:         t,
+			})
+		}
+	}
+	return ret
+}
+
+func hostname(h *models.Host) string {
+	if h.RequestedHostname != "" {
+		return h.RequestedHostname
+	}
+	var inventory models.Inventory
+	if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+		return ""
+	}
+	return inventory.Hostname
+}
@@ -0,0 +1,86 @@
+package timeline
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	KindHostStage         = "host-stage"
+	KindHostValidation    = "host-validation"
+	KindClusterValidation = "cluster-validation"
+	KindOperator          = "operator"
+)
+
+// RecordHostStage records that the host reached a new installation stage
+func RecordHostStage(db *gorm.DB, clusterID, hostID strfmt.UUID, stage models.HostStage, progressInfo string) error {
+	return add(db, &common.TimelineRecord{
+		ClusterID: clusterID,
+		HostID:    hostID,
+		Kind:      KindHostStage,
+		Name:      string(stage),
+		Info:      progressInfo,
+	})
+}
+
+// RecordHostValidation records that the status of a host validation changed
+func RecordHostValidation(db *gorm.DB, clusterID, hostID strfmt.UUID, validationID, status, message string) error {
+	return add(db, &common.TimelineRecord{
+		ClusterID: clusterID,
+		HostID:    hostID,
+		Kind:      KindHostValidation,
+		Name:      validationID,
+		Status:    status,
+		Info:      message,
+	})
+}
+
+// RecordClusterValidation records that the status of a cluster validation changed
+func RecordClusterValidation(db *gorm.DB, clusterID strfmt.UUID, validationID, status, message string) error {
+	return add(db, &common.TimelineRecord{
+		ClusterID: clusterID,
+		Kind:      KindClusterValidation,
+		Name:      validationID,
+		Status:    status,
+		Info:      message,
+	})
+}
+
+// RecordOperatorStatus records a status report of a monitored operator
+func RecordOperatorStatus(db *gorm.DB, clusterID strfmt.UUID, operatorName string, status models.OperatorStatus, statusInfo string) error {
+	return add(db, &common.TimelineRecord{
+		ClusterID: clusterID,
+		Kind:      KindOperator,
+		Name:      operatorName,
+		Status:    string(status),
+		Info:      statusInfo,
+	})
+}
+
+func add(db *gorm.DB, record *common.TimelineRecord) error {
+	record.CreatedAt = time.Now()
+	return db.Create(record).Error
+}
+
+// CountHostValidationChanges returns how many times the status of each validation of the host
+// changed to one of the given statuses since the given time
+func CountHostValidationChanges(db *gorm.DB, clusterID, hostID strfmt.UUID, since time.Time, statuses ...string) (map[string]int, error) {
+	var rows []struct {
+		Name  string
+		Count int
+	}
+	if err := db.Model(&common.TimelineRecord{}).Select("name, count(*) as count").
+		Where("cluster_id = ? and host_id = ? and kind = ? and created_at > ? and status in (?)", clusterID.String(), hostID.String(), KindHostValidation, since, statuses).
+		Group("name").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Name] = r.Count
+	}
+	return ret, nil
+}
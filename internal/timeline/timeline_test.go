@@ -0,0 +1,128 @@
+package timeline_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/history"
+	"github.com/openshift/assisted-service/internal/timeline"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/timeline"
+)
+
+func TestTimeline(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Timeline test Suite")
+}
+
+var _ = Describe("GetInstallationTimeline", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		dbName    string
+		api       *timeline.Api
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		api = timeline.NewApi(db, common.GetTestLog())
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&models.Host{ID: &hostID, ClusterID: clusterID, RequestedHostname: "master-0", Role: models.HostRoleMaster}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getTimeline := func() *models.InstallationTimeline {
+		reply := api.GetInstallationTimeline(ctx, operations.GetInstallationTimelineParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewGetInstallationTimelineOK()))
+		return reply.(*operations.GetInstallationTimelineOK).Payload
+	}
+
+	It("returns an empty timeline for a cluster without records", func() {
+		t := getTimeline()
+		Expect(t.ClusterID).Should(Equal(clusterID))
+		Expect(t.ClusterStatuses).Should(BeEmpty())
+		Expect(t.Validations).Should(BeEmpty())
+		Expect(t.Operators).Should(BeEmpty())
+		Expect(t.Hosts).Should(HaveLen(1))
+		Expect(t.Hosts[0].Hostname).Should(Equal("master-0"))
+		Expect(t.Hosts[0].Stages).Should(BeEmpty())
+	})
+
+	It("finishes every cluster status when the next one starts", func() {
+		recordStatus := func(previousStatus, status, statusInfo string) {
+			cluster := &models.Cluster{ID: &clusterID, Status: swag.String(status), StatusInfo: swag.String(statusInfo)}
+			Expect(history.RecordClusterStatusChange(db, cluster, previousStatus, "RefreshStatus", nil)).ShouldNot(HaveOccurred())
+		}
+		recordStatus(models.ClusterStatusReady, models.ClusterStatusPreparingForInstallation, "preparing")
+		recordStatus(models.ClusterStatusPreparingForInstallation, models.ClusterStatusInstalling, "installing")
+		recordStatus(models.ClusterStatusFinalizing, models.ClusterStatusInstalled, "installed")
+		host := &models.Host{ID: &hostID, ClusterID: clusterID, Status: swag.String(models.HostStatusInstalling)}
+		Expect(history.RecordHostStatusChange(db, host, models.HostStatusPreparingForInstallation, "Install", nil)).ShouldNot(HaveOccurred())
+
+		statuses := getTimeline().ClusterStatuses
+		Expect(statuses).Should(HaveLen(3))
+		Expect(statuses[0].Status).Should(Equal(models.ClusterStatusPreparingForInstallation))
+		Expect(statuses[0].FinishedAt).ShouldNot(BeNil())
+		Expect(*statuses[0].FinishedAt).Should(Equal(statuses[1].StartedAt))
+		Expect(*statuses[1].FinishedAt).Should(Equal(statuses[2].StartedAt))
+		Expect(statuses[2].StatusInfo).Should(Equal("installed"))
+		Expect(*statuses[2].FinishedAt).Should(Equal(statuses[2].StartedAt))
+	})
+
+	It("leaves the current host stage unfinished", func() {
+		Expect(timeline.RecordHostStage(db, clusterID, hostID, models.HostStageStartingInstallation, "")).ShouldNot(HaveOccurred())
+		Expect(timeline.RecordHostStage(db, clusterID, hostID, models.HostStageWritingImageToDisk, "50%")).ShouldNot(HaveOccurred())
+		Expect(timeline.RecordHostStage(db, clusterID, strfmt.UUID(uuid.New().String()), models.HostStageDone, "")).ShouldNot(HaveOccurred())
+
+		hosts := getTimeline().Hosts
+		Expect(hosts).Should(HaveLen(1))
+		Expect(hosts[0].HostID).Should(Equal(hostID))
+		Expect(hosts[0].Role).Should(Equal(models.HostRoleMaster))
+		Expect(hosts[0].Stages).Should(HaveLen(2))
+		Expect(*hosts[0].Stages[0].FinishedAt).Should(Equal(hosts[0].Stages[1].StartedAt))
+		Expect(hosts[0].Stages[1].Stage).Should(Equal(models.HostStageWritingImageToDisk))
+		Expect(hosts[0].Stages[1].ProgressInfo).Should(Equal("50%"))
+		Expect(hosts[0].Stages[1].FinishedAt).Should(BeNil())
+	})
+
+	It("lists validation and operator changes", func() {
+		Expect(timeline.RecordHostValidation(db, clusterID, hostID, string(models.HostValidationIDHasMinCPUCores), "failure", "not enough cores")).ShouldNot(HaveOccurred())
+		Expect(timeline.RecordClusterValidation(db, clusterID, string(models.ClusterValidationIDNtpServerConfigured), "success", "ok")).ShouldNot(HaveOccurred())
+		Expect(timeline.RecordOperatorStatus(db, clusterID, "lso", models.OperatorStatusProgressing, "installing")).ShouldNot(HaveOccurred())
+
+		t := getTimeline()
+		Expect(t.Validations).Should(HaveLen(2))
+		Expect(t.Validations[0].HostID).Should(Equal(hostID))
+		Expect(t.Validations[0].ValidationID).Should(Equal(string(models.HostValidationIDHasMinCPUCores)))
+		Expect(t.Validations[0].Status).Should(Equal("failure"))
+		Expect(t.Validations[0].Message).Should(Equal("not enough cores"))
+		Expect(t.Validations[1].HostID).Should(BeEmpty())
+		Expect(t.Operators).Should(HaveLen(1))
+		Expect(t.Operators[0].OperatorName).Should(Equal("lso"))
+		Expect(t.Operators[0].Status).Should(Equal(models.OperatorStatusProgressing))
+	})
+
+	It("fails for a missing cluster", func() {
+		reply := api.GetInstallationTimeline(ctx, operations.GetInstallationTimelineParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusNotFound, nil)))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusNotFound)))
+	})
+})
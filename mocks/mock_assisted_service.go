@@ -49,6 +49,20 @@ func (mr *MockInstallerAPIMockRecorder) CancelInstallation(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).CancelInstallation), arg0, arg1)
 }
 
+// CollectHostLogs mocks base method
+func (m *MockInstallerAPI) CollectHostLogs(arg0 context.Context, arg1 installer.CollectHostLogsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectHostLogs", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// CollectHostLogs indicates an expected call of CollectHostLogs
+func (mr *MockInstallerAPIMockRecorder) CollectHostLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectHostLogs", reflect.TypeOf((*MockInstallerAPI)(nil).CollectHostLogs), arg0, arg1)
+}
+
 // CompleteInstallation mocks base method
 func (m *MockInstallerAPI) CompleteInstallation(arg0 context.Context, arg1 installer.CompleteInstallationParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -203,6 +217,20 @@ func (mr *MockInstallerAPIMockRecorder) DownloadHostLogs(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadHostLogs", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadHostLogs), arg0, arg1)
 }
 
+// DryRunCluster mocks base method
+func (m *MockInstallerAPI) DryRunCluster(arg0 context.Context, arg1 installer.DryRunClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// DryRunCluster indicates an expected call of DryRunCluster
+func (mr *MockInstallerAPIMockRecorder) DryRunCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunCluster", reflect.TypeOf((*MockInstallerAPI)(nil).DryRunCluster), arg0, arg1)
+}
+
 // EnableHost mocks base method
 func (m *MockInstallerAPI) EnableHost(arg0 context.Context, arg1 installer.EnableHostParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -217,6 +245,20 @@ func (mr *MockInstallerAPIMockRecorder) EnableHost(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHost", reflect.TypeOf((*MockInstallerAPI)(nil).EnableHost), arg0, arg1)
 }
 
+// ExportCluster mocks base method
+func (m *MockInstallerAPI) ExportCluster(arg0 context.Context, arg1 installer.ExportClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ExportCluster indicates an expected call of ExportCluster
+func (mr *MockInstallerAPIMockRecorder) ExportCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCluster", reflect.TypeOf((*MockInstallerAPI)(nil).ExportCluster), arg0, arg1)
+}
+
 // GenerateClusterISO mocks base method
 func (m *MockInstallerAPI) GenerateClusterISO(arg0 context.Context, arg1 installer.GenerateClusterISOParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -287,6 +329,34 @@ func (mr *MockInstallerAPIMockRecorder) GetClusterInstallConfig(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterInstallConfig), arg0, arg1)
 }
 
+// GetClusterInstallConfigDiff mocks base method
+func (m *MockInstallerAPI) GetClusterInstallConfigDiff(arg0 context.Context, arg1 installer.GetClusterInstallConfigDiffParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterInstallConfigDiff", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterInstallConfigDiff indicates an expected call of GetClusterInstallConfigDiff
+func (mr *MockInstallerAPIMockRecorder) GetClusterInstallConfigDiff(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInstallConfigDiff", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterInstallConfigDiff), arg0, arg1)
+}
+
+// GetClusterNetworkTopology mocks base method
+func (m *MockInstallerAPI) GetClusterNetworkTopology(arg0 context.Context, arg1 installer.GetClusterNetworkTopologyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterNetworkTopology", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterNetworkTopology indicates an expected call of GetClusterNetworkTopology
+func (mr *MockInstallerAPIMockRecorder) GetClusterNetworkTopology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterNetworkTopology", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterNetworkTopology), arg0, arg1)
+}
+
 // GetCredentials mocks base method
 func (m *MockInstallerAPI) GetCredentials(arg0 context.Context, arg1 installer.GetCredentialsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -357,6 +427,20 @@ func (mr *MockInstallerAPIMockRecorder) GetHostIgnition(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostIgnition", reflect.TypeOf((*MockInstallerAPI)(nil).GetHostIgnition), arg0, arg1)
 }
 
+// GetHostIgnitionDiff mocks base method
+func (m *MockInstallerAPI) GetHostIgnitionDiff(arg0 context.Context, arg1 installer.GetHostIgnitionDiffParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostIgnitionDiff", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetHostIgnitionDiff indicates an expected call of GetHostIgnitionDiff
+func (mr *MockInstallerAPIMockRecorder) GetHostIgnitionDiff(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostIgnitionDiff", reflect.TypeOf((*MockInstallerAPI)(nil).GetHostIgnitionDiff), arg0, arg1)
+}
+
 // GetHostRequirements mocks base method
 func (m *MockInstallerAPI) GetHostRequirements(arg0 context.Context, arg1 installer.GetHostRequirementsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -413,6 +497,20 @@ func (mr *MockInstallerAPIMockRecorder) GetPresignedForClusterFiles(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresignedForClusterFiles", reflect.TypeOf((*MockInstallerAPI)(nil).GetPresignedForClusterFiles), arg0, arg1)
 }
 
+// ImportCluster mocks base method
+func (m *MockInstallerAPI) ImportCluster(arg0 context.Context, arg1 installer.ImportClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ImportCluster indicates an expected call of ImportCluster
+func (mr *MockInstallerAPIMockRecorder) ImportCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCluster", reflect.TypeOf((*MockInstallerAPI)(nil).ImportCluster), arg0, arg1)
+}
+
 // InstallCluster mocks base method
 func (m *MockInstallerAPI) InstallCluster(arg0 context.Context, arg1 installer.InstallClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -734,3 +832,17 @@ func (mr *MockInstallerAPIMockRecorder) UploadLogs(arg0, arg1 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadLogs", reflect.TypeOf((*MockInstallerAPI)(nil).UploadLogs), arg0, arg1)
 }
+
+// ValidateStaticNetworkConfig mocks base method
+func (m *MockInstallerAPI) ValidateStaticNetworkConfig(arg0 context.Context, arg1 installer.ValidateStaticNetworkConfigParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateStaticNetworkConfig", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ValidateStaticNetworkConfig indicates an expected call of ValidateStaticNetworkConfig
+func (mr *MockInstallerAPIMockRecorder) ValidateStaticNetworkConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStaticNetworkConfig", reflect.TypeOf((*MockInstallerAPI)(nil).ValidateStaticNetworkConfig), arg0, arg1)
+}
@@ -46,6 +46,9 @@ type Cluster struct {
 	// Minimum: 1
 	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
 
+	// JSON-formatted list of the cluster networks of a dual-stack cluster, the first one is the cluster_network_cidr and cluster_network_host_prefix of the cluster.
+	ClusterNetworks string `json:"cluster_networks,omitempty" gorm:"type:text"`
+
 	// Json formatted string containing the majority groups for connectivity checks.
 	ConnectivityMajorityGroups string `json:"connectivity_majority_groups,omitempty" gorm:"type:text"`
 
@@ -61,10 +64,16 @@ type Cluster struct {
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// JSON-formatted list of the custom validations that are evaluated for the hosts of the cluster instead of the service-wide defaults.
+	CustomHostValidations string `json:"custom_host_validations,omitempty" gorm:"type:text"`
+
 	// The time that the cluster was deleted.
 	// Format: date-time
 	DeletedAt *strfmt.DateTime `json:"deleted_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// disk selection policy
+	DiskSelectionPolicy *DiskSelectionPolicy `json:"disk_selection_policy,omitempty" gorm:"embedded;embedded_prefix:disk_selection_"`
+
 	// email domain
 	EmailDomain string `json:"email_domain,omitempty"`
 
@@ -141,13 +150,22 @@ type Cluster struct {
 	// The progress of log collection or empty if logs are not applicable
 	LogsInfo LogsState `json:"logs_info,omitempty" gorm:"type:varchar(2048)"`
 
+	// The total size in bytes of the logs uploaded from the cluster and its hosts.
+	LogsSizeBytes int64 `json:"logs_size_bytes,omitempty"`
+
 	// A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	MachineNetworkCidr string `json:"machine_network_cidr,omitempty"`
 
+	// JSON-formatted list of the machine networks of a dual-stack cluster, the first one is the machine_network_cidr of the cluster.
+	MachineNetworks string `json:"machine_networks,omitempty" gorm:"type:text"`
+
 	// Operators that are associated with this cluster.
 	MonitoredOperators []*MonitoredOperator `json:"monitored_operators" gorm:"foreignkey:ClusterID;association_foreignkey:ID"`
 
+	// The MTU of the NICs of the hosts in the machine network, e.g. 9000 for jumbo frames. The MTU of the cluster network is derived from it. When unset, the NICs of all the hosts must have the same MTU.
+	Mtu int64 `json:"mtu,omitempty"`
+
 	// Name of the OpenShift cluster.
 	Name string `json:"name,omitempty"`
 
@@ -173,10 +191,16 @@ type Cluster struct {
 	// hosts associated to this cluster that are in 'known' state.
 	ReadyHostCount int64 `json:"ready_host_count,omitempty" gorm:"-"`
 
+	// role assignment policy
+	RoleAssignmentPolicy *RoleAssignmentPolicy `json:"role_assignment_policy,omitempty" gorm:"embedded;embedded_prefix:role_assignment_"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
 
+	// JSON-formatted list of the service networks of a dual-stack cluster, the first one is the service_network_cidr of the cluster.
+	ServiceNetworks string `json:"service_networks,omitempty" gorm:"type:text"`
+
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
 
@@ -249,6 +273,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDiskSelectionPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHighAvailabilityMode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -309,6 +337,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRoleAssignmentPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -443,6 +475,24 @@ func (m *Cluster) validateDeletedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateDiskSelectionPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DiskSelectionPolicy) { // not required
+		return nil
+	}
+
+	if m.DiskSelectionPolicy != nil {
+		if err := m.DiskSelectionPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("disk_selection_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var clusterTypeHighAvailabilityModePropEnum []interface{}
 
 func init() {
@@ -774,6 +824,24 @@ func (m *Cluster) validateOpenshiftClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateRoleAssignmentPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RoleAssignmentPolicy) { // not required
+		return nil
+	}
+
+	if m.RoleAssignmentPolicy != nil {
+		if err := m.RoleAssignmentPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("role_assignment_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
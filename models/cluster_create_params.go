@@ -23,6 +23,10 @@ type ClusterCreateParams struct {
 	// A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.
 	AdditionalNtpSource *string `json:"additional_ntp_source,omitempty"`
 
+	// The virtual IP used to reach the OpenShift cluster's API.
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$
+	APIVip string `json:"api_vip,omitempty"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
@@ -35,6 +39,9 @@ type ClusterCreateParams struct {
 	// Minimum: 1
 	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
 
+	// The cluster networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the cluster_network_cidr and cluster_network_host_prefix of the cluster.
+	ClusterNetworks []*ClusterNetwork `json:"cluster_networks"`
+
 	// Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster
 	// over multiple master nodes whereas 'None' installs a full cluster over one node.
 	//
@@ -59,6 +66,14 @@ type ClusterCreateParams struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$
 	IngressVip string `json:"ingress_vip,omitempty"`
 
+	// The machine networks of a dual-stack cluster, at most one per IP address family with the IPv4 one first. The first one is the machine_network_cidr of the cluster.
+	MachineNetworks []*MachineNetwork `json:"machine_networks"`
+
+	// The MTU of the NICs of the hosts in the machine network, e.g. 9000 for jumbo frames. The MTU of the cluster network is derived from it. When unset, the NICs of all the hosts must have the same MTU.
+	// Maximum: 9216
+	// Minimum: 576
+	Mtu *int64 `json:"mtu,omitempty"`
+
 	// Name of the OpenShift cluster.
 	// Required: true
 	// Max Length: 54
@@ -74,9 +89,8 @@ type ClusterCreateParams struct {
 	// List of OLM operators to be installed.
 	OlmOperators []*OperatorCreateParams `json:"olm_operators"`
 
-	// Version of the OpenShift cluster.
-	// Required: true
-	OpenshiftVersion *string `json:"openshift_version"`
+	// Version of the OpenShift cluster. Required unless it is set by the cluster template.
+	OpenshiftVersion *string `json:"openshift_version,omitempty"`
 
 	// The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.
 	// Required: true
@@ -86,9 +100,19 @@ type ClusterCreateParams struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr *string `json:"service_network_cidr,omitempty"`
 
+	// The service networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the service_network_cidr of the cluster.
+	ServiceNetworks []*ServiceNetwork `json:"service_networks"`
+
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
 
+	// A cluster template whose settings are used for the settings that are not specified. The Go templates in the settings of the template can refer to the name, base DNS domain and VIPs of the new cluster, and to the template variables.
+	// Format: uuid
+	TemplateID *strfmt.UUID `json:"template_id,omitempty"`
+
+	// Values that the Go templates of the cluster template can refer to as {{ .Variables.<key> }}.
+	TemplateVariables map[string]string `json:"template_variables,omitempty"`
+
 	// Indicate if the networking is managed by the user.
 	UserManagedNetworking *bool `json:"user_managed_networking,omitempty"`
 
@@ -100,6 +124,10 @@ type ClusterCreateParams struct {
 func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAPIVip(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -108,6 +136,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateClusterNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHighAvailabilityMode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -120,15 +152,19 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateName(formats); err != nil {
+	if err := m.validateMachineNetworks(formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := m.validateOlmOperators(formats); err != nil {
+	if err := m.validateMtu(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := m.validateOpenshiftVersion(formats); err != nil {
+	if err := m.validateOlmOperators(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -140,12 +176,33 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateServiceNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTemplateID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *ClusterCreateParams) validateAPIVip(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.APIVip) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("api_vip", "body", string(m.APIVip), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateClusterNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ClusterNetworkCidr) { // not required
@@ -176,6 +233,31 @@ func (m *ClusterCreateParams) validateClusterNetworkHostPrefix(formats strfmt.Re
 	return nil
 }
 
+func (m *ClusterCreateParams) validateClusterNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+		if swag.IsZero(m.ClusterNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var clusterCreateParamsTypeHighAvailabilityModePropEnum []interface{}
 
 func init() {
@@ -281,6 +363,48 @@ func (m *ClusterCreateParams) validateIngressVip(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *ClusterCreateParams) validateMachineNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MachineNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MachineNetworks); i++ {
+		if swag.IsZero(m.MachineNetworks[i]) { // not required
+			continue
+		}
+
+		if m.MachineNetworks[i] != nil {
+			if err := m.MachineNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterCreateParams) validateMtu(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Mtu) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("mtu", "body", int64(*m.Mtu), 576, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("mtu", "body", int64(*m.Mtu), 9216, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -323,31 +447,60 @@ func (m *ClusterCreateParams) validateOlmOperators(formats strfmt.Registry) erro
 	return nil
 }
 
-func (m *ClusterCreateParams) validateOpenshiftVersion(formats strfmt.Registry) error {
+func (m *ClusterCreateParams) validatePullSecret(formats strfmt.Registry) error {
 
-	if err := validate.Required("openshift_version", "body", m.OpenshiftVersion); err != nil {
+	if err := validate.Required("pull_secret", "body", m.PullSecret); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (m *ClusterCreateParams) validatePullSecret(formats strfmt.Registry) error {
+func (m *ClusterCreateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
 
-	if err := validate.Required("pull_secret", "body", m.PullSecret); err != nil {
+	if swag.IsZero(m.ServiceNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("service_network_cidr", "body", string(*m.ServiceNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (m *ClusterCreateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
+func (m *ClusterCreateParams) validateServiceNetworks(formats strfmt.Registry) error {
 
-	if swag.IsZero(m.ServiceNetworkCidr) { // not required
+	if swag.IsZero(m.ServiceNetworks) { // not required
 		return nil
 	}
 
-	if err := validate.Pattern("service_network_cidr", "body", string(*m.ServiceNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+		if swag.IsZero(m.ServiceNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ServiceNetworks[i] != nil {
+			if err := m.ServiceNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterCreateParams) validateTemplateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TemplateID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("template_id", "body", "uuid", m.TemplateID.String(), formats); err != nil {
 		return err
 	}
 
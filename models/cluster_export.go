@@ -0,0 +1,180 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterExport A portable definition of a cluster, as exported by an assisted-service instance.
+//
+// swagger:model cluster-export
+type ClusterExport struct {
+
+	// cluster
+	// Required: true
+	Cluster *ExportedCluster `json:"cluster"`
+
+	// exported at
+	// Format: date-time
+	ExportedAt strfmt.DateTime `json:"exported_at,omitempty"`
+
+	// The version of the bundle format.
+	// Required: true
+	FormatVersion *int64 `json:"format_version"`
+
+	// host assignments
+	HostAssignments []*HostAssignment `json:"host_assignments"`
+
+	// The custom manifests of the cluster.
+	Manifests []*CreateManifestParams `json:"manifests"`
+
+	// static network configuration string in the format expected by discovery ignition generation
+	StaticNetworkConfig string `json:"static_network_config,omitempty"`
+}
+
+// Validate validates this cluster export
+func (m *ClusterExport) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCluster(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExportedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFormatVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostAssignments(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateManifests(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterExport) validateCluster(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster", "body", m.Cluster); err != nil {
+		return err
+	}
+
+	if m.Cluster != nil {
+		if err := m.Cluster.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterExport) validateExportedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ExportedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("exported_at", "body", "date-time", m.ExportedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterExport) validateFormatVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("format_version", "body", m.FormatVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterExport) validateHostAssignments(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostAssignments) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostAssignments); i++ {
+		if swag.IsZero(m.HostAssignments[i]) { // not required
+			continue
+		}
+
+		if m.HostAssignments[i] != nil {
+			if err := m.HostAssignments[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_assignments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterExport) validateManifests(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Manifests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Manifests); i++ {
+		if swag.IsZero(m.Manifests[i]) { // not required
+			continue
+		}
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterExport) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterExport) UnmarshalBinary(b []byte) error {
+	var res ClusterExport
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
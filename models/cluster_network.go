@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterNetwork IP address block from which Pod IPs are allocated.
+//
+// swagger:model cluster-network
+type ClusterNetwork struct {
+
+	// The CIDR of the network.
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
+	Cidr string `json:"cidr,omitempty"`
+
+	// The subnet prefix length to assign to each individual node.
+	// Maximum: 128
+	// Minimum: 1
+	HostPrefix int64 `json:"host_prefix,omitempty"`
+}
+
+// Validate validates this cluster network
+func (m *ClusterNetwork) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostPrefix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterNetwork) validateCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Cidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("cidr", "body", string(m.Cidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterNetwork) validateHostPrefix(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostPrefix) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("host_prefix", "body", int64(m.HostPrefix), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("host_prefix", "body", int64(m.HostPrefix), 128, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterNetwork) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterNetwork) UnmarshalBinary(b []byte) error {
+	var res ClusterNetwork
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
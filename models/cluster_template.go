@@ -0,0 +1,327 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterTemplate cluster template
+//
+// swagger:model cluster-template
+type ClusterTemplate struct {
+
+	// A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.
+	AdditionalNtpSource string `json:"additional_ntp_source,omitempty"`
+
+	// IP address block from which Pod IPs are allocated.
+	ClusterNetworkCidr string `json:"cluster_network_cidr,omitempty"`
+
+	// The subnet prefix length to assign to each individual node.
+	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// description
+	Description string `json:"description,omitempty" gorm:"type:text"`
+
+	// high availability mode
+	// Enum: [Full None]
+	HighAvailabilityMode *string `json:"high_availability_mode,omitempty"`
+
+	// A proxy URL to use for creating HTTP connections outside the cluster.
+	HTTPProxy string `json:"http_proxy,omitempty"`
+
+	// A proxy URL to use for creating HTTPS connections outside the cluster.
+	HTTPSProxy string `json:"https_proxy,omitempty"`
+
+	// hyperthreading
+	// Enum: [masters workers none all]
+	Hyperthreading *string `json:"hyperthreading,omitempty"`
+
+	// Unique identifier of the cluster template.
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id" gorm:"primary_key"`
+
+	// JSON-formatted string containing the user overrides for the install-config.yaml file.
+	InstallConfigOverrides string `json:"install_config_overrides,omitempty" gorm:"type:text"`
+
+	// manifests
+	Manifests []*CreateManifestParams `json:"manifests" gorm:"-"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.
+	NoProxy string `json:"no_proxy,omitempty" gorm:"type:text"`
+
+	// olm operators
+	OlmOperators []*OperatorCreateParams `json:"olm_operators" gorm:"-"`
+
+	// Version of the OpenShift cluster.
+	OpenshiftVersion string `json:"openshift_version,omitempty"`
+
+	// org id
+	OrgID string `json:"org_id,omitempty"`
+
+	// The IP address pool to use for service IP addresses.
+	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
+
+	// SSH public key for debugging OpenShift nodes.
+	SSHPublicKey string `json:"ssh_public_key,omitempty" gorm:"type:text"`
+
+	// user managed networking
+	UserManagedNetworking *bool `json:"user_managed_networking,omitempty"`
+
+	// user name
+	UserName string `json:"user_name,omitempty" gorm:"index"`
+
+	// vip dhcp allocation
+	VipDhcpAllocation *bool `json:"vip_dhcp_allocation,omitempty"`
+}
+
+// Validate validates this cluster template
+func (m *ClusterTemplate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHighAvailabilityMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHyperthreading(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateManifests(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOlmOperators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterTemplate) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterTemplateTypeHighAvailabilityModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Full","None"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTemplateTypeHighAvailabilityModePropEnum = append(clusterTemplateTypeHighAvailabilityModePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterTemplateHighAvailabilityModeFull captures enum value "Full"
+	ClusterTemplateHighAvailabilityModeFull string = "Full"
+
+	// ClusterTemplateHighAvailabilityModeNone captures enum value "None"
+	ClusterTemplateHighAvailabilityModeNone string = "None"
+)
+
+// prop value enum
+func (m *ClusterTemplate) validateHighAvailabilityModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTemplateTypeHighAvailabilityModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterTemplate) validateHighAvailabilityMode(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HighAvailabilityMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHighAvailabilityModeEnum("high_availability_mode", "body", *m.HighAvailabilityMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterTemplateTypeHyperthreadingPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["masters","workers","none","all"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTemplateTypeHyperthreadingPropEnum = append(clusterTemplateTypeHyperthreadingPropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterTemplateHyperthreadingMasters captures enum value "masters"
+	ClusterTemplateHyperthreadingMasters string = "masters"
+
+	// ClusterTemplateHyperthreadingWorkers captures enum value "workers"
+	ClusterTemplateHyperthreadingWorkers string = "workers"
+
+	// ClusterTemplateHyperthreadingNone captures enum value "none"
+	ClusterTemplateHyperthreadingNone string = "none"
+
+	// ClusterTemplateHyperthreadingAll captures enum value "all"
+	ClusterTemplateHyperthreadingAll string = "all"
+)
+
+// prop value enum
+func (m *ClusterTemplate) validateHyperthreadingEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTemplateTypeHyperthreadingPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterTemplate) validateHyperthreading(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hyperthreading) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHyperthreadingEnum("hyperthreading", "body", *m.Hyperthreading); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplate) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplate) validateManifests(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Manifests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Manifests); i++ {
+		if swag.IsZero(m.Manifests[i]) { // not required
+			continue
+		}
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplate) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplate) validateOlmOperators(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.OlmOperators) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.OlmOperators); i++ {
+		if swag.IsZero(m.OlmOperators[i]) { // not required
+			continue
+		}
+
+		if m.OlmOperators[i] != nil {
+			if err := m.OlmOperators[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("olm_operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterTemplate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterTemplate) UnmarshalBinary(b []byte) error {
+	var res ClusterTemplate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,342 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterTemplateCreateParams cluster template create params
+//
+// swagger:model cluster-template-create-params
+type ClusterTemplateCreateParams struct {
+
+	// A comma-separated list of NTP sources (name or IP) going to be added to all the hosts. Can be a Go template.
+	AdditionalNtpSource string `json:"additional_ntp_source,omitempty"`
+
+	// IP address block from which Pod IPs are allocated.
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
+	ClusterNetworkCidr string `json:"cluster_network_cidr,omitempty"`
+
+	// The subnet prefix length to assign to each individual node.
+	// Maximum: 128
+	// Minimum: 1
+	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// high availability mode
+	// Enum: [Full None]
+	HighAvailabilityMode *string `json:"high_availability_mode,omitempty"`
+
+	// A proxy URL to use for creating HTTP connections outside the cluster. Can be a Go template.
+	HTTPProxy string `json:"http_proxy,omitempty"`
+
+	// A proxy URL to use for creating HTTPS connections outside the cluster. Can be a Go template.
+	HTTPSProxy string `json:"https_proxy,omitempty"`
+
+	// hyperthreading
+	// Enum: [masters workers none all]
+	Hyperthreading *string `json:"hyperthreading,omitempty"`
+
+	// JSON-formatted string containing the user overrides for the install-config.yaml file. Can be a Go template.
+	InstallConfigOverrides string `json:"install_config_overrides,omitempty"`
+
+	// The custom manifests of the clusters. Their contents can be Go templates.
+	Manifests []*CreateManifestParams `json:"manifests"`
+
+	// name
+	// Required: true
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying. Can be a Go template.
+	NoProxy string `json:"no_proxy,omitempty"`
+
+	// The OLM operators of the clusters. Their properties can be Go templates.
+	OlmOperators []*OperatorCreateParams `json:"olm_operators"`
+
+	// Version of the OpenShift cluster.
+	OpenshiftVersion string `json:"openshift_version,omitempty"`
+
+	// The IP address pool to use for service IP addresses.
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
+	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
+
+	// SSH public key for debugging OpenShift nodes.
+	SSHPublicKey string `json:"ssh_public_key,omitempty"`
+
+	// user managed networking
+	UserManagedNetworking *bool `json:"user_managed_networking,omitempty"`
+
+	// vip dhcp allocation
+	VipDhcpAllocation *bool `json:"vip_dhcp_allocation,omitempty"`
+}
+
+// Validate validates this cluster template create params
+func (m *ClusterTemplateCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterNetworkHostPrefix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHighAvailabilityMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHyperthreading(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateManifests(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOlmOperators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServiceNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateClusterNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateClusterNetworkHostPrefix(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworkHostPrefix) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 128, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterTemplateCreateParamsTypeHighAvailabilityModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Full","None"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTemplateCreateParamsTypeHighAvailabilityModePropEnum = append(clusterTemplateCreateParamsTypeHighAvailabilityModePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterTemplateCreateParamsHighAvailabilityModeFull captures enum value "Full"
+	ClusterTemplateCreateParamsHighAvailabilityModeFull string = "Full"
+
+	// ClusterTemplateCreateParamsHighAvailabilityModeNone captures enum value "None"
+	ClusterTemplateCreateParamsHighAvailabilityModeNone string = "None"
+)
+
+// prop value enum
+func (m *ClusterTemplateCreateParams) validateHighAvailabilityModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTemplateCreateParamsTypeHighAvailabilityModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateHighAvailabilityMode(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HighAvailabilityMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHighAvailabilityModeEnum("high_availability_mode", "body", *m.HighAvailabilityMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterTemplateCreateParamsTypeHyperthreadingPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["masters","workers","none","all"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTemplateCreateParamsTypeHyperthreadingPropEnum = append(clusterTemplateCreateParamsTypeHyperthreadingPropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterTemplateCreateParamsHyperthreadingMasters captures enum value "masters"
+	ClusterTemplateCreateParamsHyperthreadingMasters string = "masters"
+
+	// ClusterTemplateCreateParamsHyperthreadingWorkers captures enum value "workers"
+	ClusterTemplateCreateParamsHyperthreadingWorkers string = "workers"
+
+	// ClusterTemplateCreateParamsHyperthreadingNone captures enum value "none"
+	ClusterTemplateCreateParamsHyperthreadingNone string = "none"
+
+	// ClusterTemplateCreateParamsHyperthreadingAll captures enum value "all"
+	ClusterTemplateCreateParamsHyperthreadingAll string = "all"
+)
+
+// prop value enum
+func (m *ClusterTemplateCreateParams) validateHyperthreadingEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTemplateCreateParamsTypeHyperthreadingPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateHyperthreading(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hyperthreading) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHyperthreadingEnum("hyperthreading", "body", *m.Hyperthreading); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateManifests(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Manifests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Manifests); i++ {
+		if swag.IsZero(m.Manifests[i]) { // not required
+			continue
+		}
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", string(*m.Name), 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateOlmOperators(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.OlmOperators) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.OlmOperators); i++ {
+		if swag.IsZero(m.OlmOperators[i]) { // not required
+			continue
+		}
+
+		if m.OlmOperators[i] != nil {
+			if err := m.OlmOperators[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("olm_operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ServiceNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterTemplateCreateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterTemplateCreateParams) UnmarshalBinary(b []byte) error {
+	var res ClusterTemplateCreateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -42,6 +42,12 @@ type ClusterUpdateParams struct {
 	// Minimum: 1
 	ClusterNetworkHostPrefix *int64 `json:"cluster_network_host_prefix,omitempty"`
 
+	// The cluster networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the cluster_network_cidr and cluster_network_host_prefix of the cluster.
+	ClusterNetworks []*ClusterNetwork `json:"cluster_networks"`
+
+	// disk selection policy
+	DiskSelectionPolicy *DiskSelectionPolicy `json:"disk_selection_policy,omitempty"`
+
 	// disks selected config
 	DisksSelectedConfig []*ClusterUpdateParamsDisksSelectedConfigItems0 `json:"disks_selected_config"`
 
@@ -51,6 +57,9 @@ type ClusterUpdateParams struct {
 	// The desired hostname for hosts associated with the cluster.
 	HostsNames []*ClusterUpdateParamsHostsNamesItems0 `json:"hosts_names"`
 
+	// The racks of the hosts associated with the cluster.
+	HostsRackLabels []*ClusterUpdateParamsHostsRackLabelsItems0 `json:"hosts_rack_labels"`
+
 	// The desired role for hosts associated with the cluster.
 	HostsRoles []*ClusterUpdateParamsHostsRolesItems0 `json:"hosts_roles"`
 
@@ -76,6 +85,14 @@ type ClusterUpdateParams struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	MachineNetworkCidr *string `json:"machine_network_cidr,omitempty"`
 
+	// The machine networks of a dual-stack cluster, at most one per IP address family with the IPv4 one first. The first one is the machine_network_cidr of the cluster.
+	MachineNetworks []*MachineNetwork `json:"machine_networks"`
+
+	// The MTU of the NICs of the hosts in the machine network, e.g. 9000 for jumbo frames. The MTU of the cluster network is derived from it. When unset, the NICs of all the hosts must have the same MTU. Set to 0 to unset it.
+	// Maximum: 9216
+	// Minimum: 0
+	Mtu *int64 `json:"mtu,omitempty"`
+
 	// OpenShift cluster name.
 	// Max Length: 54
 	// Min Length: 1
@@ -90,10 +107,16 @@ type ClusterUpdateParams struct {
 	// The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.
 	PullSecret *string `json:"pull_secret,omitempty"`
 
+	// role assignment policy
+	RoleAssignmentPolicy *RoleAssignmentPolicy `json:"role_assignment_policy,omitempty"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr *string `json:"service_network_cidr,omitempty"`
 
+	// The service networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the service_network_cidr of the cluster.
+	ServiceNetworks []*ServiceNetwork `json:"service_networks"`
+
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey *string `json:"ssh_public_key,omitempty"`
 
@@ -120,6 +143,14 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateClusterNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDiskSelectionPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDisksSelectedConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -132,6 +163,10 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHostsRackLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostsRoles(formats); err != nil {
 		res = append(res, err)
 	}
@@ -148,6 +183,14 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMachineNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMtu(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -156,10 +199,18 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRoleAssignmentPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateServiceNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -209,6 +260,49 @@ func (m *ClusterUpdateParams) validateClusterNetworkHostPrefix(formats strfmt.Re
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateClusterNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+		if swag.IsZero(m.ClusterNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateDiskSelectionPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DiskSelectionPolicy) { // not required
+		return nil
+	}
+
+	if m.DiskSelectionPolicy != nil {
+		if err := m.DiskSelectionPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("disk_selection_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateDisksSelectedConfig(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.DisksSelectedConfig) { // not required
@@ -284,6 +378,31 @@ func (m *ClusterUpdateParams) validateHostsNames(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateHostsRackLabels(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostsRackLabels) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostsRackLabels); i++ {
+		if swag.IsZero(m.HostsRackLabels[i]) { // not required
+			continue
+		}
+
+		if m.HostsRackLabels[i] != nil {
+			if err := m.HostsRackLabels[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts_rack_labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateHostsRoles(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.HostsRoles) { // not required
@@ -384,6 +503,48 @@ func (m *ClusterUpdateParams) validateMachineNetworkCidr(formats strfmt.Registry
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateMachineNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MachineNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MachineNetworks); i++ {
+		if swag.IsZero(m.MachineNetworks[i]) { // not required
+			continue
+		}
+
+		if m.MachineNetworks[i] != nil {
+			if err := m.MachineNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateMtu(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Mtu) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("mtu", "body", int64(*m.Mtu), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("mtu", "body", int64(*m.Mtu), 9216, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateName(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Name) { // not required
@@ -426,6 +587,24 @@ func (m *ClusterUpdateParams) validateOlmOperators(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateRoleAssignmentPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RoleAssignmentPolicy) { // not required
+		return nil
+	}
+
+	if m.RoleAssignmentPolicy != nil {
+		if err := m.RoleAssignmentPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("role_assignment_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
@@ -439,6 +618,31 @@ func (m *ClusterUpdateParams) validateServiceNetworkCidr(formats strfmt.Registry
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateServiceNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ServiceNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+		if swag.IsZero(m.ServiceNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ServiceNetworks[i] != nil {
+			if err := m.ServiceNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ClusterUpdateParams) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -660,6 +864,64 @@ func (m *ClusterUpdateParamsHostsNamesItems0) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// ClusterUpdateParamsHostsRackLabelsItems0 cluster update params hosts rack labels items0
+//
+// swagger:model ClusterUpdateParamsHostsRackLabelsItems0
+type ClusterUpdateParamsHostsRackLabelsItems0 struct {
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// rack label
+	RackLabel string `json:"rack_label,omitempty"`
+}
+
+// Validate validates this cluster update params hosts rack labels items0
+func (m *ClusterUpdateParamsHostsRackLabelsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterUpdateParamsHostsRackLabelsItems0) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsRackLabelsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsRackLabelsItems0) UnmarshalBinary(b []byte) error {
+	var res ClusterUpdateParamsHostsRackLabelsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
 // ClusterUpdateParamsHostsRolesItems0 cluster update params hosts roles items0
 //
 // swagger:model ClusterUpdateParamsHostsRolesItems0
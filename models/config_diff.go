@@ -0,0 +1,83 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ConfigDiff The differences between a configuration that the service generates and the effective configuration after the user overrides.
+//
+// swagger:model config-diff
+type ConfigDiff struct {
+
+	// JSON patch (RFC 6902) operations that turn the generated configuration into the effective one.
+	Operations []*ConfigDiffOperation `json:"operations"`
+
+	// Values set by the service that the overrides replace or remove.
+	Warnings []string `json:"warnings"`
+}
+
+// Validate validates this config diff
+func (m *ConfigDiff) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOperations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConfigDiff) validateOperations(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Operations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Operations); i++ {
+		if swag.IsZero(m.Operations[i]) { // not required
+			continue
+		}
+
+		if m.Operations[i] != nil {
+			if err := m.Operations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConfigDiff) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConfigDiff) UnmarshalBinary(b []byte) error {
+	var res ConfigDiff
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
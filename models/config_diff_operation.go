@@ -0,0 +1,127 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ConfigDiffOperation config diff operation
+//
+// swagger:model config-diff-operation
+type ConfigDiffOperation struct {
+
+	// The value in the generated configuration, for remove and replace operations. Sensitive values, e.g. the pull secret, are hidden.
+	BaseValue interface{} `json:"base_value,omitempty"`
+
+	// op
+	// Required: true
+	// Enum: [add remove replace]
+	Op *string `json:"op"`
+
+	// JSON pointer (RFC 6901) to the value that the operation changes.
+	// Required: true
+	Path *string `json:"path"`
+
+	// The value in the effective configuration, for add and replace operations. Sensitive values, e.g. the pull secret, are hidden.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Validate validates this config diff operation
+func (m *ConfigDiffOperation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var configDiffOperationTypeOpPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["add","remove","replace"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		configDiffOperationTypeOpPropEnum = append(configDiffOperationTypeOpPropEnum, v)
+	}
+}
+
+const (
+
+	// ConfigDiffOperationOpAdd captures enum value "add"
+	ConfigDiffOperationOpAdd string = "add"
+
+	// ConfigDiffOperationOpRemove captures enum value "remove"
+	ConfigDiffOperationOpRemove string = "remove"
+
+	// ConfigDiffOperationOpReplace captures enum value "replace"
+	ConfigDiffOperationOpReplace string = "replace"
+)
+
+// prop value enum
+func (m *ConfigDiffOperation) validateOpEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, configDiffOperationTypeOpPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ConfigDiffOperation) validateOp(formats strfmt.Registry) error {
+
+	if err := validate.Required("op", "body", m.Op); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateOpEnum("op", "body", *m.Op); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ConfigDiffOperation) validatePath(formats strfmt.Registry) error {
+
+	if err := validate.Required("path", "body", m.Path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConfigDiffOperation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConfigDiffOperation) UnmarshalBinary(b []byte) error {
+	var res ConfigDiffOperation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -21,6 +21,9 @@ type ConnectivityCheckNic struct {
 	// mac
 	Mac string `json:"mac,omitempty"`
 
+	// Size in bytes of the do-not-fragment packets that the agent sends to the IP addresses of the NIC to check that the path supports the MTU. The MTU is not probed when unset.
+	Mtu int64 `json:"mtu,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 }
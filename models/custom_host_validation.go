@@ -0,0 +1,92 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CustomHostValidation custom host validation
+//
+// swagger:model custom-host-validation
+type CustomHostValidation struct {
+
+	// Whether a failure of the validation keeps the host from becoming ready for installation. Defaults to true.
+	Blocking *bool `json:"blocking,omitempty"`
+
+	// Reported as the message of the validation when it fails.
+	Description string `json:"description,omitempty"`
+
+	// CEL expression that must evaluate to true for the validation to succeed. The inventory of the host is available as the inventory variable, e.g. inventory.interfaces.exists(i, i.vendor == "0x8086").
+	// Required: true
+	Expression *string `json:"expression"`
+
+	// Identifier of the validation in the host validations_info, under the custom category.
+	// Required: true
+	// Pattern: ^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	ID *string `json:"id"`
+}
+
+// Validate validates this custom host validation
+func (m *CustomHostValidation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExpression(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CustomHostValidation) validateExpression(formats strfmt.Registry) error {
+
+	if err := validate.Required("expression", "body", m.Expression); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CustomHostValidation) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("id", "body", string(*m.ID), `^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CustomHostValidation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CustomHostValidation) UnmarshalBinary(b []byte) error {
+	var res CustomHostValidation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/go-openapi/validate"
 )
 
-// DiskRole disk role
+// DiskRole The usage of a disk. A var-lib-containers or var-lib-etcd disk is a secondary disk of the host that is formatted and mounted on /var/lib/containers or /var/lib/etcd of the node, var-lib-etcd being allowed on masters only.
 //
 // swagger:model disk-role
 type DiskRole string
@@ -25,6 +25,12 @@ const (
 
 	// DiskRoleInstall captures enum value "install"
 	DiskRoleInstall DiskRole = "install"
+
+	// DiskRoleVarLibContainers captures enum value "var-lib-containers"
+	DiskRoleVarLibContainers DiskRole = "var-lib-containers"
+
+	// DiskRoleVarLibEtcd captures enum value "var-lib-etcd"
+	DiskRoleVarLibEtcd DiskRole = "var-lib-etcd"
 )
 
 // for schema
@@ -32,7 +38,7 @@ var diskRoleEnum []interface{}
 
 func init() {
 	var res []DiskRole
-	if err := json.Unmarshal([]byte(`["none","install"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["none","install","var-lib-containers","var-lib-etcd"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DiskSelectionPolicy How the service picks the installation disk of a host among its eligible disks when the inventory of the host arrives. The rules are preferences, applied in the order in which they are listed here, so a host always gets an installation disk if it has an eligible one. An installation disk that was already picked for the host is kept when its inventory is updated. When the policy changes, the installation disks of the hosts are picked again, except the ones that the user picked.
+//
+// swagger:model disk-selection-policy
+type DiskSelectionPolicy struct {
+
+	// Prefer the disks on which the agent found no bootable partition, e.g. of a previous installation. The agent only reports whether a disk is bootable, so disks that only have other partitions, e.g. data partitions, are not avoided.
+	AvoidPartitionedDisks bool `json:"avoid_partitioned_disks,omitempty"`
+
+	// The attribute of the disks that the match pattern is applied to.
+	// Enum: [none by-path wwn model serial]
+	MatchBy *string `json:"match_by,omitempty"`
+
+	// Regular expression. Prefer the disks whose attribute selected by match_by matches it.
+	MatchPattern string `json:"match_pattern,omitempty"`
+
+	// Prefer the disks with the shortest sync duration, as measured by the agent.
+	PreferFastest bool `json:"prefer_fastest,omitempty"`
+
+	// Prefer the smallest disks. Eligible disks are all larger than the minimum disk size.
+	PreferSmallest bool `json:"prefer_smallest,omitempty"`
+
+	// Prefer SSD and NVMe disks over HDD disks.
+	PreferSsd bool `json:"prefer_ssd,omitempty"`
+}
+
+// Validate validates this disk selection policy
+func (m *DiskSelectionPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMatchBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var diskSelectionPolicyTypeMatchByPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["none","by-path","wwn","model","serial"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		diskSelectionPolicyTypeMatchByPropEnum = append(diskSelectionPolicyTypeMatchByPropEnum, v)
+	}
+}
+
+const (
+
+	// DiskSelectionPolicyMatchByNone captures enum value "none"
+	DiskSelectionPolicyMatchByNone string = "none"
+
+	// DiskSelectionPolicyMatchByByPath captures enum value "by-path"
+	DiskSelectionPolicyMatchByByPath string = "by-path"
+
+	// DiskSelectionPolicyMatchByWwn captures enum value "wwn"
+	DiskSelectionPolicyMatchByWwn string = "wwn"
+
+	// DiskSelectionPolicyMatchByModel captures enum value "model"
+	DiskSelectionPolicyMatchByModel string = "model"
+
+	// DiskSelectionPolicyMatchBySerial captures enum value "serial"
+	DiskSelectionPolicyMatchBySerial string = "serial"
+)
+
+// prop value enum
+func (m *DiskSelectionPolicy) validateMatchByEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, diskSelectionPolicyTypeMatchByPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DiskSelectionPolicy) validateMatchBy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MatchBy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateMatchByEnum("match_by", "body", *m.MatchBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DiskSelectionPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DiskSelectionPolicy) UnmarshalBinary(b []byte) error {
+	var res DiskSelectionPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,396 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ExportedCluster The configuration of an exported cluster, without its hosts, state and pull secret.
+//
+// swagger:model exported-cluster
+type ExportedCluster struct {
+
+	// additional ntp source
+	AdditionalNtpSource string `json:"additional_ntp_source,omitempty"`
+
+	// api vip
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$
+	APIVip string `json:"api_vip,omitempty"`
+
+	// base dns domain
+	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
+
+	// cluster network cidr
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
+	ClusterNetworkCidr string `json:"cluster_network_cidr,omitempty"`
+
+	// cluster network host prefix
+	// Maximum: 128
+	// Minimum: 1
+	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
+
+	// high availability mode
+	// Enum: [Full None]
+	HighAvailabilityMode string `json:"high_availability_mode,omitempty"`
+
+	// http proxy
+	HTTPProxy string `json:"http_proxy,omitempty"`
+
+	// https proxy
+	HTTPSProxy string `json:"https_proxy,omitempty"`
+
+	// hyperthreading
+	// Enum: [masters workers none all]
+	Hyperthreading string `json:"hyperthreading,omitempty"`
+
+	// Unique identifier of the exported cluster.
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// JSON-formatted string containing the user overrides for the initial ignition config.
+	IgnitionConfigOverrides string `json:"ignition_config_overrides,omitempty"`
+
+	// ingress vip
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$
+	IngressVip string `json:"ingress_vip,omitempty"`
+
+	// JSON-formatted string containing the user overrides for the install-config.yaml file.
+	InstallConfigOverrides string `json:"install_config_overrides,omitempty"`
+
+	// machine network cidr
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
+	MachineNetworkCidr string `json:"machine_network_cidr,omitempty"`
+
+	// monitored operators
+	MonitoredOperators MonitoredOperatorsList `json:"monitored_operators,omitempty"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// no proxy
+	NoProxy string `json:"no_proxy,omitempty"`
+
+	// openshift version
+	// Required: true
+	OpenshiftVersion *string `json:"openshift_version"`
+
+	// service network cidr
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
+	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
+
+	// ssh public key
+	SSHPublicKey string `json:"ssh_public_key,omitempty"`
+
+	// user managed networking
+	UserManagedNetworking *bool `json:"user_managed_networking,omitempty"`
+
+	// vip dhcp allocation
+	VipDhcpAllocation *bool `json:"vip_dhcp_allocation,omitempty"`
+}
+
+// Validate validates this exported cluster
+func (m *ExportedCluster) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIVip(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterNetworkHostPrefix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHighAvailabilityMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHyperthreading(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIngressVip(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMachineNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMonitoredOperators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOpenshiftVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServiceNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ExportedCluster) validateAPIVip(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.APIVip) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("api_vip", "body", string(m.APIVip), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportedCluster) validateClusterNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportedCluster) validateClusterNetworkHostPrefix(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworkHostPrefix) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 128, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var exportedClusterTypeHighAvailabilityModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Full","None"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		exportedClusterTypeHighAvailabilityModePropEnum = append(exportedClusterTypeHighAvailabilityModePropEnum, v)
+	}
+}
+
+const (
+
+	// ExportedClusterHighAvailabilityModeFull captures enum value "Full"
+	ExportedClusterHighAvailabilityModeFull string = "Full"
+
+	// ExportedClusterHighAvailabilityModeNone captures enum value "None"
+	ExportedClusterHighAvailabilityModeNone string = "None"
+)
+
+// prop value enum
+func (m *ExportedCluster) validateHighAvailabilityModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, exportedClusterTypeHighAvailabilityModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ExportedCluster) validateHighAvailabilityMode(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HighAvailabilityMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHighAvailabilityModeEnum("high_availability_mode", "body", m.HighAvailabilityMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var exportedClusterTypeHyperthreadingPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["masters","workers","none","all"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		exportedClusterTypeHyperthreadingPropEnum = append(exportedClusterTypeHyperthreadingPropEnum, v)
+	}
+}
+
+const (
+
+	// ExportedClusterHyperthreadingMasters captures enum value "masters"
+	ExportedClusterHyperthreadingMasters string = "masters"
+
+	// ExportedClusterHyperthreadingWorkers captures enum value "workers"
+	ExportedClusterHyperthreadingWorkers string = "workers"
+
+	// ExportedClusterHyperthreadingNone captures enum value "none"
+	ExportedClusterHyperthreadingNone string = "none"
+
+	// ExportedClusterHyperthreadingAll captures enum value "all"
+	ExportedClusterHyperthreadingAll string = "all"
+)
+
+// prop value enum
+func (m *ExportedCluster) validateHyperthreadingEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, exportedClusterTypeHyperthreadingPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ExportedCluster) validateHyperthreading(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hyperthreading) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHyperthreadingEnum("hyperthreading", "body", m.Hyperthreading); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportedCluster) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportedCluster) validateIngressVip(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.IngressVip) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("ingress_vip", "body", string(m.IngressVip), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportedCluster) validateMachineNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MachineNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("machine_network_cidr", "body", string(m.MachineNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportedCluster) validateMonitoredOperators(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MonitoredOperators) { // not required
+		return nil
+	}
+
+	if err := m.MonitoredOperators.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("monitored_operators")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportedCluster) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportedCluster) validateOpenshiftVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("openshift_version", "body", m.OpenshiftVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportedCluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ServiceNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ExportedCluster) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ExportedCluster) UnmarshalBinary(b []byte) error {
+	var res ExportedCluster
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -51,6 +51,9 @@ type Host struct {
 	// Additional information about disks, formatted as JSON.
 	DisksInfo string `json:"disks_info,omitempty" gorm:"type:text"`
 
+	// JSON-formatted list of the validation ids whose status changed repeatedly within a short period, which usually indicates an unstable network rather than a persistent failure.
+	FlappingValidations string `json:"flapping_validations,omitempty" gorm:"type:text"`
+
 	// free addresses
 	FreeAddresses string `json:"free_addresses,omitempty" gorm:"type:text"`
 
@@ -69,6 +72,9 @@ type Host struct {
 	// Array of image statuses.
 	ImagesStatus string `json:"images_status,omitempty" gorm:"type:text"`
 
+	// Whether the user picked the installation disk of the host, in which case the disk selection policy of the cluster doesn't replace it.
+	InstallationDiskChosenByUser bool `json:"installation_disk_chosen_by_user,omitempty"`
+
 	// Contains the inventory disk id to install on.
 	InstallationDiskID string `json:"installation_disk_id,omitempty"`
 
@@ -95,9 +101,15 @@ type Host struct {
 	// Format: datetime
 	LogsCollectedAt strfmt.DateTime `json:"logs_collected_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// JSON-formatted on-demand log collection that was requested for the host and was not sent to its agent yet.
+	LogsCollectionRequest string `json:"logs_collection_request,omitempty" gorm:"type:text"`
+
 	// The progress of log collection or empty if logs are not applicable
 	LogsInfo LogsState `json:"logs_info,omitempty" gorm:"type:varchar(2048)"`
 
+	// The size in bytes of the logs uploaded from the host.
+	LogsSizeBytes int64 `json:"logs_size_bytes,omitempty"`
+
 	// logs started at
 	// Format: datetime
 	LogsStartedAt strfmt.DateTime `json:"logs_started_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -114,12 +126,18 @@ type Host struct {
 	// progress stages
 	ProgressStages []HostStage `json:"progress_stages" gorm:"-"`
 
+	// The rack of the host, as labeled by the user, which the role assignment policy may spread the masters across.
+	RackLabel string `json:"rack_label,omitempty"`
+
 	// requested hostname
 	RequestedHostname string `json:"requested_hostname,omitempty"`
 
 	// role
 	Role HostRole `json:"role,omitempty"`
 
+	// The disks of the host, other than the installation disk, that are formatted and mounted on a directory of the node by their role, formatted as a JSON list of disk-config-params.
+	SecondaryDisks string `json:"secondary_disks,omitempty" gorm:"type:text"`
+
 	// Time at which the current progress stage started.
 	// Format: date-time
 	StageStartedAt strfmt.DateTime `json:"stage_started_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -141,6 +159,12 @@ type Host struct {
 	// Format: date-time
 	StatusUpdatedAt strfmt.DateTime `json:"status_updated_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// suggested role
+	SuggestedRole HostRole `json:"suggested_role,omitempty"`
+
+	// The role that the service picks for the host when its role is automatically assigned, and why. It is previewed until the installation starts.
+	SuggestedRoleReason string `json:"suggested_role_reason,omitempty"`
+
 	// updated at
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -228,6 +252,10 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSuggestedRole(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUpdatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -587,6 +615,22 @@ func (m *Host) validateStatusUpdatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateSuggestedRole(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SuggestedRole) { // not required
+		return nil
+	}
+
+	if err := m.SuggestedRole.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("suggested_role")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateUpdatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.UpdatedAt) { // not required
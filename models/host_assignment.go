@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostAssignment Settings of a host that are applied to the host whose MAC address or serial number matches.
+//
+// swagger:model host-assignment
+type HostAssignment struct {
+
+	// Contains the ID of the disk to install on.
+	InstallationDiskID string `json:"installation_disk_id,omitempty"`
+
+	// mac addresses
+	MacAddresses []string `json:"mac_addresses"`
+
+	// requested hostname
+	RequestedHostname string `json:"requested_hostname,omitempty"`
+
+	// role
+	Role HostRoleUpdateParams `json:"role,omitempty"`
+
+	// serial number
+	SerialNumber string `json:"serial_number,omitempty"`
+}
+
+// Validate validates this host assignment
+func (m *HostAssignment) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMacAddresses(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostAssignment) validateMacAddresses(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MacAddresses) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MacAddresses); i++ {
+
+		if err := validate.Pattern("mac_addresses"+"."+strconv.Itoa(i), "body", string(m.MacAddresses[i]), `^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostAssignment) validateRole(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Role) { // not required
+		return nil
+	}
+
+	if err := m.Role.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("role")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostAssignment) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostAssignment) UnmarshalBinary(b []byte) error {
+	var res HostAssignment
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,97 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostLogsCollectionParams host logs collection params
+//
+// swagger:model host-logs-collection-params
+type HostLogsCollectionParams struct {
+
+	// Absolute paths of directories on the host to collect. The paths may not contain whitespaces, ':' or ','.
+	Directories []string `json:"directories"`
+
+	// The systemd units whose journal should be collected.
+	JournalUnits []string `json:"journal_units"`
+}
+
+// Validate validates this host logs collection params
+func (m *HostLogsCollectionParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDirectories(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateJournalUnits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostLogsCollectionParams) validateDirectories(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Directories) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Directories); i++ {
+
+		if err := validate.Pattern("directories"+"."+strconv.Itoa(i), "body", string(m.Directories[i]), `^/[^\s:,]*$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostLogsCollectionParams) validateJournalUnits(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.JournalUnits) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.JournalUnits); i++ {
+
+		if err := validate.Pattern("journal_units"+"."+strconv.Itoa(i), "body", string(m.JournalUnits[i]), `^[a-zA-Z0-9:_.@-]+$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostLogsCollectionParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostLogsCollectionParams) UnmarshalBinary(b []byte) error {
+	var res HostLogsCollectionParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
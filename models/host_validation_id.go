@@ -79,6 +79,18 @@ const (
 
 	// HostValidationIDCnvRequirementsSatisfied captures enum value "cnv-requirements-satisfied"
 	HostValidationIDCnvRequirementsSatisfied HostValidationID = "cnv-requirements-satisfied"
+
+	// HostValidationIDSriovRequirementsSatisfied captures enum value "sriov-requirements-satisfied"
+	HostValidationIDSriovRequirementsSatisfied HostValidationID = "sriov-requirements-satisfied"
+
+	// HostValidationIDSecondaryDisksValid captures enum value "secondary-disks-valid"
+	HostValidationIDSecondaryDisksValid HostValidationID = "secondary-disks-valid"
+
+	// HostValidationIDMtuConsistent captures enum value "mtu-consistent"
+	HostValidationIDMtuConsistent HostValidationID = "mtu-consistent"
+
+	// HostValidationIDIgnitionConfigOverridesSafe captures enum value "ignition-config-overrides-safe"
+	HostValidationIDIgnitionConfigOverridesSafe HostValidationID = "ignition-config-overrides-safe"
 )
 
 // for schema
@@ -86,7 +98,7 @@ var hostValidationIdEnum []interface{}
 
 func init() {
 	var res []HostValidationID
-	if err := json.Unmarshal([]byte(`["connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","api-vip-connected","belongs-to-majority-group","valid-platform","ntp-synced","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","sufficient-or-unknown-installation-disk-speed","cnv-requirements-satisfied"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","api-vip-connected","belongs-to-majority-group","valid-platform","ntp-synced","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","sufficient-or-unknown-installation-disk-speed","cnv-requirements-satisfied","sriov-requirements-satisfied","secondary-disks-valid","mtu-consistent","ignition-config-overrides-safe"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ImportClusterParams import cluster params
+//
+// swagger:model import-cluster-params
+type ImportClusterParams struct {
+
+	// bundle
+	// Required: true
+	Bundle *ClusterExport `json:"bundle"`
+
+	// Name of the new cluster. Defaults to the name of the exported cluster.
+	Name string `json:"name,omitempty"`
+
+	// The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.
+	// Required: true
+	PullSecret *string `json:"pull_secret"`
+}
+
+// Validate validates this import cluster params
+func (m *ImportClusterParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBundle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePullSecret(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ImportClusterParams) validateBundle(formats strfmt.Registry) error {
+
+	if err := validate.Required("bundle", "body", m.Bundle); err != nil {
+		return err
+	}
+
+	if m.Bundle != nil {
+		if err := m.Bundle.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bundle")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ImportClusterParams) validatePullSecret(formats strfmt.Registry) error {
+
+	if err := validate.Required("pull_secret", "body", m.PullSecret); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ImportClusterParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ImportClusterParams) UnmarshalBinary(b []byte) error {
+	var res ImportClusterParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
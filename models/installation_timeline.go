@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallationTimeline installation timeline
+//
+// swagger:model installation-timeline
+type InstallationTimeline struct {
+
+	// cluster id
+	// Required: true
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id"`
+
+	// The statuses of the cluster in the order they were entered.
+	ClusterStatuses []*TimelineClusterStatus `json:"cluster_statuses"`
+
+	// hosts
+	Hosts []*TimelineHost `json:"hosts"`
+
+	// The status reports of the monitored operators that changed status, in the order they were reported.
+	Operators []*TimelineOperatorChange `json:"operators"`
+
+	// The cluster and host validations that changed status, in the order they changed.
+	Validations []*TimelineValidationChange `json:"validations"`
+}
+
+// Validate validates this installation timeline
+func (m *InstallationTimeline) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterStatuses(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOperators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValidations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallationTimeline) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_id", "body", m.ClusterID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateClusterStatuses(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterStatuses) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ClusterStatuses); i++ {
+		if swag.IsZero(m.ClusterStatuses[i]) { // not required
+			continue
+		}
+
+		if m.ClusterStatuses[i] != nil {
+			if err := m.ClusterStatuses[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_statuses" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateHosts(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hosts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Hosts); i++ {
+		if swag.IsZero(m.Hosts[i]) { // not required
+			continue
+		}
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateOperators(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Operators) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Operators); i++ {
+		if swag.IsZero(m.Operators[i]) { // not required
+			continue
+		}
+
+		if m.Operators[i] != nil {
+			if err := m.Operators[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateValidations(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Validations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Validations); i++ {
+		if swag.IsZero(m.Validations[i]) { // not required
+			continue
+		}
+
+		if m.Validations[i] != nil {
+			if err := m.Validations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("validations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallationTimeline) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallationTimeline) UnmarshalBinary(b []byte) error {
+	var res InstallationTimeline
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -6,8 +6,12 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Interface interface
@@ -36,6 +40,9 @@ type Interface struct {
 	// mac address
 	MacAddress string `json:"mac_address,omitempty"`
 
+	// The names of the interfaces that a bond or a bridge aggregates, or the name of the interface that a VLAN is based on.
+	Members []string `json:"members,omitempty"`
+
 	// mtu
 	Mtu int64 `json:"mtu,omitempty"`
 
@@ -48,12 +55,77 @@ type Interface struct {
 	// speed mbps
 	SpeedMbps int64 `json:"speed_mbps,omitempty"`
 
+	// The kind of the interface. Interfaces without a type are physical NICs.
+	// Enum: [physical bond vlan bridge]
+	Type string `json:"type,omitempty"`
+
 	// vendor
 	Vendor string `json:"vendor,omitempty"`
+
+	// The VLAN ID of a VLAN interface.
+	VlanID int64 `json:"vlan_id,omitempty"`
 }
 
 // Validate validates this interface
 func (m *Interface) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var interfaceTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["physical","bond","vlan","bridge"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		interfaceTypeTypePropEnum = append(interfaceTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// InterfaceTypePhysical captures enum value "physical"
+	InterfaceTypePhysical string = "physical"
+
+	// InterfaceTypeBond captures enum value "bond"
+	InterfaceTypeBond string = "bond"
+
+	// InterfaceTypeVlan captures enum value "vlan"
+	InterfaceTypeVlan string = "vlan"
+
+	// InterfaceTypeBridge captures enum value "bridge"
+	InterfaceTypeBridge string = "bridge"
+)
+
+// prop value enum
+func (m *Interface) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, interfaceTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Interface) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -18,6 +18,9 @@ type L3Connectivity struct {
 	// Average round trip time in milliseconds.
 	AverageRTTMs float64 `json:"average_rtt_ms,omitempty"`
 
+	// Whether the do-not-fragment packets of the probed MTU reached the remote IP address. Unset when the MTU was not probed.
+	MtuProbeSuccessful *bool `json:"mtu_probe_successful,omitempty"`
+
 	// outgoing nic
 	OutgoingNic string `json:"outgoing_nic,omitempty"`
 
@@ -0,0 +1,220 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LogFinding log finding
+//
+// swagger:model log-finding
+type LogFinding struct {
+
+	// Unique identifier of the cluster whose logs were analyzed.
+	// Required: true
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id" gorm:"index"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at" gorm:"type:timestamp with time zone"`
+
+	// The first line that matched the rule.
+	Excerpt string `json:"excerpt,omitempty" gorm:"type:text"`
+
+	// Path in the logs archive of the first file that matched the rule.
+	File string `json:"file,omitempty" gorm:"type:text"`
+
+	// Unique identifier of the host that uploaded the logs.
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty"`
+
+	// id
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id" gorm:"primary_key"`
+
+	// logs type
+	// Required: true
+	LogsType LogsType `json:"logs_type"`
+
+	// Number of lines in the logs that matched the rule.
+	Matches int64 `json:"matches,omitempty"`
+
+	// What is usually done to fix the failure.
+	Remediation string `json:"remediation,omitempty" gorm:"type:text"`
+
+	// Identifier of the rule of a known failure that matched the logs, e.g. etcd-quorum-lost.
+	// Required: true
+	RuleID *string `json:"rule_id"`
+
+	// severity
+	// Required: true
+	Severity LogFindingSeverity `json:"severity"`
+
+	// Short description of the known failure.
+	// Required: true
+	Title *string `json:"title"`
+}
+
+// Validate validates this log finding
+func (m *LogFinding) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLogsType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRuleID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSeverity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LogFinding) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_id", "body", m.ClusterID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LogFinding) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LogFinding) validateHostID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LogFinding) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LogFinding) validateLogsType(formats strfmt.Registry) error {
+
+	if err := m.LogsType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("logs_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *LogFinding) validateRuleID(formats strfmt.Registry) error {
+
+	if err := validate.Required("rule_id", "body", m.RuleID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LogFinding) validateSeverity(formats strfmt.Registry) error {
+
+	if err := m.Severity.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("severity")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *LogFinding) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("title", "body", m.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LogFinding) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LogFinding) UnmarshalBinary(b []byte) error {
+	var res LogFinding
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
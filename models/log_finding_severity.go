@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// LogFindingSeverity log finding severity
+//
+// swagger:model log-finding-severity
+type LogFindingSeverity string
+
+const (
+
+	// LogFindingSeverityInfo captures enum value "info"
+	LogFindingSeverityInfo LogFindingSeverity = "info"
+
+	// LogFindingSeverityWarning captures enum value "warning"
+	LogFindingSeverityWarning LogFindingSeverity = "warning"
+
+	// LogFindingSeverityCritical captures enum value "critical"
+	LogFindingSeverityCritical LogFindingSeverity = "critical"
+)
+
+// for schema
+var logFindingSeverityEnum []interface{}
+
+func init() {
+	var res []LogFindingSeverity
+	if err := json.Unmarshal([]byte(`["info","warning","critical"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		logFindingSeverityEnum = append(logFindingSeverityEnum, v)
+	}
+}
+
+func (m LogFindingSeverity) validateLogFindingSeverityEnum(path, location string, value LogFindingSeverity) error {
+	if err := validate.EnumCase(path, location, value, logFindingSeverityEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this log finding severity
+func (m LogFindingSeverity) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateLogFindingSeverityEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
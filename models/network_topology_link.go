@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NetworkTopologyLink network topology link
+//
+// swagger:model network-topology-link
+type NetworkTopologyLink struct {
+
+	// average rtt ms
+	AverageRttMs float64 `json:"average_rtt_ms,omitempty"`
+
+	// host id
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty"`
+
+	// Whether the remote IP address replied to ARP or NDP. Unset when L2 connectivity wasn't checked.
+	L2Successful *bool `json:"l2_successful,omitempty"`
+
+	// Whether the remote IP address replied to ping. Unset when L3 connectivity wasn't checked.
+	L3Successful *bool `json:"l3_successful,omitempty"`
+
+	// outgoing nic
+	OutgoingNic string `json:"outgoing_nic,omitempty"`
+
+	// packet loss percentage
+	PacketLossPercentage float64 `json:"packet_loss_percentage,omitempty"`
+
+	// remote host id
+	// Format: uuid
+	RemoteHostID strfmt.UUID `json:"remote_host_id,omitempty"`
+
+	// remote ip address
+	RemoteIPAddress string `json:"remote_ip_address,omitempty"`
+
+	// remote mac
+	RemoteMac string `json:"remote_mac,omitempty"`
+
+	// The subnet of the remote IP address, if it's a subnet of a host of the cluster.
+	Subnet string `json:"subnet,omitempty"`
+}
+
+// Validate validates this network topology link
+func (m *NetworkTopologyLink) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRemoteHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkTopologyLink) validateHostID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NetworkTopologyLink) validateRemoteHostID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RemoteHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("remote_host_id", "body", "uuid", m.RemoteHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkTopologyLink) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkTopologyLink) UnmarshalBinary(b []byte) error {
+	var res NetworkTopologyLink
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
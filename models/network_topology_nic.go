@@ -0,0 +1,52 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NetworkTopologyNic network topology nic
+//
+// swagger:model network-topology-nic
+type NetworkTopologyNic struct {
+
+	// ip addresses
+	IPAddresses []string `json:"ip_addresses"`
+
+	// mac address
+	MacAddress string `json:"mac_address,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// subnets
+	Subnets []string `json:"subnets"`
+}
+
+// Validate validates this network topology nic
+func (m *NetworkTopologyNic) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkTopologyNic) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkTopologyNic) UnmarshalBinary(b []byte) error {
+	var res NetworkTopologyNic
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RoleAssignmentPolicy How the service picks the masters among the hosts whose role is automatically assigned. The rules are combined, hosts that are excluded by a rule are never picked as masters.
+//
+// swagger:model role-assignment-policy
+type RoleAssignmentPolicy struct {
+
+	// Never pick virtual hosts as masters.
+	ExcludeVirtualMasters bool `json:"exclude_virtual_masters,omitempty"`
+
+	// Regular expression that the hostname of a host must match for it to be picked as a master.
+	MasterHostnamePattern string `json:"master_hostname_pattern,omitempty"`
+
+	// Pick the hosts with the most memory, and then the most CPU cores, as masters.
+	PreferMostResources bool `json:"prefer_most_resources,omitempty"`
+
+	// Length of the serial number prefix that identifies the group of a host when spreading by serial number prefix.
+	// Minimum: 1
+	SerialNumberPrefixLength int64 `json:"serial_number_prefix_length,omitempty"`
+
+	// Pick masters whose serial numbers have distinct prefixes, or whose rack labels are distinct, before picking masters that share a prefix or a rack.
+	// Enum: [none serial-number-prefix rack-label]
+	SpreadBy *string `json:"spread_by,omitempty"`
+}
+
+// Validate validates this role assignment policy
+func (m *RoleAssignmentPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSerialNumberPrefixLength(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSpreadBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RoleAssignmentPolicy) validateSerialNumberPrefixLength(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SerialNumberPrefixLength) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("serial_number_prefix_length", "body", int64(m.SerialNumberPrefixLength), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var roleAssignmentPolicyTypeSpreadByPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["none","serial-number-prefix","rack-label"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		roleAssignmentPolicyTypeSpreadByPropEnum = append(roleAssignmentPolicyTypeSpreadByPropEnum, v)
+	}
+}
+
+const (
+
+	// RoleAssignmentPolicySpreadByNone captures enum value "none"
+	RoleAssignmentPolicySpreadByNone string = "none"
+
+	// RoleAssignmentPolicySpreadBySerialNumberPrefix captures enum value "serial-number-prefix"
+	RoleAssignmentPolicySpreadBySerialNumberPrefix string = "serial-number-prefix"
+
+	// RoleAssignmentPolicySpreadByRackLabel captures enum value "rack-label"
+	RoleAssignmentPolicySpreadByRackLabel string = "rack-label"
+)
+
+// prop value enum
+func (m *RoleAssignmentPolicy) validateSpreadByEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, roleAssignmentPolicyTypeSpreadByPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *RoleAssignmentPolicy) validateSpreadBy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SpreadBy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSpreadByEnum("spread_by", "body", *m.SpreadBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RoleAssignmentPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RoleAssignmentPolicy) UnmarshalBinary(b []byte) error {
+	var res RoleAssignmentPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
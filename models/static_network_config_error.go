@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaticNetworkConfigError static network config error
+//
+// swagger:model static-network-config-error
+type StaticNetworkConfigError struct {
+
+	// The interface that the error is about, if any.
+	Interface string `json:"interface,omitempty"`
+
+	// message
+	Message string `json:"message,omitempty"`
+
+	// type
+	// Enum: [invalid-yaml unknown-interface missing-mac duplicate-ip gateway-outside-subnet render-failed]
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this static network config error
+func (m *StaticNetworkConfigError) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var staticNetworkConfigErrorTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["invalid-yaml","unknown-interface","missing-mac","duplicate-ip","gateway-outside-subnet","render-failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		staticNetworkConfigErrorTypeTypePropEnum = append(staticNetworkConfigErrorTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// StaticNetworkConfigErrorTypeInvalidYaml captures enum value "invalid-yaml"
+	StaticNetworkConfigErrorTypeInvalidYaml string = "invalid-yaml"
+
+	// StaticNetworkConfigErrorTypeUnknownInterface captures enum value "unknown-interface"
+	StaticNetworkConfigErrorTypeUnknownInterface string = "unknown-interface"
+
+	// StaticNetworkConfigErrorTypeMissingMac captures enum value "missing-mac"
+	StaticNetworkConfigErrorTypeMissingMac string = "missing-mac"
+
+	// StaticNetworkConfigErrorTypeDuplicateIP captures enum value "duplicate-ip"
+	StaticNetworkConfigErrorTypeDuplicateIP string = "duplicate-ip"
+
+	// StaticNetworkConfigErrorTypeGatewayOutsideSubnet captures enum value "gateway-outside-subnet"
+	StaticNetworkConfigErrorTypeGatewayOutsideSubnet string = "gateway-outside-subnet"
+
+	// StaticNetworkConfigErrorTypeRenderFailed captures enum value "render-failed"
+	StaticNetworkConfigErrorTypeRenderFailed string = "render-failed"
+)
+
+// prop value enum
+func (m *StaticNetworkConfigError) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, staticNetworkConfigErrorTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigError) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigError) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigError) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigError
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,89 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaticNetworkConfigValidateParams static network config validate params
+//
+// swagger:model static-network-config-validate-params
+type StaticNetworkConfigValidateParams struct {
+
+	// The static network config of the hosts, every host being rendered with nmstatectl.
+	// Required: true
+	// Max Items: 500
+	StaticNetworkConfig []*HostStaticNetworkConfig `json:"static_network_config"`
+}
+
+// Validate validates this static network config validate params
+func (m *StaticNetworkConfigValidateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStaticNetworkConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigValidateParams) validateStaticNetworkConfig(formats strfmt.Registry) error {
+
+	if err := validate.Required("static_network_config", "body", m.StaticNetworkConfig); err != nil {
+		return err
+	}
+
+	iStaticNetworkConfigSize := int64(len(m.StaticNetworkConfig))
+
+	if err := validate.MaxItems("static_network_config", "body", iStaticNetworkConfigSize, 500); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.StaticNetworkConfig); i++ {
+		if swag.IsZero(m.StaticNetworkConfig[i]) { // not required
+			continue
+		}
+
+		if m.StaticNetworkConfig[i] != nil {
+			if err := m.StaticNetworkConfig[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("static_network_config" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigValidateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigValidateParams) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigValidateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StatusHistoryEntry status history entry
+//
+// swagger:model status-history-entry
+type StatusHistoryEntry struct {
+
+	// Unique identifier of the cluster whose status changed, or of the cluster of the host.
+	// Required: true
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id" gorm:"index"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at" gorm:"type:timestamp with time zone"`
+
+	// The validations that were failing right after the change.
+	FailedValidations []string `json:"failed_validations" gorm:"-"`
+
+	// Unique identifier of the host whose status changed. Missing for changes of the cluster status.
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty"`
+
+	// id
+	ID int64 `json:"id,omitempty" gorm:"primary_key"`
+
+	// The status before the change.
+	PreviousStatus string `json:"previous_status,omitempty"`
+
+	// The status after the change.
+	// Required: true
+	Status *string `json:"status"`
+
+	// status info
+	StatusInfo string `json:"status_info,omitempty" gorm:"type:text"`
+
+	// The state machine transition that changed the status, e.g. RefreshHost or InstallHost.
+	// Required: true
+	Trigger *string `json:"trigger"`
+}
+
+// Validate validates this status history entry
+func (m *StatusHistoryEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StatusHistoryEntry) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_id", "body", m.ClusterID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StatusHistoryEntry) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StatusHistoryEntry) validateHostID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StatusHistoryEntry) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StatusHistoryEntry) validateTrigger(formats strfmt.Registry) error {
+
+	if err := validate.Required("trigger", "body", m.Trigger); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StatusHistoryEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StatusHistoryEntry) UnmarshalBinary(b []byte) error {
+	var res StatusHistoryEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TimelineHost timeline host
+//
+// swagger:model timeline-host
+type TimelineHost struct {
+
+	// host id
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty"`
+
+	// hostname
+	Hostname string `json:"hostname,omitempty"`
+
+	// role
+	Role HostRole `json:"role,omitempty"`
+
+	// The installation stages of the host in the order they were reached.
+	Stages []*TimelineHostStage `json:"stages"`
+}
+
+// Validate validates this timeline host
+func (m *TimelineHost) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStages(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimelineHost) validateHostID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimelineHost) validateRole(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Role) { // not required
+		return nil
+	}
+
+	if err := m.Role.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("role")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimelineHost) validateStages(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Stages) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Stages); i++ {
+		if swag.IsZero(m.Stages[i]) { // not required
+			continue
+		}
+
+		if m.Stages[i] != nil {
+			if err := m.Stages[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("stages" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TimelineHost) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TimelineHost) UnmarshalBinary(b []byte) error {
+	var res TimelineHost
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
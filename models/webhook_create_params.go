@@ -0,0 +1,190 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookCreateParams webhook create params
+//
+// swagger:model webhook-create-params
+type WebhookCreateParams struct {
+
+	// Notify only about events with these categories. Only user events are sent to webhooks.
+	Categories []string `json:"categories"`
+
+	// Notify only about these types of notifications. All types are notified if empty.
+	EventTypes []WebhookEventType `json:"event_types"`
+
+	// Shared secret used to sign the notifications payload with HMAC-SHA256. The signature is sent in the X-Assisted-Signature header.
+	Secret string `json:"secret,omitempty" secret:"true"`
+
+	// Notify only about events with these severities. All severities are notified if empty.
+	Severities []string `json:"severities"`
+
+	// The URL to which the notifications are posted. Loopback, link-local and private addresses are rejected unless the service allows their network.
+	// Required: true
+	// Pattern: ^https?://.+$
+	URL *string `json:"url"`
+}
+
+// Validate validates this webhook create params
+func (m *WebhookCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCategories(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEventTypes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSeverities(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var webhookCreateParamsCategoriesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["user"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookCreateParamsCategoriesItemsEnum = append(webhookCreateParamsCategoriesItemsEnum, v)
+	}
+}
+
+func (m *WebhookCreateParams) validateCategoriesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, webhookCreateParamsCategoriesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *WebhookCreateParams) validateCategories(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Categories) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Categories); i++ {
+
+		// value enum
+		if err := m.validateCategoriesItemsEnum("categories"+"."+strconv.Itoa(i), "body", m.Categories[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *WebhookCreateParams) validateEventTypes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EventTypes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.EventTypes); i++ {
+
+		if err := m.EventTypes[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("event_types" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+var webhookCreateParamsSeveritiesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["info","warning","error","critical"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookCreateParamsSeveritiesItemsEnum = append(webhookCreateParamsSeveritiesItemsEnum, v)
+	}
+}
+
+func (m *WebhookCreateParams) validateSeveritiesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, webhookCreateParamsSeveritiesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *WebhookCreateParams) validateSeverities(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Severities) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Severities); i++ {
+
+		// value enum
+		if err := m.validateSeveritiesItemsEnum("severities"+"."+strconv.Itoa(i), "body", m.Severities[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *WebhookCreateParams) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("url", "body", string(*m.URL), `^https?://.+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WebhookCreateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WebhookCreateParams) UnmarshalBinary(b []byte) error {
+	var res WebhookCreateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookDelivery webhook delivery
+//
+// swagger:model webhook-delivery
+type WebhookDelivery struct {
+
+	// Number of delivery attempts that were made.
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// The cluster that the notification relates to.
+	// Required: true
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id" gorm:"index"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// event type
+	// Required: true
+	EventType WebhookEventType `json:"event_type"`
+
+	// Unique identifier of the delivery.
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id" gorm:"primary_key"`
+
+	// last attempt at
+	// Format: date-time
+	LastAttemptAt strfmt.DateTime `json:"last_attempt_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// Error of the last failed attempt.
+	LastError string `json:"last_error,omitempty" gorm:"type:text"`
+
+	// Time of the next delivery attempt of a pending notification.
+	// Format: date-time
+	NextAttemptAt strfmt.DateTime `json:"next_attempt_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// HTTP status code returned by the webhook on the last attempt.
+	ResponseCode int64 `json:"response_code,omitempty"`
+
+	// status
+	// Required: true
+	// Enum: [pending delivered failed]
+	Status *string `json:"status" gorm:"index"`
+
+	// The webhook that the notification is delivered to.
+	// Required: true
+	// Format: uuid
+	WebhookID *strfmt.UUID `json:"webhook_id" gorm:"index"`
+}
+
+// Validate validates this webhook delivery
+func (m *WebhookDelivery) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEventType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastAttemptAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextAttemptAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWebhookID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_id", "body", m.ClusterID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) validateEventType(formats strfmt.Registry) error {
+
+	if err := m.EventType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("event_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) validateLastAttemptAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastAttemptAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_attempt_at", "body", "date-time", m.LastAttemptAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) validateNextAttemptAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NextAttemptAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_attempt_at", "body", "date-time", m.NextAttemptAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var webhookDeliveryTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","delivered","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookDeliveryTypeStatusPropEnum = append(webhookDeliveryTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// WebhookDeliveryStatusPending captures enum value "pending"
+	WebhookDeliveryStatusPending string = "pending"
+
+	// WebhookDeliveryStatusDelivered captures enum value "delivered"
+	WebhookDeliveryStatusDelivered string = "delivered"
+
+	// WebhookDeliveryStatusFailed captures enum value "failed"
+	WebhookDeliveryStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *WebhookDelivery) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, webhookDeliveryTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) validateWebhookID(formats strfmt.Registry) error {
+
+	if err := validate.Required("webhook_id", "body", m.WebhookID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("webhook_id", "body", "uuid", m.WebhookID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WebhookDelivery) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WebhookDelivery) UnmarshalBinary(b []byte) error {
+	var res WebhookDelivery
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookEventType webhook event type
+//
+// swagger:model webhook-event-type
+type WebhookEventType string
+
+const (
+
+	// WebhookEventTypeEvent captures enum value "event"
+	WebhookEventTypeEvent WebhookEventType = "event"
+
+	// WebhookEventTypeHostStatusChanged captures enum value "host-status-changed"
+	WebhookEventTypeHostStatusChanged WebhookEventType = "host-status-changed"
+
+	// WebhookEventTypeClusterStatusChanged captures enum value "cluster-status-changed"
+	WebhookEventTypeClusterStatusChanged WebhookEventType = "cluster-status-changed"
+)
+
+// for schema
+var webhookEventTypeEnum []interface{}
+
+func init() {
+	var res []WebhookEventType
+	if err := json.Unmarshal([]byte(`["event","host-status-changed","cluster-status-changed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookEventTypeEnum = append(webhookEventTypeEnum, v)
+	}
+}
+
+func (m WebhookEventType) validateWebhookEventTypeEnum(path, location string, value WebhookEventType) error {
+	if err := validate.EnumCase(path, location, value, webhookEventTypeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this webhook event type
+func (m WebhookEventType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateWebhookEventTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -120,6 +120,10 @@ func (f fakeInventory) GetFreeAddresses(ctx context.Context, params installer.Ge
 	return installer.NewGetFreeAddressesOK()
 }
 
+func (f fakeInventory) GetClusterNetworkTopology(ctx context.Context, params installer.GetClusterNetworkTopologyParams) middleware.Responder {
+	return installer.NewGetClusterNetworkTopologyOK()
+}
+
 func (f fakeInventory) GetHost(ctx context.Context, params installer.GetHostParams) middleware.Responder {
 	return installer.NewGetHostOK()
 }
@@ -132,6 +136,18 @@ func (f fakeInventory) InstallCluster(ctx context.Context, params installer.Inst
 	return installer.NewInstallClusterAccepted()
 }
 
+func (f fakeInventory) DryRunCluster(ctx context.Context, params installer.DryRunClusterParams) middleware.Responder {
+	return installer.NewDryRunClusterOK()
+}
+
+func (f fakeInventory) ExportCluster(ctx context.Context, params installer.ExportClusterParams) middleware.Responder {
+	return installer.NewExportClusterOK()
+}
+
+func (f fakeInventory) ImportCluster(ctx context.Context, params installer.ImportClusterParams) middleware.Responder {
+	return installer.NewImportClusterCreated()
+}
+
 func (f fakeInventory) InstallHosts(ctx context.Context, params installer.InstallHostsParams) middleware.Responder {
 	return installer.NewInstallHostsAccepted()
 }
@@ -172,10 +188,18 @@ func (f fakeInventory) ResetHost(ctx context.Context, params installer.ResetHost
 	return installer.NewResetHostOK()
 }
 
+func (f fakeInventory) CollectHostLogs(ctx context.Context, params installer.CollectHostLogsParams) middleware.Responder {
+	return installer.NewCollectHostLogsAccepted()
+}
+
 func (f fakeInventory) UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder {
 	return installer.NewUpdateClusterCreated()
 }
 
+func (f fakeInventory) GetClusterInstallConfigDiff(ctx context.Context, params installer.GetClusterInstallConfigDiffParams) middleware.Responder {
+	return installer.NewGetClusterInstallConfigDiffOK()
+}
+
 func (f fakeInventory) GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder {
 	return installer.NewGetClusterInstallConfigOK()
 }
@@ -228,6 +252,10 @@ func (f fakeInventory) GetHostRequirements(ctx context.Context, params installer
 	return installer.NewGetHostRequirementsOK()
 }
 
+func (f fakeInventory) ValidateStaticNetworkConfig(ctx context.Context, params installer.ValidateStaticNetworkConfigParams) middleware.Responder {
+	return installer.NewValidateStaticNetworkConfigOK()
+}
+
 func (f fakeInventory) DownloadClusterLogs(ctx context.Context, params installer.DownloadClusterLogsParams) middleware.Responder {
 	file, err := ioutil.TempFile("/tmp", "test.file")
 	if err != nil {
@@ -252,6 +280,10 @@ func (f fakeInventory) UpdateHostIgnition(ctx context.Context, params installer.
 	return installer.NewUpdateHostIgnitionCreated()
 }
 
+func (f fakeInventory) GetHostIgnitionDiff(ctx context.Context, params installer.GetHostIgnitionDiffParams) middleware.Responder {
+	return installer.NewGetHostIgnitionDiffOK()
+}
+
 func (f fakeInventory) GetHostIgnition(ctx context.Context, params installer.GetHostIgnitionParams) middleware.Responder {
 	return installer.NewGetHostIgnitionOK()
 }
@@ -290,6 +322,12 @@ func (f fakeEventsAPI) ListEvents(
 	return eventsapi.NewListEventsOK()
 }
 
+func (f fakeEventsAPI) StreamEvents(
+	_ context.Context,
+	_ eventsapi.StreamEventsParams) middleware.Responder {
+	return eventsapi.NewStreamEventsOK()
+}
+
 type fakeVersionsAPI struct{}
 
 func (f fakeVersionsAPI) ListComponentVersions(
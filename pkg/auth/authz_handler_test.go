@@ -470,6 +470,11 @@ var _ = Describe("authz", func() {
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
 			apiCall:      getFreeAddresses,
 		},
+		{
+			name:         "get cluster network topology",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      getClusterNetworkTopology,
+		},
 		{
 			name:         "list events",
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
@@ -953,6 +958,13 @@ func getFreeAddresses(ctx context.Context, cli *client.AssistedInstall) error {
 	return err
 }
 
+func getClusterNetworkTopology(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.GetClusterNetworkTopology(
+		ctx,
+		&installer.GetClusterNetworkTopologyParams{ClusterID: strfmt.UUID(uuid.New().String())})
+	return err
+}
+
 func listEvents(ctx context.Context, cli *client.AssistedInstall) error {
 	hostId := strfmt.UUID(uuid.New().String())
 	_, err := cli.Events.ListEvents(
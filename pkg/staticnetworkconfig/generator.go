@@ -28,6 +28,7 @@ type StaticNetworkConfigData struct {
 //go:generate mockgen -source=generator.go -package=staticnetworkconfig -destination=mock_generator.go
 type StaticNetworkConfig interface {
 	GenerateStaticNetworkConfigData(hostsYAMLS string) ([]StaticNetworkConfigData, error)
+	ValidateStaticNetworkConfig(staticNetworkConfig []*models.HostStaticNetworkConfig) *models.StaticNetworkConfigValidation
 }
 
 type StaticNetworkConfigGenerator struct {
@@ -68,12 +69,13 @@ func (s *StaticNetworkConfigGenerator) generateHostStaticNetworkConfigData(hostC
 		s.log.WithError(err).Errorf("Failed to create temp file")
 		return nil, err
 	}
+	defer os.Remove(f.Name())
 	_, err = f.WriteString(hostYAML)
+	f.Close()
 	if err != nil {
 		s.log.WithError(err).Errorf("Failed to write host config to temp file")
 		return nil, err
 	}
-	f.Close()
 	stdout, stderr, retCode := executer.Execute("nmstatectl", "gc", f.Name())
 	if retCode != 0 {
 		msg := fmt.Sprintf("<nmstatectl gc> failed, errorCode %d, stderr %s, input yaml <%s>", retCode, stderr, hostYAML)
@@ -85,7 +87,6 @@ func (s *StaticNetworkConfigGenerator) generateHostStaticNetworkConfigData(hostC
 		s.log.WithError(err).Errorf("failed to create NM connection files")
 		return nil, err
 	}
-	os.Remove(f.Name())
 	mapConfigData := StaticNetworkConfigData{
 		FilePath:     filepath.Join(hostDir, "mac_interface.ini"),
 		FileContents: macInterfaceMapping,
@@ -184,6 +185,34 @@ func FormatStaticNetworkConfigForDB(staticNetworkConfig []*models.HostStaticNetw
 	return strings.Join(lines, staticNetworkConfigHostsDelimeter)
 }
 
+// ParseStaticNetworkConfigFromDB parses the static network config of the hosts from its format in the DB, see
+// FormatStaticNetworkConfigForDB
+func ParseStaticNetworkConfigFromDB(staticNetworkConfig string) ([]*models.HostStaticNetworkConfig, error) {
+	ret := make([]*models.HostStaticNetworkConfig, 0)
+	if staticNetworkConfig == "" {
+		return ret, nil
+	}
+	for i, hostLine := range strings.Split(staticNetworkConfig, staticNetworkConfigHostsDelimeter) {
+		hostConfig := strings.Split(hostLine, hostStaticNetworkDelimeter)
+		if len(hostConfig) != 2 {
+			return nil, errors.Errorf("invalid format of the static network config of host %d", i)
+		}
+		macInterfaceMap := models.MacInterfaceMap{}
+		for _, line := range strings.Split(hostConfig[1], "\n") {
+			if line == "" {
+				continue
+			}
+			entry := strings.SplitN(line, "=", 2)
+			if len(entry) != 2 {
+				return nil, errors.Errorf("invalid MAC to interface mapping %s of host %d", line, i)
+			}
+			macInterfaceMap = append(macInterfaceMap, &models.MacInterfaceMapItems0{MacAddress: entry[0], LogicalNicName: entry[1]})
+		}
+		ret = append(ret, &models.HostStaticNetworkConfig{NetworkYaml: hostConfig[0], MacInterfaceMap: macInterfaceMap})
+	}
+	return ret, nil
+}
+
 func formatMacInterfaceMap(macInterfaceMap models.MacInterfaceMap) string {
 	lines := make([]string, len(macInterfaceMap))
 	for i, entry := range macInterfaceMap {
@@ -1,10 +1,13 @@
 package staticnetworkconfig
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 )
 
@@ -35,4 +38,49 @@ var _ = Describe("StaticNetworkConfig", func() {
 		Expect(err).To(HaveOccurred())
 		Expect(mac).To(Equal(""))
 	})
+
+	It("removes the nmstatectl input file when the rendering fails", func() {
+		tmpDir, err := ioutil.TempDir("", "static-network-config")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(tmpDir)
+		tmpEnv := os.Getenv("TMPDIR")
+		Expect(os.Setenv("TMPDIR", tmpDir)).To(Succeed())
+		defer os.Setenv("TMPDIR", tmpEnv)
+
+		_, err = staticNetworkGenerator.generateHostStaticNetworkConfigData("interfaces: [\n"+hostStaticNetworkDelimeter, "host0")
+		Expect(err).To(HaveOccurred())
+		files, err := ioutil.ReadDir(tmpDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ParseStaticNetworkConfigFromDB", func() {
+	It("parses the format of the DB", func() {
+		staticNetworkConfig := []*models.HostStaticNetworkConfig{
+			{NetworkYaml: "interfaces: []\n", MacInterfaceMap: models.MacInterfaceMap{
+				{MacAddress: "02:00:00:80:12:14", LogicalNicName: "eth0"},
+				{MacAddress: "02:00:00:80:12:15", LogicalNicName: "eth1"},
+			}},
+			{NetworkYaml: "dns-resolver: {}\n", MacInterfaceMap: models.MacInterfaceMap{
+				{MacAddress: "02:00:00:80:12:16", LogicalNicName: "eth0"},
+			}},
+		}
+		parsed, err := ParseStaticNetworkConfigFromDB(FormatStaticNetworkConfigForDB(staticNetworkConfig))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed).To(ConsistOf(staticNetworkConfig[0], staticNetworkConfig[1]))
+	})
+
+	It("returns no hosts for an empty config", func() {
+		parsed, err := ParseStaticNetworkConfigFromDB("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed).To(BeEmpty())
+	})
+
+	It("fails on an invalid format", func() {
+		_, err := ParseStaticNetworkConfigFromDB("interfaces: []")
+		Expect(err).To(HaveOccurred())
+		_, err = ParseStaticNetworkConfigFromDB("interfaces: []" + hostStaticNetworkDelimeter + "02:00:00:80:12:14")
+		Expect(err).To(HaveOccurred())
+	})
 })
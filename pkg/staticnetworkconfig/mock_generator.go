@@ -6,6 +6,7 @@ package staticnetworkconfig
 
 import (
 	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
 	reflect "reflect"
 )
 
@@ -46,3 +47,17 @@ func (mr *MockStaticNetworkConfigMockRecorder) GenerateStaticNetworkConfigData(h
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStaticNetworkConfigData", reflect.TypeOf((*MockStaticNetworkConfig)(nil).GenerateStaticNetworkConfigData), hostsYAMLS)
 }
+
+// ValidateStaticNetworkConfig mocks base method
+func (m *MockStaticNetworkConfig) ValidateStaticNetworkConfig(staticNetworkConfig []*models.HostStaticNetworkConfig) *models.StaticNetworkConfigValidation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateStaticNetworkConfig", staticNetworkConfig)
+	ret0, _ := ret[0].(*models.StaticNetworkConfigValidation)
+	return ret0
+}
+
+// ValidateStaticNetworkConfig indicates an expected call of ValidateStaticNetworkConfig
+func (mr *MockStaticNetworkConfigMockRecorder) ValidateStaticNetworkConfig(staticNetworkConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStaticNetworkConfig", reflect.TypeOf((*MockStaticNetworkConfig)(nil).ValidateStaticNetworkConfig), staticNetworkConfig)
+}
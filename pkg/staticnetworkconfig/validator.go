@@ -0,0 +1,218 @@
+package staticnetworkconfig
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v2"
+)
+
+// The parts of the nmstate YAML that the validation checks
+type nmstateAddress struct {
+	IP           string `yaml:"ip"`
+	PrefixLength int    `yaml:"prefix-length"`
+}
+
+type nmstateIP struct {
+	Address []nmstateAddress `yaml:"address"`
+}
+
+type nmstateInterface struct {
+	Name string    `yaml:"name"`
+	Type string    `yaml:"type"`
+	IPv4 nmstateIP `yaml:"ipv4"`
+	IPv6 nmstateIP `yaml:"ipv6"`
+	Vlan struct {
+		BaseIface string `yaml:"base-iface"`
+	} `yaml:"vlan"`
+	LinkAggregation struct {
+		Slaves []string `yaml:"slaves"`
+		Port   []string `yaml:"port"`
+	} `yaml:"link-aggregation"`
+}
+
+type nmstateRoute struct {
+	Destination      string `yaml:"destination"`
+	NextHopAddress   string `yaml:"next-hop-address"`
+	NextHopInterface string `yaml:"next-hop-interface"`
+}
+
+type nmstateConfig struct {
+	Interfaces []nmstateInterface `yaml:"interfaces"`
+	Routes     struct {
+		Config []nmstateRoute `yaml:"config"`
+	} `yaml:"routes"`
+}
+
+func newStaticNetworkConfigError(errorType, iface, format string, args ...interface{}) *models.StaticNetworkConfigError {
+	return &models.StaticNetworkConfigError{Type: errorType, Interface: iface, Message: fmt.Sprintf(format, args...)}
+}
+
+func (i *nmstateInterface) addresses() []nmstateAddress {
+	return append(append([]nmstateAddress{}, i.IPv4.Address...), i.IPv6.Address...)
+}
+
+// isPhysical tells whether the interface is a NIC of the host, which the MAC to interface map must identify
+func (i *nmstateInterface) isPhysical() bool {
+	return i.Type == "" || i.Type == "ethernet"
+}
+
+type interfaceAddress struct {
+	iface string
+	ip    string
+}
+
+// validateHostConfig checks the nmstate YAML of a host against its MAC to interface map. It returns the static IP
+// addresses of the host, for the checks across the hosts.
+func validateHostConfig(hostConfig *models.HostStaticNetworkConfig) ([]interfaceAddress, []*models.StaticNetworkConfigError) {
+	var config nmstateConfig
+	if err := yaml.Unmarshal([]byte(hostConfig.NetworkYaml), &config); err != nil {
+		return nil, []*models.StaticNetworkConfigError{newStaticNetworkConfigError(models.StaticNetworkConfigErrorTypeInvalidYaml, "", "Failed to parse the network YAML: %s", err)}
+	}
+
+	validationErrors := make([]*models.StaticNetworkConfigError, 0)
+	interfaces := make(map[string]*nmstateInterface)
+	for i := range config.Interfaces {
+		interfaces[config.Interfaces[i].Name] = &config.Interfaces[i]
+	}
+	mappedNics := make([]string, 0, len(hostConfig.MacInterfaceMap))
+	for _, entry := range hostConfig.MacInterfaceMap {
+		if _, ok := interfaces[entry.LogicalNicName]; !ok {
+			validationErrors = append(validationErrors, newStaticNetworkConfigError(models.StaticNetworkConfigErrorTypeUnknownInterface, entry.LogicalNicName,
+				"Interface %s of MAC address %s is not defined in the network YAML", entry.LogicalNicName, entry.MacAddress))
+		}
+		mappedNics = append(mappedNics, entry.LogicalNicName)
+	}
+
+	for _, iface := range config.Interfaces {
+		if iface.isPhysical() && !funk.ContainsString(mappedNics, iface.Name) {
+			validationErrors = append(validationErrors, newStaticNetworkConfigError(models.StaticNetworkConfigErrorTypeMissingMac, iface.Name,
+				"Interface %s has no MAC address in the MAC to interface map", iface.Name))
+		}
+		referenced := append(append([]string{}, iface.LinkAggregation.Slaves...), iface.LinkAggregation.Port...)
+		if iface.Vlan.BaseIface != "" {
+			referenced = append(referenced, iface.Vlan.BaseIface)
+		}
+		for _, name := range referenced {
+			if _, ok := interfaces[name]; !ok {
+				validationErrors = append(validationErrors, newStaticNetworkConfigError(models.StaticNetworkConfigErrorTypeUnknownInterface, name,
+					"Interface %s that interface %s is based on is not defined in the network YAML", name, iface.Name))
+			}
+		}
+	}
+
+	for _, route := range config.Routes.Config {
+		var candidates []*nmstateInterface
+		if route.NextHopInterface != "" {
+			iface, ok := interfaces[route.NextHopInterface]
+			if !ok {
+				validationErrors = append(validationErrors, newStaticNetworkConfigError(models.StaticNetworkConfigErrorTypeUnknownInterface, route.NextHopInterface,
+					"Interface %s of the route to %s is not defined in the network YAML", route.NextHopInterface, route.Destination))
+				continue
+			}
+			candidates = []*nmstateInterface{iface}
+		} else {
+			for i := range config.Interfaces {
+				candidates = append(candidates, &config.Interfaces[i])
+			}
+		}
+		if err := validateGateway(route, candidates); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	addresses := make([]interfaceAddress, 0)
+	for _, iface := range config.Interfaces {
+		for _, address := range iface.addresses() {
+			addresses = append(addresses, interfaceAddress{iface: iface.Name, ip: address.IP})
+		}
+	}
+	return addresses, validationErrors
+}
+
+// validateGateway checks that the next hop of the route is in the subnet of a static address of the interfaces that
+// the route may go through. Interfaces without static addresses of the family of the next hop, e.g. DHCP ones, are
+// not checked.
+func validateGateway(route nmstateRoute, candidates []*nmstateInterface) *models.StaticNetworkConfigError {
+	gateway := net.ParseIP(route.NextHopAddress)
+	if route.NextHopAddress == "" || gateway == nil || gateway.IsUnspecified() {
+		return nil
+	}
+	checked := false
+	for _, iface := range candidates {
+		for _, address := range iface.addresses() {
+			ip := net.ParseIP(address.IP)
+			if ip == nil || (ip.To4() == nil) != (gateway.To4() == nil) {
+				continue
+			}
+			checked = true
+			_, subnet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", address.IP, address.PrefixLength))
+			if err == nil && subnet.Contains(gateway) {
+				return nil
+			}
+		}
+	}
+	if !checked {
+		return nil
+	}
+	return newStaticNetworkConfigError(models.StaticNetworkConfigErrorTypeGatewayOutsideSubnet, route.NextHopInterface,
+		"Gateway %s of the route to %s is outside the subnets of the interface", route.NextHopAddress, route.Destination)
+}
+
+// validateStaticNetworkConfig checks the static network configurations of the hosts without rendering them, and returns
+// the errors of every host by its index
+func validateStaticNetworkConfig(staticNetworkConfig []*models.HostStaticNetworkConfig) [][]*models.StaticNetworkConfigError {
+	ret := make([][]*models.StaticNetworkConfigError, len(staticNetworkConfig))
+	type owner struct {
+		hostIndex int
+		iface     string
+	}
+	owners := make(map[string]owner)
+	for i, hostConfig := range staticNetworkConfig {
+		addresses, validationErrors := validateHostConfig(hostConfig)
+		for _, address := range addresses {
+			key := strings.ToLower(address.ip)
+			if parsed := net.ParseIP(address.ip); parsed != nil {
+				key = parsed.String()
+			}
+			if other, ok := owners[key]; ok {
+				validationErrors = append(validationErrors, newStaticNetworkConfigError(models.StaticNetworkConfigErrorTypeDuplicateIP, address.iface,
+					"IP address %s of interface %s is already assigned to interface %s of host %d", address.ip, address.iface, other.iface, other.hostIndex))
+				continue
+			}
+			owners[key] = owner{hostIndex: i, iface: address.iface}
+		}
+		ret[i] = validationErrors
+	}
+	return ret
+}
+
+func (s *StaticNetworkConfigGenerator) ValidateStaticNetworkConfig(staticNetworkConfig []*models.HostStaticNetworkConfig) *models.StaticNetworkConfigValidation {
+	ret := &models.StaticNetworkConfigValidation{Valid: swag.Bool(true), Hosts: make([]*models.StaticNetworkConfigHostValidation, 0, len(staticNetworkConfig))}
+	for i, validationErrors := range validateStaticNetworkConfig(staticNetworkConfig) {
+		hostValidation := &models.StaticNetworkConfigHostValidation{
+			HostIndex: int64(i),
+			Files:     make([]*models.StaticNetworkConfigFile, 0),
+			Errors:    validationErrors,
+		}
+		if len(validationErrors) == 0 {
+			hostConfig := staticNetworkConfig[i].NetworkYaml + hostStaticNetworkDelimeter + formatMacInterfaceMap(staticNetworkConfig[i].MacInterfaceMap)
+			files, err := s.generateHostStaticNetworkConfigData(hostConfig, fmt.Sprintf("host%d", i))
+			if err != nil {
+				hostValidation.Errors = append(hostValidation.Errors, newStaticNetworkConfigError(models.StaticNetworkConfigErrorTypeRenderFailed, "", "%s", err))
+			}
+			for _, file := range files {
+				hostValidation.Files = append(hostValidation.Files, &models.StaticNetworkConfigFile{Path: file.FilePath, Contents: file.FileContents})
+			}
+		}
+		if len(hostValidation.Errors) > 0 {
+			ret.Valid = swag.Bool(false)
+		}
+		ret.Hosts = append(ret.Hosts, hostValidation)
+	}
+	return ret
+}
@@ -0,0 +1,124 @@
+package staticnetworkconfig
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+const hostNetworkYaml = `
+interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    dhcp: false
+    address:
+    - ip: 192.168.126.30
+      prefix-length: 24
+- name: eth1
+  type: ethernet
+  state: up
+- name: bond0
+  type: bond
+  state: up
+  link-aggregation:
+    mode: active-backup
+    slaves:
+    - eth1
+- name: bond0.100
+  type: vlan
+  state: up
+  vlan:
+    base-iface: bond0
+    id: 100
+  ipv6:
+    enabled: true
+    address:
+    - ip: 2001:db8::30
+      prefix-length: 64
+routes:
+  config:
+  - destination: 0.0.0.0/0
+    next-hop-address: 192.168.126.1
+    next-hop-interface: eth0
+  - destination: ::/0
+    next-hop-address: 2001:db8::1
+    next-hop-interface: bond0.100
+`
+
+var _ = Describe("StaticNetworkConfig validation", func() {
+	var hostConfig *models.HostStaticNetworkConfig
+
+	BeforeEach(func() {
+		hostConfig = &models.HostStaticNetworkConfig{
+			NetworkYaml: hostNetworkYaml,
+			MacInterfaceMap: models.MacInterfaceMap{
+				{MacAddress: "02:00:00:80:12:14", LogicalNicName: "eth0"},
+				{MacAddress: "02:00:00:80:12:15", LogicalNicName: "eth1"},
+			},
+		}
+	})
+
+	errorTypes := func(validationErrors []*models.StaticNetworkConfigError) []string {
+		ret := make([]string, 0)
+		for _, e := range validationErrors {
+			ret = append(ret, e.Type)
+		}
+		return ret
+	}
+
+	It("accepts a valid configuration", func() {
+		Expect(validateStaticNetworkConfig([]*models.HostStaticNetworkConfig{hostConfig})).To(Equal([][]*models.StaticNetworkConfigError{{}}))
+	})
+
+	It("rejects invalid YAML", func() {
+		hostConfig.NetworkYaml = "interfaces: [name: eth0"
+		Expect(errorTypes(validateStaticNetworkConfig([]*models.HostStaticNetworkConfig{hostConfig})[0])).To(Equal([]string{models.StaticNetworkConfigErrorTypeInvalidYaml}))
+	})
+
+	It("rejects unknown interfaces and interfaces without a MAC address", func() {
+		hostConfig.MacInterfaceMap = models.MacInterfaceMap{
+			{MacAddress: "02:00:00:80:12:14", LogicalNicName: "eth0"},
+			{MacAddress: "02:00:00:80:12:16", LogicalNicName: "eth2"},
+		}
+		validationErrors := validateStaticNetworkConfig([]*models.HostStaticNetworkConfig{hostConfig})[0]
+		Expect(errorTypes(validationErrors)).To(Equal([]string{models.StaticNetworkConfigErrorTypeUnknownInterface, models.StaticNetworkConfigErrorTypeMissingMac}))
+		Expect(validationErrors[0].Interface).To(Equal("eth2"))
+		Expect(validationErrors[1].Interface).To(Equal("eth1"))
+	})
+
+	It("rejects gateways outside the subnets of their interface", func() {
+		hostConfig.NetworkYaml = hostNetworkYaml + `  - destination: 10.0.0.0/8
+    next-hop-address: 192.168.127.1
+    next-hop-interface: eth0
+`
+		validationErrors := validateStaticNetworkConfig([]*models.HostStaticNetworkConfig{hostConfig})[0]
+		Expect(errorTypes(validationErrors)).To(Equal([]string{models.StaticNetworkConfigErrorTypeGatewayOutsideSubnet}))
+		Expect(validationErrors[0].Message).To(Equal("Gateway 192.168.127.1 of the route to 10.0.0.0/8 is outside the subnets of the interface"))
+	})
+
+	It("rejects IP addresses of several hosts", func() {
+		otherHostConfig := &models.HostStaticNetworkConfig{
+			NetworkYaml:     hostNetworkYaml,
+			MacInterfaceMap: models.MacInterfaceMap{{MacAddress: "02:00:00:80:12:24", LogicalNicName: "eth0"}, {MacAddress: "02:00:00:80:12:25", LogicalNicName: "eth1"}},
+		}
+		validationErrors := validateStaticNetworkConfig([]*models.HostStaticNetworkConfig{hostConfig, otherHostConfig})
+		Expect(validationErrors[0]).To(BeEmpty())
+		Expect(errorTypes(validationErrors[1])).To(Equal([]string{models.StaticNetworkConfigErrorTypeDuplicateIP, models.StaticNetworkConfigErrorTypeDuplicateIP}))
+		Expect(validationErrors[1][1].Message).To(Equal("IP address 2001:db8::30 of interface bond0.100 is already assigned to interface bond0.100 of host 0"))
+	})
+
+	It("doesn't render invalid configurations", func() {
+		hostConfig.MacInterfaceMap = nil
+		staticNetworkGenerator := StaticNetworkConfigGenerator{log: logrus.New()}
+		validation := staticNetworkGenerator.ValidateStaticNetworkConfig([]*models.HostStaticNetworkConfig{hostConfig})
+		Expect(swag.BoolValue(validation.Valid)).To(BeFalse())
+		Expect(validation.Hosts).To(HaveLen(1))
+		Expect(validation.Hosts[0].Files).To(BeEmpty())
+		Expect(errorTypes(validation.Hosts[0].Errors)).To(Equal([]string{models.StaticNetworkConfigErrorTypeMissingMac, models.StaticNetworkConfigErrorTypeMissingMac}))
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -17,12 +18,18 @@ import (
 	"github.com/openshift/assisted-service/restapi/operations"
 	"github.com/openshift/assisted-service/restapi/operations/assisted_service_iso"
 	"github.com/openshift/assisted-service/restapi/operations/bootfiles"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/openshift/assisted-service/restapi/operations/history"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/openshift/assisted-service/restapi/operations/logs"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/timeline"
+	"github.com/openshift/assisted-service/restapi/operations/validations"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 type contextKey string
@@ -51,12 +58,43 @@ type BootfilesAPI interface {
 	DownloadBootFiles(ctx context.Context, params bootfiles.DownloadBootFilesParams) middleware.Responder
 }
 
+//go:generate mockery -name ClusterTemplatesAPI -inpkg
+
+/* ClusterTemplatesAPI  */
+type ClusterTemplatesAPI interface {
+	/* CreateClusterTemplate Creates a cluster template that clusters can be registered from. */
+	CreateClusterTemplate(ctx context.Context, params cluster_templates.CreateClusterTemplateParams) middleware.Responder
+
+	/* DeleteClusterTemplate Deletes a cluster template. Clusters that were registered from the template are not affected. */
+	DeleteClusterTemplate(ctx context.Context, params cluster_templates.DeleteClusterTemplateParams) middleware.Responder
+
+	/* GetClusterTemplate Retrieves the details of a cluster template. */
+	GetClusterTemplate(ctx context.Context, params cluster_templates.GetClusterTemplateParams) middleware.Responder
+
+	/* ListClusterTemplates Retrieves the list of cluster templates. */
+	ListClusterTemplates(ctx context.Context, params cluster_templates.ListClusterTemplatesParams) middleware.Responder
+}
+
 //go:generate mockery -name EventsAPI -inpkg
 
 /* EventsAPI  */
 type EventsAPI interface {
 	/* ListEvents Lists events for a cluster. */
 	ListEvents(ctx context.Context, params events.ListEventsParams) middleware.Responder
+
+	/* StreamEvents Streams the events of a cluster as Server-Sent Events. The stream starts with the events that were added after the last seen event, if specified, and then pushes new events as they are added. */
+	StreamEvents(ctx context.Context, params events.StreamEventsParams) middleware.Responder
+}
+
+//go:generate mockery -name HistoryAPI -inpkg
+
+/* HistoryAPI  */
+type HistoryAPI interface {
+	/* ListClusterStatusHistory Retrieves the status changes of a cluster in the order they occurred. */
+	ListClusterStatusHistory(ctx context.Context, params history.ListClusterStatusHistoryParams) middleware.Responder
+
+	/* ListHostStatusHistory Retrieves the status changes of a host in the order they occurred. */
+	ListHostStatusHistory(ctx context.Context, params history.ListHostStatusHistoryParams) middleware.Responder
 }
 
 //go:generate mockery -name InstallerAPI -inpkg
@@ -66,6 +104,9 @@ type InstallerAPI interface {
 	/* CancelInstallation Cancels an ongoing installation. */
 	CancelInstallation(ctx context.Context, params installer.CancelInstallationParams) middleware.Responder
 
+	/* CollectHostLogs Requests the agent of the host to collect and upload its logs, whatever the status of the host is. */
+	CollectHostLogs(ctx context.Context, params installer.CollectHostLogsParams) middleware.Responder
+
 	/* CompleteInstallation Agent API to mark a finalizing installation as complete. */
 	CompleteInstallation(ctx context.Context, params installer.CompleteInstallationParams) middleware.Responder
 
@@ -99,9 +140,15 @@ type InstallerAPI interface {
 	/* DownloadHostLogs Download host logs. */
 	DownloadHostLogs(ctx context.Context, params installer.DownloadHostLogsParams) middleware.Responder
 
+	/* DryRunCluster Renders the install config, manifests and ignitions of the cluster without installing it, and returns them as a tar.gz bundle with their secrets redacted. The cluster and its hosts are not modified. */
+	DryRunCluster(ctx context.Context, params installer.DryRunClusterParams) middleware.Responder
+
 	/* EnableHost Enables a host for inclusion in the cluster. */
 	EnableHost(ctx context.Context, params installer.EnableHostParams) middleware.Responder
 
+	/* ExportCluster Exports the definition of the cluster as a portable bundle that can be imported by this or another assisted-service instance. The pull secret of the cluster is not exported. */
+	ExportCluster(ctx context.Context, params installer.ExportClusterParams) middleware.Responder
+
 	/* GenerateClusterISO Creates a new OpenShift per-cluster Discovery ISO. */
 	GenerateClusterISO(ctx context.Context, params installer.GenerateClusterISOParams) middleware.Responder
 
@@ -117,6 +164,12 @@ type InstallerAPI interface {
 	/* GetClusterInstallConfig Get the cluster's install config YAML. */
 	GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder
 
+	/* GetClusterInstallConfigDiff Get the differences between the install config that the service generates for the cluster and the effective install config after the install config overrides of the cluster. */
+	GetClusterInstallConfigDiff(ctx context.Context, params installer.GetClusterInstallConfigDiffParams) middleware.Responder
+
+	/* GetClusterNetworkTopology Retrieves the network topology of the cluster as the connectivity checks of its hosts see it. */
+	GetClusterNetworkTopology(ctx context.Context, params installer.GetClusterNetworkTopologyParams) middleware.Responder
+
 	/* GetCredentials Get the cluster admin credentials. */
 	GetCredentials(ctx context.Context, params installer.GetCredentialsParams) middleware.Responder
 
@@ -135,6 +188,9 @@ type InstallerAPI interface {
 	/* GetHostIgnition Get the customized ignition file for this host */
 	GetHostIgnition(ctx context.Context, params installer.GetHostIgnitionParams) middleware.Responder
 
+	/* GetHostIgnitionDiff Get the differences between the ignition config that the service generates for the host and the effective ignition config after the ignition config overrides of the host. Until the installation assets of the cluster are generated, the generated ignition config only includes the values that the service sets for the host. */
+	GetHostIgnitionDiff(ctx context.Context, params installer.GetHostIgnitionDiffParams) middleware.Responder
+
 	/* GetHostRequirements Get minimum host requirements. */
 	GetHostRequirements(ctx context.Context, params installer.GetHostRequirementsParams) middleware.Responder
 
@@ -147,6 +203,9 @@ type InstallerAPI interface {
 	/* GetPresignedForClusterFiles Retrieves a pre-signed S3 URL for downloading cluster files. */
 	GetPresignedForClusterFiles(ctx context.Context, params installer.GetPresignedForClusterFilesParams) middleware.Responder
 
+	/* ImportCluster Creates a new OpenShift cluster definition from a bundle exported by this or another assisted-service instance. The host assignments of the bundle are applied as hosts with matching MAC addresses or serial numbers register to the new cluster. */
+	ImportCluster(ctx context.Context, params installer.ImportClusterParams) middleware.Responder
+
 	/* InstallCluster Installs the OpenShift cluster. */
 	InstallCluster(ctx context.Context, params installer.InstallClusterParams) middleware.Responder
 
@@ -215,6 +274,17 @@ type InstallerAPI interface {
 
 	/* UploadLogs Agent API to upload logs. */
 	UploadLogs(ctx context.Context, params installer.UploadLogsParams) middleware.Responder
+
+	/* ValidateStaticNetworkConfig Validates static network configurations and renders the NetworkManager keyfiles that the discovery image would configure the hosts with. */
+	ValidateStaticNetworkConfig(ctx context.Context, params installer.ValidateStaticNetworkConfigParams) middleware.Responder
+}
+
+//go:generate mockery -name LogsAPI -inpkg
+
+/* LogsAPI  */
+type LogsAPI interface {
+	/* GetClusterLogsAnalysis Retrieves the known failures that were found in the uploaded logs of the cluster and its hosts. */
+	GetClusterLogsAnalysis(ctx context.Context, params logs.GetClusterLogsAnalysisParams) middleware.Responder
 }
 
 //go:generate mockery -name ManagedDomainsAPI -inpkg
@@ -259,6 +329,28 @@ type OperatorsAPI interface {
 	ReportMonitoredOperatorStatus(ctx context.Context, params operators.ReportMonitoredOperatorStatusParams) middleware.Responder
 }
 
+//go:generate mockery -name TimelineAPI -inpkg
+
+/* TimelineAPI  */
+type TimelineAPI interface {
+	/* GetInstallationTimeline Retrieves the installation of a cluster laid out over time, including the stages of every host, the status changes of the cluster, the validations that changed and the progress of the operators. */
+	GetInstallationTimeline(ctx context.Context, params timeline.GetInstallationTimelineParams) middleware.Responder
+}
+
+//go:generate mockery -name ValidationsAPI -inpkg
+
+/* ValidationsAPI  */
+type ValidationsAPI interface {
+	/* ListCustomHostValidations Retrieves the custom validations that are evaluated for the hosts of the cluster, which are the service-wide defaults unless the cluster has its own. */
+	ListCustomHostValidations(ctx context.Context, params validations.ListCustomHostValidationsParams) middleware.Responder
+
+	/* ResetCustomHostValidations Removes the custom validations of the cluster, so that the service-wide defaults are evaluated for its hosts. */
+	ResetCustomHostValidations(ctx context.Context, params validations.ResetCustomHostValidationsParams) middleware.Responder
+
+	/* UpdateCustomHostValidations Replaces the custom validations that are evaluated for the hosts of the cluster instead of the service-wide defaults. */
+	UpdateCustomHostValidations(ctx context.Context, params validations.UpdateCustomHostValidationsParams) middleware.Responder
+}
+
 //go:generate mockery -name VersionsAPI -inpkg
 
 /* VersionsAPI  */
@@ -270,16 +362,39 @@ type VersionsAPI interface {
 	ListSupportedOpenshiftVersions(ctx context.Context, params versions.ListSupportedOpenshiftVersionsParams) middleware.Responder
 }
 
+//go:generate mockery -name WebhooksAPI -inpkg
+
+/* WebhooksAPI  */
+type WebhooksAPI interface {
+	/* DeregisterWebhook Deregisters a webhook of a cluster. */
+	DeregisterWebhook(ctx context.Context, params webhooks.DeregisterWebhookParams) middleware.Responder
+
+	/* ListWebhookDeliveries Lists the delivery log of a webhook. */
+	ListWebhookDeliveries(ctx context.Context, params webhooks.ListWebhookDeliveriesParams) middleware.Responder
+
+	/* ListWebhooks Lists the webhooks that are registered for a cluster. */
+	ListWebhooks(ctx context.Context, params webhooks.ListWebhooksParams) middleware.Responder
+
+	/* RegisterWebhook Registers a webhook that is notified about the events and status changes of a cluster and its hosts. */
+	RegisterWebhook(ctx context.Context, params webhooks.RegisterWebhookParams) middleware.Responder
+}
+
 // Config is configuration for Handler
 type Config struct {
 	AssistedServiceIsoAPI
 	BootfilesAPI
+	ClusterTemplatesAPI
 	EventsAPI
+	HistoryAPI
 	InstallerAPI
+	LogsAPI
 	ManagedDomainsAPI
 	ManifestsAPI
 	OperatorsAPI
+	TimelineAPI
+	ValidationsAPI
 	VersionsAPI
+	WebhooksAPI
 	Logger func(string, ...interface{})
 	// InnerMiddleware is for the handler executors. These do not apply to the swagger.json document.
 	// The middleware executes after routing but before authentication, binding and validation
@@ -339,6 +454,9 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	api.MultipartformConsumer = runtime.DiscardConsumer
 	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textEventStream producer has not yet been implemented")
+	})
 	api.AgentAuthAuth = func(token string) (interface{}, error) {
 		if c.AuthAgentAuth == nil {
 			return token, nil
@@ -366,6 +484,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.CancelInstallation(ctx, params)
 	})
+	api.InstallerCollectHostLogsHandler = installer.CollectHostLogsHandlerFunc(func(params installer.CollectHostLogsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.CollectHostLogs(ctx, params)
+	})
 	api.InstallerCompleteInstallationHandler = installer.CompleteInstallationHandlerFunc(func(params installer.CompleteInstallationParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -376,6 +499,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.CreateClusterManifest(ctx, params)
 	})
+	api.ClusterTemplatesCreateClusterTemplateHandler = cluster_templates.CreateClusterTemplateHandlerFunc(func(params cluster_templates.CreateClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.CreateClusterTemplate(ctx, params)
+	})
 	api.AssistedServiceIsoCreateISOAndUploadToS3Handler = assisted_service_iso.CreateISOAndUploadToS3HandlerFunc(func(params assisted_service_iso.CreateISOAndUploadToS3Params, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -386,6 +514,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.DeleteClusterManifest(ctx, params)
 	})
+	api.ClusterTemplatesDeleteClusterTemplateHandler = cluster_templates.DeleteClusterTemplateHandlerFunc(func(params cluster_templates.DeleteClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.DeleteClusterTemplate(ctx, params)
+	})
 	api.InstallerDeregisterClusterHandler = installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -396,6 +529,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DeregisterHost(ctx, params)
 	})
+	api.WebhooksDeregisterWebhookHandler = webhooks.DeregisterWebhookHandlerFunc(func(params webhooks.DeregisterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.DeregisterWebhook(ctx, params)
+	})
 	api.InstallerDisableHostHandler = installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -450,11 +588,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.AssistedServiceIsoAPI.DownloadISO(ctx, params)
 	})
+	api.InstallerDryRunClusterHandler = installer.DryRunClusterHandlerFunc(func(params installer.DryRunClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DryRunCluster(ctx, params)
+	})
 	api.InstallerEnableHostHandler = installer.EnableHostHandlerFunc(func(params installer.EnableHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.EnableHost(ctx, params)
 	})
+	api.InstallerExportClusterHandler = installer.ExportClusterHandlerFunc(func(params installer.ExportClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ExportCluster(ctx, params)
+	})
 	api.InstallerGenerateClusterISOHandler = installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -480,6 +628,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetClusterInstallConfig(ctx, params)
 	})
+	api.InstallerGetClusterInstallConfigDiffHandler = installer.GetClusterInstallConfigDiffHandlerFunc(func(params installer.GetClusterInstallConfigDiffParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterInstallConfigDiff(ctx, params)
+	})
+	api.LogsGetClusterLogsAnalysisHandler = logs.GetClusterLogsAnalysisHandlerFunc(func(params logs.GetClusterLogsAnalysisParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.LogsAPI.GetClusterLogsAnalysis(ctx, params)
+	})
+	api.InstallerGetClusterNetworkTopologyHandler = installer.GetClusterNetworkTopologyHandlerFunc(func(params installer.GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterNetworkTopology(ctx, params)
+	})
+	api.ClusterTemplatesGetClusterTemplateHandler = cluster_templates.GetClusterTemplateHandlerFunc(func(params cluster_templates.GetClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.GetClusterTemplate(ctx, params)
+	})
 	api.InstallerGetCredentialsHandler = installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -505,11 +673,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetHostIgnition(ctx, params)
 	})
+	api.InstallerGetHostIgnitionDiffHandler = installer.GetHostIgnitionDiffHandlerFunc(func(params installer.GetHostIgnitionDiffParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetHostIgnitionDiff(ctx, params)
+	})
 	api.InstallerGetHostRequirementsHandler = installer.GetHostRequirementsHandlerFunc(func(params installer.GetHostRequirementsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetHostRequirements(ctx, params)
 	})
+	api.TimelineGetInstallationTimelineHandler = timeline.GetInstallationTimelineHandlerFunc(func(params timeline.GetInstallationTimelineParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.TimelineAPI.GetInstallationTimeline(ctx, params)
+	})
 	api.InstallerGetNextStepsHandler = installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -530,6 +708,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetPresignedForClusterFiles(ctx, params)
 	})
+	api.InstallerImportClusterHandler = installer.ImportClusterHandlerFunc(func(params installer.ImportClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ImportCluster(ctx, params)
+	})
 	api.InstallerInstallClusterHandler = installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -550,6 +733,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.ListClusterManifests(ctx, params)
 	})
+	api.HistoryListClusterStatusHistoryHandler = history.ListClusterStatusHistoryHandlerFunc(func(params history.ListClusterStatusHistoryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.HistoryAPI.ListClusterStatusHistory(ctx, params)
+	})
+	api.ClusterTemplatesListClusterTemplatesHandler = cluster_templates.ListClusterTemplatesHandlerFunc(func(params cluster_templates.ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.ListClusterTemplates(ctx, params)
+	})
 	api.InstallerListClustersHandler = installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -560,11 +753,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.VersionsAPI.ListComponentVersions(ctx, params)
 	})
+	api.ValidationsListCustomHostValidationsHandler = validations.ListCustomHostValidationsHandlerFunc(func(params validations.ListCustomHostValidationsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ValidationsAPI.ListCustomHostValidations(ctx, params)
+	})
 	api.EventsListEventsHandler = events.ListEventsHandlerFunc(func(params events.ListEventsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.EventsAPI.ListEvents(ctx, params)
 	})
+	api.HistoryListHostStatusHistoryHandler = history.ListHostStatusHistoryHandlerFunc(func(params history.ListHostStatusHistoryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.HistoryAPI.ListHostStatusHistory(ctx, params)
+	})
 	api.InstallerListHostsHandler = installer.ListHostsHandlerFunc(func(params installer.ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -595,6 +798,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.OperatorsAPI.ListSupportedOperators(ctx, params)
 	})
+	api.WebhooksListWebhookDeliveriesHandler = webhooks.ListWebhookDeliveriesHandlerFunc(func(params webhooks.ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.ListWebhookDeliveries(ctx, params)
+	})
+	api.WebhooksListWebhooksHandler = webhooks.ListWebhooksHandlerFunc(func(params webhooks.ListWebhooksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.ListWebhooks(ctx, params)
+	})
 	api.InstallerPostStepReplyHandler = installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -615,6 +828,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.RegisterHost(ctx, params)
 	})
+	api.WebhooksRegisterWebhookHandler = webhooks.RegisterWebhookHandlerFunc(func(params webhooks.RegisterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.RegisterWebhook(ctx, params)
+	})
 	api.OperatorsReportMonitoredOperatorStatusHandler = operators.ReportMonitoredOperatorStatusHandlerFunc(func(params operators.ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -625,6 +843,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ResetCluster(ctx, params)
 	})
+	api.ValidationsResetCustomHostValidationsHandler = validations.ResetCustomHostValidationsHandlerFunc(func(params validations.ResetCustomHostValidationsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ValidationsAPI.ResetCustomHostValidations(ctx, params)
+	})
 	api.InstallerResetHostHandler = installer.ResetHostHandlerFunc(func(params installer.ResetHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -635,6 +858,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ResetHostValidation(ctx, params)
 	})
+	api.EventsStreamEventsHandler = events.StreamEventsHandlerFunc(func(params events.StreamEventsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.StreamEvents(ctx, params)
+	})
 	api.InstallerUpdateClusterHandler = installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -650,6 +878,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateClusterLogsProgress(ctx, params)
 	})
+	api.ValidationsUpdateCustomHostValidationsHandler = validations.UpdateCustomHostValidationsHandlerFunc(func(params validations.UpdateCustomHostValidationsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ValidationsAPI.UpdateCustomHostValidations(ctx, params)
+	})
 	api.InstallerUpdateDiscoveryIgnitionHandler = installer.UpdateDiscoveryIgnitionHandlerFunc(func(params installer.UpdateDiscoveryIgnitionParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -690,6 +923,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UploadLogs(ctx, params)
 	})
+	api.InstallerValidateStaticNetworkConfigHandler = installer.ValidateStaticNetworkConfigHandlerFunc(func(params installer.ValidateStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ValidateStaticNetworkConfig(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
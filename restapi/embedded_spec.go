@@ -314,7 +314,7 @@ func init() {
         }
       }
     },
-    "/clusters": {
+    "/cluster-templates": {
       "get": {
         "security": [
           {
@@ -325,32 +325,16 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Retrieves the list of cluster templates.",
         "tags": [
-          "installer"
-        ],
-        "operationId": "ListClusters",
-        "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
-          }
+          "cluster_templates"
         ],
+        "operationId": "ListClusterTemplates",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/cluster-template-list"
             }
           },
           "401": {
@@ -376,29 +360,31 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Creates a cluster template that clusters can be registered from.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "RegisterCluster",
+        "operationId": "CreateClusterTemplate",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
+            "description": "The settings of the new cluster template.",
+            "name": "new-cluster-template-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-create-params"
+              "$ref": "#/definitions/cluster-template-create-params"
             }
           }
         ],
@@ -406,7 +392,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "400": {
@@ -442,7 +428,7 @@ func init() {
         }
       }
     },
-    "/clusters/default-config": {
+    "/cluster-templates/{template_id}": {
       "get": {
         "security": [
           {
@@ -453,16 +439,26 @@ func init() {
             ]
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Retrieves the details of a cluster template.",
         "tags": [
-          "installer"
+          "cluster_templates"
+        ],
+        "operationId": "GetClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be retrieved.",
+            "name": "template_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "GetClusterDefaultConfig",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "401": {
@@ -477,6 +473,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -484,56 +492,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}": {
-      "get": {
+      },
+      "delete": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Deletes a cluster template. Clusters that were registered from the template are not affected.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "GetCluster",
+        "operationId": "DeleteClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
-            "name": "cluster_id",
+            "description": "The cluster template to be deleted.",
+            "name": "template_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -564,34 +550,48 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      }
+    },
+    "/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterCluster",
+        "operationId": "ListClusters",
         "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -605,54 +605,40 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateCluster",
+        "operationId": "RegisterCluster",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to be updated.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-update-params"
+              "$ref": "#/definitions/cluster-create-params"
             }
           }
         ],
@@ -681,23 +667,55 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
           "500": {
             "description": "Error.",
@@ -708,30 +726,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/cancel": {
+    "/clusters/import": {
       "post": {
-        "description": "Cancels an ongoing installation.",
+        "description": "Creates a new OpenShift cluster definition from a bundle exported by this or another assisted-service instance. The host assignments of the bundle are applied as hosts with matching MAC addresses or serial numbers register to the new cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "CancelInstallation",
+        "operationId": "ImportCluster",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "The exported cluster bundle and the properties that are not part of it.",
+            "name": "import-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/import-cluster-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -744,25 +769,13 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -771,45 +784,50 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/complete_installation": {
-      "post": {
+    "/clusters/{cluster_id}": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete.",
+        "description": "Retrieves the details of the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "CompleteInstallation",
+        "operationId": "GetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster to be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
-          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
             "name": "discovery_agent_version",
             "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
@@ -839,12 +857,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -858,37 +870,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallCluster",
+        "operationId": "DeregisterCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster to be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -927,27 +928,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/install_hosts": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHosts",
+        "operationId": "UpdateCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The existing cluster whose hosts should be added.",
+            "description": "The cluster to be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
@@ -998,18 +1006,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/reset": {
+    "/clusters/{cluster_id}/actions/cancel": {
       "post": {
-        "description": "Resets a failed installation.",
+        "description": "Cancels an ongoing installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetCluster",
+        "operationId": "CancelInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster whose installation is to be canceled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1061,37 +1069,48 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/credentials": {
-      "get": {
+    "/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Agent API to mark a finalizing installation as complete.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCredentials",
+        "operationId": "CompleteInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster whose installation is being completing.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1129,31 +1148,31 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/discovery-ignition": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
+    "/clusters/{cluster_id}/actions/dry-run": {
+      "post": {
+        "description": "Renders the install config, manifests and ignitions of the cluster without installing it, and returns them as a tar.gz bundle with their secrets redacted. The cluster and its hosts are not modified.",
+        "produces": [
+          "application/octet-stream"
         ],
-        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
         "tags": [
           "installer"
         ],
-        "operationId": "GetDiscoveryIgnition",
+        "operationId": "DryRunCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "description": "The cluster whose installation assets should be rendered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1163,7 +1182,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "type": "file"
             }
           },
           "401": {
@@ -1190,6 +1209,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1197,35 +1222,31 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the discovery ignition config.",
+      }
+    },
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateDiscoveryIgnition",
+        "operationId": "InstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be updated.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Overrides for the discovery ignition config.",
-            "name": "discovery-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "400": {
             "description": "Error.",
@@ -1257,6 +1278,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1266,70 +1293,34 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/actions/install_hosts": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterFiles",
+        "operationId": "InstallHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The existing cluster whose hosts should be added.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "discovery.ign"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1367,96 +1358,32 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPresignedForClusterFiles",
+        "operationId": "ResetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is to be reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1498,7 +1425,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/image": {
+    "/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
@@ -1507,24 +1434,18 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISO",
+        "operationId": "GetCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be downloaded.",
+            "description": "The cluster whose admin credentials should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1534,14 +1455,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/credentials"
             }
           },
           "401": {
@@ -1563,7 +1477,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "409": {
             "description": "Error.",
@@ -1578,43 +1495,39 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+      }
+    },
+    "/clusters/{cluster_id}/custom-host-validations": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the custom validations that are evaluated for the hosts of the cluster, which are the service-wide defaults unless the cluster has its own.",
         "tags": [
-          "installer"
+          "validations"
         ],
-        "operationId": "GenerateClusterISO",
+        "operationId": "ListCustomHostValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be generated.",
+            "description": "The cluster whose custom host validations are affected.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The parameters for the generated ISO.",
-            "name": "image-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/image-create-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/custom-host-validation-list"
             }
           },
           "401": {
@@ -1641,12 +1554,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1655,42 +1562,43 @@ func init() {
           }
         }
       },
-      "head": {
+      "put": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+        "description": "Replaces the custom validations that are evaluated for the hosts of the cluster instead of the service-wide defaults.",
         "tags": [
-          "installer"
+          "validations"
         ],
-        "operationId": "DownloadClusterISOHeaders",
+        "operationId": "UpdateCustomHostValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO headers should be downloaded.",
+            "description": "The cluster whose custom host validations are affected.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "custom-host-validations",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/custom-host-validation-list"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
-            "headers": {
-              "Content-Length": {
-                "type": "integer",
-                "description": "Size of the ISO in bytes"
-              }
+            "schema": {
+              "$ref": "#/definitions/custom-host-validation-list"
             }
           },
           "400": {
@@ -1718,10 +1626,7 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
-          },
-          "409": {
-            "description": "Error.",
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1733,47 +1638,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
-      "get": {
+      },
+      "delete": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads the kubeconfig file for this cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Removes the custom validations of the cluster, so that the service-wide defaults are evaluated for its hosts.",
         "tags": [
-          "installer"
+          "validations"
         ],
-        "operationId": "DownloadClusterKubeconfig",
+        "operationId": "ResetCustomHostValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose kubeconfig should be downloaded.",
+            "description": "The cluster whose custom host validations are affected.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string",
-              "format": "binary"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -1799,12 +1691,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1814,7 +1700,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/events": {
+    "/clusters/{cluster_id}/discovery-ignition": {
       "get": {
         "security": [
           {
@@ -1825,42 +1711,26 @@ func init() {
             ]
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "ListEvents",
+        "operationId": "GetDiscoveryIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster for which the discovery ignition config should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
           },
           "401": {
@@ -1894,62 +1764,139 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/free_addresses": {
-      "get": {
-        "security": [
+      },
+      "patch": {
+        "description": "Override values in the discovery ignition config.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateDiscoveryIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Overrides for the discovery ignition config.",
+            "name": "discovery-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/discovery-ignition-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/downloads/files": {
+      "get": {
+        "security": [
           {
             "userAuth": [
               "admin",
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the free address list for a network.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetFreeAddresses",
+        "operationId": "DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return free addresses for.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "discovery.ign"
+            ],
             "type": "string",
-            "description": "The cluster network to return free addresses for.",
-            "name": "network",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
             "in": "query",
             "required": true
           },
-          {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "description": "The maximum number of free addresses to return.",
-            "name": "limit",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "A prefix for the free addresses to return.",
-            "name": "prefix",
-            "in": "query"
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "type": "file"
             }
           },
           "401": {
@@ -1976,16 +1923,28 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/host-requirements": {
+    "/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
@@ -1996,26 +1955,75 @@ func init() {
             ]
           }
         ],
-        "description": "Get host requirements of a cluster.",
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterHostRequirements",
+        "operationId": "GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-host-requirements-list"
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2042,6 +2050,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2051,7 +2065,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/downloads/image": {
       "get": {
         "security": [
           {
@@ -2062,35 +2076,39 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift hosts.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "ListHosts",
+        "operationId": "DownloadClusterISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be listed.",
+            "description": "The cluster whose ISO should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is listing hosts.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2105,20 +2123,23 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2126,46 +2147,35 @@ func init() {
         }
       },
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Registers a new OpenShift host.",
+        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterHost",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster into which the host should be registered.",
+            "description": "The cluster whose ISO should be generated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the host being registered.",
-            "name": "new-host-params",
+            "description": "The parameters for the generated ISO.",
+            "name": "image-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/image-create-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the host.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
+              "$ref": "#/definitions/cluster"
             }
           },
           "400": {
@@ -2199,7 +2209,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Cluster cannot accept new hosts due to its current state.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2209,18 +2219,10 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
-      "get": {
+      },
+      "head": {
         "security": [
           {
             "userAuth": [
@@ -2228,36 +2230,40 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHost",
+        "operationId": "DownloadClusterISOHeaders",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be retrieved.",
+            "description": "The cluster whose ISO headers should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be retrieved.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
+            "headers": {
+              "Content-Length": {
+                "type": "integer",
+                "description": "Size of the ISO in bytes"
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2279,7 +2285,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed.",
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2291,39 +2300,46 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the kubeconfig file for this cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterHost",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be deregistered.",
+            "description": "The cluster whose kubeconfig should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be deregistered.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "string",
+              "format": "binary"
             }
           },
           "401": {
@@ -2350,6 +2366,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2359,18 +2381,27 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
-        "description": "Enables a host for inclusion in the cluster.",
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "EnableHost",
+        "operationId": "ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being enabled.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2378,17 +2409,81 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being enabled.",
+            "description": "A host in the specified cluster to return events for.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities. Events of all severities are returned if not specified.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only events that occurred at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only events that occurred before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return only events whose message contains this text (case insensitive).",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of events to return. All the matching events are returned if not specified.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "default": 0,
+            "description": "The number of matching events to skip before starting to return events.",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of events that match the filters, regardless of the limit and offset."
+              }
             }
           },
           "401": {
@@ -2415,12 +2510,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2428,18 +2517,32 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Disables a host for inclusion in the cluster.",
+      }
+    },
+    "/clusters/{cluster_id}/events/stream": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Streams the events of a cluster as Server-Sent Events. The stream starts with the events that were added after the last seen event, if specified, and then pushes new events as they are added.",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "DisableHost",
+        "operationId": "StreamEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being disabled.",
+            "description": "The cluster to stream events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2447,17 +2550,37 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being disabled.",
+            "description": "A host in the specified cluster to stream events for.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The ID of the last event that the client received, sent by the browser when it reconnects to the stream.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "description": "Stream only the events that were added after the event with this ID. Used when the Last-Event-ID header can't be set, the header takes precedence.",
+            "name": "after_event_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string"
             }
           },
           "401": {
@@ -2484,12 +2607,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2499,36 +2616,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+    "/clusters/{cluster_id}/export": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Exports the definition of the cluster as a portable bundle that can be imported by this or another assisted-service instance. The pull secret of the cluster is not exported.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHost",
+        "operationId": "ExportCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being installed.",
+            "description": "The cluster to be exported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/cluster-export"
             }
           },
           "401": {
@@ -2549,8 +2667,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2564,36 +2682,60 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the free address list for a network.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetHost",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being reset.",
+            "description": "The cluster to return free addresses for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The cluster network to return free addresses for.",
+            "name": "network",
+            "in": "query",
             "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "description": "The maximum number of free addresses to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A prefix for the free addresses to return.",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "401": {
@@ -2614,8 +2756,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2629,50 +2771,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
+    "/clusters/{cluster_id}/history": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the status changes of a cluster in the order they occurred.",
         "tags": [
-          "installer"
+          "history"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "ResetHostValidation",
+        "operationId": "ListClusterStatusHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that its validation is being reset.",
+            "description": "The cluster whose status history should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that its validation is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/status-history"
             }
           },
           "401": {
@@ -2693,8 +2822,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2708,7 +2837,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
+    "/clusters/{cluster_id}/host-requirements": {
       "get": {
         "security": [
           {
@@ -2717,42 +2846,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get host requirements of a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostIgnition",
+        "operationId": "GetClusterHostRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be downloaded.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster-host-requirements-list"
             }
           },
           "401": {
@@ -2779,57 +2894,55 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+    "/clusters/{cluster_id}/hosts": {
       "get": {
-        "description": "Get the customized ignition file for this host",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostIgnition",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose customized ignition file should be retrieved.",
+            "description": "The cluster whose hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose customized ignition file should be retrieved.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The software version of the discovery agent that is listing hosts.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -2844,68 +2957,68 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostIgnition",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be updated.",
+            "description": "The cluster into which the host should be registered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
+            "description": "The description of the host being registered.",
+            "name": "new-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/host-create-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the host.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host_registration_response"
+            }
           },
           "400": {
             "description": "Error.",
@@ -2937,27 +3050,48 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Cluster cannot accept new hosts due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/clusters/{cluster_id}/hosts/{host_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallerArgs",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose installer arguments should be updated.",
+            "description": "The cluster of the host that should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2965,34 +3099,19 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
+            "description": "The host that should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -3017,38 +3136,25 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
-      "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetNextSteps",
+        "operationId": "DeregisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is retrieving instructions.",
+            "description": "The cluster of the host that should be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3056,23 +3162,20 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is retrieving instructions.",
+            "description": "The host that should be deregistered.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3104,37 +3207,22 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Requests the agent of the host to collect and upload its logs, whatever the status of the host is.",
         "tags": [
           "installer"
         ],
-        "operationId": "PostStepReply",
+        "operationId": "CollectHostLogs",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is posting results.",
+            "description": "The cluster of the host whose logs should be collected.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3142,23 +3230,26 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is posting results.",
+            "description": "The host whose logs should be collected.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The results to be posted.",
-            "name": "reply",
+            "description": "The journal units and directories to include in the logs, in addition to the default ones.",
+            "name": "collect-logs-params",
             "in": "body",
             "schema": {
-              "$ref": "#/definitions/step-reply"
+              "$ref": "#/definitions/host-logs-collection-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
             "description": "Error.",
@@ -3184,8 +3275,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3195,44 +3286,22 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Download host logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
+        "description": "Enables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostLogs",
-        "deprecated": true,
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be downloaded.",
+            "description": "The cluster of the host that is being enabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3240,7 +3309,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be downloaded.",
+            "description": "The host that is being enabled.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -3250,7 +3319,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -3291,26 +3360,17 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+      "delete": {
+        "description": "Disables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadHostLogs",
-        "deprecated": true,
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be uploaded.",
+            "description": "The cluster of the host that is being disabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3318,28 +3378,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
+            "description": "The host that is being disabled.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the logs.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3359,14 +3409,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3374,23 +3430,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostLogsProgress",
+        "operationId": "InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster of the host that is being installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3398,24 +3449,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose log progress is being updated.",
+            "description": "The host that is being installed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3435,12 +3480,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -3452,39 +3491,22 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation progress.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallProgress",
+        "operationId": "ResetHost",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve.",
+            "description": "The cluster of the host that is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3492,24 +3514,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to retrieve.",
+            "description": "The host that is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3529,8 +3545,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3540,47 +3556,54 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/install-config": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster's install config YAML.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterInstallConfig",
+        "summary": "Reset failed host validation.",
+        "operationId": "ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster of the host that its validation is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3601,8 +3624,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3614,40 +3637,53 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterInstallConfig",
+        "operationId": "DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster of the host whose ignition file should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -3674,16 +3710,28 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/logs": {
+    "/clusters/{cluster_id}/hosts/{host_id}/history": {
       "get": {
         "security": [
           {
@@ -3692,52 +3740,36 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves the status changes of a host in the order they occurred.",
         "tags": [
-          "installer"
+          "history"
         ],
-        "operationId": "DownloadClusterLogs",
+        "operationId": "ListHostStatusHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster whose status history should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "description": "The host whose status history should be retrieved.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/status-history"
             }
           },
           "401": {
@@ -3764,12 +3796,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3777,60 +3803,39 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Get the customized ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadLogs",
+        "operationId": "GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster of the host whose customized ignition file should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
+            "description": "The host whose customized ignition file should be retrieved.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3850,55 +3855,68 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/logs_progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterLogsProgress",
+        "operationId": "UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster of the host whose ignition file should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/host-ignition-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3924,54 +3942,54 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/manifests": {
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition/diff": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Get the differences between the ignition config that the service generates for the host and the effective ignition config after the ignition config overrides of the host. Until the installation assets of the cluster are generated, the generated ignition config only includes the values that the service sets for the host.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "ListClusterManifests",
+        "operationId": "GetHostIgnitionDiff",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster of the host whose ignition config differences are being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition config differences are being retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/config-diff"
             }
           },
           "401": {
@@ -3998,12 +4016,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4011,34 +4023,39 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Creates a manifest for customizing cluster installation.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "CreateClusterManifest",
+        "operationId": "UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster of the host whose installer arguments should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/create-manifest-params"
+              "$ref": "#/definitions/installer-args-params"
             }
           }
         ],
@@ -4046,7 +4063,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -4092,44 +4109,50 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DeleteClusterManifest",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster of the host that is retrieving instructions.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/steps"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -4155,69 +4178,71 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/manifests/files": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DownloadClusterManifest",
+        "operationId": "PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster of the host that is posting results.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4244,14 +4269,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4259,40 +4284,52 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/monitored_operators": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Download host logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ListOfClusterOperators",
+        "operationId": "DownloadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster of the host whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "type": "file"
             }
           },
           "401": {
@@ -4319,6 +4356,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4327,47 +4370,56 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ReportMonitoredOperatorStatus",
+        "operationId": "UploadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster of the host whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the logs.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "Success."
           },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4386,14 +4438,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
+          "413": {
+            "description": "Uploading the logs would exceed the log storage quota.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4413,38 +4459,48 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/preflight-requirements": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPreflightRequirements",
+        "operationId": "UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4470,61 +4526,75 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadClusterIngressCert",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The ID of the cluster to retrieve.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to retrieve.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/host-progress"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "200": {
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4565,7 +4635,7 @@ func init() {
         }
       }
     },
-    "/component_versions": {
+    "/clusters/{cluster_id}/install-config": {
       "get": {
         "security": [
           {
@@ -4576,42 +4646,117 @@ func init() {
             ]
           }
         ],
-        "description": "List of component versions.",
+        "description": "Get the cluster's install config YAML.",
         "tags": [
-          "versions"
+          "installer"
+        ],
+        "operationId": "GetClusterInstallConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose install config is being retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "ListComponentVersions",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-versions"
+              "type": "string"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/domains": {
-      "get": {
-        "security": [
+      },
+      "patch": {
+        "description": "Override values in the install config.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterInstallConfig",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose install config is being updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "ListManagedDomains",
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -4623,7 +4768,7 @@ func init() {
         }
       }
     },
-    "/host_requirements": {
+    "/clusters/{cluster_id}/install-config/diff": {
       "get": {
         "security": [
           {
@@ -4634,25 +4779,26 @@ func init() {
             ]
           }
         ],
-        "description": "Get minimum host requirements.",
+        "description": "Get the differences between the install config that the service generates for the cluster and the effective install config after the install config overrides of the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostRequirements",
+        "operationId": "GetClusterInstallConfigDiff",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Get hw requirements for single node.",
-            "name": "single_node",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose install config differences are being retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-requirements"
+              "$ref": "#/definitions/config-diff"
             }
           },
           "401": {
@@ -4667,6 +4813,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -4682,7 +4834,7 @@ func init() {
         }
       }
     },
-    "/openshift_versions": {
+    "/clusters/{cluster_id}/installation-timeline": {
       "get": {
         "security": [
           {
@@ -4693,20 +4845,54 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+        "description": "Retrieves the installation of a cluster laid out over time, including the stages of every host, the status changes of the cluster, the validations that changed and the progress of the operators.",
         "tags": [
-          "versions"
+          "timeline"
+        ],
+        "operationId": "GetInstallationTimeline",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation timeline should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "ListSupportedOpenshiftVersions",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/installation-timeline"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4714,22 +4900,154 @@ func init() {
         }
       }
     },
-    "/supported-operators": {
+    "/clusters/{cluster_id}/logs": {
       "get": {
-        "description": "Retrieves the list of supported operators.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "operators"
+          "installer"
+        ],
+        "operationId": "DownloadClusterLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
+          }
         ],
-        "operationId": "ListSupportedOperators",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UploadLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs should be uploaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4743,16 +5061,34 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "413": {
+            "description": "Uploading the logs would exceed the log storage quota.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/supported-operators/{operator_name}": {
+    "/clusters/{cluster_id}/logs/analysis": {
       "get": {
         "security": [
           {
@@ -4763,25 +5099,48 @@ func init() {
             ]
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Retrieves the known failures that were found in the uploaded logs of the cluster and its hosts.",
         "tags": [
-          "operators"
+          "logs"
         ],
-        "operationId": "ListOperatorProperties",
+        "operationId": "GetClusterLogsAnalysis",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The cluster whose logs analysis should be retrieved.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs analysis should be retrieved.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "Only findings of these severities are retrieved.",
+            "name": "severities",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/log-findings"
             }
           },
           "401": {
@@ -4802,6 +5161,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4810,226 +5175,3571 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "add-hosts-cluster-create-params": {
-      "type": "object",
-      "required": [
-        "id",
-        "name",
-        "api_vip_dnsname",
-        "openshift_version"
-      ],
-      "properties": {
-        "api_vip_dnsname": {
-          "description": "api vip domain.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
+    },
+    "/clusters/{cluster_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterLogsProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose log progress is being updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/manifests": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Lists manifests for customizing cluster installation.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "ListClusterManifests",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the manifests should be listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-manifests"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Creates a manifest for customizing cluster installation.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "CreateClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which a new manifest should be created.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "DeleteClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be deleted.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/manifests/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "DownloadClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/monitored_operators": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Lists operators to be monitored for a cluster.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "ListOfClusterOperators",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return operators for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "ReportMonitoredOperatorStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose operators are being monitored.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/network-topology": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the network topology of the cluster as the connectivity checks of its hosts see it.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterNetworkTopology",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose network topology is being retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/network-topology"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get preflight requirements for a cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetPreflightRequirements",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return preflight requrements for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/preflight-hardware-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Transfer the ingress certificate for the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UploadClusterIngressCert",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to associate with the ingress certificate.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/webhooks": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the webhooks that are registered for a cluster.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "ListWebhooks",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return webhooks for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Registers a webhook that is notified about the events and status changes of a cluster and its hosts.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "RegisterWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to register the webhook for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The webhook to register.",
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/webhooks/{webhook_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Deregisters a webhook of a cluster.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "DeregisterWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster of the webhook.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook to deregister.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/webhooks/{webhook_id}/deliveries": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the delivery log of a webhook.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "ListWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster of the webhook.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook to return deliveries for.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-delivery-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/component_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/host_requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get minimum host requirements.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetHostRequirements",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Get hw requirements for single node.",
+            "name": "single_node",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/openshift_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/static-network-config/validate": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Validates static network configurations and renders the NetworkManager keyfiles that the discovery image would configure the hosts with.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ValidateStaticNetworkConfig",
+        "parameters": [
+          {
+            "description": "The static network configurations of the hosts, as they would be passed to the image creation.",
+            "name": "static-network-config-validate-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/static-network-config-validate-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/static-network-config-validation"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "add-hosts-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "api_vip_dnsname",
+        "openshift_version"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "api vip domain.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "type": "object",
+      "properties": {
+        "is_success": {
+          "description": "API VIP connectivity check result.",
+          "type": "boolean"
+        }
+      }
+    },
+    "assisted-service-iso-create-params": {
+      "type": "object",
+      "properties": {
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "JSON-formatted list of the cluster networks of a dual-stack cluster, the first one is the cluster_network_cidr and cluster_network_host_prefix of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "custom_host_validations": {
+          "description": "JSON-formatted list of the custom validations that are evaluated for the hosts of the cluster instead of the service-wide defaults.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "deleted_at": {
+          "description": "The time that the cluster was deleted.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "disk_selection_policy": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:disk_selection_\"",
+          "$ref": "#/definitions/disk-selection-policy"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the initial ignition config",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        },
+        "image_info": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
+          "$ref": "#/definitions/image_info"
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster"
+          ]
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
+          "$ref": "#/definitions/logs_state"
+        },
+        "logs_size_bytes": {
+          "description": "The total size in bytes of the logs uploaded from the cluster and its hosts.",
+          "type": "integer"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_networks": {
+          "description": "JSON-formatted list of the machine networks of a dual-stack cluster, the first one is the machine_network_cidr of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "mtu": {
+          "description": "The MTU of the NICs of the hosts in the machine network, e.g. 9000 for jumbo frames. The MTU of the cluster network is derived from it. When unset, the NICs of all the hosts must have the same MTU.",
+          "type": "integer"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "role_assignment_policy": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:role_assignment_\"",
+          "$ref": "#/definitions/role-assignment-policy"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "JSON-formatted list of the service networks of a dual-stack cluster, the first one is the service_network_cidr of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the cluster_network_cidr and cluster_network_host_prefix of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          },
+          "x-nullable": true
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "machine_networks": {
+          "description": "The machine networks of a dual-stack cluster, at most one per IP address family with the IPv4 one first. The first one is the machine_network_cidr of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine-network"
+          },
+          "x-nullable": true
+        },
+        "mtu": {
+          "description": "The MTU of the NICs of the hosts in the machine network, e.g. 9000 for jumbo frames. The MTU of the cluster network is derived from it. When unset, the NICs of all the hosts must have the same MTU.",
+          "type": "integer",
+          "maximum": 9216,
+          "minimum": 576,
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster. Required unless it is set by the cluster template.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the service_network_cidr of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service-network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "template_id": {
+          "description": "A cluster template whose settings are used for the settings that are not specified. The Go templates in the settings of the template can refer to the name, base DNS domain and VIPs of the new cluster, and to the template variables.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "template_variables": {
+          "description": "Values that the Go templates of the cluster template can refer to as {{ .Variables.\u003ckey\u003e }}.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-export": {
+      "description": "A portable definition of a cluster, as exported by an assisted-service instance.",
+      "type": "object",
+      "required": [
+        "format_version",
+        "cluster"
+      ],
+      "properties": {
+        "cluster": {
+          "$ref": "#/definitions/exported-cluster"
+        },
+        "exported_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "format_version": {
+          "description": "The version of the bundle format.",
+          "type": "integer"
+        },
+        "host_assignments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-assignment"
+          }
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-network": {
+      "description": "IP address block from which Pod IPs are allocated.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The CIDR of the network.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        }
+      }
+    },
+    "cluster-template": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated.",
+          "type": "string"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node.",
+          "type": "integer"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "description": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "type": "string",
+          "enum": [
+            "Full",
+            "None"
+          ],
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.",
+          "type": "string"
+        },
+        "hyperthreading": {
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ],
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the cluster template.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "manifests": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "name": {
+          "type": "string"
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "olm_operators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses.",
+          "type": "string"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "user_managed_networking": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "vip_dhcp_allocation": {
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-template-create-params": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts. Can be a Go template.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "description": {
+          "type": "string"
+        },
+        "high_availability_mode": {
+          "type": "string",
+          "enum": [
+            "Full",
+            "None"
+          ],
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster. Can be a Go template.",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster. Can be a Go template.",
+          "type": "string"
+        },
+        "hyperthreading": {
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ],
+          "x-nullable": true
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file. Can be a Go template.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "The custom manifests of the clusters. Their contents can be Go templates.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying. Can be a Go template.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "The OLM operators of the clusters. Their properties can be Go templates.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-template-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-template"
+      }
+    },
+    "cluster-update-params": {
+      "type": "object",
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the cluster_network_cidr and cluster_network_host_prefix of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          },
+          "x-nullable": true
+        },
+        "disk_selection_policy": {
+          "$ref": "#/definitions/disk-selection-policy"
+        },
+        "disks_selected_config": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "disks_config": {
+                "description": "The desired disks parameters (such as the disk's role).",
+                "type": "array",
+                "items": {
+                  "$ref": "#/definitions/disk-config-params"
+                }
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_machine_config_pool_names": {
+          "description": "The desired machine config pool for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "machine_config_pool_name": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_names": {
+          "description": "The desired hostname for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "hostname": {
+                "type": "string"
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_rack_labels": {
+          "description": "The racks of the hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "rack_label": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_roles": {
+          "description": "The desired role for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "role": {
+                "$ref": "#/definitions/host-role-update-params"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ],
+          "x-nullable": true
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "machine_networks": {
+          "description": "The machine networks of a dual-stack cluster, at most one per IP address family with the IPv4 one first. The first one is the machine_network_cidr of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine-network"
+          },
+          "x-nullable": true
+        },
+        "mtu": {
+          "description": "The MTU of the NICs of the hosts in the machine network, e.g. 9000 for jumbo frames. The MTU of the cluster network is derived from it. When unset, the NICs of all the hosts must have the same MTU. Set to 0 to unset it.",
+          "type": "integer",
+          "maximum": 9216,
+          "x-nullable": true
+        },
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "role_assignment_policy": {
+          "$ref": "#/definitions/role-assignment-policy"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the service_network_cidr of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service-network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vip-defined",
+        "api-vip-valid",
+        "ingress-vip-defined",
+        "ingress-vip-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "ntp-server-configured",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "cnv-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "install-config-overrides-safe"
+      ]
+    },
+    "cluster_default_config": {
+      "type": "object",
+      "properties": {
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "ntp_source": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        }
+      }
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "config-diff": {
+      "description": "The differences between a configuration that the service generates and the effective configuration after the user overrides.",
+      "type": "object",
+      "properties": {
+        "operations": {
+          "description": "JSON patch (RFC 6902) operations that turn the generated configuration into the effective one.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/config-diff-operation"
+          }
+        },
+        "warnings": {
+          "description": "Values set by the service that the overrides replace or remove.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "config-diff-operation": {
+      "type": "object",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "base_value": {
+          "description": "The value in the generated configuration, for remove and replace operations. Sensitive values, e.g. the pull secret, are hidden."
+        },
+        "op": {
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace"
+          ]
+        },
+        "path": {
+          "description": "JSON pointer (RFC 6901) to the value that the operation changes.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value in the effective configuration, for add and replace operations. Sensitive values, e.g. the pull secret, are hidden."
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac": {
+          "type": "string"
+        },
+        "mtu": {
+          "description": "Size in bytes of the do-not-fragment packets that the agent sends to the IP addresses of the NIC to check that the path supports the MTU. The MTU is not probed when unset.",
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container_image_availability": {
+      "type": "object",
+      "properties": {
+        "download_rate": {
+          "description": "The rate of size/time in seconds MBps.",
+          "type": "number"
+        },
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container_image_availability_result"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes.",
+          "type": "number"
+        },
+        "time": {
+          "description": "Seconds it took to pull the image.",
+          "type": "number"
+        }
+      }
+    },
+    "container_image_availability_request": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of image names to be checked.",
+          "type": "array",
+          "items": {
+            "description": "A fully qualified image name (FQIN).",
+            "type": "string"
+          }
+        },
+        "timeout": {
+          "description": "Positive number represents a timeout in seconds for a pull operation.",
+          "type": "integer"
+        }
+      }
+    },
+    "container_image_availability_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container_image_availability"
+          }
+        }
+      }
+    },
+    "container_image_availability_result": {
+      "description": "Image availability result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "base64 encoded manifest content.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest to customize the installed OCP cluster.",
+          "type": "string",
+          "pattern": "^[^/]*\\.(yaml|yml|json)$"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "custom-host-validation": {
+      "type": "object",
+      "required": [
+        "id",
+        "expression"
+      ],
+      "properties": {
+        "blocking": {
+          "description": "Whether a failure of the validation keeps the host from becoming ready for installation. Defaults to true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "description": {
+          "description": "Reported as the message of the validation when it fails.",
+          "type": "string"
+        },
+        "expression": {
+          "description": "CEL expression that must evaluate to true for the validation to succeed. The inventory of the host is available as the inventory variable, e.g. inventory.interfaces.exists(i, i.vendor == \"0x8086\").",
+          "type": "string"
+        },
+        "id": {
+          "description": "Identifier of the validation in the host validations_info, under the custom category.",
+          "type": "string",
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        }
+      }
+    },
+    "custom-host-validation-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/custom-host-validation"
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_lease": {
+          "description": "Contents of lease file to be used for API virtual IP.",
+          "type": "string"
+        },
+        "api_vip_mac": {
+          "description": "MAC address for the API virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_mac": {
+          "description": "MAC address for the Ingress virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "interface": {
+          "description": "The network interface (NIC) to run the DHCP requests on.",
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "api_vip_lease": {
+          "description": "Contents of last acquired lease for API virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of last acquired lease for Ingress virtual IP.",
+          "type": "string"
+        }
+      }
+    },
+    "discovery-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "bootable": {
+          "type": "boolean"
+        },
+        "by_id": {
+          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
+          "type": "string"
+        },
+        "by_path": {
+          "description": "by-path is the shortest physical path to the device",
+          "type": "string"
+        },
+        "drive_type": {
+          "type": "string"
+        },
+        "hctl": {
+          "type": "string"
+        },
+        "id": {
+          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+          "type": "string"
+        },
+        "installation_eligibility": {
+          "type": "object",
+          "properties": {
+            "eligible": {
+              "description": "Whether the disk is eligible for installation or not.",
+              "type": "boolean"
+            },
+            "not_eligible_reasons": {
+              "description": "Reasons for why this disk is not eligible for installation.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": false
+        },
+        "io_perf": {
+          "$ref": "#/definitions/io_perf"
+        },
+        "is_installation_media": {
+          "description": "Whether the disk appears to be an installation media or not",
+          "type": "boolean"
+        },
+        "model": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "smart": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "disk-config-params": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/disk-role"
+        }
+      }
+    },
+    "disk-role": {
+      "description": "The usage of a disk. A var-lib-containers or var-lib-etcd disk is a secondary disk of the host that is formatted and mounted on /var/lib/containers or /var/lib/etcd of the node, var-lib-etcd being allowed on masters only.",
+      "type": "string",
+      "enum": [
+        "none",
+        "install",
+        "var-lib-containers",
+        "var-lib-etcd"
+      ]
+    },
+    "disk-selection-policy": {
+      "description": "How the service picks the installation disk of a host among its eligible disks when the inventory of the host arrives. The rules are preferences, applied in the order in which they are listed here, so a host always gets an installation disk if it has an eligible one. An installation disk that was already picked for the host is kept when its inventory is updated. When the policy changes, the installation disks of the hosts are picked again, except the ones that the user picked.",
+      "type": "object",
+      "properties": {
+        "avoid_partitioned_disks": {
+          "description": "Prefer the disks on which the agent found no bootable partition, e.g. of a previous installation. The agent only reports whether a disk is bootable, so disks that only have other partitions, e.g. data partitions, are not avoided.",
+          "type": "boolean"
+        },
+        "match_by": {
+          "description": "The attribute of the disks that the match pattern is applied to.",
+          "type": "string",
+          "default": "none",
+          "enum": [
+            "none",
+            "by-path",
+            "wwn",
+            "model",
+            "serial"
+          ]
+        },
+        "match_pattern": {
+          "description": "Regular expression. Prefer the disks whose attribute selected by match_by matches it.",
+          "type": "string",
+          "example": "^pci-0000:00:1f.2-ata-1"
+        },
+        "prefer_fastest": {
+          "description": "Prefer the disks with the shortest sync duration, as measured by the agent.",
+          "type": "boolean"
+        },
+        "prefer_smallest": {
+          "description": "Prefer the smallest disks. Eligible disks are all larger than the minimum disk size.",
+          "type": "boolean"
+        },
+        "prefer_ssd": {
+          "description": "Prefer SSD and NVMe disks over HDD disks.",
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_info": {
+      "type": "object",
+      "properties": {
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
+        },
+        "speed_ms": {
+          "type": "integer"
+        },
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed_check_response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "The device path.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain name that should be resolved",
+                "type": "string"
+              }
+            },
+            "x-go-name": "DomainResolutionRequestDomain"
+          }
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain that was resolved",
+                "type": "string"
+              },
+              "ipv4_addresses": {
+                "description": "The IPv4 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv4"
+                }
+              },
+              "ipv6_addresses": {
+                "description": "The IPv6 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv6"
+                }
+              }
+            },
+            "x-go-name": "DomainResolutionResponseDomain"
+          }
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
+        },
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "category": {
+          "type": "string",
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
+        },
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        },
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "exported-cluster": {
+      "description": "The configuration of an exported cluster, without its hosts, state and pull secret.",
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "type": "string"
+        },
+        "api_vip": {
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "base_dns_domain": {
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "high_availability_mode": {
+          "type": "string",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "type": "string"
+        },
+        "https_proxy": {
+          "type": "string"
+        },
+        "hyperthreading": {
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the exported cluster.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ignition_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
+        },
+        "ingress_vip": {
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string"
+        },
+        "machine_network_cidr": {
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "monitored_operators": {
+          "$ref": "#/definitions/monitored-operators-list"
+        },
+        "name": {
+          "type": "string"
+        },
+        "no_proxy": {
+          "type": "string"
+        },
         "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "ssh_public_key": {
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "fio_perf_check_request": {
+      "type": "object",
+      "required": [
+        "path",
+        "duration_threshold_ms",
+        "exit_code"
+      ],
+      "properties": {
+        "duration_threshold_ms": {
+          "description": "The maximal fdatasync duration in ms that is considered acceptable.",
+          "type": "integer"
+        },
+        "exit_code": {
+          "description": "Exit code to return in case of an error.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
           "type": "string"
         }
       }
     },
-    "api_vip_connectivity_request": {
+    "fio_perf_check_response": {
       "type": "object",
-      "required": [
-        "url"
-      ],
       "properties": {
-        "url": {
-          "description": "URL address of the API.",
-          "type": "string"
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
         },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
-          "type": "boolean"
+        "path": {
+          "description": "The device path.",
+          "type": "string"
         }
       }
     },
-    "api_vip_connectivity_response": {
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "ipv4"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+      }
+    },
+    "free_network_addresses": {
       "type": "object",
       "properties": {
-        "is_success": {
-          "description": "API VIP connectivity check result.",
-          "type": "boolean"
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
+        },
+        "network": {
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
         }
       }
     },
-    "assisted-service-iso-create-params": {
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "gpu": {
       "type": "object",
       "properties": {
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
           "type": "string"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
           "type": "string"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
           "type": "string"
-        }
-      }
-    },
-    "boot": {
-      "type": "object",
-      "properties": {
-        "current_boot_mode": {
+        },
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
           "type": "string"
         },
-        "pxe_interface": {
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
           "type": "string"
         }
       }
     },
-    "cluster": {
+    "host": {
       "type": "object",
       "required": [
         "kind",
         "id",
         "href",
-        "image_info",
         "status",
         "status_info"
       ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
+        "api_vip_connectivity": {
           "type": "string",
-          "format": "uuid"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        "bootstrap": {
+          "type": "boolean"
         },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
           "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
+        "connectivity": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "controller_logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "controller_logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
         "created_at": {
-          "description": "The time that this cluster was created.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
         "deleted_at": {
-          "description": "The time that the cluster was deleted.",
+          "description": "The time that the host was deleted.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
           "x-nullable": true
         },
-        "email_domain": {
+        "discovery_agent_version": {
           "type": "string"
         },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+        "flapping_validations": {
+          "description": "JSON-formatted list of the validation ids whose status changed repeatedly within a short period, which usually indicates an unstable network rather than a persistent failure.",
           "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/host"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        "free_addresses": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
         "href": {
           "description": "Self link.",
           "type": "string"
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
-          "type": "string",
-          "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ]
-        },
         "id": {
           "description": "Unique identifier of the object.",
           "type": "string",
@@ -5037,2654 +8747,3702 @@ func init() {
           "x-go-custom-tag": "gorm:\"primary_key\""
         },
         "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the initial ignition config",
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\"",
           "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
-        "image_info": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
-          "$ref": "#/definitions/image_info"
-        },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
+        "images_status": {
+          "description": "Array of image statuses.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        "installation_disk_chosen_by_user": {
+          "description": "Whether the user picked the installation disk of the host, in which case the disk selection policy of the cluster doesn't replace it.",
+          "type": "boolean"
         },
-        "install_config_overrides": {
-          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
+          "type": "string"
+        },
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+          "example": "/dev/sda"
         },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
+        "installer_args": {
+          "type": "string"
+        },
+        "installer_version": {
+          "description": "Installer version.",
+          "type": "string"
+        },
+        "inventory": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
         "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
           "type": "string",
           "enum": [
-            "Cluster",
-            "AddHostsCluster"
+            "Host",
+            "AddToExistingClusterHost"
           ]
         },
+        "logs_collected_at": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "logs_collection_request": {
+          "description": "JSON-formatted on-demand log collection that was requested for the host and was not sent to its agent yet.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "logs_info": {
           "description": "The progress of log collection or empty if logs are not applicable",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
           "$ref": "#/definitions/logs_state"
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        "logs_size_bytes": {
+          "description": "The size in bytes of the logs uploaded from the host.",
+          "type": "integer"
         },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/monitored-operator"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        "logs_started_at": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
+        "machine_config_pool_name": {
           "type": "string"
         },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string"
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
+        "progress": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
+          "$ref": "#/definitions/host-progress-info"
         },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
-          "type": "string",
-          "format": "uuid"
+        "progress_stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "rack_label": {
+          "description": "The rack of the host, as labeled by the user, which the role assignment policy may spread the masters across.",
           "type": "string"
         },
-        "org_id": {
+        "requested_hostname": {
           "type": "string"
         },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+        "secondary_disks": {
+          "description": "The disks of the host, other than the installation disk, that are formatted and mounted on a directory of the node by their role, formatted as a JSON list of disk-config-params.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
         "status": {
-          "description": "Status of the OpenShift cluster.",
           "type": "string",
           "enum": [
+            "discovering",
+            "known",
+            "disconnected",
             "insufficient",
-            "ready",
-            "error",
+            "disabled",
             "preparing-for-installation",
+            "preparing-successful",
             "pending-for-input",
             "installing",
-            "finalizing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
             "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action"
+            "error",
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled"
           ]
         },
         "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
         "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
+          "description": "The last time that the host status was updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+        "suggested_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "suggested_role_reason": {
+          "description": "The role that the service picks for the host when its role is automatically assigned, and why. It is previewed until the installation starts.",
+          "type": "string"
         },
         "updated_at": {
-          "description": "The last time that this cluster was updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
-        },
         "user_name": {
           "type": "string"
         },
         "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
         }
       }
     },
-    "cluster-create-params": {
+    "host-assignment": {
+      "description": "Settings of a host that are applied to the host whose MAC address or serial number matches.",
       "type": "object",
-      "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
-      ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
-        },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
-          "type": "string",
-          "default": "all",
-          "enum": [
-            "masters",
-            "workers",
-            "none",
-            "all"
-          ]
-        },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
+        "installation_disk_id": {
+          "description": "Contains the ID of the disk to install on.",
           "type": "string"
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
+        "mac_addresses": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-create-params"
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
           }
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+        "requested_hostname": {
           "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "serial_number": {
           "type": "string"
-        },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": true,
-          "x-nullable": true
-        }
-      }
-    },
-    "cluster-host-requirements": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "operators": {
-          "description": "Host requirements related to requested operators",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-host-requirements"
-          }
-        },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "cluster-host-requirements-details": {
+    "host-create-params": {
       "type": "object",
+      "required": [
+        "host_id"
+      ],
       "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
-        },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
-        },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
+        "discovery_agent_version": {
+          "type": "string"
         },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
         }
       }
     },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
+    "host-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
+        }
       }
     },
-    "cluster-list": {
+    "host-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/cluster"
+        "$ref": "#/definitions/host"
       }
     },
-    "cluster-update-params": {
+    "host-logs-collection-params": {
       "type": "object",
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-          "x-nullable": true
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1,
-          "x-nullable": true
-        },
-        "disks_selected_config": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "disks_config": {
-                "description": "The desired disks parameters (such as the disk's role).",
-                "type": "array",
-                "items": {
-                  "$ref": "#/definitions/disk-config-params"
-                }
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
-          },
-          "x-nullable": true
-        },
-        "hosts_machine_config_pool_names": {
-          "description": "The desired machine config pool for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "machine_config_pool_name": {
-                "type": "string"
-              }
-            }
-          },
-          "x-nullable": true
-        },
-        "hosts_names": {
-          "description": "The desired hostname for hosts associated with the cluster.",
+        "directories": {
+          "description": "Absolute paths of directories on the host to collect. The paths may not contain whitespaces, ':' or ','.",
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "hostname": {
-                "type": "string"
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
-          },
-          "x-nullable": true
+            "type": "string",
+            "pattern": "^/[^\\s:,]*$"
+          }
         },
-        "hosts_roles": {
-          "description": "The desired role for hosts associated with the cluster.",
+        "journal_units": {
+          "description": "The systemd units whose journal should be collected.",
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "role": {
-                "$ref": "#/definitions/host-role-update-params"
-              }
-            }
-          },
-          "x-nullable": true
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+            "type": "string",
+            "pattern": "^[a-zA-Z0-9:_.@-]+$"
+          }
+        }
+      }
+    },
+    "host-progress": {
+      "type": "object",
+      "required": [
+        "current_stage"
+      ],
+      "properties": {
+        "current_stage": {
           "type": "string",
-          "x-nullable": true
+          "$ref": "#/definitions/host-stage"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "progress_info": {
           "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        }
+      }
+    },
+    "host-progress-info": {
+      "type": "object",
+      "required": [
+        "current_stage"
+      ],
+      "properties": {
+        "current_stage": {
           "type": "string",
-          "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ],
-          "x-nullable": true
+          "$ref": "#/definitions/host-stage"
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
+        "progress_info": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "name": {
-          "description": "OpenShift cluster name.",
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
           "type": "string",
-          "maxLength": 54,
-          "minLength": 1,
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        }
+      }
+    },
+    "host-requirements": {
+      "type": "object",
+      "properties": {
+        "master": {
+          "$ref": "#/definitions/host-requirements-role"
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
+        "worker": {
+          "$ref": "#/definitions/host-requirements-role"
+        }
+      }
+    },
+    "host-requirements-role": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "type": "integer"
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
+        "disk_size_gb": {
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "type": "integer"
+        },
+        "ram_gib": {
+          "type": "integer"
+        }
+      }
+    },
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker"
+      ]
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Start waiting for control plane",
+        "Installing",
+        "Writing image to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
+    },
+    "host-type-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "qualitative": {
+          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-create-params"
+            "type": "string"
           }
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
+        "quantitative": {
+          "description": "Host requirements that can be quantified",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "host-type-hardware-requirements-wrapper": {
+      "type": "object",
+      "properties": {
+        "master": {
+          "description": "Requirements towards a master node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+        "worker": {
+          "description": "Requirements towards a worker node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         }
       }
     },
-    "cluster-validation-id": {
+    "host-validation-id": {
       "type": "string",
       "enum": [
+        "connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
         "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vip-defined",
-        "api-vip-valid",
-        "ingress-vip-defined",
-        "ingress-vip-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set",
-        "ntp-server-configured",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "api-vip-connected",
+        "belongs-to-majority-group",
+        "valid-platform",
+        "ntp-synced",
+        "container-images-available",
         "lso-requirements-satisfied",
         "ocs-requirements-satisfied",
-        "cnv-requirements-satisfied"
+        "sufficient-or-unknown-installation-disk-speed",
+        "cnv-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "secondary-disks-valid",
+        "mtu-consistent",
+        "ignition-config-overrides-safe"
       ]
     },
-    "cluster_default_config": {
+    "host_network": {
       "type": "object",
       "properties": {
-        "cluster_network_cidr": {
-          "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
-        },
-        "cluster_network_host_prefix": {
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
-        },
-        "ntp_source": {
-          "type": "string",
-          "x-omitempty": false
+        "cidr": {
+          "type": "string"
         },
-        "service_network_cidr": {
-          "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        "host_ids": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         }
       }
     },
-    "completion-params": {
+    "host_registration_response": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/host"
+        },
+        {
+          "properties": {
+            "next_step_runner_command": {
+              "description": "Command for starting the next step runner",
+              "type": "object",
+              "properties": {
+                "args": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "command": {
+                  "type": "string"
+                },
+                "retry_seconds": {
+                  "description": "How long in seconds to wait before retrying registration if the command fails",
+                  "type": "integer"
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "host_static_network_config": {
       "type": "object",
-      "required": [
-        "is_success"
-      ],
       "properties": {
-        "error_info": {
-          "type": "string"
+        "mac_interface_map": {
+          "description": "mapping of host macs to logical interfaces used in the network yaml",
+          "$ref": "#/definitions/mac_interface_map"
         },
-        "is_success": {
-          "type": "boolean"
+        "network_yaml": {
+          "description": "yaml string that can be processed by nmstate",
+          "type": "string"
         }
       }
     },
-    "connectivity-check-host": {
+    "image-create-params": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "image_type": {
+          "description": "Type of image that should be generated.",
+          "$ref": "#/definitions/image_type"
         },
-        "nics": {
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
+            "$ref": "#/definitions/host_static_network_config"
           }
         }
       }
     },
-    "connectivity-check-nic": {
+    "image_info": {
       "type": "object",
       "properties": {
-        "ip_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "mac": {
+        "download_url": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "generator_version": {
+          "description": "Image generator version.",
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
           "type": "string"
         },
+        "type": {
+          "$ref": "#/definitions/image_type"
+        }
+      }
+    },
+    "image_type": {
+      "type": "string",
+      "enum": [
+        "full-iso",
+        "minimal-iso"
+      ]
+    },
+    "import-cluster-params": {
+      "type": "object",
+      "required": [
+        "pull_secret",
+        "bundle"
+      ],
+      "properties": {
+        "bundle": {
+          "$ref": "#/definitions/cluster-export"
+        },
         "name": {
+          "description": "Name of the new cluster. Defaults to the name of the exported cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
           "type": "string"
         }
       }
     },
-    "connectivity-check-params": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/connectivity-check-host"
+    "infra_error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
+        },
+        "message": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
       }
     },
-    "connectivity-remote-host": {
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "installation-timeline": {
       "type": "object",
+      "required": [
+        "cluster_id"
+      ],
       "properties": {
-        "host_id": {
+        "cluster_id": {
           "type": "string",
           "format": "uuid"
         },
-        "l2_connectivity": {
+        "cluster_statuses": {
+          "description": "The statuses of the cluster in the order they were entered.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l2-connectivity"
+            "$ref": "#/definitions/timeline-cluster-status"
           }
         },
-        "l3_connectivity": {
+        "hosts": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l3-connectivity"
+            "$ref": "#/definitions/timeline-host"
+          }
+        },
+        "operators": {
+          "description": "The status reports of the monitored operators that changed status, in the order they were reported.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeline-operator-change"
+          }
+        },
+        "validations": {
+          "description": "The cluster and host validations that changed status, in the order they changed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeline-validation-change"
           }
         }
       }
     },
-    "connectivity-report": {
+    "installer-args-params": {
       "type": "object",
       "properties": {
-        "remote_hosts": {
+        "args": {
+          "description": "List of additional arguments passed to coreos-installer",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
+            "type": "string"
+          },
+          "example": [
+            "--append-karg",
+            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
+            "--save-partindex",
+            "1",
+            "-n"
+          ]
         }
       }
     },
-    "container_image_availability": {
+    "interface": {
       "type": "object",
       "properties": {
-        "download_rate": {
-          "description": "The rate of size/time in seconds MBps.",
-          "type": "number"
+        "biosdevname": {
+          "type": "string"
         },
-        "name": {
-          "description": "A fully qualified image name (FQIN).",
+        "client_id": {
           "type": "string"
         },
-        "result": {
-          "$ref": "#/definitions/container_image_availability_result"
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "size_bytes": {
-          "description": "Size of the image in bytes.",
-          "type": "number"
+        "has_carrier": {
+          "type": "boolean"
         },
-        "time": {
-          "description": "Seconds it took to pull the image.",
-          "type": "number"
-        }
-      }
-    },
-    "container_image_availability_request": {
-      "type": "object",
-      "required": [
-        "images"
-      ],
-      "properties": {
-        "images": {
-          "description": "List of image names to be checked.",
+        "ipv4_addresses": {
           "type": "array",
           "items": {
-            "description": "A fully qualified image name (FQIN).",
             "type": "string"
           }
         },
-        "timeout": {
-          "description": "Positive number represents a timeout in seconds for a pull operation.",
-          "type": "integer"
-        }
-      }
-    },
-    "container_image_availability_response": {
-      "type": "object",
-      "required": [
-        "images"
-      ],
-      "properties": {
-        "images": {
-          "description": "List of images that were checked.",
+        "ipv6_addresses": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/container_image_availability"
+            "type": "string"
           }
-        }
-      }
-    },
-    "container_image_availability_result": {
-      "description": "Image availability result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "cpu": {
-      "type": "object",
-      "properties": {
-        "architecture": {
-          "type": "string"
         },
-        "count": {
-          "type": "integer"
+        "mac_address": {
+          "type": "string"
         },
-        "flags": {
+        "members": {
+          "description": "The names of the interfaces that a bond or a bridge aggregates, or the name of the interface that a VLAN is based on.",
           "type": "array",
           "items": {
             "type": "string"
-          }
+          },
+          "x-omitempty": true
         },
-        "frequency": {
-          "type": "number"
+        "mtu": {
+          "type": "integer"
         },
-        "model_name": {
+        "name": {
           "type": "string"
-        }
-      }
-    },
-    "create-manifest-params": {
-      "type": "object",
-      "required": [
-        "file_name",
-        "content"
-      ],
-      "properties": {
-        "content": {
-          "description": "base64 encoded manifest content.",
+        },
+        "product": {
           "type": "string"
         },
-        "file_name": {
-          "description": "The name of the manifest to customize the installed OCP cluster.",
-          "type": "string",
-          "pattern": "^[^/]*\\.(yaml|yml|json)$"
+        "speed_mbps": {
+          "type": "integer"
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+        "type": {
+          "description": "The kind of the interface. Interfaces without a type are physical NICs.",
           "type": "string",
-          "default": "manifests",
           "enum": [
-            "manifests",
-            "openshift"
+            "physical",
+            "bond",
+            "vlan",
+            "bridge"
           ]
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "vlan_id": {
+          "description": "The VLAN ID of a VLAN interface.",
+          "type": "integer"
         }
       }
     },
-    "credentials": {
+    "inventory": {
       "type": "object",
       "properties": {
-        "console_url": {
+        "bmc_address": {
           "type": "string"
         },
-        "password": {
+        "bmc_v6address": {
           "type": "string"
         },
-        "username": {
+        "boot": {
+          "$ref": "#/definitions/boot"
+        },
+        "cpu": {
+          "$ref": "#/definitions/cpu"
+        },
+        "disks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk"
+          }
+        },
+        "gpus": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
+          }
+        },
+        "hostname": {
           "type": "string"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
+        },
+        "memory": {
+          "$ref": "#/definitions/memory"
+        },
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        },
+        "timestamp": {
+          "type": "integer"
         }
       }
     },
-    "dhcp_allocation_request": {
+    "io_perf": {
       "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
       "properties": {
-        "api_vip_lease": {
-          "description": "Contents of lease file to be used for API virtual IP.",
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
+          "type": "integer"
+        }
+      }
+    },
+    "l2-connectivity": {
+      "type": "object",
+      "properties": {
+        "outgoing_ip_address": {
           "type": "string"
         },
-        "api_vip_mac": {
-          "description": "MAC address for the API virtual IP.",
-          "type": "string",
-          "format": "mac"
-        },
-        "ingress_vip_lease": {
-          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+        "outgoing_nic": {
           "type": "string"
         },
-        "ingress_vip_mac": {
-          "description": "MAC address for the Ingress virtual IP.",
-          "type": "string",
-          "format": "mac"
+        "remote_ip_address": {
+          "type": "string"
         },
-        "interface": {
-          "description": "The network interface (NIC) to run the DHCP requests on.",
+        "remote_mac": {
           "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "dhcp_allocation_response": {
+    "l3-connectivity": {
       "type": "object",
-      "required": [
-        "api_vip_address",
-        "ingress_vip_address"
-      ],
       "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
-          "type": "string",
-          "format": "ipv4"
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
         },
-        "api_vip_lease": {
-          "description": "Contents of last acquired lease for API virtual IP.",
-          "type": "string"
+        "mtu_probe_successful": {
+          "description": "Whether the do-not-fragment packets of the probed MTU reached the remote IP address. Unset when the MTU was not probed.",
+          "type": "boolean",
+          "x-nullable": true
         },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
-          "type": "string",
-          "format": "ipv4"
+        "outgoing_nic": {
+          "type": "string"
         },
-        "ingress_vip_lease": {
-          "description": "Contents of last acquired lease for Ingress virtual IP.",
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost during connectivity check.",
+          "type": "number",
+          "format": "double"
+        },
+        "remote_ip_address": {
           "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "discovery-ignition-params": {
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain"
+      }
+    },
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
+      }
+    },
+    "list-versions": {
+      "type": "object",
       "properties": {
-        "config": {
+        "release_tag": {
           "type": "string"
+        },
+        "versions": {
+          "$ref": "#/definitions/versions"
         }
       }
     },
-    "disk": {
+    "log-finding": {
       "type": "object",
+      "required": [
+        "id",
+        "cluster_id",
+        "logs_type",
+        "rule_id",
+        "severity",
+        "title",
+        "created_at"
+      ],
       "properties": {
-        "bootable": {
-          "type": "boolean"
+        "cluster_id": {
+          "description": "Unique identifier of the cluster whose logs were analyzed.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "by_id": {
-          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
-          "type": "string"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "by_path": {
-          "description": "by-path is the shortest physical path to the device",
-          "type": "string"
+        "excerpt": {
+          "description": "The first line that matched the rule.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "drive_type": {
-          "type": "string"
+        "file": {
+          "description": "Path in the logs archive of the first file that matched the rule.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "hctl": {
-          "type": "string"
+        "host_id": {
+          "description": "Unique identifier of the host that uploaded the logs.",
+          "type": "string",
+          "format": "uuid"
         },
         "id": {
-          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
-          "type": "string"
-        },
-        "installation_eligibility": {
-          "type": "object",
-          "properties": {
-            "eligible": {
-              "description": "Whether the disk is eligible for installation or not.",
-              "type": "boolean"
-            },
-            "not_eligible_reasons": {
-              "description": "Reasons for why this disk is not eligible for installation.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "x-nullable": false
-        },
-        "io_perf": {
-          "$ref": "#/definitions/io_perf"
-        },
-        "is_installation_media": {
-          "description": "Whether the disk appears to be an installation media or not",
-          "type": "boolean"
-        },
-        "model": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "path": {
-          "type": "string"
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "serial": {
-          "type": "string"
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
         },
-        "size_bytes": {
+        "matches": {
+          "description": "Number of lines in the logs that matched the rule.",
           "type": "integer"
         },
-        "smart": {
-          "type": "string"
+        "remediation": {
+          "description": "What is usually done to fix the failure.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "vendor": {
+        "rule_id": {
+          "description": "Identifier of the rule of a known failure that matched the logs, e.g. etcd-quorum-lost.",
           "type": "string"
         },
-        "wwn": {
+        "severity": {
+          "$ref": "#/definitions/log-finding-severity"
+        },
+        "title": {
+          "description": "Short description of the known failure.",
           "type": "string"
         }
       }
     },
-    "disk-config-params": {
+    "log-finding-severity": {
+      "type": "string",
+      "enum": [
+        "info",
+        "warning",
+        "critical"
+      ]
+    },
+    "log-findings": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/log-finding"
+      }
+    },
+    "logs-progress-params": {
       "type": "object",
       "required": [
-        "id"
+        "logs_state"
       ],
       "properties": {
-        "id": {
-          "type": "string"
-        },
-        "role": {
-          "$ref": "#/definitions/disk-role"
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
         }
       }
     },
-    "disk-role": {
+    "logs_state": {
       "type": "string",
       "enum": [
-        "none",
-        "install"
+        "requested",
+        "collecting",
+        "completed",
+        "timeout",
+        ""
       ]
     },
-    "disk_info": {
+    "logs_type": {
+      "type": "string",
+      "enum": [
+        "host",
+        "controller",
+        "all",
+        ""
+      ]
+    },
+    "mac_interface_map": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "logical_nic_name": {
+            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+            "type": "string"
+          },
+          "mac_address": {
+            "description": "mac address present on the host",
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          }
+        }
+      }
+    },
+    "machine-network": {
+      "description": "A network that every host of the cluster has an address in.",
       "type": "object",
       "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
-        },
-        "id": {
+        "cidr": {
+          "description": "The CIDR of the network.",
           "type": "string",
-          "format": "uuid"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        }
+      }
+    },
+    "managed-domain": {
+      "type": "object",
+      "properties": {
+        "domain": {
+          "type": "string"
         },
-        "path": {
+        "provider": {
+          "type": "string",
+          "enum": [
+            "route53",
+            "rfc2136",
+            "powerdns"
+          ]
+        }
+      }
+    },
+    "manifest": {
+      "type": "object",
+      "properties": {
+        "file_name": {
+          "description": "The file name prefaced by the folder that contains it.",
           "type": "string"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
         }
       }
     },
-    "disk_speed": {
+    "memory": {
       "type": "object",
       "properties": {
-        "exit_code": {
+        "physical_bytes": {
           "type": "integer"
         },
-        "speed_ms": {
+        "usable_bytes": {
+          "type": "integer"
+        }
+      }
+    },
+    "monitored-operator": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this operator is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
+        },
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "namespace": {
+          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
+          "type": "string"
+        },
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
+        },
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        },
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
+          "type": "string"
+        },
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
           "type": "integer"
-        },
-        "tested": {
-          "type": "boolean"
         }
       }
     },
-    "disk_speed_check_request": {
-      "type": "object",
-      "required": [
-        "path"
-      ],
-      "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
-          "type": "string"
-        }
+    "monitored-operators-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator"
       }
     },
-    "disk_speed_check_response": {
+    "network-topology": {
       "type": "object",
       "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
-        },
-        "path": {
-          "description": "The device path.",
+        "dot": {
+          "description": "The topology as a Graphviz DOT graph.",
           "type": "string"
-        }
-      }
-    },
-    "domain_resolution_request": {
-      "type": "object",
-      "required": [
-        "domains"
-      ],
-      "properties": {
-        "domains": {
+        },
+        "hosts": {
           "type": "array",
           "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain name that should be resolved",
-                "type": "string"
-              }
-            },
-            "x-go-name": "DomainResolutionRequestDomain"
+            "$ref": "#/definitions/network-topology-host"
           }
-        }
-      }
-    },
-    "domain_resolution_response": {
-      "type": "object",
-      "required": [
-        "resolutions"
-      ],
-      "properties": {
-        "resolutions": {
+        },
+        "links": {
+          "description": "The connectivity of every host to every address of the other hosts, as its last connectivity check reported it.",
           "type": "array",
           "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain that was resolved",
-                "type": "string"
-              },
-              "ipv4_addresses": {
-                "description": "The IPv4 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv4"
-                }
-              },
-              "ipv6_addresses": {
-                "description": "The IPv6 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv6"
-                }
-              }
-            },
-            "x-go-name": "DomainResolutionResponseDomain"
+            "$ref": "#/definitions/network-topology-link"
+          }
+        },
+        "subnets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-subnet"
           }
         }
       }
     },
-    "error": {
+    "network-topology-host": {
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
-      ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
-          "type": "string"
-        },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
+        "host_id": {
           "type": "string",
-          "enum": [
-            "Error"
-          ]
+          "format": "uuid"
         },
-        "reason": {
-          "description": "Human-readable description of the error.",
+        "hostname": {
           "type": "string"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-nic"
+          }
         }
       }
     },
-    "event": {
+    "network-topology-link": {
       "type": "object",
-      "required": [
-        "cluster_id",
-        "severity",
-        "message",
-        "event_time"
-      ],
       "properties": {
-        "category": {
-          "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
-        },
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "event_time": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "average_rtt_ms": {
+          "type": "number",
+          "format": "double"
         },
         "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
           "type": "string",
           "format": "uuid"
         },
-        "message": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        "l2_successful": {
+          "description": "Whether the remote IP address replied to ARP or NDP. Unset when L2 connectivity wasn't checked.",
+          "type": "boolean",
+          "x-nullable": true
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "l3_successful": {
+          "description": "Whether the remote IP address replied to ping. Unset when L3 connectivity wasn't checked.",
+          "type": "boolean",
+          "x-nullable": true
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "packet_loss_percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "remote_host_id": {
           "type": "string",
           "format": "uuid"
         },
-        "severity": {
-          "type": "string",
-          "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
+          "type": "string"
+        },
+        "subnet": {
+          "description": "The subnet of the remote IP address, if it's a subnet of a host of the cluster.",
+          "type": "string"
         }
       }
     },
-    "event-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/event"
+    "network-topology-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac_address": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "subnets": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
       }
     },
-    "fio_perf_check_request": {
+    "network-topology-subnet": {
       "type": "object",
-      "required": [
-        "path",
-        "duration_threshold_ms",
-        "exit_code"
-      ],
       "properties": {
-        "duration_threshold_ms": {
-          "description": "The maximal fdatasync duration in ms that is considered acceptable.",
-          "type": "integer"
+        "cidr": {
+          "type": "string"
         },
-        "exit_code": {
-          "description": "Exit code to return in case of an error.",
-          "type": "integer"
+        "groups": {
+          "description": "The groups of at least 3 hosts in the subnet with full mesh L2 connectivity, the largest first.",
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            }
+          }
         },
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
+        "host_ids": {
+          "description": "The hosts with an address in the subnet.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "ntp_source": {
+      "type": "object",
+      "properties": {
+        "source_name": {
+          "description": "NTP source name or IP.",
           "type": "string"
+        },
+        "source_state": {
+          "description": "Indication of state of an NTP source.",
+          "$ref": "#/definitions/source_state"
         }
       }
     },
-    "fio_perf_check_response": {
+    "ntp_synchronization_request": {
       "type": "object",
+      "required": [
+        "ntp_source"
+      ],
       "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
-        },
-        "path": {
-          "description": "The device path.",
+        "ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string"
         }
       }
     },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
-      }
-    },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-      }
-    },
-    "free_network_addresses": {
-      "type": "object",
+    "ntp_synchronization_response": {
       "properties": {
-        "free_addresses": {
+        "ntp_sources": {
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "ipv4"
+            "$ref": "#/definitions/ntp_source"
           }
-        },
-        "network": {
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
         }
       }
     },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
-      }
-    },
-    "gpu": {
+    "openshift-version": {
       "type": "object",
+      "required": [
+        "display_name",
+        "release_image",
+        "release_version",
+        "rhcos_image",
+        "rhcos_version",
+        "support_level"
+      ],
       "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
+        },
+        "display_name": {
+          "description": "Name of the version to be presented to the user.",
           "type": "string"
         },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
+        "release_image": {
+          "description": "The installation image of the OpenShift cluster.",
           "type": "string"
         },
-        "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+        "release_version": {
+          "description": "OCP version from the release metadata.",
           "type": "string"
         },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+        "rhcos_image": {
+          "description": "The base RHCOS image used for the discovery iso.",
           "type": "string"
         },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
+        "rhcos_version": {
+          "description": "Build ID of the RHCOS image.",
           "type": "string"
+        },
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production",
+            "custom"
+          ]
         }
       }
     },
-    "host": {
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
+    },
+    "operator-create-params": {
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
-      ],
       "properties": {
-        "api_vip_connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "bootstrap": {
-          "type": "boolean"
-        },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "cluster_id": {
-          "description": "The cluster that this host is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "deleted_at": {
-          "description": "The time that the host was deleted.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-nullable": true
-        },
-        "discovery_agent_version": {
-          "type": "string"
-        },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "free_addresses": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "href": {
-          "description": "Self link.",
+        "name": {
           "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
-        },
-        "images_status": {
-          "description": "Array of image statuses.",
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "operator-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "dependencies": {
+          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
+        "operator_name": {
+          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
           "type": "string"
         },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
-          "type": "string",
-          "example": "/dev/sda"
-        },
-        "installer_args": {
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
+    "operator-host-requirements": {
+      "type": "object",
+      "properties": {
+        "operator_name": {
+          "description": "Name of the operator",
           "type": "string"
         },
-        "installer_version": {
-          "description": "Installer version.",
+        "requirements": {
+          "description": "Host requirements for the operator",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "operator-monitor-report": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Unique name of the operator.",
           "type": "string"
         },
-        "inventory": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
-          "type": "string",
-          "enum": [
-            "Host",
-            "AddToExistingClusterHost"
-          ]
-        },
-        "logs_collected_at": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
-          "$ref": "#/definitions/logs_state"
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "logs_started_at": {
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        }
+      }
+    },
+    "operator-properties": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-property"
+      }
+    },
+    "operator-property": {
+      "type": "object",
+      "properties": {
+        "data_type": {
+          "description": "Type of the property",
           "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "enum": [
+            "boolean",
+            "string",
+            "integer",
+            "float"
+          ]
         },
-        "machine_config_pool_name": {
+        "default_value": {
+          "description": "Default value for the property",
           "type": "string"
         },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "description": {
+          "description": "Description of a property",
+          "type": "string"
         },
-        "progress": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
-          "$ref": "#/definitions/host-progress-info"
+        "mandatory": {
+          "description": "Indicates whether the property is reqired",
+          "type": "boolean"
         },
-        "progress_stages": {
+        "name": {
+          "description": "Name of the property",
+          "type": "string"
+        },
+        "options": {
+          "description": "Values to select from",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
+            "type": "string"
+          }
+        }
+      }
+    },
+    "operator-status": {
+      "description": "Represents the operator state.",
+      "type": "string",
+      "enum": [
+        "failed",
+        "progressing",
+        "available"
+      ]
+    },
+    "operator-type": {
+      "description": "Kind of operator. Different types are monitored by the service differently.",
+      "type": "string",
+      "enum": [
+        "builtin",
+        "olm"
+      ]
+    },
+    "preflight-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "ocp": {
+          "description": "Preflight OCP requirements",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         },
-        "requested_hostname": {
+        "operators": {
+          "description": "Preflight operators hardware requirements",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-hardware-requirements"
+          }
+        }
+      }
+    },
+    "presigned": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "url": {
           "type": "string"
+        }
+      }
+    },
+    "role-assignment-policy": {
+      "description": "How the service picks the masters among the hosts whose role is automatically assigned. The rules are combined, hosts that are excluded by a rule are never picked as masters.",
+      "type": "object",
+      "properties": {
+        "exclude_virtual_masters": {
+          "description": "Never pick virtual hosts as masters.",
+          "type": "boolean"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
+        "master_hostname_pattern": {
+          "description": "Regular expression that the hostname of a host must match for it to be picked as a master.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "example": "^cp-"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "prefer_most_resources": {
+          "description": "Pick the hosts with the most memory, and then the most CPU cores, as masters.",
+          "type": "boolean"
         },
-        "status": {
+        "serial_number_prefix_length": {
+          "description": "Length of the serial number prefix that identifies the group of a host when spreading by serial number prefix.",
+          "type": "integer",
+          "minimum": 1
+        },
+        "spread_by": {
+          "description": "Pick masters whose serial numbers have distinct prefixes, or whose rack labels are distinct, before picking masters that share a prefix or a rack.",
           "type": "string",
+          "default": "none",
           "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled"
+            "none",
+            "serial-number-prefix",
+            "rack-label"
           ]
-        },
-        "status_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "updated_at": {
+        }
+      }
+    },
+    "service-network": {
+      "description": "IP address block from which Service IPs are allocated.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The CIDR of the network.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        }
+      }
+    },
+    "source_state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
+      ]
+    },
+    "static-network-config-error": {
+      "type": "object",
+      "properties": {
+        "interface": {
+          "description": "The interface that the error is about, if any.",
+          "type": "string"
         },
-        "user_name": {
+        "message": {
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
+        "type": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "enum": [
+            "invalid-yaml",
+            "unknown-interface",
+            "missing-mac",
+            "duplicate-ip",
+            "gateway-outside-subnet",
+            "render-failed"
+          ]
         }
       }
     },
-    "host-create-params": {
+    "static-network-config-file": {
       "type": "object",
-      "required": [
-        "host_id"
-      ],
       "properties": {
-        "discovery_agent_version": {
+        "contents": {
           "type": "string"
         },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "path": {
+          "type": "string"
         }
       }
     },
-    "host-ignition-params": {
+    "static-network-config-host-validation": {
+      "type": "object",
       "properties": {
-        "config": {
-          "type": "string"
+        "errors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-error"
+          }
+        },
+        "files": {
+          "description": "The NetworkManager keyfiles and the MAC to interface mapping that the discovery image would contain for the host. Empty when the configuration of the host has errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-file"
+          }
+        },
+        "host_index": {
+          "description": "The index of the host in the static network configurations of the request.",
+          "type": "integer",
+          "x-omitempty": false
         }
       }
     },
-    "host-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/host"
+    "static-network-config-validate-params": {
+      "type": "object",
+      "required": [
+        "static_network_config"
+      ],
+      "properties": {
+        "static_network_config": {
+          "description": "The static network config of the hosts, every host being rendered with nmstatectl.",
+          "type": "array",
+          "maxItems": 500,
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
       }
     },
-    "host-progress": {
+    "static-network-config-validation": {
       "type": "object",
       "required": [
-        "current_stage"
+        "valid"
       ],
       "properties": {
-        "current_stage": {
-          "type": "string",
-          "$ref": "#/definitions/host-stage"
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-host-validation"
+          }
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "valid": {
+          "description": "Whether none of the static network configurations has errors.",
+          "type": "boolean"
         }
       }
     },
-    "host-progress-info": {
+    "status-history": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/status-history-entry"
+      }
+    },
+    "status-history-entry": {
       "type": "object",
       "required": [
-        "current_stage"
+        "cluster_id",
+        "status",
+        "trigger",
+        "created_at"
       ],
       "properties": {
-        "current_stage": {
-          "type": "string",
-          "$ref": "#/definitions/host-stage"
-        },
-        "progress_info": {
+        "cluster_id": {
+          "description": "Unique identifier of the cluster whose status changed, or of the cluster of the host.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
+        "created_at": {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
+        "failed_validations": {
+          "description": "The validations that were failing right after the change.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host whose status changed. Missing for changes of the cluster status.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "previous_status": {
+          "description": "The status before the change.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The status after the change.",
+          "type": "string"
+        },
+        "status_info": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "trigger": {
+          "description": "The state machine transition that changed the status, e.g. RefreshHost or InstallHost.",
+          "type": "string"
         }
       }
     },
-    "host-requirements": {
+    "step": {
       "type": "object",
       "properties": {
-        "master": {
-          "$ref": "#/definitions/host-requirements-role"
+        "args": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "worker": {
-          "$ref": "#/definitions/host-requirements-role"
+        "command": {
+          "type": "string"
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "host-requirements-role": {
+    "step-reply": {
       "type": "object",
       "properties": {
-        "cpu_cores": {
-          "type": "integer"
+        "error": {
+          "type": "string"
         },
-        "disk_size_gb": {
+        "exit_code": {
           "type": "integer"
         },
-        "installation_disk_speed_threshold_ms": {
-          "type": "integer"
+        "output": {
+          "type": "string"
         },
-        "ram_gib": {
-          "type": "integer"
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker",
-        "bootstrap"
-      ]
-    },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker"
-      ]
-    },
-    "host-stage": {
+    "step-type": {
       "type": "string",
       "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Start waiting for control plane",
-        "Installing",
-        "Writing image to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "reset-installation",
+        "dhcp-lease-allocate",
+        "api-vip-connectivity-check",
+        "ntp-synchronizer",
+        "fio-perf-check",
+        "installation-disk-speed-check",
+        "container-image-availability",
+        "domain-resolution"
       ]
     },
-    "host-type-hardware-requirements": {
+    "steps": {
       "type": "object",
       "properties": {
-        "qualitative": {
-          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+        "instructions": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/step"
           }
         },
-        "quantitative": {
-          "description": "Host requirements that can be quantified",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        }
-      }
-    },
-    "host-type-hardware-requirements-wrapper": {
-      "type": "object",
-      "properties": {
-        "master": {
-          "description": "Requirements towards a master node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "next_instruction_seconds": {
+          "type": "integer"
         },
-        "worker": {
-          "description": "Requirements towards a worker node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "post_step_action": {
+          "description": "What to do after finishing to run step instructions",
+          "type": "string",
+          "default": "continue",
+          "enum": [
+            "exit",
+            "continue"
+          ]
         }
       }
     },
-    "host-validation-id": {
-      "type": "string",
-      "enum": [
-        "connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr",
-        "api-vip-connected",
-        "belongs-to-majority-group",
-        "valid-platform",
-        "ntp-synced",
-        "container-images-available",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "sufficient-or-unknown-installation-disk-speed",
-        "cnv-requirements-satisfied"
-      ]
+    "steps-reply": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step-reply"
+      }
     },
-    "host_network": {
+    "system_vendor": {
       "type": "object",
       "properties": {
-        "cidr": {
+        "manufacturer": {
           "type": "string"
         },
-        "host_ids": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "uuid"
-          }
-        }
-      }
-    },
-    "host_registration_response": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/host"
+        "product_name": {
+          "type": "string"
         },
-        {
-          "properties": {
-            "next_step_runner_command": {
-              "description": "Command for starting the next step runner",
-              "type": "object",
-              "properties": {
-                "args": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "command": {
-                  "type": "string"
-                },
-                "retry_seconds": {
-                  "description": "How long in seconds to wait before retrying registration if the command fails",
-                  "type": "integer"
-                }
-              }
-            }
-          }
+        "serial_number": {
+          "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not",
+          "type": "boolean"
         }
-      ]
+      }
     },
-    "host_static_network_config": {
+    "timeline-cluster-status": {
       "type": "object",
       "properties": {
-        "mac_interface_map": {
-          "description": "mapping of host macs to logical interfaces used in the network yaml",
-          "$ref": "#/definitions/mac_interface_map"
+        "finished_at": {
+          "description": "Missing if the cluster is still in this status.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
         },
-        "network_yaml": {
-          "description": "yaml string that can be processed by nmstate",
+        "started_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "type": "string"
+        },
+        "status_info": {
           "type": "string"
         }
       }
     },
-    "image-create-params": {
+    "timeline-host": {
       "type": "object",
       "properties": {
-        "image_type": {
-          "description": "Type of image that should be generated.",
-          "$ref": "#/definitions/image_type"
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "hostname": {
           "type": "string"
         },
-        "static_network_config": {
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "stages": {
+          "description": "The installation stages of the host in the order they were reached.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host_static_network_config"
+            "$ref": "#/definitions/timeline-host-stage"
           }
         }
       }
     },
-    "image_info": {
+    "timeline-host-stage": {
       "type": "object",
       "properties": {
-        "created_at": {
+        "finished_at": {
+          "description": "Missing if the host is still in this stage.",
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-nullable": true
         },
-        "download_url": {
+        "progress_info": {
           "type": "string"
         },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "stage": {
+          "$ref": "#/definitions/host-stage"
         },
-        "generator_version": {
-          "description": "Image generator version.",
+        "started_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "timeline-operator-change": {
+      "type": "object",
+      "properties": {
+        "operator_name": {
           "type": "string"
         },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation",
+        "status_info": {
           "type": "string"
         },
-        "type": {
-          "$ref": "#/definitions/image_type"
+        "time": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "image_type": {
-      "type": "string",
-      "enum": [
-        "full-iso",
-        "minimal-iso"
-      ]
-    },
-    "infra_error": {
+    "timeline-validation-change": {
       "type": "object",
-      "required": [
-        "code",
-        "message"
-      ],
       "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
+        "host_id": {
+          "description": "Missing for cluster validations.",
+          "type": "string",
+          "format": "uuid"
         },
         "message": {
-          "description": "Human-readable description of the error.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "validation_id": {
           "type": "string"
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
-    },
-    "installer-args-params": {
+    "usage": {
       "type": "object",
       "properties": {
-        "args": {
-          "description": "List of additional arguments passed to coreos-installer",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "--append-karg",
-            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
-            "--save-partindex",
-            "1",
-            "-n"
-          ]
+        "data": {
+          "description": "additional properties of the feature",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "name": {
+          "description": "name of the feature to track",
+          "type": "string"
         }
       }
     },
-    "interface": {
+    "versioned-host-requirements": {
       "type": "object",
       "properties": {
-        "biosdevname": {
-          "type": "string"
+        "master": {
+          "description": "Master node requirements",
+          "x-go-name": "MasterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "client_id": {
+        "version": {
+          "description": "Version of the component for which requirements are defined",
           "type": "string"
         },
-        "flags": {
+        "worker": {
+          "description": "Worker node requirements",
+          "x-go-name": "WorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "versions": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "string"
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "id",
+        "cluster_id",
+        "url"
+      ],
+      "properties": {
+        "categories": {
+          "description": "Notify only about events with these categories. Only user events are sent to webhooks.",
           "type": "array",
           "items": {
             "type": "string"
-          }
+          },
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "has_carrier": {
-          "type": "boolean"
+        "cluster_id": {
+          "description": "The cluster that this webhook is registered for.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "ipv4_addresses": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "event_types": {
+          "description": "Notify only about these types of notifications. All types are notified if empty.",
           "type": "array",
           "items": {
-            "type": "string"
-          }
+            "$ref": "#/definitions/webhook-event-type"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "ipv6_addresses": {
+        "id": {
+          "description": "Unique identifier of the webhook.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "severities": {
+          "description": "Notify only about events with these severities. All severities are notified if empty.",
           "type": "array",
           "items": {
             "type": "string"
-          }
-        },
-        "mac_address": {
-          "type": "string"
-        },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "product": {
-          "type": "string"
-        },
-        "speed_mbps": {
-          "type": "integer"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "vendor": {
-          "type": "string"
+        "url": {
+          "description": "The URL to which the notifications are posted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "inventory": {
+    "webhook-create-params": {
       "type": "object",
+      "required": [
+        "url"
+      ],
       "properties": {
-        "bmc_address": {
-          "type": "string"
-        },
-        "bmc_v6address": {
-          "type": "string"
-        },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
+        "categories": {
+          "description": "Notify only about events with these categories. Only user events are sent to webhooks.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/disk"
+            "type": "string",
+            "enum": [
+              "user"
+            ]
           }
         },
-        "gpus": {
+        "event_types": {
+          "description": "Notify only about these types of notifications. All types are notified if empty.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/gpu"
+            "$ref": "#/definitions/webhook-event-type"
           }
         },
-        "hostname": {
-          "type": "string"
+        "secret": {
+          "description": "Shared secret used to sign the notifications payload with HMAC-SHA256. The signature is sent in the X-Assisted-Signature header.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
-        "interfaces": {
+        "severities": {
+          "description": "Notify only about events with these severities. All severities are notified if empty.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/interface"
+            "type": "string",
+            "enum": [
+              "info",
+              "warning",
+              "error",
+              "critical"
+            ]
           }
         },
-        "memory": {
-          "$ref": "#/definitions/memory"
-        },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
-        },
-        "timestamp": {
-          "type": "integer"
+        "url": {
+          "description": "The URL to which the notifications are posted. Loopback, link-local and private addresses are rejected unless the service allows their network.",
+          "type": "string",
+          "pattern": "^https?://.+$"
         }
       }
     },
-    "io_perf": {
+    "webhook-delivery": {
       "type": "object",
+      "required": [
+        "id",
+        "webhook_id",
+        "cluster_id",
+        "event_type",
+        "status"
+      ],
       "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
+        "attempts": {
+          "description": "Number of delivery attempts that were made.",
           "type": "integer"
-        }
-      }
-    },
-    "l2-connectivity": {
-      "type": "object",
-      "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
         },
-        "outgoing_nic": {
-          "type": "string"
+        "cluster_id": {
+          "description": "The cluster that the notification relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "remote_ip_address": {
-          "type": "string"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "remote_mac": {
-          "type": "string"
+        "event_type": {
+          "$ref": "#/definitions/webhook-event-type"
         },
-        "successful": {
-          "type": "boolean"
-        }
-      }
-    },
-    "l3-connectivity": {
-      "type": "object",
-      "properties": {
-        "average_rtt_ms": {
-          "description": "Average round trip time in milliseconds.",
-          "type": "number",
-          "format": "double",
-          "x-go-name": "AverageRTTMs"
+        "id": {
+          "description": "Unique identifier of the delivery.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "outgoing_nic": {
-          "type": "string"
+        "last_attempt_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "packet_loss_percentage": {
-          "description": "Percentage of packets lost during connectivity check.",
-          "type": "number",
-          "format": "double"
+        "last_error": {
+          "description": "Error of the last failed attempt.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "remote_ip_address": {
-          "type": "string"
+        "next_attempt_at": {
+          "description": "Time of the next delivery attempt of a pending notification.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "response_code": {
+          "description": "HTTP status code returned by the webhook on the last attempt.",
+          "type": "integer"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "successful": {
-          "type": "boolean"
+        "webhook_id": {
+          "description": "The webhook that the notification is delivered to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
-    "list-managed-domains": {
+    "webhook-delivery-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/managed-domain"
+        "$ref": "#/definitions/webhook-delivery"
       }
     },
-    "list-manifests": {
+    "webhook-event-type": {
+      "type": "string",
+      "enum": [
+        "event",
+        "host-status-changed",
+        "cluster-status-changed"
+      ]
+    },
+    "webhook-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/manifest"
-      }
-    },
-    "list-versions": {
-      "type": "object",
-      "properties": {
-        "release_tag": {
-          "type": "string"
-        },
-        "versions": {
-          "$ref": "#/definitions/versions"
-        }
+        "$ref": "#/definitions/webhook"
       }
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
     },
-    "logs-progress-params": {
-      "type": "object",
-      "required": [
-        "logs_state"
-      ],
-      "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
-        }
-      }
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
     },
-    "logs_state": {
-      "type": "string",
-      "enum": [
-        "requested",
-        "collecting",
-        "completed",
-        "timeout",
-        ""
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": [
+        "admin",
+        "user"
       ]
+    }
+  ],
+  "tags": [
+    {
+      "description": "Agent-driven installation",
+      "name": "Assisted installation"
     },
-    "logs_type": {
-      "type": "string",
-      "enum": [
-        "host",
-        "controller",
-        "all",
-        ""
-      ]
+    {
+      "description": "ISO that contains the Assisted Service.",
+      "name": "assisted-service-iso"
     },
-    "mac_interface_map": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "logical_nic_name": {
-            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
-            "type": "string"
+    {
+      "description": "Non-customized files used to boot hosts.",
+      "name": "bootfiles"
+    },
+    {
+      "description": "Events related to a cluster installation.",
+      "name": "events"
+    },
+    {
+      "description": "General OpenShift cluster installation APIs.",
+      "name": "installer"
+    },
+    {
+      "description": "Managed dns domains for a cluster installation.",
+      "name": "managed_domains"
+    },
+    {
+      "description": "Manifests for customizing a cluster installation.",
+      "name": "manifests"
+    },
+    {
+      "description": "Information regarding supported operators.",
+      "name": "operators"
+    },
+    {
+      "description": "Information regarding versions.",
+      "name": "versions"
+    },
+    {
+      "description": "Notifications about events and status changes of a cluster.",
+      "name": "webhooks"
+    },
+    {
+      "description": "Installation progress of a cluster and its hosts over time.",
+      "name": "timeline"
+    },
+    {
+      "description": "Status changes of a cluster and its hosts.",
+      "name": "history"
+    },
+    {
+      "description": "User-defined validations of the hosts of a cluster.",
+      "name": "validations"
+    },
+    {
+      "description": "Analysis of the logs uploaded from a cluster and its hosts.",
+      "name": "logs"
+    },
+    {
+      "description": "Reusable settings for creating clusters.",
+      "name": "cluster_templates"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install/v1",
+  "paths": {
+    "/add_hosts_clusters": {
+      "post": {
+        "description": "Creates a new OpenShift cluster definition for adding nodes to and existing OCP cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterAddHostsCluster",
+        "parameters": [
+          {
+            "description": "Parameters for creating a new cluster for adding nodes.",
+            "name": "new-add-hosts-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/add-hosts-cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
-          "mac_address": {
-            "description": "mac address present on the host",
-            "type": "string",
-            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/assisted-service-iso": {
+      "post": {
+        "description": "Creates ISO for the user and uploads to S3.",
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "CreateISOAndUploadToS3",
+        "parameters": [
+          {
+            "description": "Parameters for creating an Assisted Service ISO.",
+            "name": "assisted-service-iso-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/assisted-service-iso-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "managed-domain": {
-      "type": "object",
-      "properties": {
-        "domain": {
-          "type": "string"
-        },
-        "provider": {
-          "type": "string",
-          "enum": [
-            "route53"
-          ]
-        }
-      }
-    },
-    "manifest": {
-      "type": "object",
-      "properties": {
-        "file_name": {
-          "description": "The file name prefaced by the folder that contains it.",
-          "type": "string"
-        },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
-        }
-      }
-    },
-    "memory": {
-      "type": "object",
-      "properties": {
-        "physical_bytes": {
-          "type": "integer"
-        },
-        "usable_bytes": {
-          "type": "integer"
+    "/assisted-service-iso/data": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Downloads the Assisted Service ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "DownloadISO",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "monitored-operator": {
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this operator is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "namespace": {
-          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
-          "type": "string"
-        },
-        "operator_type": {
-          "$ref": "#/definitions/operator-type"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
-        },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
-          "type": "string"
-        },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+    "/assisted-service-iso/presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading assisted-service ISO.",
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "GetPresignedForAssistedServiceISO",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
-      }
-    },
-    "ntp_source": {
-      "type": "object",
-      "properties": {
-        "source_name": {
-          "description": "NTP source name or IP.",
-          "type": "string"
-        },
-        "source_state": {
-          "description": "Indication of state of an NTP source.",
-          "$ref": "#/definitions/source_state"
+    "/boot-files": {
+      "get": {
+        "security": [],
+        "description": "Downloads files used for booting servers.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "bootfiles"
+        ],
+        "operationId": "DownloadBootFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The corresponding OpenShift version for the boot file.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "initrd.img",
+              "rootfs.img",
+              "vmlinuz"
+            ],
+            "type": "string",
+            "description": "The file type to download.",
+            "name": "file_type",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "307": {
+            "description": "Redirect.",
+            "headers": {
+              "Location": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "ntp_synchronization_request": {
-      "type": "object",
-      "required": [
-        "ntp_source"
-      ],
-      "properties": {
-        "ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
+    "/cluster-templates": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of cluster templates.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "ListClusterTemplates",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "ntp_synchronization_response": {
-      "properties": {
-        "ntp_sources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ntp_source"
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Creates a cluster template that clusters can be registered from.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "CreateClusterTemplate",
+        "parameters": [
+          {
+            "description": "The settings of the new cluster template.",
+            "name": "new-cluster-template-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "openshift-version": {
-      "type": "object",
-      "required": [
-        "display_name",
-        "release_image",
-        "release_version",
-        "rhcos_image",
-        "rhcos_version",
-        "support_level"
-      ],
-      "properties": {
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "display_name": {
-          "description": "Name of the version to be presented to the user.",
-          "type": "string"
-        },
-        "release_image": {
-          "description": "The installation image of the OpenShift cluster.",
-          "type": "string"
-        },
-        "release_version": {
-          "description": "OCP version from the release metadata.",
-          "type": "string"
-        },
-        "rhcos_image": {
-          "description": "The base RHCOS image used for the discovery iso.",
-          "type": "string"
-        },
-        "rhcos_version": {
-          "description": "Build ID of the RHCOS image.",
-          "type": "string"
-        },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "custom"
-          ]
+    "/cluster-templates/{template_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of a cluster template.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "GetClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be retrieved.",
+            "name": "template_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Deletes a cluster template. Clusters that were registered from the template are not affected.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "DeleteClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be deleted.",
+            "name": "template_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "openshift-versions": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/openshift-version"
+    "/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
-    "operator-create-params": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+    "/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "operator-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "dependencies": {
-          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/import": {
+      "post": {
+        "description": "Creates a new OpenShift cluster definition from a bundle exported by this or another assisted-service instance. The host assignments of the bundle are applied as hosts with matching MAC addresses or serial numbers register to the new cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ImportCluster",
+        "parameters": [
+          {
+            "description": "The exported cluster bundle and the properties that are not part of it.",
+            "name": "import-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "operator_name": {
-          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "string"
-        },
-        "requirements": {
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         }
       }
     },
-    "operator-host-requirements": {
-      "type": "object",
-      "properties": {
-        "operator_name": {
-          "description": "Name of the operator",
-          "type": "string"
-        },
-        "requirements": {
-          "description": "Host requirements for the operator",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+    "/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "operator-monitor-report": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string"
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "operator-properties": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/operator-property"
-      }
-    },
-    "operator-property": {
-      "type": "object",
-      "properties": {
-        "data_type": {
-          "description": "Type of the property",
-          "type": "string",
-          "enum": [
-            "boolean",
-            "string",
-            "integer",
-            "float"
-          ]
-        },
-        "default_value": {
-          "description": "Default value for the property",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of a property",
-          "type": "string"
-        },
-        "mandatory": {
-          "description": "Indicates whether the property is reqired",
-          "type": "boolean"
-        },
-        "name": {
-          "description": "Name of the property",
-          "type": "string"
-        },
-        "options": {
-          "description": "Values to select from",
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "operator-status": {
-      "description": "Represents the operator state.",
-      "type": "string",
-      "enum": [
-        "failed",
-        "progressing",
-        "available"
-      ]
-    },
-    "operator-type": {
-      "description": "Kind of operator. Different types are monitored by the service differently.",
-      "type": "string",
-      "enum": [
-        "builtin",
-        "olm"
-      ]
-    },
-    "preflight-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "ocp": {
-          "description": "Preflight OCP requirements",
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        },
-        "operators": {
-          "description": "Preflight operators hardware requirements",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-hardware-requirements"
+    "/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
-        }
-      }
-    },
-    "presigned": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "url": {
-          "type": "string"
-        }
-      }
-    },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
-    },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "command": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+    "/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "reset-installation",
-        "dhcp-lease-allocate",
-        "api-vip-connectivity-check",
-        "ntp-synchronizer",
-        "fio-perf-check",
-        "installation-disk-speed-check",
-        "container-image-availability",
-        "domain-resolution"
-      ]
-    },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+    "/clusters/{cluster_id}/actions/dry-run": {
+      "post": {
+        "description": "Renders the install config, manifests and ignitions of the cluster without installing it, and returns them as a tar.gz bundle with their secrets redacted. The cluster and its hosts are not modified.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DryRunCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation assets should be rendered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
-        },
-        "post_step_action": {
-          "description": "What to do after finishing to run step instructions",
-          "type": "string",
-          "default": "continue",
-          "enum": [
-            "exit",
-            "continue"
-          ]
         }
       }
     },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
-        },
-        "virtual": {
-          "description": "Whether the machine appears to be a virtual machine or not",
-          "type": "boolean"
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "usage": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "description": "additional properties of the feature",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/clusters/{cluster_id}/actions/install_hosts": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The existing cluster whose hosts should be added.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "description": "name of the feature to track",
-          "type": "string"
         }
       }
     },
-    "versioned-host-requirements": {
-      "type": "object",
-      "properties": {
-        "master": {
-          "description": "Master node requirements",
-          "x-go-name": "MasterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "version": {
-          "description": "Version of the component for which requirements are defined",
-          "type": "string"
-        },
-        "worker": {
-          "description": "Worker node requirements",
-          "x-go-name": "WorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "urlAuth": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": [
-        "admin",
-        "user"
-      ]
-    }
-  ],
-  "tags": [
-    {
-      "description": "Agent-driven installation",
-      "name": "Assisted installation"
-    },
-    {
-      "description": "ISO that contains the Assisted Service.",
-      "name": "assisted-service-iso"
-    },
-    {
-      "description": "Non-customized files used to boot hosts.",
-      "name": "bootfiles"
-    },
-    {
-      "description": "Events related to a cluster installation.",
-      "name": "events"
-    },
-    {
-      "description": "General OpenShift cluster installation APIs.",
-      "name": "installer"
-    },
-    {
-      "description": "Managed dns domains for a cluster installation.",
-      "name": "managed_domains"
-    },
-    {
-      "description": "Manifests for customizing a cluster installation.",
-      "name": "manifests"
-    },
-    {
-      "description": "Information regarding supported operators.",
-      "name": "operators"
-    },
-    {
-      "description": "Information regarding versions.",
-      "name": "versions"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install/v1",
-  "paths": {
-    "/add_hosts_clusters": {
-      "post": {
-        "description": "Creates a new OpenShift cluster definition for adding nodes to and existing OCP cluster.",
+    "/clusters/{cluster_id}/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/custom-host-validations": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the custom validations that are evaluated for the hosts of the cluster, which are the service-wide defaults unless the cluster has its own.",
         "tags": [
-          "installer"
+          "validations"
         ],
-        "operationId": "RegisterAddHostsCluster",
+        "operationId": "ListCustomHostValidations",
         "parameters": [
           {
-            "description": "Parameters for creating a new cluster for adding nodes.",
-            "name": "new-add-hosts-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/add-hosts-cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose custom host validations are affected.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/custom-host-validation-list"
             }
           },
           "401": {
@@ -7699,6 +12457,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7706,29 +12476,45 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/assisted-service-iso": {
-      "post": {
-        "description": "Creates ISO for the user and uploads to S3.",
+      },
+      "put": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Replaces the custom validations that are evaluated for the hosts of the cluster instead of the service-wide defaults.",
         "tags": [
-          "assisted-service-iso"
+          "validations"
         ],
-        "operationId": "CreateISOAndUploadToS3",
+        "operationId": "UpdateCustomHostValidations",
         "parameters": [
           {
-            "description": "Parameters for creating an Assisted Service ISO.",
-            "name": "assisted-service-iso-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose custom host validations are affected.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "custom-host-validations",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/assisted-service-iso-create-params"
+              "$ref": "#/definitions/custom-host-validation-list"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/custom-host-validation-list"
+            }
           },
           "400": {
             "description": "Error.",
@@ -7748,6 +12534,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7755,34 +12553,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/assisted-service-iso/data": {
-      "get": {
+      },
+      "delete": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "Downloads the Assisted Service ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Removes the custom validations of the cluster, so that the service-wide defaults are evaluated for its hosts.",
         "tags": [
-          "assisted-service-iso"
+          "validations"
+        ],
+        "operationId": "ResetCustomHostValidations",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose custom host validations are affected.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "DownloadISO",
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string",
-              "format": "binary"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -7802,6 +12600,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7811,7 +12615,7 @@ func init() {
         }
       }
     },
-    "/assisted-service-iso/presigned": {
+    "/clusters/{cluster_id}/discovery-ignition": {
       "get": {
         "security": [
           {
@@ -7822,16 +12626,26 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading assisted-service ISO.",
+        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
         "tags": [
-          "assisted-service-iso"
+          "installer"
+        ],
+        "operationId": "GetDiscoveryIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "GetPresignedForAssistedServiceISO",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
           },
           "401": {
@@ -7852,6 +12666,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7859,53 +12679,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/boot-files": {
-      "get": {
-        "security": [],
-        "description": "Downloads files used for booting servers.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "patch": {
+        "description": "Override values in the discovery ignition config.",
         "tags": [
-          "bootfiles"
+          "installer"
         ],
-        "operationId": "DownloadBootFiles",
+        "operationId": "UpdateDiscoveryIgnition",
         "parameters": [
           {
             "type": "string",
-            "description": "The corresponding OpenShift version for the boot file.",
-            "name": "openshift_version",
-            "in": "query",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be updated.",
+            "name": "cluster_id",
+            "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "initrd.img",
-              "rootfs.img",
-              "vmlinuz"
-            ],
-            "type": "string",
-            "description": "The file type to download.",
-            "name": "file_type",
-            "in": "query",
-            "required": true
+            "description": "Overrides for the discovery ignition config.",
+            "name": "discovery-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/discovery-ignition-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "201": {
+            "description": "Success."
           },
-          "307": {
-            "description": "Redirect.",
-            "headers": {
-              "Location": {
-                "type": "string"
-              }
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -7920,6 +12727,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7929,7 +12748,7 @@ func init() {
         }
       }
     },
-    "/clusters": {
+    "/clusters/{cluster_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -7938,34 +12757,61 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "ListClusters",
+        "operationId": "DownloadClusterFiles",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "discovery.ign"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "type": "file"
             }
           },
           "401": {
@@ -7980,12 +12826,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7999,29 +12857,82 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+      }
+    },
+    "/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterCluster",
+        "operationId": "GetPresignedForClusterFiles",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/presigned"
             }
           },
           "400": {
@@ -8042,12 +12953,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8057,7 +12980,7 @@ func init() {
         }
       }
     },
-    "/clusters/default-config": {
+    "/clusters/{cluster_id}/downloads/image": {
       "get": {
         "security": [
           {
@@ -8066,18 +12989,41 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterDefaultConfig",
+        "operationId": "DownloadClusterISO",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -8092,6 +13038,21 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8099,57 +13060,45 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+      },
+      "post": {
+        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCluster",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster whose ISO should be generated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "description": "The parameters for the generated ISO.",
+            "name": "image-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/image-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -8174,39 +13123,63 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      "head": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterCluster",
+        "operationId": "DownloadClusterISOHeaders",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster whose ISO headers should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "headers": {
+              "Content-Length": {
+                "type": "integer",
+                "description": "Size of the ISO in bytes"
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -8227,10 +13200,7 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+            "description": "Method Not Allowed."
           },
           "409": {
             "description": "Error.",
@@ -8245,43 +13215,46 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      }
+    },
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the kubeconfig file for this cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateCluster",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster whose kubeconfig should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "string",
+              "format": "binary"
             }
           },
           "401": {
@@ -8323,28 +13296,110 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "CancelInstallation",
+        "operationId": "ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities. Events of all severities are returned if not specified.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only events that occurred at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only events that occurred before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return only events whose message contains this text (case insensitive).",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of events to return. All the matching events are returned if not specified.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "The number of matching events to skip before starting to return events.",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of events that match the filters, regardless of the limit and offset."
+              }
             }
           },
           "401": {
@@ -8371,12 +13426,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8386,48 +13435,70 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/complete_installation": {
-      "post": {
+    "/clusters/{cluster_id}/events/stream": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete.",
+        "description": "Streams the events of a cluster as Server-Sent Events. The stream starts with the events that were added after the last seen event, if specified, and then pushes new events as they are added.",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "CompleteInstallation",
+        "operationId": "StreamEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster to stream events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to stream events for.",
+            "name": "host_id",
+            "in": "query"
           },
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "The ID of the last event that the client received, sent by the browser when it reconnects to the stream.",
+            "name": "Last-Event-ID",
             "in": "header"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Stream only the events that were added after the event with this ID. Used when the Last-Event-ID header can't be set, the header takes precedence.",
+            "name": "after_event_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string"
             }
           },
           "401": {
@@ -8454,55 +13525,46 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/clusters/{cluster_id}/export": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Exports the definition of the cluster as a portable bundle that can be imported by this or another assisted-service instance. The pull secret of the cluster is not exported.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallCluster",
+        "operationId": "ExportCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster to be exported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster-export"
             }
           },
           "401": {
@@ -8529,12 +13591,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8544,34 +13600,60 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install_hosts": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the free address list for a network.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHosts",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The existing cluster whose hosts should be added.",
-            "name": "cluster_id",
-            "in": "path",
+            "format": "uuid",
+            "description": "The cluster to return free addresses for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+            "type": "string",
+            "description": "The cluster network to return free addresses for.",
+            "name": "network",
+            "in": "query",
             "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "description": "The maximum number of free addresses to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A prefix for the free addresses to return.",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "401": {
@@ -8598,12 +13680,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8613,28 +13689,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
+    "/clusters/{cluster_id}/history": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the status changes of a cluster in the order they occurred.",
         "tags": [
-          "installer"
+          "history"
         ],
-        "operationId": "ResetCluster",
+        "operationId": "ListClusterStatusHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster whose status history should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/status-history"
             }
           },
           "401": {
@@ -8661,12 +13746,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8676,7 +13755,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/credentials": {
+    "/clusters/{cluster_id}/host-requirements": {
       "get": {
         "security": [
           {
@@ -8687,16 +13766,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Get host requirements of a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCredentials",
+        "operationId": "GetClusterHostRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -8706,7 +13785,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/cluster-host-requirements-list"
             }
           },
           "401": {
@@ -8733,12 +13812,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8748,7 +13821,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/discovery-ignition": {
+    "/clusters/{cluster_id}/hosts": {
       "get": {
         "security": [
           {
@@ -8757,28 +13830,37 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+        "description": "Retrieves the list of OpenShift hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetDiscoveryIgnition",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "description": "The cluster whose hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is listing hosts.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -8793,12 +13875,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -8810,37 +13886,57 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
-      "patch": {
-        "description": "Override values in the discovery ignition config.",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateDiscoveryIgnition",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be updated.",
+            "description": "The cluster into which the host should be registered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Overrides for the discovery ignition config.",
-            "name": "discovery-ignition-params",
+            "description": "The description of the host being registered.",
+            "name": "new-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "$ref": "#/definitions/host-create-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the host.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host_registration_response"
+            }
           },
           "400": {
             "description": "Error.",
@@ -8872,16 +13968,28 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Cluster cannot accept new hosts due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files": {
+    "/clusters/{cluster_id}/hosts/{host_id}": {
       "get": {
         "security": [
           {
@@ -8890,61 +13998,36 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterFiles",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster of the host that should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "discovery.ign"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -8971,101 +14054,119 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeregisterHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster of the host that should be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs": {
+      "post": {
+        "description": "Requests the agent of the host to collect and upload its logs, whatever the status of the host is.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPresignedForClusterFiles",
+        "operationId": "CollectHostLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster of the host whose logs should be collected.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
+            "description": "The host whose logs should be collected.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "description": "The journal units and directories to include in the logs, in addition to the default ones.",
+            "name": "collect-logs-params",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/host-logs-collection-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -9092,12 +14193,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -9113,50 +14208,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/image": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
+        "description": "Enables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISO",
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be downloaded.",
+            "description": "The cluster of the host that is being enabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being enabled.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -9178,7 +14259,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "409": {
             "description": "Error.",
@@ -9194,44 +14278,37 @@ func init() {
           }
         }
       },
-      "post": {
-        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+      "delete": {
+        "description": "Disables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GenerateClusterISO",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be generated.",
+            "description": "The cluster of the host that is being disabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "image-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/image-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being disabled.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -9269,49 +14346,38 @@ func init() {
             }
           }
         }
-      },
-      "head": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISOHeaders",
+        "operationId": "InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO headers should be downloaded.",
+            "description": "The cluster of the host that is being installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
-            "headers": {
-              "Content-Length": {
-                "type": "integer",
-                "description": "Size of the ISO in bytes"
-              }
-            }
-          },
-          "400": {
-            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -9332,9 +14398,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed."
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -9350,44 +14413,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the kubeconfig file for this cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterKubeconfig",
+        "operationId": "ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose kubeconfig should be downloaded.",
+            "description": "The cluster of the host that is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -9408,12 +14463,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -9421,35 +14470,27 @@ func init() {
             }
           },
           "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/clusters/{cluster_id}/events": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        ],
-        "description": "Lists events for a cluster.",
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "ListEvents",
+        "summary": "Reset failed host validation.",
+        "operationId": "ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster of the host that its validation is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9457,25 +14498,30 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
+            "description": "The host that its validation is being reset.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -9496,8 +14542,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -9511,7 +14557,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/free_addresses": {
+    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -9520,51 +14566,42 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the free address list for a network.",
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetFreeAddresses",
+        "operationId": "DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return free addresses for.",
+            "description": "The cluster of the host whose ignition file should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "description": "The cluster network to return free addresses for.",
-            "name": "network",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
             "required": true
-          },
-          {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "description": "The maximum number of free addresses to return.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "A prefix for the free addresses to return.",
-            "name": "prefix",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "type": "file"
             }
           },
           "401": {
@@ -9591,16 +14628,28 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/host-requirements": {
+    "/clusters/{cluster_id}/hosts/{host_id}/history": {
       "get": {
         "security": [
           {
@@ -9611,26 +14660,34 @@ func init() {
             ]
           }
         ],
-        "description": "Get host requirements of a cluster.",
+        "description": "Retrieves the status changes of a host in the order they occurred.",
         "tags": [
-          "installer"
+          "history"
         ],
-        "operationId": "GetClusterHostRequirements",
+        "operationId": "ListHostStatusHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose status history should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose status history should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-host-requirements-list"
+              "$ref": "#/definitions/status-history"
             }
           },
           "401": {
@@ -9666,46 +14723,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the list of OpenShift hosts.",
+        "description": "Get the customized ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "ListHosts",
+        "operationId": "GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be listed.",
+            "description": "The cluster of the host whose customized ignition file should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is listing hosts.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "The host whose customized ignition file should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -9720,68 +14767,68 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Registers a new OpenShift host.",
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterHost",
+        "operationId": "UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster into which the host should be registered.",
+            "description": "The cluster of the host whose ignition file should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the host being registered.",
-            "name": "new-host-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/host-ignition-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the host.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host_registration_response"
-            }
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -9813,28 +14860,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new hosts due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition/diff": {
       "get": {
         "security": [
           {
@@ -9845,16 +14880,16 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Get the differences between the ignition config that the service generates for the host and the effective ignition config after the ignition config overrides of the host. Until the installation assets of the cluster are generated, the generated ignition config only includes the values that the service sets for the host.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHost",
+        "operationId": "GetHostIgnitionDiff",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be retrieved.",
+            "description": "The cluster of the host whose ignition config differences are being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9862,7 +14897,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be retrieved.",
+            "description": "The host whose ignition config differences are being retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -9872,7 +14907,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/config-diff"
             }
           },
           "401": {
@@ -9906,18 +14941,20 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterHost",
+        "operationId": "UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be deregistered.",
+            "description": "The cluster of the host whose installer arguments should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9925,15 +14962,27 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be deregistered.",
+            "description": "The host whose installer arguments should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
             "description": "Error.",
@@ -9965,6 +15014,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9974,18 +15029,23 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
-        "description": "Enables a host for inclusion in the cluster.",
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "EnableHost",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being enabled.",
+            "description": "The cluster of the host that is retrieving instructions.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9993,17 +15053,23 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being enabled.",
+            "description": "The host that is retrieving instructions.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -10030,31 +15096,42 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "delete": {
-        "description": "Disables a host for inclusion in the cluster.",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "DisableHost",
+        "operationId": "PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being disabled.",
+            "description": "The cluster of the host that is posting results.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -10062,17 +15139,28 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being disabled.",
+            "description": "The host that is posting results.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -10099,14 +15187,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10114,18 +15202,34 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Download host logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHost",
+        "operationId": "DownloadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being installed.",
+            "description": "The cluster of the host whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -10133,17 +15237,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being installed.",
+            "description": "The host whose logs should be downloaded.",
             "name": "host_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
             }
           },
           "401": {
@@ -10164,6 +15268,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -10177,20 +15287,27 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
+      },
       "post": {
-        "description": "reset a failed host for day2 cluster.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "ResetHost",
+        "operationId": "UploadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being reset.",
+            "description": "The cluster of the host whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -10198,18 +15315,28 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being reset.",
+            "description": "The host whose logs should be uploaded.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the logs.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10229,8 +15356,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "413": {
+            "description": "Uploading the logs would exceed the log storage quota.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10240,23 +15367,33 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
+    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "ResetHostValidation",
+        "operationId": "UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that its validation is being reset.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -10264,31 +15401,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "The host whose log progress is being updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10308,6 +15438,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -10319,37 +15455,39 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostIgnition",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be downloaded.",
+            "description": "The ID of the cluster to retrieve.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -10357,18 +15495,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
+            "description": "The ID of the host to retrieve.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10383,19 +15527,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10415,36 +15553,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+    "/clusters/{cluster_id}/install-config": {
       "get": {
-        "description": "Get the customized ignition file for this host",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostIgnition",
+        "operationId": "GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose customized ignition file should be retrieved.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose customized ignition file should be retrieved.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "type": "string"
             }
           },
           "401": {
@@ -10471,12 +15610,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10486,35 +15619,27 @@ func init() {
         }
       },
       "patch": {
-        "description": "Patch the ignition file for this host",
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostIgnition",
+        "operationId": "UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be updated.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
+            "description": "Install config overrides.",
+            "name": "install-config-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "type": "string"
             }
           }
         ],
@@ -10561,51 +15686,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/clusters/{cluster_id}/install-config/diff": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the differences between the install config that the service generates for the cluster and the effective install config after the install config overrides of the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallerArgs",
+        "operationId": "GetClusterInstallConfigDiff",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose installer arguments should be updated.",
+            "description": "The cluster whose install config differences are being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/config-diff"
             }
           },
           "401": {
@@ -10632,12 +15743,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10647,47 +15752,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+    "/clusters/{cluster_id}/installation-timeline": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Retrieves the installation of a cluster laid out over time, including the stages of every host, the status changes of the cluster, the validations that changed and the progress of the operators.",
         "tags": [
-          "installer"
+          "timeline"
         ],
-        "operationId": "GetNextSteps",
+        "operationId": "GetInstallationTimeline",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is retrieving instructions.",
+            "description": "The cluster whose installation timeline should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/installation-timeline"
             }
           },
           "401": {
@@ -10719,66 +15814,65 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/logs": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "PostStepReply",
+        "operationId": "DownloadClusterLogs",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is posting results.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
           },
           {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -10805,68 +15899,73 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Download host logs.",
-        "produces": [
-          "application/octet-stream"
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostLogs",
-        "deprecated": true,
+        "operationId": "UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be downloaded.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be downloaded.",
+            "description": "The host whose logs should be uploaded.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10886,46 +15985,48 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "413": {
+            "description": "Uploading the logs would exceed the log storage quota.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/logs/analysis": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Retrieves the known failures that were found in the uploaded logs of the cluster and its hosts.",
         "tags": [
-          "installer"
+          "logs"
         ],
-        "operationId": "UploadHostLogs",
-        "deprecated": true,
+        "operationId": "GetClusterLogsAnalysis",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be uploaded.",
+            "description": "The cluster whose logs analysis should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -10933,28 +16034,32 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
+            "description": "A specific host in the cluster whose logs analysis should be retrieved.",
             "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
+            "in": "query"
           },
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the logs.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "Only findings of these severities are retrieved.",
+            "name": "severities",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/log-findings"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -10974,14 +16079,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10989,7 +16094,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
+    "/clusters/{cluster_id}/logs_progress": {
       "put": {
         "security": [
           {
@@ -11000,7 +16105,7 @@ func init() {
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostLogsProgress",
+        "operationId": "UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
@@ -11010,14 +16115,6 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
           {
             "description": "Parameters for updating log progress.",
             "name": "logs-progress-params",
@@ -11077,54 +16174,34 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/clusters/{cluster_id}/manifests": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "UpdateHostInstallProgress",
+        "operationId": "ListClusterManifests",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to retrieve.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-manifests"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11150,52 +16227,61 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/install-config": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "GetClusterInstallConfig",
+        "operationId": "CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11222,6 +16308,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11230,41 +16322,44 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Override values in the install config.",
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "UpdateClusterInstallConfig",
+        "operationId": "DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -11289,6 +16384,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11298,54 +16399,47 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/logs": {
+    "/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Download cluster logs.",
+        "description": "Downloads cluster manifest.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "DownloadClusterLogs",
+        "operationId": "DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "host",
-              "controller",
-              "all"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
@@ -11392,60 +16486,43 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/monitored_operators": {
+      "get": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "UploadLogs",
+        "operationId": "ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
             "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11465,55 +16542,60 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/logs_progress": {
+      },
       "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "UpdateClusterLogsProgress",
+        "operationId": "ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The operators monitor report.",
+            "name": "report-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/operator-monitor-report"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11560,23 +16642,27 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests": {
+    "/clusters/{cluster_id}/network-topology": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Retrieves the network topology of the cluster as the connectivity checks of its hosts see it.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "ListClusterManifests",
+        "operationId": "GetClusterNetworkTopology",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster whose network topology is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -11586,7 +16672,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/network-topology"
             }
           },
           "401": {
@@ -11613,12 +16699,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11626,125 +16706,40 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "CreateClusterManifest",
+        "operationId": "GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster to return preflight requrements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
-          }
-        }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
-        "tags": [
-          "manifests"
-        ],
-        "operationId": "DeleteClusterManifest",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -11770,12 +16765,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11785,54 +16774,51 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests/files": {
-      "get": {
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DownloadClusterManifest",
+        "operationId": "UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
+          {
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
           },
           {
             "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11859,14 +16845,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11874,40 +16860,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/monitored_operators": {
+    "/clusters/{cluster_id}/webhooks": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Lists the webhooks that are registered for a cluster.",
         "tags": [
-          "operators",
-          "installer"
+          "webhooks"
         ],
-        "operationId": "ListOfClusterOperators",
+        "operationId": "ListWebhooks",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster to return webhooks for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/webhook-list"
             }
           },
           "401": {
@@ -11942,43 +16925,48 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "user"
+            ]
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Registers a webhook that is notified about the events and status changes of a cluster and its hosts.",
         "tags": [
-          "operators",
-          "installer"
+          "webhooks"
         ],
-        "operationId": "ReportMonitoredOperatorStatus",
+        "operationId": "RegisterWebhook",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster to register the webhook for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "description": "The webhook to register.",
+            "name": "new-webhook-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/webhook-create-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -12007,59 +16995,51 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/preflight-requirements": {
-      "get": {
+    "/clusters/{cluster_id}/webhooks/{webhook_id}": {
+      "delete": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Deregisters a webhook of a cluster.",
         "tags": [
-          "installer"
+          "webhooks"
         ],
-        "operationId": "GetPreflightRequirements",
+        "operationId": "DeregisterWebhook",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
+            "description": "The cluster of the webhook.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook to deregister.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12094,51 +17074,45 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/clusters/{cluster_id}/webhooks/{webhook_id}/deliveries": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Lists the delivery log of a webhook.",
         "tags": [
-          "installer"
+          "webhooks"
         ],
-        "operationId": "UploadClusterIngressCert",
+        "operationId": "ListWebhookDeliveries",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster of the webhook.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
-          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "The webhook to return deliveries for.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/webhook-delivery-list"
             }
           },
           "401": {
@@ -12170,12 +17144,6 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
@@ -12326,24 +17294,143 @@ func init() {
               "$ref": "#/definitions/error"
             }
           }
-        }
-      }
-    },
-    "/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
+        }
+      }
+    },
+    "/static-network-config/validate": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Validates static network configurations and renders the NetworkManager keyfiles that the discovery image would configure the hosts with.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ValidateStaticNetworkConfig",
+        "parameters": [
+          {
+            "description": "The static network configurations of the hosts, as they would be passed to the image creation.",
+            "name": "static-network-config-validate-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/static-network-config-validate-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/static-network-config-validation"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
         "tags": [
           "operators"
         ],
-        "operationId": "ListSupportedOperators",
+        "operationId": "ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/operator-properties"
             }
           },
           "401": {
@@ -12358,6 +17445,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12366,511 +17459,954 @@ func init() {
           }
         }
       }
+    }
+  },
+  "definitions": {
+    "ClusterUpdateParamsDisksSelectedConfigItems0": {
+      "type": "object",
+      "properties": {
+        "disks_config": {
+          "description": "The desired disks parameters (such as the disk's role).",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-config-params"
+          }
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "ClusterUpdateParamsHostsMachineConfigPoolNamesItems0": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "machine_config_pool_name": {
+          "type": "string"
+        }
+      }
+    },
+    "ClusterUpdateParamsHostsNamesItems0": {
+      "type": "object",
+      "properties": {
+        "hostname": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "ClusterUpdateParamsHostsRackLabelsItems0": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "rack_label": {
+          "type": "string"
+        }
+      }
+    },
+    "ClusterUpdateParamsHostsRolesItems0": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        }
+      }
+    },
+    "DiskInstallationEligibility": {
+      "type": "object",
+      "properties": {
+        "eligible": {
+          "description": "Whether the disk is eligible for installation or not.",
+          "type": "boolean"
+        },
+        "not_eligible_reasons": {
+          "description": "Reasons for why this disk is not eligible for installation.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "x-nullable": false
+    },
+    "DomainResolutionRequestDomainsItems0": {
+      "type": "object",
+      "required": [
+        "domain_name"
+      ],
+      "properties": {
+        "domain_name": {
+          "description": "The domain name that should be resolved",
+          "type": "string"
+        }
+      },
+      "x-go-name": "DomainResolutionRequestDomain"
+    },
+    "DomainResolutionResponseResolutionsItems0": {
+      "type": "object",
+      "required": [
+        "domain_name"
+      ],
+      "properties": {
+        "domain_name": {
+          "description": "The domain that was resolved",
+          "type": "string"
+        },
+        "ipv4_addresses": {
+          "description": "The IPv4 addresses of the domain, empty if none",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
+        },
+        "ipv6_addresses": {
+          "description": "The IPv6 addresses of the domain, empty if none",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv6"
+          }
+        }
+      },
+      "x-go-name": "DomainResolutionResponseDomain"
+    },
+    "HostRegistrationResponseAO1NextStepRunnerCommand": {
+      "description": "Command for starting the next step runner",
+      "type": "object",
+      "properties": {
+        "args": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "command": {
+          "type": "string"
+        },
+        "retry_seconds": {
+          "description": "How long in seconds to wait before retrying registration if the command fails",
+          "type": "integer"
+        }
+      }
+    },
+    "MacInterfaceMapItems0": {
+      "type": "object",
+      "properties": {
+        "logical_nic_name": {
+          "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+          "type": "string"
+        },
+        "mac_address": {
+          "description": "mac address present on the host",
+          "type": "string",
+          "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+        }
+      }
+    },
+    "add-hosts-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "api_vip_dnsname",
+        "openshift_version"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "api vip domain.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "type": "object",
+      "properties": {
+        "is_success": {
+          "description": "API VIP connectivity check result.",
+          "type": "boolean"
+        }
+      }
+    },
+    "assisted-service-iso-create-params": {
+      "type": "object",
+      "properties": {
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        }
+      }
     },
-    "/supported-operators/{operator_name}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Lists properties for an operator.",
-        "tags": [
-          "operators"
-        ],
-        "operationId": "ListOperatorProperties",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/operator-properties"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
         }
       }
-    }
-  },
-  "definitions": {
-    "ClusterUpdateParamsDisksSelectedConfigItems0": {
+    },
+    "cluster": {
       "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
       "properties": {
-        "disks_config": {
-          "description": "The desired disks parameters (such as the disk's role).",
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "JSON-formatted list of the cluster networks of a dual-stack cluster, the first one is the cluster_network_cidr and cluster_network_host_prefix of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "custom_host_validations": {
+          "description": "JSON-formatted list of the custom validations that are evaluated for the hosts of the cluster instead of the service-wide defaults.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "deleted_at": {
+          "description": "The time that the cluster was deleted.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "disk_selection_policy": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:disk_selection_\"",
+          "$ref": "#/definitions/disk-selection-policy"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the initial ignition config",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        },
+        "image_info": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
+          "$ref": "#/definitions/image_info"
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster"
+          ]
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
+          "$ref": "#/definitions/logs_state"
+        },
+        "logs_size_bytes": {
+          "description": "The total size in bytes of the logs uploaded from the cluster and its hosts.",
+          "type": "integer"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_networks": {
+          "description": "JSON-formatted list of the machine networks of a dual-stack cluster, the first one is the machine_network_cidr of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/disk-config-params"
-          }
+            "type": "object",
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
         },
-        "id": {
+        "mtu": {
+          "description": "The MTU of the NICs of the hosts in the machine network, e.g. 9000 for jumbo frames. The MTU of the cluster network is derived from it. When unset, the NICs of all the hosts must have the same MTU.",
+          "type": "integer"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
           "type": "string",
           "format": "uuid"
-        }
-      }
-    },
-    "ClusterUpdateParamsHostsMachineConfigPoolNamesItems0": {
-      "type": "object",
-      "properties": {
-        "id": {
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "role_assignment_policy": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:role_assignment_\"",
+          "$ref": "#/definitions/role-assignment-policy"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "format": "uuid"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
-        "machine_config_pool_name": {
+        "service_networks": {
+          "description": "JSON-formatted list of the service networks of a dual-stack cluster, the first one is the service_network_cidr of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string"
-        }
-      }
-    },
-    "ClusterUpdateParamsHostsNamesItems0": {
-      "type": "object",
-      "properties": {
-        "hostname": {
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
           "type": "string"
         },
-        "id": {
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
           "type": "string",
-          "format": "uuid"
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
         }
       }
     },
-    "ClusterUpdateParamsHostsRolesItems0": {
+    "cluster-create-params": {
       "type": "object",
+      "required": [
+        "name",
+        "pull_secret"
+      ],
       "properties": {
-        "id": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string",
-          "format": "uuid"
+          "x-nullable": true
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the cluster_network_cidr and cluster_network_host_prefix of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          },
+          "x-nullable": true
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "machine_networks": {
+          "description": "The machine networks of a dual-stack cluster, at most one per IP address family with the IPv4 one first. The first one is the machine_network_cidr of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine-network"
+          },
+          "x-nullable": true
+        },
+        "mtu": {
+          "description": "The MTU of the NICs of the hosts in the machine network, e.g. 9000 for jumbo frames. The MTU of the cluster network is derived from it. When unset, the NICs of all the hosts must have the same MTU.",
+          "type": "integer",
+          "maximum": 9216,
+          "minimum": 576,
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster. Required unless it is set by the cluster template.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role-update-params"
-        }
-      }
-    },
-    "DiskInstallationEligibility": {
-      "type": "object",
-      "properties": {
-        "eligible": {
-          "description": "Whether the disk is eligible for installation or not.",
-          "type": "boolean"
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
-        "not_eligible_reasons": {
-          "description": "Reasons for why this disk is not eligible for installation.",
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the service_network_cidr of the cluster.",
           "type": "array",
           "items": {
+            "$ref": "#/definitions/service-network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "template_id": {
+          "description": "A cluster template whose settings are used for the settings that are not specified. The Go templates in the settings of the template can refer to the name, base DNS domain and VIPs of the new cluster, and to the template variables.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "template_variables": {
+          "description": "Values that the Go templates of the cluster template can refer to as {{ .Variables.\u003ckey\u003e }}.",
+          "type": "object",
+          "additionalProperties": {
             "type": "string"
           }
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
         }
-      },
-      "x-nullable": false
-    },
-    "DomainResolutionRequestDomainsItems0": {
-      "type": "object",
-      "required": [
-        "domain_name"
-      ],
-      "properties": {
-        "domain_name": {
-          "description": "The domain name that should be resolved",
-          "type": "string"
-        }
-      },
-      "x-go-name": "DomainResolutionRequestDomain"
+      }
     },
-    "DomainResolutionResponseResolutionsItems0": {
+    "cluster-export": {
+      "description": "A portable definition of a cluster, as exported by an assisted-service instance.",
       "type": "object",
       "required": [
-        "domain_name"
+        "format_version",
+        "cluster"
       ],
       "properties": {
-        "domain_name": {
-          "description": "The domain that was resolved",
-          "type": "string"
+        "cluster": {
+          "$ref": "#/definitions/exported-cluster"
         },
-        "ipv4_addresses": {
-          "description": "The IPv4 addresses of the domain, empty if none",
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "ipv4"
-          }
+        "exported_at": {
+          "type": "string",
+          "format": "date-time"
         },
-        "ipv6_addresses": {
-          "description": "The IPv6 addresses of the domain, empty if none",
+        "format_version": {
+          "description": "The version of the bundle format.",
+          "type": "integer"
+        },
+        "host_assignments": {
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "ipv6"
+            "$ref": "#/definitions/host-assignment"
           }
-        }
-      },
-      "x-go-name": "DomainResolutionResponseDomain"
-    },
-    "HostRegistrationResponseAO1NextStepRunnerCommand": {
-      "description": "Command for starting the next step runner",
-      "type": "object",
-      "properties": {
-        "args": {
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/create-manifest-params"
           }
         },
-        "command": {
-          "type": "string"
-        },
-        "retry_seconds": {
-          "description": "How long in seconds to wait before retrying registration if the command fails",
-          "type": "integer"
-        }
-      }
-    },
-    "MacInterfaceMapItems0": {
-      "type": "object",
-      "properties": {
-        "logical_nic_name": {
-          "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
           "type": "string"
-        },
-        "mac_address": {
-          "description": "mac address present on the host",
-          "type": "string",
-          "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
         }
       }
     },
-    "add-hosts-cluster-create-params": {
+    "cluster-host-requirements": {
       "type": "object",
-      "required": [
-        "id",
-        "name",
-        "api_vip_dnsname",
-        "openshift_version"
-      ],
       "properties": {
-        "api_vip_dnsname": {
-          "description": "api vip domain.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
           "type": "string",
           "format": "uuid"
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        }
-      }
-    },
-    "api_vip_connectivity_request": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "url": {
-          "description": "URL address of the API.",
-          "type": "string"
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
         },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
-          "type": "boolean"
-        }
-      }
-    },
-    "api_vip_connectivity_response": {
-      "type": "object",
-      "properties": {
-        "is_success": {
-          "description": "API VIP connectivity check result.",
-          "type": "boolean"
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "assisted-service-iso-create-params": {
+    "cluster-host-requirements-details": {
       "type": "object",
       "properties": {
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
         }
       }
     },
-    "boot": {
-      "type": "object",
-      "properties": {
-        "current_boot_mode": {
-          "type": "string"
-        },
-        "pxe_interface": {
-          "type": "string"
-        }
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
       }
     },
-    "cluster": {
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-network": {
+      "description": "IP address block from which Pod IPs are allocated.",
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
-      ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "cidr": {
+          "description": "The CIDR of the network.",
           "type": "string",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+        "host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node.",
           "type": "integer",
           "maximum": 128,
           "minimum": 1
+        }
+      }
+    },
+    "cluster-template": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
         },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "controller_logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated.",
+          "type": "string"
         },
-        "controller_logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node.",
+          "type": "integer"
         },
         "created_at": {
-          "description": "The time that this cluster was created.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "deleted_at": {
-          "description": "The time that the cluster was deleted.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-nullable": true
-        },
-        "email_domain": {
-          "type": "string"
-        },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
+        "description": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
         "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
-          "default": "Full",
           "enum": [
             "Full",
             "None"
-          ]
-        },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/host"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
-        },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
+          ],
+          "x-nullable": true
         },
         "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.",
           "type": "string"
         },
         "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.",
+          "type": "string"
         },
         "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
           "type": "string",
           "enum": [
             "masters",
             "workers",
-            "all",
-            "none"
-          ]
+            "none",
+            "all"
+          ],
+          "x-nullable": true
         },
         "id": {
-          "description": "Unique identifier of the object.",
+          "description": "Unique identifier of the cluster template.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the initial ignition config",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
-        },
-        "image_info": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
-          "$ref": "#/definitions/image_info"
-        },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-        },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
-        },
         "install_config_overrides": {
           "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
-        },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
-          "type": "string",
-          "enum": [
-            "Cluster",
-            "AddHostsCluster"
-          ]
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
-          "$ref": "#/definitions/logs_state"
-        },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
+        "manifests": {
           "type": "array",
           "items": {
-            "type": "object",
-            "$ref": "#/definitions/monitored-operator"
+            "$ref": "#/definitions/create-manifest-params"
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+          "x-go-custom-tag": "gorm:\"-\""
         },
         "name": {
-          "description": "Name of the OpenShift cluster.",
           "type": "string"
         },
         "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string"
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
-        },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
           "type": "string",
-          "format": "uuid"
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "olm_operators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
         },
         "openshift_version": {
           "description": "Version of the OpenShift cluster.",
@@ -12879,167 +18415,100 @@ func init() {
         "org_id": {
           "type": "string"
         },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
-        },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
         "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "description": "The IP address pool to use for service IP addresses.",
+          "type": "string"
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
-        },
-        "status": {
-          "description": "Status of the OpenShift cluster.",
-          "type": "string",
-          "enum": [
-            "insufficient",
-            "ready",
-            "error",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "finalizing",
-            "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action"
-          ]
-        },
-        "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "updated_at": {
-          "description": "The last time that this cluster was updated.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
         "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
           "type": "boolean",
           "x-nullable": true
         },
         "user_name": {
-          "type": "string"
-        },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-go-custom-tag": "gorm:\"index\""
         },
         "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
           "type": "boolean",
           "x-nullable": true
         }
       }
     },
-    "cluster-create-params": {
+    "cluster-template-create-params": {
       "type": "object",
       "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
+        "name"
       ],
       "properties": {
         "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts. Can be a Go template.",
           "type": "string"
         },
         "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "description": "IP address block from which Pod IPs are allocated.",
           "type": "string",
-          "default": "10.128.0.0/14",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
         "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "description": "The subnet prefix length to assign to each individual node.",
           "type": "integer",
-          "default": 23,
           "maximum": 128,
           "minimum": 1
         },
+        "description": {
+          "type": "string"
+        },
         "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
-          "default": "Full",
           "enum": [
             "Full",
             "None"
-          ]
+          ],
+          "x-nullable": true
         },
         "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster. Can be a Go template.",
+          "type": "string"
         },
         "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster. Can be a Go template.",
+          "type": "string"
         },
         "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
           "type": "string",
-          "default": "all",
           "enum": [
             "masters",
             "workers",
             "none",
             "all"
-          ]
+          ],
+          "x-nullable": true
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file. Can be a Go template.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "The custom manifests of the clusters. Their contents can be Go templates.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
         },
         "name": {
-          "description": "Name of the OpenShift cluster.",
           "type": "string",
-          "maxLength": 54,
           "minLength": 1
         },
         "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying. Can be a Go template.",
           "type": "string"
         },
         "olm_operators": {
-          "description": "List of OLM operators to be installed.",
+          "description": "The OLM operators of the clusters. Their properties can be Go templates.",
           "type": "array",
           "items": {
             "$ref": "#/definitions/operator-create-params"
@@ -13049,14 +18518,9 @@ func init() {
           "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
-        },
         "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "description": "The IP address pool to use for service IP addresses.",
           "type": "string",
-          "default": "172.30.0.0/16",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
         "ssh_public_key": {
@@ -13064,75 +18528,19 @@ func init() {
           "type": "string"
         },
         "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
           "type": "boolean",
-          "default": false,
           "x-nullable": true
         },
         "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
           "type": "boolean",
-          "default": true,
           "x-nullable": true
         }
       }
     },
-    "cluster-host-requirements": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "operators": {
-          "description": "Host requirements related to requested operators",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-host-requirements"
-          }
-        },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        }
-      }
-    },
-    "cluster-host-requirements-details": {
-      "type": "object",
-      "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
-        },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
-        },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
-        },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
-        }
-      }
-    },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
-      }
-    },
-    "cluster-list": {
+    "cluster-template-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/cluster"
+        "$ref": "#/definitions/cluster-template"
       }
     },
     "cluster-update-params": {
@@ -13172,6 +18580,17 @@ func init() {
           "minimum": 1,
           "x-nullable": true
         },
+        "cluster_networks": {
+          "description": "The cluster networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the cluster_network_cidr and cluster_network_host_prefix of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-network"
+          },
+          "x-nullable": true
+        },
+        "disk_selection_policy": {
+          "$ref": "#/definitions/disk-selection-policy"
+        },
         "disks_selected_config": {
           "type": "array",
           "items": {
@@ -13195,6 +18614,14 @@ func init() {
           },
           "x-nullable": true
         },
+        "hosts_rack_labels": {
+          "description": "The racks of the hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusterUpdateParamsHostsRackLabelsItems0"
+          },
+          "x-nullable": true
+        },
         "hosts_roles": {
           "description": "The desired role for hosts associated with the cluster.",
           "type": "array",
@@ -13236,6 +18663,21 @@ func init() {
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
           "x-nullable": true
         },
+        "machine_networks": {
+          "description": "The machine networks of a dual-stack cluster, at most one per IP address family with the IPv4 one first. The first one is the machine_network_cidr of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine-network"
+          },
+          "x-nullable": true
+        },
+        "mtu": {
+          "description": "The MTU of the NICs of the hosts in the machine network, e.g. 9000 for jumbo frames. The MTU of the cluster network is derived from it. When unset, the NICs of all the hosts must have the same MTU. Set to 0 to unset it.",
+          "type": "integer",
+          "maximum": 9216,
+          "minimum": 0,
+          "x-nullable": true
+        },
         "name": {
           "description": "OpenShift cluster name.",
           "type": "string",
@@ -13260,12 +18702,23 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "role_assignment_policy": {
+          "$ref": "#/definitions/role-assignment-policy"
+        },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
           "x-nullable": true
         },
+        "service_networks": {
+          "description": "The service networks of a dual-stack cluster, one per IP address family with the IPv4 one first. The first one is the service_network_cidr of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service-network"
+          },
+          "x-nullable": true
+        },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string",
@@ -13303,7 +18756,9 @@ func init() {
         "ntp-server-configured",
         "lso-requirements-satisfied",
         "ocs-requirements-satisfied",
-        "cnv-requirements-satisfied"
+        "cnv-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "install-config-overrides-safe"
       ]
     },
     "cluster_default_config": {
@@ -13342,6 +18797,53 @@ func init() {
         }
       }
     },
+    "config-diff": {
+      "description": "The differences between a configuration that the service generates and the effective configuration after the user overrides.",
+      "type": "object",
+      "properties": {
+        "operations": {
+          "description": "JSON patch (RFC 6902) operations that turn the generated configuration into the effective one.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/config-diff-operation"
+          }
+        },
+        "warnings": {
+          "description": "Values set by the service that the overrides replace or remove.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "config-diff-operation": {
+      "type": "object",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "base_value": {
+          "description": "The value in the generated configuration, for remove and replace operations. Sensitive values, e.g. the pull secret, are hidden."
+        },
+        "op": {
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace"
+          ]
+        },
+        "path": {
+          "description": "JSON pointer (RFC 6901) to the value that the operation changes.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value in the effective configuration, for add and replace operations. Sensitive values, e.g. the pull secret, are hidden."
+        }
+      }
+    },
     "connectivity-check-host": {
       "type": "object",
       "properties": {
@@ -13369,6 +18871,10 @@ func init() {
         "mac": {
           "type": "string"
         },
+        "mtu": {
+          "description": "Size in bytes of the do-not-fragment packets that the agent sends to the IP addresses of the NIC to check that the path supports the MTU. The MTU is not probed when unset.",
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         }
@@ -13543,6 +19049,39 @@ func init() {
         }
       }
     },
+    "custom-host-validation": {
+      "type": "object",
+      "required": [
+        "id",
+        "expression"
+      ],
+      "properties": {
+        "blocking": {
+          "description": "Whether a failure of the validation keeps the host from becoming ready for installation. Defaults to true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "description": {
+          "description": "Reported as the message of the validation when it fails.",
+          "type": "string"
+        },
+        "expression": {
+          "description": "CEL expression that must evaluate to true for the validation to succeed. The inventory of the host is available as the inventory variable, e.g. inventory.interfaces.exists(i, i.vendor == \"0x8086\").",
+          "type": "string"
+        },
+        "id": {
+          "description": "Identifier of the validation in the host validations_info, under the custom category.",
+          "type": "string",
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        }
+      }
+    },
+    "custom-host-validation-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/custom-host-validation"
+      }
+    },
     "dhcp_allocation_request": {
       "type": "object",
       "required": [
@@ -13698,12 +19237,54 @@ func init() {
       }
     },
     "disk-role": {
+      "description": "The usage of a disk. A var-lib-containers or var-lib-etcd disk is a secondary disk of the host that is formatted and mounted on /var/lib/containers or /var/lib/etcd of the node, var-lib-etcd being allowed on masters only.",
       "type": "string",
       "enum": [
         "none",
-        "install"
+        "install",
+        "var-lib-containers",
+        "var-lib-etcd"
       ]
     },
+    "disk-selection-policy": {
+      "description": "How the service picks the installation disk of a host among its eligible disks when the inventory of the host arrives. The rules are preferences, applied in the order in which they are listed here, so a host always gets an installation disk if it has an eligible one. An installation disk that was already picked for the host is kept when its inventory is updated. When the policy changes, the installation disks of the hosts are picked again, except the ones that the user picked.",
+      "type": "object",
+      "properties": {
+        "avoid_partitioned_disks": {
+          "description": "Prefer the disks on which the agent found no bootable partition, e.g. of a previous installation. The agent only reports whether a disk is bootable, so disks that only have other partitions, e.g. data partitions, are not avoided.",
+          "type": "boolean"
+        },
+        "match_by": {
+          "description": "The attribute of the disks that the match pattern is applied to.",
+          "type": "string",
+          "default": "none",
+          "enum": [
+            "none",
+            "by-path",
+            "wwn",
+            "model",
+            "serial"
+          ]
+        },
+        "match_pattern": {
+          "description": "Regular expression. Prefer the disks whose attribute selected by match_by matches it.",
+          "type": "string",
+          "example": "^pci-0000:00:1f.2-ata-1"
+        },
+        "prefer_fastest": {
+          "description": "Prefer the disks with the shortest sync duration, as measured by the agent.",
+          "type": "boolean"
+        },
+        "prefer_smallest": {
+          "description": "Prefer the smallest disks. Eligible disks are all larger than the minimum disk size.",
+          "type": "boolean"
+        },
+        "prefer_ssd": {
+          "description": "Prefer SSD and NVMe disks over HDD disks.",
+          "type": "boolean"
+        }
+      }
+    },
     "disk_info": {
       "type": "object",
       "properties": {
@@ -13836,58 +19417,157 @@ func init() {
         "category": {
           "type": "string",
           "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
+        },
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        },
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "exported-cluster": {
+      "description": "The configuration of an exported cluster, without its hosts, state and pull secret.",
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "type": "string"
+        },
+        "api_vip": {
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "base_dns_domain": {
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "high_availability_mode": {
+          "type": "string",
+          "enum": [
+            "Full",
+            "None"
+          ]
         },
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
+        "http_proxy": {
+          "type": "string"
         },
-        "event_time": {
+        "https_proxy": {
+          "type": "string"
+        },
+        "hyperthreading": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
         },
-        "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+        "id": {
+          "description": "Unique identifier of the exported cluster.",
           "type": "string",
           "format": "uuid"
         },
-        "message": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        "ignition_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
+        "ingress_vip": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string"
+        },
+        "machine_network_cidr": {
           "type": "string",
-          "format": "uuid"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
-        "severity": {
+        "monitored_operators": {
+          "$ref": "#/definitions/monitored-operators-list"
+        },
+        "name": {
+          "type": "string"
+        },
+        "no_proxy": {
+          "type": "string"
+        },
+        "openshift_version": {
+          "type": "string"
+        },
+        "service_network_cidr": {
           "type": "string",
-          "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "ssh_public_key": {
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "type": "boolean",
+          "x-nullable": true
         }
       }
     },
-    "event-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/event"
-      }
-    },
     "fio_perf_check_request": {
       "type": "object",
       "required": [
@@ -14037,6 +19717,11 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "flapping_validations": {
+          "description": "JSON-formatted list of the validation ids whose status changed repeatedly within a short period, which usually indicates an unstable network rather than a persistent failure.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "free_addresses": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
@@ -14062,6 +19747,10 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "installation_disk_chosen_by_user": {
+          "description": "Whether the user picked the installation disk of the host, in which case the disk selection policy of the cluster doesn't replace it.",
+          "type": "boolean"
+        },
         "installation_disk_id": {
           "description": "Contains the inventory disk id to install on.",
           "type": "string"
@@ -14095,11 +19784,20 @@ func init() {
           "format": "datetime",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "logs_collection_request": {
+          "description": "JSON-formatted on-demand log collection that was requested for the host and was not sent to its agent yet.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "logs_info": {
           "description": "The progress of log collection or empty if logs are not applicable",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
           "$ref": "#/definitions/logs_state"
         },
+        "logs_size_bytes": {
+          "description": "The size in bytes of the logs uploaded from the host.",
+          "type": "integer"
+        },
         "logs_started_at": {
           "type": "string",
           "format": "datetime",
@@ -14124,12 +19822,21 @@ func init() {
           },
           "x-go-custom-tag": "gorm:\"-\""
         },
+        "rack_label": {
+          "description": "The rack of the host, as labeled by the user, which the role assignment policy may spread the masters across.",
+          "type": "string"
+        },
         "requested_hostname": {
           "type": "string"
         },
         "role": {
           "$ref": "#/definitions/host-role"
         },
+        "secondary_disks": {
+          "description": "The disks of the host, other than the installation disk, that are formatted and mounted on a directory of the node by their role, formatted as a JSON list of disk-config-params.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "stage_started_at": {
           "description": "Time at which the current progress stage started.",
           "type": "string",
@@ -14174,6 +19881,13 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "suggested_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "suggested_role_reason": {
+          "description": "The role that the service picks for the host when its role is automatically assigned, and why. It is previewed until the installation starts.",
+          "type": "string"
+        },
         "updated_at": {
           "type": "string",
           "format": "date-time",
@@ -14189,6 +19903,32 @@ func init() {
         }
       }
     },
+    "host-assignment": {
+      "description": "Settings of a host that are applied to the host whose MAC address or serial number matches.",
+      "type": "object",
+      "properties": {
+        "installation_disk_id": {
+          "description": "Contains the ID of the disk to install on.",
+          "type": "string"
+        },
+        "mac_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          }
+        },
+        "requested_hostname": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        },
+        "serial_number": {
+          "type": "string"
+        }
+      }
+    },
     "host-create-params": {
       "type": "object",
       "required": [
@@ -14217,6 +19957,27 @@ func init() {
         "$ref": "#/definitions/host"
       }
     },
+    "host-logs-collection-params": {
+      "type": "object",
+      "properties": {
+        "directories": {
+          "description": "Absolute paths of directories on the host to collect. The paths may not contain whitespaces, ':' or ','.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^/[^\\s:,]*$"
+          }
+        },
+        "journal_units": {
+          "description": "The systemd units whose journal should be collected.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^[a-zA-Z0-9:_.@-]+$"
+          }
+        }
+      }
+    },
     "host-progress": {
       "type": "object",
       "required": [
@@ -14373,7 +20134,11 @@ func init() {
         "lso-requirements-satisfied",
         "ocs-requirements-satisfied",
         "sufficient-or-unknown-installation-disk-speed",
-        "cnv-requirements-satisfied"
+        "cnv-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "secondary-disks-valid",
+        "mtu-consistent",
+        "ignition-config-overrides-safe"
       ]
     },
     "host_network": {
@@ -14497,6 +20262,26 @@ func init() {
         "minimal-iso"
       ]
     },
+    "import-cluster-params": {
+      "type": "object",
+      "required": [
+        "pull_secret",
+        "bundle"
+      ],
+      "properties": {
+        "bundle": {
+          "$ref": "#/definitions/cluster-export"
+        },
+        "name": {
+          "description": "Name of the new cluster. Defaults to the name of the exported cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        }
+      }
+    },
     "infra_error": {
       "type": "object",
       "required": [
@@ -14520,6 +20305,45 @@ func init() {
     "ingress-cert-params": {
       "type": "string"
     },
+    "installation-timeline": {
+      "type": "object",
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "cluster_statuses": {
+          "description": "The statuses of the cluster in the order they were entered.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeline-cluster-status"
+          }
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeline-host"
+          }
+        },
+        "operators": {
+          "description": "The status reports of the monitored operators that changed status, in the order they were reported.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeline-operator-change"
+          }
+        },
+        "validations": {
+          "description": "The cluster and host validations that changed status, in the order they changed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeline-validation-change"
+          }
+        }
+      }
+    },
     "installer-args-params": {
       "type": "object",
       "properties": {
@@ -14572,6 +20396,14 @@ func init() {
         "mac_address": {
           "type": "string"
         },
+        "members": {
+          "description": "The names of the interfaces that a bond or a bridge aggregates, or the name of the interface that a VLAN is based on.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "mtu": {
           "type": "integer"
         },
@@ -14584,8 +20416,22 @@ func init() {
         "speed_mbps": {
           "type": "integer"
         },
+        "type": {
+          "description": "The kind of the interface. Interfaces without a type are physical NICs.",
+          "type": "string",
+          "enum": [
+            "physical",
+            "bond",
+            "vlan",
+            "bridge"
+          ]
+        },
         "vendor": {
           "type": "string"
+        },
+        "vlan_id": {
+          "description": "The VLAN ID of a VLAN interface.",
+          "type": "integer"
         }
       }
     },
@@ -14674,6 +20520,11 @@ func init() {
           "format": "double",
           "x-go-name": "AverageRTTMs"
         },
+        "mtu_probe_successful": {
+          "description": "Whether the do-not-fragment packets of the probed MTU reached the remote IP address. Unset when the MTU was not probed.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "outgoing_nic": {
           "type": "string"
         },
@@ -14713,6 +20564,88 @@ func init() {
         }
       }
     },
+    "log-finding": {
+      "type": "object",
+      "required": [
+        "id",
+        "cluster_id",
+        "logs_type",
+        "rule_id",
+        "severity",
+        "title",
+        "created_at"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "Unique identifier of the cluster whose logs were analyzed.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "excerpt": {
+          "description": "The first line that matched the rule.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "file": {
+          "description": "Path in the logs archive of the first file that matched the rule.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host that uploaded the logs.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "matches": {
+          "description": "Number of lines in the logs that matched the rule.",
+          "type": "integer"
+        },
+        "remediation": {
+          "description": "What is usually done to fix the failure.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "rule_id": {
+          "description": "Identifier of the rule of a known failure that matched the logs, e.g. etcd-quorum-lost.",
+          "type": "string"
+        },
+        "severity": {
+          "$ref": "#/definitions/log-finding-severity"
+        },
+        "title": {
+          "description": "Short description of the known failure.",
+          "type": "string"
+        }
+      }
+    },
+    "log-finding-severity": {
+      "type": "string",
+      "enum": [
+        "info",
+        "warning",
+        "critical"
+      ]
+    },
+    "log-findings": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/log-finding"
+      }
+    },
     "logs-progress-params": {
       "type": "object",
       "required": [
@@ -14750,6 +20683,17 @@ func init() {
         "$ref": "#/definitions/MacInterfaceMapItems0"
       }
     },
+    "machine-network": {
+      "description": "A network that every host of the cluster has an address in.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The CIDR of the network.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        }
+      }
+    },
     "managed-domain": {
       "type": "object",
       "properties": {
@@ -14759,7 +20703,9 @@ func init() {
         "provider": {
           "type": "string",
           "enum": [
-            "route53"
+            "route53",
+            "rfc2136",
+            "powerdns"
           ]
         }
       }
@@ -14847,6 +20793,146 @@ func init() {
         "$ref": "#/definitions/monitored-operator"
       }
     },
+    "network-topology": {
+      "type": "object",
+      "properties": {
+        "dot": {
+          "description": "The topology as a Graphviz DOT graph.",
+          "type": "string"
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-host"
+          }
+        },
+        "links": {
+          "description": "The connectivity of every host to every address of the other hosts, as its last connectivity check reported it.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-link"
+          }
+        },
+        "subnets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-subnet"
+          }
+        }
+      }
+    },
+    "network-topology-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-nic"
+          }
+        }
+      }
+    },
+    "network-topology-link": {
+      "type": "object",
+      "properties": {
+        "average_rtt_ms": {
+          "type": "number",
+          "format": "double"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_successful": {
+          "description": "Whether the remote IP address replied to ARP or NDP. Unset when L2 connectivity wasn't checked.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "l3_successful": {
+          "description": "Whether the remote IP address replied to ping. Unset when L3 connectivity wasn't checked.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "packet_loss_percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "remote_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
+          "type": "string"
+        },
+        "subnet": {
+          "description": "The subnet of the remote IP address, if it's a subnet of a host of the cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "network-topology-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac_address": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "subnets": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "network-topology-subnet": {
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "type": "string"
+        },
+        "groups": {
+          "description": "The groups of at least 3 hosts in the subnet with full mesh L2 connectivity, the largest first.",
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        },
+        "host_ids": {
+          "description": "The hosts with an address in the subnet.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
     "ntp_source": {
       "type": "object",
       "properties": {
@@ -15083,17 +21169,214 @@ func init() {
         }
       }
     },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
-    },
+    "role-assignment-policy": {
+      "description": "How the service picks the masters among the hosts whose role is automatically assigned. The rules are combined, hosts that are excluded by a rule are never picked as masters.",
+      "type": "object",
+      "properties": {
+        "exclude_virtual_masters": {
+          "description": "Never pick virtual hosts as masters.",
+          "type": "boolean"
+        },
+        "master_hostname_pattern": {
+          "description": "Regular expression that the hostname of a host must match for it to be picked as a master.",
+          "type": "string",
+          "example": "^cp-"
+        },
+        "prefer_most_resources": {
+          "description": "Pick the hosts with the most memory, and then the most CPU cores, as masters.",
+          "type": "boolean"
+        },
+        "serial_number_prefix_length": {
+          "description": "Length of the serial number prefix that identifies the group of a host when spreading by serial number prefix.",
+          "type": "integer",
+          "minimum": 1
+        },
+        "spread_by": {
+          "description": "Pick masters whose serial numbers have distinct prefixes, or whose rack labels are distinct, before picking masters that share a prefix or a rack.",
+          "type": "string",
+          "default": "none",
+          "enum": [
+            "none",
+            "serial-number-prefix",
+            "rack-label"
+          ]
+        }
+      }
+    },
+    "service-network": {
+      "description": "IP address block from which Service IPs are allocated.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The CIDR of the network.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        }
+      }
+    },
+    "source_state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
+      ]
+    },
+    "static-network-config-error": {
+      "type": "object",
+      "properties": {
+        "interface": {
+          "description": "The interface that the error is about, if any.",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "invalid-yaml",
+            "unknown-interface",
+            "missing-mac",
+            "duplicate-ip",
+            "gateway-outside-subnet",
+            "render-failed"
+          ]
+        }
+      }
+    },
+    "static-network-config-file": {
+      "type": "object",
+      "properties": {
+        "contents": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "static-network-config-host-validation": {
+      "type": "object",
+      "properties": {
+        "errors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-error"
+          }
+        },
+        "files": {
+          "description": "The NetworkManager keyfiles and the MAC to interface mapping that the discovery image would contain for the host. Empty when the configuration of the host has errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-file"
+          }
+        },
+        "host_index": {
+          "description": "The index of the host in the static network configurations of the request.",
+          "type": "integer",
+          "x-omitempty": false
+        }
+      }
+    },
+    "static-network-config-validate-params": {
+      "type": "object",
+      "required": [
+        "static_network_config"
+      ],
+      "properties": {
+        "static_network_config": {
+          "description": "The static network config of the hosts, every host being rendered with nmstatectl.",
+          "type": "array",
+          "maxItems": 500,
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "static-network-config-validation": {
+      "type": "object",
+      "required": [
+        "valid"
+      ],
+      "properties": {
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-host-validation"
+          }
+        },
+        "valid": {
+          "description": "Whether none of the static network configurations has errors.",
+          "type": "boolean"
+        }
+      }
+    },
+    "status-history": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/status-history-entry"
+      }
+    },
+    "status-history-entry": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "status",
+        "trigger",
+        "created_at"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "Unique identifier of the cluster whose status changed, or of the cluster of the host.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "failed_validations": {
+          "description": "The validations that were failing right after the change.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host whose status changed. Missing for changes of the cluster status.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "previous_status": {
+          "description": "The status before the change.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The status after the change.",
+          "type": "string"
+        },
+        "status_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "trigger": {
+          "description": "The state machine transition that changed the status, e.g. RefreshHost or InstallHost.",
+          "type": "string"
+        }
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -15199,6 +21482,111 @@ func init() {
         }
       }
     },
+    "timeline-cluster-status": {
+      "type": "object",
+      "properties": {
+        "finished_at": {
+          "description": "Missing if the cluster is still in this status.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "type": "string"
+        },
+        "status_info": {
+          "type": "string"
+        }
+      }
+    },
+    "timeline-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "stages": {
+          "description": "The installation stages of the host in the order they were reached.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeline-host-stage"
+          }
+        }
+      }
+    },
+    "timeline-host-stage": {
+      "type": "object",
+      "properties": {
+        "finished_at": {
+          "description": "Missing if the host is still in this stage.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "progress_info": {
+          "type": "string"
+        },
+        "stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "timeline-operator-change": {
+      "type": "object",
+      "properties": {
+        "operator_name": {
+          "type": "string"
+        },
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "timeline-validation-change": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Missing for cluster validations.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "message": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "validation_id": {
+          "type": "string"
+        }
+      }
+    },
     "usage": {
       "type": "object",
       "properties": {
@@ -15239,6 +21627,201 @@ func init() {
       "additionalProperties": {
         "type": "string"
       }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "id",
+        "cluster_id",
+        "url"
+      ],
+      "properties": {
+        "categories": {
+          "description": "Notify only about events with these categories. Only user events are sent to webhooks.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this webhook is registered for.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "event_types": {
+          "description": "Notify only about these types of notifications. All types are notified if empty.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook-event-type"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "id": {
+          "description": "Unique identifier of the webhook.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "severities": {
+          "description": "Notify only about events with these severities. All severities are notified if empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "url": {
+          "description": "The URL to which the notifications are posted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "webhook-create-params": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "categories": {
+          "description": "Notify only about events with these categories. Only user events are sent to webhooks.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "user"
+            ]
+          }
+        },
+        "event_types": {
+          "description": "Notify only about these types of notifications. All types are notified if empty.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook-event-type"
+          }
+        },
+        "secret": {
+          "description": "Shared secret used to sign the notifications payload with HMAC-SHA256. The signature is sent in the X-Assisted-Signature header.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
+        },
+        "severities": {
+          "description": "Notify only about events with these severities. All severities are notified if empty.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "info",
+              "warning",
+              "error",
+              "critical"
+            ]
+          }
+        },
+        "url": {
+          "description": "The URL to which the notifications are posted. Loopback, link-local and private addresses are rejected unless the service allows their network.",
+          "type": "string",
+          "pattern": "^https?://.+$"
+        }
+      }
+    },
+    "webhook-delivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "webhook_id",
+        "cluster_id",
+        "event_type",
+        "status"
+      ],
+      "properties": {
+        "attempts": {
+          "description": "Number of delivery attempts that were made.",
+          "type": "integer"
+        },
+        "cluster_id": {
+          "description": "The cluster that the notification relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "event_type": {
+          "$ref": "#/definitions/webhook-event-type"
+        },
+        "id": {
+          "description": "Unique identifier of the delivery.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "last_attempt_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "last_error": {
+          "description": "Error of the last failed attempt.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "next_attempt_at": {
+          "description": "Time of the next delivery attempt of a pending notification.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "response_code": {
+          "description": "HTTP status code returned by the webhook on the last attempt.",
+          "type": "integer"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "webhook_id": {
+          "description": "The webhook that the notification is delivered to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "webhook-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook-delivery"
+      }
+    },
+    "webhook-event-type": {
+      "type": "string",
+      "enum": [
+        "event",
+        "host-status-changed",
+        "cluster-status-changed"
+      ]
+    },
+    "webhook-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook"
+      }
     }
   },
   "securityDefinitions": {
@@ -15302,6 +21885,30 @@ func init() {
     {
       "description": "Information regarding versions.",
       "name": "versions"
+    },
+    {
+      "description": "Notifications about events and status changes of a cluster.",
+      "name": "webhooks"
+    },
+    {
+      "description": "Installation progress of a cluster and its hosts over time.",
+      "name": "timeline"
+    },
+    {
+      "description": "Status changes of a cluster and its hosts.",
+      "name": "history"
+    },
+    {
+      "description": "User-defined validations of the hosts of a cluster.",
+      "name": "validations"
+    },
+    {
+      "description": "Analysis of the logs uploaded from a cluster and its hosts.",
+      "name": "logs"
+    },
+    {
+      "description": "Reusable settings for creating clusters.",
+      "name": "cluster_templates"
     }
   ]
 }`))
@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -21,12 +22,18 @@ import (
 
 	"github.com/openshift/assisted-service/restapi/operations/assisted_service_iso"
 	"github.com/openshift/assisted-service/restapi/operations/bootfiles"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/openshift/assisted-service/restapi/operations/history"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/openshift/assisted-service/restapi/operations/logs"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/timeline"
+	"github.com/openshift/assisted-service/restapi/operations/validations"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 // NewAssistedInstallAPI creates a new AssistedInstall instance
@@ -52,28 +59,43 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
 		InstallerCancelInstallationHandler: installer.CancelInstallationHandlerFunc(func(params installer.CancelInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.CancelInstallation has not yet been implemented")
 		}),
+		InstallerCollectHostLogsHandler: installer.CollectHostLogsHandlerFunc(func(params installer.CollectHostLogsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.CollectHostLogs has not yet been implemented")
+		}),
 		InstallerCompleteInstallationHandler: installer.CompleteInstallationHandlerFunc(func(params installer.CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.CompleteInstallation has not yet been implemented")
 		}),
 		ManifestsCreateClusterManifestHandler: manifests.CreateClusterManifestHandlerFunc(func(params manifests.CreateClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.CreateClusterManifest has not yet been implemented")
 		}),
+		ClusterTemplatesCreateClusterTemplateHandler: cluster_templates.CreateClusterTemplateHandlerFunc(func(params cluster_templates.CreateClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.CreateClusterTemplate has not yet been implemented")
+		}),
 		AssistedServiceIsoCreateISOAndUploadToS3Handler: assisted_service_iso.CreateISOAndUploadToS3HandlerFunc(func(params assisted_service_iso.CreateISOAndUploadToS3Params, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation assisted_service_iso.CreateISOAndUploadToS3 has not yet been implemented")
 		}),
 		ManifestsDeleteClusterManifestHandler: manifests.DeleteClusterManifestHandlerFunc(func(params manifests.DeleteClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.DeleteClusterManifest has not yet been implemented")
 		}),
+		ClusterTemplatesDeleteClusterTemplateHandler: cluster_templates.DeleteClusterTemplateHandlerFunc(func(params cluster_templates.DeleteClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.DeleteClusterTemplate has not yet been implemented")
+		}),
 		InstallerDeregisterClusterHandler: installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterCluster has not yet been implemented")
 		}),
 		InstallerDeregisterHostHandler: installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterHost has not yet been implemented")
 		}),
+		WebhooksDeregisterWebhookHandler: webhooks.DeregisterWebhookHandlerFunc(func(params webhooks.DeregisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.DeregisterWebhook has not yet been implemented")
+		}),
 		InstallerDisableHostHandler: installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DisableHost has not yet been implemented")
 		}),
@@ -107,9 +129,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		AssistedServiceIsoDownloadISOHandler: assisted_service_iso.DownloadISOHandlerFunc(func(params assisted_service_iso.DownloadISOParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation assisted_service_iso.DownloadISO has not yet been implemented")
 		}),
+		InstallerDryRunClusterHandler: installer.DryRunClusterHandlerFunc(func(params installer.DryRunClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DryRunCluster has not yet been implemented")
+		}),
 		InstallerEnableHostHandler: installer.EnableHostHandlerFunc(func(params installer.EnableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.EnableHost has not yet been implemented")
 		}),
+		InstallerExportClusterHandler: installer.ExportClusterHandlerFunc(func(params installer.ExportClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ExportCluster has not yet been implemented")
+		}),
 		InstallerGenerateClusterISOHandler: installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GenerateClusterISO has not yet been implemented")
 		}),
@@ -125,6 +153,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetClusterInstallConfigHandler: installer.GetClusterInstallConfigHandlerFunc(func(params installer.GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerGetClusterInstallConfigDiffHandler: installer.GetClusterInstallConfigDiffHandlerFunc(func(params installer.GetClusterInstallConfigDiffParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterInstallConfigDiff has not yet been implemented")
+		}),
+		LogsGetClusterLogsAnalysisHandler: logs.GetClusterLogsAnalysisHandlerFunc(func(params logs.GetClusterLogsAnalysisParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation logs.GetClusterLogsAnalysis has not yet been implemented")
+		}),
+		InstallerGetClusterNetworkTopologyHandler: installer.GetClusterNetworkTopologyHandlerFunc(func(params installer.GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterNetworkTopology has not yet been implemented")
+		}),
+		ClusterTemplatesGetClusterTemplateHandler: cluster_templates.GetClusterTemplateHandlerFunc(func(params cluster_templates.GetClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.GetClusterTemplate has not yet been implemented")
+		}),
 		InstallerGetCredentialsHandler: installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCredentials has not yet been implemented")
 		}),
@@ -140,9 +180,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetHostIgnitionHandler: installer.GetHostIgnitionHandlerFunc(func(params installer.GetHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHostIgnition has not yet been implemented")
 		}),
+		InstallerGetHostIgnitionDiffHandler: installer.GetHostIgnitionDiffHandlerFunc(func(params installer.GetHostIgnitionDiffParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetHostIgnitionDiff has not yet been implemented")
+		}),
 		InstallerGetHostRequirementsHandler: installer.GetHostRequirementsHandlerFunc(func(params installer.GetHostRequirementsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHostRequirements has not yet been implemented")
 		}),
+		TimelineGetInstallationTimelineHandler: timeline.GetInstallationTimelineHandlerFunc(func(params timeline.GetInstallationTimelineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation timeline.GetInstallationTimeline has not yet been implemented")
+		}),
 		InstallerGetNextStepsHandler: installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetNextSteps has not yet been implemented")
 		}),
@@ -155,6 +201,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetPresignedForClusterFilesHandler: installer.GetPresignedForClusterFilesHandlerFunc(func(params installer.GetPresignedForClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetPresignedForClusterFiles has not yet been implemented")
 		}),
+		InstallerImportClusterHandler: installer.ImportClusterHandlerFunc(func(params installer.ImportClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ImportCluster has not yet been implemented")
+		}),
 		InstallerInstallClusterHandler: installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.InstallCluster has not yet been implemented")
 		}),
@@ -167,15 +216,27 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		ManifestsListClusterManifestsHandler: manifests.ListClusterManifestsHandlerFunc(func(params manifests.ListClusterManifestsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.ListClusterManifests has not yet been implemented")
 		}),
+		HistoryListClusterStatusHistoryHandler: history.ListClusterStatusHistoryHandlerFunc(func(params history.ListClusterStatusHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation history.ListClusterStatusHistory has not yet been implemented")
+		}),
+		ClusterTemplatesListClusterTemplatesHandler: cluster_templates.ListClusterTemplatesHandlerFunc(func(params cluster_templates.ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.ListClusterTemplates has not yet been implemented")
+		}),
 		InstallerListClustersHandler: installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListClusters has not yet been implemented")
 		}),
 		VersionsListComponentVersionsHandler: versions.ListComponentVersionsHandlerFunc(func(params versions.ListComponentVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.ListComponentVersions has not yet been implemented")
 		}),
+		ValidationsListCustomHostValidationsHandler: validations.ListCustomHostValidationsHandlerFunc(func(params validations.ListCustomHostValidationsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation validations.ListCustomHostValidations has not yet been implemented")
+		}),
 		EventsListEventsHandler: events.ListEventsHandlerFunc(func(params events.ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.ListEvents has not yet been implemented")
 		}),
+		HistoryListHostStatusHistoryHandler: history.ListHostStatusHistoryHandlerFunc(func(params history.ListHostStatusHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation history.ListHostStatusHistory has not yet been implemented")
+		}),
 		InstallerListHostsHandler: installer.ListHostsHandlerFunc(func(params installer.ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListHosts has not yet been implemented")
 		}),
@@ -194,6 +255,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		OperatorsListSupportedOperatorsHandler: operators.ListSupportedOperatorsHandlerFunc(func(params operators.ListSupportedOperatorsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.ListSupportedOperators has not yet been implemented")
 		}),
+		WebhooksListWebhookDeliveriesHandler: webhooks.ListWebhookDeliveriesHandlerFunc(func(params webhooks.ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ListWebhookDeliveries has not yet been implemented")
+		}),
+		WebhooksListWebhooksHandler: webhooks.ListWebhooksHandlerFunc(func(params webhooks.ListWebhooksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ListWebhooks has not yet been implemented")
+		}),
 		InstallerPostStepReplyHandler: installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.PostStepReply has not yet been implemented")
 		}),
@@ -206,18 +273,27 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerRegisterHostHandler: installer.RegisterHostHandlerFunc(func(params installer.RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterHost has not yet been implemented")
 		}),
+		WebhooksRegisterWebhookHandler: webhooks.RegisterWebhookHandlerFunc(func(params webhooks.RegisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.RegisterWebhook has not yet been implemented")
+		}),
 		OperatorsReportMonitoredOperatorStatusHandler: operators.ReportMonitoredOperatorStatusHandlerFunc(func(params operators.ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.ReportMonitoredOperatorStatus has not yet been implemented")
 		}),
 		InstallerResetClusterHandler: installer.ResetClusterHandlerFunc(func(params installer.ResetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ResetCluster has not yet been implemented")
 		}),
+		ValidationsResetCustomHostValidationsHandler: validations.ResetCustomHostValidationsHandlerFunc(func(params validations.ResetCustomHostValidationsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation validations.ResetCustomHostValidations has not yet been implemented")
+		}),
 		InstallerResetHostHandler: installer.ResetHostHandlerFunc(func(params installer.ResetHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ResetHost has not yet been implemented")
 		}),
 		InstallerResetHostValidationHandler: installer.ResetHostValidationHandlerFunc(func(params installer.ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ResetHostValidation has not yet been implemented")
 		}),
+		EventsStreamEventsHandler: events.StreamEventsHandlerFunc(func(params events.StreamEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.StreamEvents has not yet been implemented")
+		}),
 		InstallerUpdateClusterHandler: installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateCluster has not yet been implemented")
 		}),
@@ -227,6 +303,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerUpdateClusterLogsProgressHandler: installer.UpdateClusterLogsProgressHandlerFunc(func(params installer.UpdateClusterLogsProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateClusterLogsProgress has not yet been implemented")
 		}),
+		ValidationsUpdateCustomHostValidationsHandler: validations.UpdateCustomHostValidationsHandlerFunc(func(params validations.UpdateCustomHostValidationsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation validations.UpdateCustomHostValidations has not yet been implemented")
+		}),
 		InstallerUpdateDiscoveryIgnitionHandler: installer.UpdateDiscoveryIgnitionHandlerFunc(func(params installer.UpdateDiscoveryIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateDiscoveryIgnition has not yet been implemented")
 		}),
@@ -251,6 +330,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerUploadLogsHandler: installer.UploadLogsHandlerFunc(func(params installer.UploadLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UploadLogs has not yet been implemented")
 		}),
+		InstallerValidateStaticNetworkConfigHandler: installer.ValidateStaticNetworkConfigHandlerFunc(func(params installer.ValidateStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ValidateStaticNetworkConfig has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -305,6 +387,9 @@ type AssistedInstallAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
 	// AgentAuthAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key X-Secret-Key provided in the header
@@ -323,18 +408,26 @@ type AssistedInstallAPI struct {
 
 	// InstallerCancelInstallationHandler sets the operation handler for the cancel installation operation
 	InstallerCancelInstallationHandler installer.CancelInstallationHandler
+	// InstallerCollectHostLogsHandler sets the operation handler for the collect host logs operation
+	InstallerCollectHostLogsHandler installer.CollectHostLogsHandler
 	// InstallerCompleteInstallationHandler sets the operation handler for the complete installation operation
 	InstallerCompleteInstallationHandler installer.CompleteInstallationHandler
 	// ManifestsCreateClusterManifestHandler sets the operation handler for the create cluster manifest operation
 	ManifestsCreateClusterManifestHandler manifests.CreateClusterManifestHandler
+	// ClusterTemplatesCreateClusterTemplateHandler sets the operation handler for the create cluster template operation
+	ClusterTemplatesCreateClusterTemplateHandler cluster_templates.CreateClusterTemplateHandler
 	// AssistedServiceIsoCreateISOAndUploadToS3Handler sets the operation handler for the create i s o and upload to s3 operation
 	AssistedServiceIsoCreateISOAndUploadToS3Handler assisted_service_iso.CreateISOAndUploadToS3Handler
 	// ManifestsDeleteClusterManifestHandler sets the operation handler for the delete cluster manifest operation
 	ManifestsDeleteClusterManifestHandler manifests.DeleteClusterManifestHandler
+	// ClusterTemplatesDeleteClusterTemplateHandler sets the operation handler for the delete cluster template operation
+	ClusterTemplatesDeleteClusterTemplateHandler cluster_templates.DeleteClusterTemplateHandler
 	// InstallerDeregisterClusterHandler sets the operation handler for the deregister cluster operation
 	InstallerDeregisterClusterHandler installer.DeregisterClusterHandler
 	// InstallerDeregisterHostHandler sets the operation handler for the deregister host operation
 	InstallerDeregisterHostHandler installer.DeregisterHostHandler
+	// WebhooksDeregisterWebhookHandler sets the operation handler for the deregister webhook operation
+	WebhooksDeregisterWebhookHandler webhooks.DeregisterWebhookHandler
 	// InstallerDisableHostHandler sets the operation handler for the disable host operation
 	InstallerDisableHostHandler installer.DisableHostHandler
 	// BootfilesDownloadBootFilesHandler sets the operation handler for the download boot files operation
@@ -357,8 +450,12 @@ type AssistedInstallAPI struct {
 	InstallerDownloadHostLogsHandler installer.DownloadHostLogsHandler
 	// AssistedServiceIsoDownloadISOHandler sets the operation handler for the download i s o operation
 	AssistedServiceIsoDownloadISOHandler assisted_service_iso.DownloadISOHandler
+	// InstallerDryRunClusterHandler sets the operation handler for the dry run cluster operation
+	InstallerDryRunClusterHandler installer.DryRunClusterHandler
 	// InstallerEnableHostHandler sets the operation handler for the enable host operation
 	InstallerEnableHostHandler installer.EnableHostHandler
+	// InstallerExportClusterHandler sets the operation handler for the export cluster operation
+	InstallerExportClusterHandler installer.ExportClusterHandler
 	// InstallerGenerateClusterISOHandler sets the operation handler for the generate cluster i s o operation
 	InstallerGenerateClusterISOHandler installer.GenerateClusterISOHandler
 	// InstallerGetClusterHandler sets the operation handler for the get cluster operation
@@ -369,6 +466,14 @@ type AssistedInstallAPI struct {
 	InstallerGetClusterHostRequirementsHandler installer.GetClusterHostRequirementsHandler
 	// InstallerGetClusterInstallConfigHandler sets the operation handler for the get cluster install config operation
 	InstallerGetClusterInstallConfigHandler installer.GetClusterInstallConfigHandler
+	// InstallerGetClusterInstallConfigDiffHandler sets the operation handler for the get cluster install config diff operation
+	InstallerGetClusterInstallConfigDiffHandler installer.GetClusterInstallConfigDiffHandler
+	// LogsGetClusterLogsAnalysisHandler sets the operation handler for the get cluster logs analysis operation
+	LogsGetClusterLogsAnalysisHandler logs.GetClusterLogsAnalysisHandler
+	// InstallerGetClusterNetworkTopologyHandler sets the operation handler for the get cluster network topology operation
+	InstallerGetClusterNetworkTopologyHandler installer.GetClusterNetworkTopologyHandler
+	// ClusterTemplatesGetClusterTemplateHandler sets the operation handler for the get cluster template operation
+	ClusterTemplatesGetClusterTemplateHandler cluster_templates.GetClusterTemplateHandler
 	// InstallerGetCredentialsHandler sets the operation handler for the get credentials operation
 	InstallerGetCredentialsHandler installer.GetCredentialsHandler
 	// InstallerGetDiscoveryIgnitionHandler sets the operation handler for the get discovery ignition operation
@@ -379,8 +484,12 @@ type AssistedInstallAPI struct {
 	InstallerGetHostHandler installer.GetHostHandler
 	// InstallerGetHostIgnitionHandler sets the operation handler for the get host ignition operation
 	InstallerGetHostIgnitionHandler installer.GetHostIgnitionHandler
+	// InstallerGetHostIgnitionDiffHandler sets the operation handler for the get host ignition diff operation
+	InstallerGetHostIgnitionDiffHandler installer.GetHostIgnitionDiffHandler
 	// InstallerGetHostRequirementsHandler sets the operation handler for the get host requirements operation
 	InstallerGetHostRequirementsHandler installer.GetHostRequirementsHandler
+	// TimelineGetInstallationTimelineHandler sets the operation handler for the get installation timeline operation
+	TimelineGetInstallationTimelineHandler timeline.GetInstallationTimelineHandler
 	// InstallerGetNextStepsHandler sets the operation handler for the get next steps operation
 	InstallerGetNextStepsHandler installer.GetNextStepsHandler
 	// InstallerGetPreflightRequirementsHandler sets the operation handler for the get preflight requirements operation
@@ -389,6 +498,8 @@ type AssistedInstallAPI struct {
 	AssistedServiceIsoGetPresignedForAssistedServiceISOHandler assisted_service_iso.GetPresignedForAssistedServiceISOHandler
 	// InstallerGetPresignedForClusterFilesHandler sets the operation handler for the get presigned for cluster files operation
 	InstallerGetPresignedForClusterFilesHandler installer.GetPresignedForClusterFilesHandler
+	// InstallerImportClusterHandler sets the operation handler for the import cluster operation
+	InstallerImportClusterHandler installer.ImportClusterHandler
 	// InstallerInstallClusterHandler sets the operation handler for the install cluster operation
 	InstallerInstallClusterHandler installer.InstallClusterHandler
 	// InstallerInstallHostHandler sets the operation handler for the install host operation
@@ -397,12 +508,20 @@ type AssistedInstallAPI struct {
 	InstallerInstallHostsHandler installer.InstallHostsHandler
 	// ManifestsListClusterManifestsHandler sets the operation handler for the list cluster manifests operation
 	ManifestsListClusterManifestsHandler manifests.ListClusterManifestsHandler
+	// HistoryListClusterStatusHistoryHandler sets the operation handler for the list cluster status history operation
+	HistoryListClusterStatusHistoryHandler history.ListClusterStatusHistoryHandler
+	// ClusterTemplatesListClusterTemplatesHandler sets the operation handler for the list cluster templates operation
+	ClusterTemplatesListClusterTemplatesHandler cluster_templates.ListClusterTemplatesHandler
 	// InstallerListClustersHandler sets the operation handler for the list clusters operation
 	InstallerListClustersHandler installer.ListClustersHandler
 	// VersionsListComponentVersionsHandler sets the operation handler for the list component versions operation
 	VersionsListComponentVersionsHandler versions.ListComponentVersionsHandler
+	// ValidationsListCustomHostValidationsHandler sets the operation handler for the list custom host validations operation
+	ValidationsListCustomHostValidationsHandler validations.ListCustomHostValidationsHandler
 	// EventsListEventsHandler sets the operation handler for the list events operation
 	EventsListEventsHandler events.ListEventsHandler
+	// HistoryListHostStatusHistoryHandler sets the operation handler for the list host status history operation
+	HistoryListHostStatusHistoryHandler history.ListHostStatusHistoryHandler
 	// InstallerListHostsHandler sets the operation handler for the list hosts operation
 	InstallerListHostsHandler installer.ListHostsHandler
 	// ManagedDomainsListManagedDomainsHandler sets the operation handler for the list managed domains operation
@@ -415,6 +534,10 @@ type AssistedInstallAPI struct {
 	VersionsListSupportedOpenshiftVersionsHandler versions.ListSupportedOpenshiftVersionsHandler
 	// OperatorsListSupportedOperatorsHandler sets the operation handler for the list supported operators operation
 	OperatorsListSupportedOperatorsHandler operators.ListSupportedOperatorsHandler
+	// WebhooksListWebhookDeliveriesHandler sets the operation handler for the list webhook deliveries operation
+	WebhooksListWebhookDeliveriesHandler webhooks.ListWebhookDeliveriesHandler
+	// WebhooksListWebhooksHandler sets the operation handler for the list webhooks operation
+	WebhooksListWebhooksHandler webhooks.ListWebhooksHandler
 	// InstallerPostStepReplyHandler sets the operation handler for the post step reply operation
 	InstallerPostStepReplyHandler installer.PostStepReplyHandler
 	// InstallerRegisterAddHostsClusterHandler sets the operation handler for the register add hosts cluster operation
@@ -423,20 +546,28 @@ type AssistedInstallAPI struct {
 	InstallerRegisterClusterHandler installer.RegisterClusterHandler
 	// InstallerRegisterHostHandler sets the operation handler for the register host operation
 	InstallerRegisterHostHandler installer.RegisterHostHandler
+	// WebhooksRegisterWebhookHandler sets the operation handler for the register webhook operation
+	WebhooksRegisterWebhookHandler webhooks.RegisterWebhookHandler
 	// OperatorsReportMonitoredOperatorStatusHandler sets the operation handler for the report monitored operator status operation
 	OperatorsReportMonitoredOperatorStatusHandler operators.ReportMonitoredOperatorStatusHandler
 	// InstallerResetClusterHandler sets the operation handler for the reset cluster operation
 	InstallerResetClusterHandler installer.ResetClusterHandler
+	// ValidationsResetCustomHostValidationsHandler sets the operation handler for the reset custom host validations operation
+	ValidationsResetCustomHostValidationsHandler validations.ResetCustomHostValidationsHandler
 	// InstallerResetHostHandler sets the operation handler for the reset host operation
 	InstallerResetHostHandler installer.ResetHostHandler
 	// InstallerResetHostValidationHandler sets the operation handler for the reset host validation operation
 	InstallerResetHostValidationHandler installer.ResetHostValidationHandler
+	// EventsStreamEventsHandler sets the operation handler for the stream events operation
+	EventsStreamEventsHandler events.StreamEventsHandler
 	// InstallerUpdateClusterHandler sets the operation handler for the update cluster operation
 	InstallerUpdateClusterHandler installer.UpdateClusterHandler
 	// InstallerUpdateClusterInstallConfigHandler sets the operation handler for the update cluster install config operation
 	InstallerUpdateClusterInstallConfigHandler installer.UpdateClusterInstallConfigHandler
 	// InstallerUpdateClusterLogsProgressHandler sets the operation handler for the update cluster logs progress operation
 	InstallerUpdateClusterLogsProgressHandler installer.UpdateClusterLogsProgressHandler
+	// ValidationsUpdateCustomHostValidationsHandler sets the operation handler for the update custom host validations operation
+	ValidationsUpdateCustomHostValidationsHandler validations.UpdateCustomHostValidationsHandler
 	// InstallerUpdateDiscoveryIgnitionHandler sets the operation handler for the update discovery ignition operation
 	InstallerUpdateDiscoveryIgnitionHandler installer.UpdateDiscoveryIgnitionHandler
 	// InstallerUpdateHostIgnitionHandler sets the operation handler for the update host ignition operation
@@ -453,6 +584,8 @@ type AssistedInstallAPI struct {
 	InstallerUploadHostLogsHandler installer.UploadHostLogsHandler
 	// InstallerUploadLogsHandler sets the operation handler for the upload logs operation
 	InstallerUploadLogsHandler installer.UploadLogsHandler
+	// InstallerValidateStaticNetworkConfigHandler sets the operation handler for the validate static network config operation
+	InstallerValidateStaticNetworkConfigHandler installer.ValidateStaticNetworkConfigHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -534,6 +667,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
 	if o.AgentAuthAuth == nil {
 		unregistered = append(unregistered, "XSecretKeyAuth")
@@ -548,24 +684,36 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerCancelInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.CancelInstallationHandler")
 	}
+	if o.InstallerCollectHostLogsHandler == nil {
+		unregistered = append(unregistered, "installer.CollectHostLogsHandler")
+	}
 	if o.InstallerCompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.CompleteInstallationHandler")
 	}
 	if o.ManifestsCreateClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.CreateClusterManifestHandler")
 	}
+	if o.ClusterTemplatesCreateClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.CreateClusterTemplateHandler")
+	}
 	if o.AssistedServiceIsoCreateISOAndUploadToS3Handler == nil {
 		unregistered = append(unregistered, "assisted_service_iso.CreateISOAndUploadToS3Handler")
 	}
 	if o.ManifestsDeleteClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.DeleteClusterManifestHandler")
 	}
+	if o.ClusterTemplatesDeleteClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.DeleteClusterTemplateHandler")
+	}
 	if o.InstallerDeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterClusterHandler")
 	}
 	if o.InstallerDeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterHostHandler")
 	}
+	if o.WebhooksDeregisterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.DeregisterWebhookHandler")
+	}
 	if o.InstallerDisableHostHandler == nil {
 		unregistered = append(unregistered, "installer.DisableHostHandler")
 	}
@@ -599,9 +747,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.AssistedServiceIsoDownloadISOHandler == nil {
 		unregistered = append(unregistered, "assisted_service_iso.DownloadISOHandler")
 	}
+	if o.InstallerDryRunClusterHandler == nil {
+		unregistered = append(unregistered, "installer.DryRunClusterHandler")
+	}
 	if o.InstallerEnableHostHandler == nil {
 		unregistered = append(unregistered, "installer.EnableHostHandler")
 	}
+	if o.InstallerExportClusterHandler == nil {
+		unregistered = append(unregistered, "installer.ExportClusterHandler")
+	}
 	if o.InstallerGenerateClusterISOHandler == nil {
 		unregistered = append(unregistered, "installer.GenerateClusterISOHandler")
 	}
@@ -617,6 +771,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterInstallConfigHandler")
 	}
+	if o.InstallerGetClusterInstallConfigDiffHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterInstallConfigDiffHandler")
+	}
+	if o.LogsGetClusterLogsAnalysisHandler == nil {
+		unregistered = append(unregistered, "logs.GetClusterLogsAnalysisHandler")
+	}
+	if o.InstallerGetClusterNetworkTopologyHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterNetworkTopologyHandler")
+	}
+	if o.ClusterTemplatesGetClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.GetClusterTemplateHandler")
+	}
 	if o.InstallerGetCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.GetCredentialsHandler")
 	}
@@ -632,9 +798,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostIgnitionHandler")
 	}
+	if o.InstallerGetHostIgnitionDiffHandler == nil {
+		unregistered = append(unregistered, "installer.GetHostIgnitionDiffHandler")
+	}
 	if o.InstallerGetHostRequirementsHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostRequirementsHandler")
 	}
+	if o.TimelineGetInstallationTimelineHandler == nil {
+		unregistered = append(unregistered, "timeline.GetInstallationTimelineHandler")
+	}
 	if o.InstallerGetNextStepsHandler == nil {
 		unregistered = append(unregistered, "installer.GetNextStepsHandler")
 	}
@@ -647,6 +819,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetPresignedForClusterFilesHandler == nil {
 		unregistered = append(unregistered, "installer.GetPresignedForClusterFilesHandler")
 	}
+	if o.InstallerImportClusterHandler == nil {
+		unregistered = append(unregistered, "installer.ImportClusterHandler")
+	}
 	if o.InstallerInstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.InstallClusterHandler")
 	}
@@ -659,15 +834,27 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.ManifestsListClusterManifestsHandler == nil {
 		unregistered = append(unregistered, "manifests.ListClusterManifestsHandler")
 	}
+	if o.HistoryListClusterStatusHistoryHandler == nil {
+		unregistered = append(unregistered, "history.ListClusterStatusHistoryHandler")
+	}
+	if o.ClusterTemplatesListClusterTemplatesHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.ListClusterTemplatesHandler")
+	}
 	if o.InstallerListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.ListClustersHandler")
 	}
 	if o.VersionsListComponentVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.ListComponentVersionsHandler")
 	}
+	if o.ValidationsListCustomHostValidationsHandler == nil {
+		unregistered = append(unregistered, "validations.ListCustomHostValidationsHandler")
+	}
 	if o.EventsListEventsHandler == nil {
 		unregistered = append(unregistered, "events.ListEventsHandler")
 	}
+	if o.HistoryListHostStatusHistoryHandler == nil {
+		unregistered = append(unregistered, "history.ListHostStatusHistoryHandler")
+	}
 	if o.InstallerListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.ListHostsHandler")
 	}
@@ -686,6 +873,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.OperatorsListSupportedOperatorsHandler == nil {
 		unregistered = append(unregistered, "operators.ListSupportedOperatorsHandler")
 	}
+	if o.WebhooksListWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "webhooks.ListWebhookDeliveriesHandler")
+	}
+	if o.WebhooksListWebhooksHandler == nil {
+		unregistered = append(unregistered, "webhooks.ListWebhooksHandler")
+	}
 	if o.InstallerPostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.PostStepReplyHandler")
 	}
@@ -698,18 +891,27 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerRegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterHostHandler")
 	}
+	if o.WebhooksRegisterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.RegisterWebhookHandler")
+	}
 	if o.OperatorsReportMonitoredOperatorStatusHandler == nil {
 		unregistered = append(unregistered, "operators.ReportMonitoredOperatorStatusHandler")
 	}
 	if o.InstallerResetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.ResetClusterHandler")
 	}
+	if o.ValidationsResetCustomHostValidationsHandler == nil {
+		unregistered = append(unregistered, "validations.ResetCustomHostValidationsHandler")
+	}
 	if o.InstallerResetHostHandler == nil {
 		unregistered = append(unregistered, "installer.ResetHostHandler")
 	}
 	if o.InstallerResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.ResetHostValidationHandler")
 	}
+	if o.EventsStreamEventsHandler == nil {
+		unregistered = append(unregistered, "events.StreamEventsHandler")
+	}
 	if o.InstallerUpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterHandler")
 	}
@@ -719,6 +921,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerUpdateClusterLogsProgressHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterLogsProgressHandler")
 	}
+	if o.ValidationsUpdateCustomHostValidationsHandler == nil {
+		unregistered = append(unregistered, "validations.UpdateCustomHostValidationsHandler")
+	}
 	if o.InstallerUpdateDiscoveryIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateDiscoveryIgnitionHandler")
 	}
@@ -743,6 +948,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerUploadLogsHandler == nil {
 		unregistered = append(unregistered, "installer.UploadLogsHandler")
 	}
+	if o.InstallerValidateStaticNetworkConfigHandler == nil {
+		unregistered = append(unregistered, "installer.ValidateStaticNetworkConfigHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -812,6 +1020,8 @@ func (o *AssistedInstallAPI) ProducersFor(mediaTypes []string) map[string]runtim
 			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -859,6 +1069,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs"] = installer.NewCollectHostLogs(o.context, o.InstallerCollectHostLogsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/actions/complete_installation"] = installer.NewCompleteInstallation(o.context, o.InstallerCompleteInstallationHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -867,6 +1081,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/cluster-templates"] = cluster_templates.NewCreateClusterTemplate(o.context, o.ClusterTemplatesCreateClusterTemplateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/assisted-service-iso"] = assisted_service_iso.NewCreateISOAndUploadToS3(o.context, o.AssistedServiceIsoCreateISOAndUploadToS3Handler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -875,6 +1093,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/cluster-templates/{template_id}"] = cluster_templates.NewDeleteClusterTemplate(o.context, o.ClusterTemplatesDeleteClusterTemplateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/clusters/{cluster_id}"] = installer.NewDeregisterCluster(o.context, o.InstallerDeregisterClusterHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -883,6 +1105,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/webhooks/{webhook_id}"] = webhooks.NewDeregisterWebhook(o.context, o.WebhooksDeregisterWebhookHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/clusters/{cluster_id}/hosts/{host_id}/actions/enable"] = installer.NewDisableHost(o.context, o.InstallerDisableHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -927,7 +1153,15 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/clusters/{cluster_id}/actions/dry-run"] = installer.NewDryRunCluster(o.context, o.InstallerDryRunClusterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/hosts/{host_id}/actions/enable"] = installer.NewEnableHost(o.context, o.InstallerEnableHostHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/export"] = installer.NewExportCluster(o.context, o.InstallerExportClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -951,6 +1185,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/install-config/diff"] = installer.NewGetClusterInstallConfigDiff(o.context, o.InstallerGetClusterInstallConfigDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/logs/analysis"] = logs.NewGetClusterLogsAnalysis(o.context, o.LogsGetClusterLogsAnalysisHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/network-topology"] = installer.NewGetClusterNetworkTopology(o.context, o.InstallerGetClusterNetworkTopologyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster-templates/{template_id}"] = cluster_templates.NewGetClusterTemplate(o.context, o.ClusterTemplatesGetClusterTemplateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/credentials"] = installer.NewGetCredentials(o.context, o.InstallerGetCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -971,10 +1221,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/ignition/diff"] = installer.NewGetHostIgnitionDiff(o.context, o.InstallerGetHostIgnitionDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/host_requirements"] = installer.NewGetHostRequirements(o.context, o.InstallerGetHostRequirementsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/installation-timeline"] = timeline.NewGetInstallationTimeline(o.context, o.TimelineGetInstallationTimelineHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/instructions"] = installer.NewGetNextSteps(o.context, o.InstallerGetNextStepsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -991,6 +1249,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/clusters/import"] = installer.NewImportCluster(o.context, o.InstallerImportClusterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/actions/install"] = installer.NewInstallCluster(o.context, o.InstallerInstallClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1007,6 +1269,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/history"] = history.NewListClusterStatusHistory(o.context, o.HistoryListClusterStatusHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster-templates"] = cluster_templates.NewListClusterTemplates(o.context, o.ClusterTemplatesListClusterTemplatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters"] = installer.NewListClusters(o.context, o.InstallerListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1015,10 +1285,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/custom-host-validations"] = validations.NewListCustomHostValidations(o.context, o.ValidationsListCustomHostValidationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/events"] = events.NewListEvents(o.context, o.EventsListEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/history"] = history.NewListHostStatusHistory(o.context, o.HistoryListHostStatusHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts"] = installer.NewListHosts(o.context, o.InstallerListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1040,6 +1318,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/supported-operators"] = operators.NewListSupportedOperators(o.context, o.OperatorsListSupportedOperatorsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/webhooks/{webhook_id}/deliveries"] = webhooks.NewListWebhookDeliveries(o.context, o.WebhooksListWebhookDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/webhooks"] = webhooks.NewListWebhooks(o.context, o.WebhooksListWebhooksHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1056,6 +1342,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/hosts"] = installer.NewRegisterHost(o.context, o.InstallerRegisterHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/webhooks"] = webhooks.NewRegisterWebhook(o.context, o.WebhooksRegisterWebhookHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1064,6 +1354,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/actions/reset"] = installer.NewResetCluster(o.context, o.InstallerResetClusterHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/custom-host-validations"] = validations.NewResetCustomHostValidations(o.context, o.ValidationsResetCustomHostValidationsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1072,6 +1366,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewResetHostValidation(o.context, o.InstallerResetHostValidationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/events/stream"] = events.NewStreamEvents(o.context, o.EventsStreamEventsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1084,6 +1382,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/clusters/{cluster_id}/logs_progress"] = installer.NewUpdateClusterLogsProgress(o.context, o.InstallerUpdateClusterLogsProgressHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/custom-host-validations"] = validations.NewUpdateCustomHostValidations(o.context, o.ValidationsUpdateCustomHostValidationsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1116,6 +1418,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/logs"] = installer.NewUploadLogs(o.context, o.InstallerUploadLogsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/static-network-config/validate"] = installer.NewValidateStaticNetworkConfig(o.context, o.InstallerValidateStaticNetworkConfigHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
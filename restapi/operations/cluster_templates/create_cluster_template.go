@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateClusterTemplateHandlerFunc turns a function with the right signature into a create cluster template handler
+type CreateClusterTemplateHandlerFunc func(CreateClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateClusterTemplateHandlerFunc) Handle(params CreateClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateClusterTemplateHandler interface for that can handle valid create cluster template params
+type CreateClusterTemplateHandler interface {
+	Handle(CreateClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewCreateClusterTemplate creates a new http.Handler for the create cluster template operation
+func NewCreateClusterTemplate(ctx *middleware.Context, handler CreateClusterTemplateHandler) *CreateClusterTemplate {
+	return &CreateClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/*CreateClusterTemplate swagger:route POST /cluster-templates cluster_templates createClusterTemplate
+
+Creates a cluster template that clusters can be registered from.
+
+*/
+type CreateClusterTemplate struct {
+	Context *middleware.Context
+	Handler CreateClusterTemplateHandler
+}
+
+func (o *CreateClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreateClusterTemplateParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// CreateClusterTemplateCreatedCode is the HTTP code returned for type CreateClusterTemplateCreated
+const CreateClusterTemplateCreatedCode int = 201
+
+/*CreateClusterTemplateCreated Success.
+
+swagger:response createClusterTemplateCreated
+*/
+type CreateClusterTemplateCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterTemplate `json:"body,omitempty"`
+}
+
+// NewCreateClusterTemplateCreated creates CreateClusterTemplateCreated with default headers values
+func NewCreateClusterTemplateCreated() *CreateClusterTemplateCreated {
+
+	return &CreateClusterTemplateCreated{}
+}
+
+// WithPayload adds the payload to the create cluster template created response
+func (o *CreateClusterTemplateCreated) WithPayload(payload *models.ClusterTemplate) *CreateClusterTemplateCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create cluster template created response
+func (o *CreateClusterTemplateCreated) SetPayload(payload *models.ClusterTemplate) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateClusterTemplateCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateClusterTemplateBadRequestCode is the HTTP code returned for type CreateClusterTemplateBadRequest
+const CreateClusterTemplateBadRequestCode int = 400
+
+/*CreateClusterTemplateBadRequest Error.
+
+swagger:response createClusterTemplateBadRequest
+*/
+type CreateClusterTemplateBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateClusterTemplateBadRequest creates CreateClusterTemplateBadRequest with default headers values
+func NewCreateClusterTemplateBadRequest() *CreateClusterTemplateBadRequest {
+
+	return &CreateClusterTemplateBadRequest{}
+}
+
+// WithPayload adds the payload to the create cluster template bad request response
+func (o *CreateClusterTemplateBadRequest) WithPayload(payload *models.Error) *CreateClusterTemplateBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create cluster template bad request response
+func (o *CreateClusterTemplateBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateClusterTemplateBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateClusterTemplateUnauthorizedCode is the HTTP code returned for type CreateClusterTemplateUnauthorized
+const CreateClusterTemplateUnauthorizedCode int = 401
+
+/*CreateClusterTemplateUnauthorized Unauthorized.
+
+swagger:response createClusterTemplateUnauthorized
+*/
+type CreateClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewCreateClusterTemplateUnauthorized creates CreateClusterTemplateUnauthorized with default headers values
+func NewCreateClusterTemplateUnauthorized() *CreateClusterTemplateUnauthorized {
+
+	return &CreateClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the create cluster template unauthorized response
+func (o *CreateClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *CreateClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create cluster template unauthorized response
+func (o *CreateClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateClusterTemplateForbiddenCode is the HTTP code returned for type CreateClusterTemplateForbidden
+const CreateClusterTemplateForbiddenCode int = 403
+
+/*CreateClusterTemplateForbidden Forbidden.
+
+swagger:response createClusterTemplateForbidden
+*/
+type CreateClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewCreateClusterTemplateForbidden creates CreateClusterTemplateForbidden with default headers values
+func NewCreateClusterTemplateForbidden() *CreateClusterTemplateForbidden {
+
+	return &CreateClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the create cluster template forbidden response
+func (o *CreateClusterTemplateForbidden) WithPayload(payload *models.InfraError) *CreateClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create cluster template forbidden response
+func (o *CreateClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateClusterTemplateMethodNotAllowedCode is the HTTP code returned for type CreateClusterTemplateMethodNotAllowed
+const CreateClusterTemplateMethodNotAllowedCode int = 405
+
+/*CreateClusterTemplateMethodNotAllowed Method Not Allowed.
+
+swagger:response createClusterTemplateMethodNotAllowed
+*/
+type CreateClusterTemplateMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateClusterTemplateMethodNotAllowed creates CreateClusterTemplateMethodNotAllowed with default headers values
+func NewCreateClusterTemplateMethodNotAllowed() *CreateClusterTemplateMethodNotAllowed {
+
+	return &CreateClusterTemplateMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the create cluster template method not allowed response
+func (o *CreateClusterTemplateMethodNotAllowed) WithPayload(payload *models.Error) *CreateClusterTemplateMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create cluster template method not allowed response
+func (o *CreateClusterTemplateMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateClusterTemplateMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateClusterTemplateInternalServerErrorCode is the HTTP code returned for type CreateClusterTemplateInternalServerError
+const CreateClusterTemplateInternalServerErrorCode int = 500
+
+/*CreateClusterTemplateInternalServerError Error.
+
+swagger:response createClusterTemplateInternalServerError
+*/
+type CreateClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateClusterTemplateInternalServerError creates CreateClusterTemplateInternalServerError with default headers values
+func NewCreateClusterTemplateInternalServerError() *CreateClusterTemplateInternalServerError {
+
+	return &CreateClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the create cluster template internal server error response
+func (o *CreateClusterTemplateInternalServerError) WithPayload(payload *models.Error) *CreateClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create cluster template internal server error response
+func (o *CreateClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
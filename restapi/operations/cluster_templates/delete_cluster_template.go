@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteClusterTemplateHandlerFunc turns a function with the right signature into a delete cluster template handler
+type DeleteClusterTemplateHandlerFunc func(DeleteClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteClusterTemplateHandlerFunc) Handle(params DeleteClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeleteClusterTemplateHandler interface for that can handle valid delete cluster template params
+type DeleteClusterTemplateHandler interface {
+	Handle(DeleteClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewDeleteClusterTemplate creates a new http.Handler for the delete cluster template operation
+func NewDeleteClusterTemplate(ctx *middleware.Context, handler DeleteClusterTemplateHandler) *DeleteClusterTemplate {
+	return &DeleteClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/*DeleteClusterTemplate swagger:route DELETE /cluster-templates/{template_id} cluster_templates deleteClusterTemplate
+
+Deletes a cluster template. Clusters that were registered from the template are not affected.
+
+*/
+type DeleteClusterTemplate struct {
+	Context *middleware.Context
+	Handler DeleteClusterTemplateHandler
+}
+
+func (o *DeleteClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewDeleteClusterTemplateParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
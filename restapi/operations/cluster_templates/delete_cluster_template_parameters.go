@@ -0,0 +1,91 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeleteClusterTemplateParams creates a new DeleteClusterTemplateParams object
+// no default values defined in spec.
+func NewDeleteClusterTemplateParams() DeleteClusterTemplateParams {
+
+	return DeleteClusterTemplateParams{}
+}
+
+// DeleteClusterTemplateParams contains all the bound params for the delete cluster template operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters DeleteClusterTemplate
+type DeleteClusterTemplateParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster template to be deleted.
+	  Required: true
+	  In: path
+	*/
+	TemplateID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteClusterTemplateParams() beforehand.
+func (o *DeleteClusterTemplateParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rTemplateID, rhkTemplateID, _ := route.Params.GetOK("template_id")
+	if err := o.bindTemplateID(rTemplateID, rhkTemplateID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindTemplateID binds and validates parameter TemplateID from path.
+func (o *DeleteClusterTemplateParams) bindTemplateID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("template_id", "path", "strfmt.UUID", raw)
+	}
+	o.TemplateID = *(value.(*strfmt.UUID))
+
+	if err := o.validateTemplateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTemplateID carries on validations for parameter TemplateID
+func (o *DeleteClusterTemplateParams) validateTemplateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("template_id", "path", "uuid", o.TemplateID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// DeleteClusterTemplateNoContentCode is the HTTP code returned for type DeleteClusterTemplateNoContent
+const DeleteClusterTemplateNoContentCode int = 204
+
+/*DeleteClusterTemplateNoContent Success.
+
+swagger:response deleteClusterTemplateNoContent
+*/
+type DeleteClusterTemplateNoContent struct {
+}
+
+// NewDeleteClusterTemplateNoContent creates DeleteClusterTemplateNoContent with default headers values
+func NewDeleteClusterTemplateNoContent() *DeleteClusterTemplateNoContent {
+
+	return &DeleteClusterTemplateNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteClusterTemplateNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// DeleteClusterTemplateUnauthorizedCode is the HTTP code returned for type DeleteClusterTemplateUnauthorized
+const DeleteClusterTemplateUnauthorizedCode int = 401
+
+/*DeleteClusterTemplateUnauthorized Unauthorized.
+
+swagger:response deleteClusterTemplateUnauthorized
+*/
+type DeleteClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewDeleteClusterTemplateUnauthorized creates DeleteClusterTemplateUnauthorized with default headers values
+func NewDeleteClusterTemplateUnauthorized() *DeleteClusterTemplateUnauthorized {
+
+	return &DeleteClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the delete cluster template unauthorized response
+func (o *DeleteClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *DeleteClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete cluster template unauthorized response
+func (o *DeleteClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteClusterTemplateForbiddenCode is the HTTP code returned for type DeleteClusterTemplateForbidden
+const DeleteClusterTemplateForbiddenCode int = 403
+
+/*DeleteClusterTemplateForbidden Forbidden.
+
+swagger:response deleteClusterTemplateForbidden
+*/
+type DeleteClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewDeleteClusterTemplateForbidden creates DeleteClusterTemplateForbidden with default headers values
+func NewDeleteClusterTemplateForbidden() *DeleteClusterTemplateForbidden {
+
+	return &DeleteClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the delete cluster template forbidden response
+func (o *DeleteClusterTemplateForbidden) WithPayload(payload *models.InfraError) *DeleteClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete cluster template forbidden response
+func (o *DeleteClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteClusterTemplateNotFoundCode is the HTTP code returned for type DeleteClusterTemplateNotFound
+const DeleteClusterTemplateNotFoundCode int = 404
+
+/*DeleteClusterTemplateNotFound Error.
+
+swagger:response deleteClusterTemplateNotFound
+*/
+type DeleteClusterTemplateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteClusterTemplateNotFound creates DeleteClusterTemplateNotFound with default headers values
+func NewDeleteClusterTemplateNotFound() *DeleteClusterTemplateNotFound {
+
+	return &DeleteClusterTemplateNotFound{}
+}
+
+// WithPayload adds the payload to the delete cluster template not found response
+func (o *DeleteClusterTemplateNotFound) WithPayload(payload *models.Error) *DeleteClusterTemplateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete cluster template not found response
+func (o *DeleteClusterTemplateNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteClusterTemplateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteClusterTemplateMethodNotAllowedCode is the HTTP code returned for type DeleteClusterTemplateMethodNotAllowed
+const DeleteClusterTemplateMethodNotAllowedCode int = 405
+
+/*DeleteClusterTemplateMethodNotAllowed Method Not Allowed.
+
+swagger:response deleteClusterTemplateMethodNotAllowed
+*/
+type DeleteClusterTemplateMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteClusterTemplateMethodNotAllowed creates DeleteClusterTemplateMethodNotAllowed with default headers values
+func NewDeleteClusterTemplateMethodNotAllowed() *DeleteClusterTemplateMethodNotAllowed {
+
+	return &DeleteClusterTemplateMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the delete cluster template method not allowed response
+func (o *DeleteClusterTemplateMethodNotAllowed) WithPayload(payload *models.Error) *DeleteClusterTemplateMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete cluster template method not allowed response
+func (o *DeleteClusterTemplateMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteClusterTemplateMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteClusterTemplateInternalServerErrorCode is the HTTP code returned for type DeleteClusterTemplateInternalServerError
+const DeleteClusterTemplateInternalServerErrorCode int = 500
+
+/*DeleteClusterTemplateInternalServerError Error.
+
+swagger:response deleteClusterTemplateInternalServerError
+*/
+type DeleteClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteClusterTemplateInternalServerError creates DeleteClusterTemplateInternalServerError with default headers values
+func NewDeleteClusterTemplateInternalServerError() *DeleteClusterTemplateInternalServerError {
+
+	return &DeleteClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the delete cluster template internal server error response
+func (o *DeleteClusterTemplateInternalServerError) WithPayload(payload *models.Error) *DeleteClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete cluster template internal server error response
+func (o *DeleteClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetClusterTemplateHandlerFunc turns a function with the right signature into a get cluster template handler
+type GetClusterTemplateHandlerFunc func(GetClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetClusterTemplateHandlerFunc) Handle(params GetClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetClusterTemplateHandler interface for that can handle valid get cluster template params
+type GetClusterTemplateHandler interface {
+	Handle(GetClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewGetClusterTemplate creates a new http.Handler for the get cluster template operation
+func NewGetClusterTemplate(ctx *middleware.Context, handler GetClusterTemplateHandler) *GetClusterTemplate {
+	return &GetClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/*GetClusterTemplate swagger:route GET /cluster-templates/{template_id} cluster_templates getClusterTemplate
+
+Retrieves the details of a cluster template.
+
+*/
+type GetClusterTemplate struct {
+	Context *middleware.Context
+	Handler GetClusterTemplateHandler
+}
+
+func (o *GetClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetClusterTemplateParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetClusterTemplateOKCode is the HTTP code returned for type GetClusterTemplateOK
+const GetClusterTemplateOKCode int = 200
+
+/*GetClusterTemplateOK Success.
+
+swagger:response getClusterTemplateOK
+*/
+type GetClusterTemplateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterTemplate `json:"body,omitempty"`
+}
+
+// NewGetClusterTemplateOK creates GetClusterTemplateOK with default headers values
+func NewGetClusterTemplateOK() *GetClusterTemplateOK {
+
+	return &GetClusterTemplateOK{}
+}
+
+// WithPayload adds the payload to the get cluster template o k response
+func (o *GetClusterTemplateOK) WithPayload(payload *models.ClusterTemplate) *GetClusterTemplateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster template o k response
+func (o *GetClusterTemplateOK) SetPayload(payload *models.ClusterTemplate) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterTemplateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterTemplateUnauthorizedCode is the HTTP code returned for type GetClusterTemplateUnauthorized
+const GetClusterTemplateUnauthorizedCode int = 401
+
+/*GetClusterTemplateUnauthorized Unauthorized.
+
+swagger:response getClusterTemplateUnauthorized
+*/
+type GetClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetClusterTemplateUnauthorized creates GetClusterTemplateUnauthorized with default headers values
+func NewGetClusterTemplateUnauthorized() *GetClusterTemplateUnauthorized {
+
+	return &GetClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the get cluster template unauthorized response
+func (o *GetClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *GetClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster template unauthorized response
+func (o *GetClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterTemplateForbiddenCode is the HTTP code returned for type GetClusterTemplateForbidden
+const GetClusterTemplateForbiddenCode int = 403
+
+/*GetClusterTemplateForbidden Forbidden.
+
+swagger:response getClusterTemplateForbidden
+*/
+type GetClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetClusterTemplateForbidden creates GetClusterTemplateForbidden with default headers values
+func NewGetClusterTemplateForbidden() *GetClusterTemplateForbidden {
+
+	return &GetClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the get cluster template forbidden response
+func (o *GetClusterTemplateForbidden) WithPayload(payload *models.InfraError) *GetClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster template forbidden response
+func (o *GetClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterTemplateNotFoundCode is the HTTP code returned for type GetClusterTemplateNotFound
+const GetClusterTemplateNotFoundCode int = 404
+
+/*GetClusterTemplateNotFound Error.
+
+swagger:response getClusterTemplateNotFound
+*/
+type GetClusterTemplateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterTemplateNotFound creates GetClusterTemplateNotFound with default headers values
+func NewGetClusterTemplateNotFound() *GetClusterTemplateNotFound {
+
+	return &GetClusterTemplateNotFound{}
+}
+
+// WithPayload adds the payload to the get cluster template not found response
+func (o *GetClusterTemplateNotFound) WithPayload(payload *models.Error) *GetClusterTemplateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster template not found response
+func (o *GetClusterTemplateNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterTemplateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterTemplateMethodNotAllowedCode is the HTTP code returned for type GetClusterTemplateMethodNotAllowed
+const GetClusterTemplateMethodNotAllowedCode int = 405
+
+/*GetClusterTemplateMethodNotAllowed Method Not Allowed.
+
+swagger:response getClusterTemplateMethodNotAllowed
+*/
+type GetClusterTemplateMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterTemplateMethodNotAllowed creates GetClusterTemplateMethodNotAllowed with default headers values
+func NewGetClusterTemplateMethodNotAllowed() *GetClusterTemplateMethodNotAllowed {
+
+	return &GetClusterTemplateMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the get cluster template method not allowed response
+func (o *GetClusterTemplateMethodNotAllowed) WithPayload(payload *models.Error) *GetClusterTemplateMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster template method not allowed response
+func (o *GetClusterTemplateMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterTemplateMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterTemplateInternalServerErrorCode is the HTTP code returned for type GetClusterTemplateInternalServerError
+const GetClusterTemplateInternalServerErrorCode int = 500
+
+/*GetClusterTemplateInternalServerError Error.
+
+swagger:response getClusterTemplateInternalServerError
+*/
+type GetClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterTemplateInternalServerError creates GetClusterTemplateInternalServerError with default headers values
+func NewGetClusterTemplateInternalServerError() *GetClusterTemplateInternalServerError {
+
+	return &GetClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the get cluster template internal server error response
+func (o *GetClusterTemplateInternalServerError) WithPayload(payload *models.Error) *GetClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster template internal server error response
+func (o *GetClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListClusterTemplatesHandlerFunc turns a function with the right signature into a list cluster templates handler
+type ListClusterTemplatesHandlerFunc func(ListClusterTemplatesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListClusterTemplatesHandlerFunc) Handle(params ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListClusterTemplatesHandler interface for that can handle valid list cluster templates params
+type ListClusterTemplatesHandler interface {
+	Handle(ListClusterTemplatesParams, interface{}) middleware.Responder
+}
+
+// NewListClusterTemplates creates a new http.Handler for the list cluster templates operation
+func NewListClusterTemplates(ctx *middleware.Context, handler ListClusterTemplatesHandler) *ListClusterTemplates {
+	return &ListClusterTemplates{Context: ctx, Handler: handler}
+}
+
+/*ListClusterTemplates swagger:route GET /cluster-templates cluster_templates listClusterTemplates
+
+Retrieves the list of cluster templates.
+
+*/
+type ListClusterTemplates struct {
+	Context *middleware.Context
+	Handler ListClusterTemplatesHandler
+}
+
+func (o *ListClusterTemplates) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListClusterTemplatesParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,237 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListClusterTemplatesOKCode is the HTTP code returned for type ListClusterTemplatesOK
+const ListClusterTemplatesOKCode int = 200
+
+/*ListClusterTemplatesOK Success.
+
+swagger:response listClusterTemplatesOK
+*/
+type ListClusterTemplatesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.ClusterTemplateList `json:"body,omitempty"`
+}
+
+// NewListClusterTemplatesOK creates ListClusterTemplatesOK with default headers values
+func NewListClusterTemplatesOK() *ListClusterTemplatesOK {
+
+	return &ListClusterTemplatesOK{}
+}
+
+// WithPayload adds the payload to the list cluster templates o k response
+func (o *ListClusterTemplatesOK) WithPayload(payload models.ClusterTemplateList) *ListClusterTemplatesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster templates o k response
+func (o *ListClusterTemplatesOK) SetPayload(payload models.ClusterTemplateList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterTemplatesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.ClusterTemplateList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ListClusterTemplatesUnauthorizedCode is the HTTP code returned for type ListClusterTemplatesUnauthorized
+const ListClusterTemplatesUnauthorizedCode int = 401
+
+/*ListClusterTemplatesUnauthorized Unauthorized.
+
+swagger:response listClusterTemplatesUnauthorized
+*/
+type ListClusterTemplatesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListClusterTemplatesUnauthorized creates ListClusterTemplatesUnauthorized with default headers values
+func NewListClusterTemplatesUnauthorized() *ListClusterTemplatesUnauthorized {
+
+	return &ListClusterTemplatesUnauthorized{}
+}
+
+// WithPayload adds the payload to the list cluster templates unauthorized response
+func (o *ListClusterTemplatesUnauthorized) WithPayload(payload *models.InfraError) *ListClusterTemplatesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster templates unauthorized response
+func (o *ListClusterTemplatesUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterTemplatesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListClusterTemplatesForbiddenCode is the HTTP code returned for type ListClusterTemplatesForbidden
+const ListClusterTemplatesForbiddenCode int = 403
+
+/*ListClusterTemplatesForbidden Forbidden.
+
+swagger:response listClusterTemplatesForbidden
+*/
+type ListClusterTemplatesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListClusterTemplatesForbidden creates ListClusterTemplatesForbidden with default headers values
+func NewListClusterTemplatesForbidden() *ListClusterTemplatesForbidden {
+
+	return &ListClusterTemplatesForbidden{}
+}
+
+// WithPayload adds the payload to the list cluster templates forbidden response
+func (o *ListClusterTemplatesForbidden) WithPayload(payload *models.InfraError) *ListClusterTemplatesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster templates forbidden response
+func (o *ListClusterTemplatesForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterTemplatesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListClusterTemplatesMethodNotAllowedCode is the HTTP code returned for type ListClusterTemplatesMethodNotAllowed
+const ListClusterTemplatesMethodNotAllowedCode int = 405
+
+/*ListClusterTemplatesMethodNotAllowed Method Not Allowed.
+
+swagger:response listClusterTemplatesMethodNotAllowed
+*/
+type ListClusterTemplatesMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListClusterTemplatesMethodNotAllowed creates ListClusterTemplatesMethodNotAllowed with default headers values
+func NewListClusterTemplatesMethodNotAllowed() *ListClusterTemplatesMethodNotAllowed {
+
+	return &ListClusterTemplatesMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the list cluster templates method not allowed response
+func (o *ListClusterTemplatesMethodNotAllowed) WithPayload(payload *models.Error) *ListClusterTemplatesMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster templates method not allowed response
+func (o *ListClusterTemplatesMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterTemplatesMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListClusterTemplatesInternalServerErrorCode is the HTTP code returned for type ListClusterTemplatesInternalServerError
+const ListClusterTemplatesInternalServerErrorCode int = 500
+
+/*ListClusterTemplatesInternalServerError Error.
+
+swagger:response listClusterTemplatesInternalServerError
+*/
+type ListClusterTemplatesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListClusterTemplatesInternalServerError creates ListClusterTemplatesInternalServerError with default headers values
+func NewListClusterTemplatesInternalServerError() *ListClusterTemplatesInternalServerError {
+
+	return &ListClusterTemplatesInternalServerError{}
+}
+
+// WithPayload adds the payload to the list cluster templates internal server error response
+func (o *ListClusterTemplatesInternalServerError) WithPayload(payload *models.Error) *ListClusterTemplatesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster templates internal server error response
+func (o *ListClusterTemplatesInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterTemplatesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
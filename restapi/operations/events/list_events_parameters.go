@@ -6,6 +6,7 @@ package events
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -17,10 +18,18 @@ import (
 )
 
 // NewListEventsParams creates a new ListEventsParams object
-// no default values defined in spec.
+// with the default values initialized.
 func NewListEventsParams() ListEventsParams {
 
-	return ListEventsParams{}
+	var (
+		// initialize parameters with default values
+
+		offsetDefault = int64(0)
+	)
+
+	return ListEventsParams{
+		Offset: &offsetDefault,
+	}
 }
 
 // ListEventsParams contains all the bound params for the list events operation
@@ -45,6 +54,34 @@ type ListEventsParams struct {
 	  In: query
 	*/
 	HostID *strfmt.UUID
+	/*The maximum number of events to return. All the matching events are returned if not specified.
+	  Maximum: 10000
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
+	/*Return only events whose message contains this text (case insensitive).
+	  In: query
+	*/
+	Message *string
+	/*The number of matching events to skip before starting to return events.
+	  Minimum: 0
+	  In: query
+	  Default: 0
+	*/
+	Offset *int64
+	/*A comma-separated list of event severities. Events of all severities are returned if not specified.
+	  In: query
+	*/
+	Severities []string
+	/*Return only events that occurred at or after this time.
+	  In: query
+	*/
+	Since *strfmt.DateTime
+	/*Return only events that occurred before this time.
+	  In: query
+	*/
+	Until *strfmt.DateTime
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -73,6 +110,36 @@ func (o *ListEventsParams) BindRequest(r *http.Request, route *middleware.Matche
 		res = append(res, err)
 	}
 
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMessage, qhkMessage, _ := qs.GetOK("message")
+	if err := o.bindMessage(qMessage, qhkMessage, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSeverities, qhkSeverities, _ := qs.GetOK("severities")
+	if err := o.bindSeverities(qSeverities, qhkSeverities, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSince, qhkSince, _ := qs.GetOK("since")
+	if err := o.bindSince(qSince, qhkSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUntil, qhkUntil, _ := qs.GetOK("until")
+	if err := o.bindUntil(qUntil, qhkUntil, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -175,3 +242,202 @@ func (o *ListEventsParams) validateHostID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *ListEventsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *ListEventsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64(*o.Limit), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", int64(*o.Limit), 10000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMessage binds and validates parameter Message from query.
+func (o *ListEventsParams) bindMessage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Message = &raw
+
+	return nil
+}
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *ListEventsParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListEventsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int64", raw)
+	}
+	o.Offset = &value
+
+	if err := o.validateOffset(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOffset carries on validations for parameter Offset
+func (o *ListEventsParams) validateOffset(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("offset", "query", int64(*o.Offset), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSeverities binds and validates array parameter Severities from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *ListEventsParams) bindSeverities(rawData []string, hasKey bool, formats strfmt.Registry) error {
+
+	var qvSeverities string
+	if len(rawData) > 0 {
+		qvSeverities = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	severitiesIC := swag.SplitByFormat(qvSeverities, "")
+	if len(severitiesIC) == 0 {
+		return nil
+	}
+
+	var severitiesIR []string
+	for i, severitiesIV := range severitiesIC {
+		severitiesI := severitiesIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "severities", i), "query", severitiesI, []interface{}{"info", "warning", "error", "critical"}, true); err != nil {
+			return err
+		}
+
+		severitiesIR = append(severitiesIR, severitiesI)
+	}
+
+	o.Severities = severitiesIR
+
+	return nil
+}
+
+// bindSince binds and validates parameter Since from query.
+func (o *ListEventsParams) bindSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("since", "query", "strfmt.DateTime", raw)
+	}
+	o.Since = (value.(*strfmt.DateTime))
+
+	if err := o.validateSince(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSince carries on validations for parameter Since
+func (o *ListEventsParams) validateSince(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("since", "query", "date-time", o.Since.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindUntil binds and validates parameter Until from query.
+func (o *ListEventsParams) bindUntil(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("until", "query", "strfmt.DateTime", raw)
+	}
+	o.Until = (value.(*strfmt.DateTime))
+
+	if err := o.validateUntil(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateUntil carries on validations for parameter Until
+func (o *ListEventsParams) validateUntil(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("until", "query", "date-time", o.Until.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -21,6 +22,10 @@ const ListEventsOKCode int = 200
 swagger:response listEventsOK
 */
 type ListEventsOK struct {
+	/*The total number of events that match the filters, regardless of the limit and offset.
+
+	 */
+	XTotalCount int64 `json:"X-Total-Count"`
 
 	/*
 	  In: Body
@@ -34,6 +39,17 @@ func NewListEventsOK() *ListEventsOK {
 	return &ListEventsOK{}
 }
 
+// WithXTotalCount adds the xTotalCount to the list events o k response
+func (o *ListEventsOK) WithXTotalCount(xTotalCount int64) *ListEventsOK {
+	o.XTotalCount = xTotalCount
+	return o
+}
+
+// SetXTotalCount sets the xTotalCount to the list events o k response
+func (o *ListEventsOK) SetXTotalCount(xTotalCount int64) {
+	o.XTotalCount = xTotalCount
+}
+
 // WithPayload adds the payload to the list events o k response
 func (o *ListEventsOK) WithPayload(payload models.EventList) *ListEventsOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *ListEventsOK) SetPayload(payload models.EventList) {
 // WriteResponse to the client
 func (o *ListEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Total-Count
+
+	xTotalCount := swag.FormatInt64(o.XTotalCount)
+	if xTotalCount != "" {
+		rw.Header().Set("X-Total-Count", xTotalCount)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
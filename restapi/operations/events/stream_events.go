@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// StreamEventsHandlerFunc turns a function with the right signature into a stream events handler
+type StreamEventsHandlerFunc func(StreamEventsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn StreamEventsHandlerFunc) Handle(params StreamEventsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// StreamEventsHandler interface for that can handle valid stream events params
+type StreamEventsHandler interface {
+	Handle(StreamEventsParams, interface{}) middleware.Responder
+}
+
+// NewStreamEvents creates a new http.Handler for the stream events operation
+func NewStreamEvents(ctx *middleware.Context, handler StreamEventsHandler) *StreamEvents {
+	return &StreamEvents{Context: ctx, Handler: handler}
+}
+
+/*StreamEvents swagger:route GET /clusters/{cluster_id}/events/stream events streamEvents
+
+Streams the events of a cluster as Server-Sent Events. The stream starts with the events that were added after the last seen event, if specified, and then pushes new events as they are added.
+
+*/
+type StreamEvents struct {
+	Context *middleware.Context
+	Handler StreamEventsHandler
+}
+
+func (o *StreamEvents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewStreamEventsParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
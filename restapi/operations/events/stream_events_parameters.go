@@ -0,0 +1,268 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewStreamEventsParams creates a new StreamEventsParams object
+// no default values defined in spec.
+func NewStreamEventsParams() StreamEventsParams {
+
+	return StreamEventsParams{}
+}
+
+// StreamEventsParams contains all the bound params for the stream events operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters StreamEvents
+type StreamEventsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The ID of the last event that the client received, sent by the browser when it reconnects to the stream.
+	  Minimum: 0
+	  In: header
+	*/
+	LastEventID *int64
+	/*Stream only the events that were added after the event with this ID. Used when the Last-Event-ID header can't be set, the header takes precedence.
+	  Minimum: 0
+	  In: query
+	*/
+	AfterEventID *int64
+	/*A comma-separated list of event categories.
+	  In: query
+	*/
+	Categories []string
+	/*The cluster to stream events for.
+	  Required: true
+	  In: path
+	*/
+	ClusterID strfmt.UUID
+	/*A host in the specified cluster to stream events for.
+	  In: query
+	*/
+	HostID *strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewStreamEventsParams() beforehand.
+func (o *StreamEventsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindLastEventID(r.Header[http.CanonicalHeaderKey("Last-Event-ID")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qAfterEventID, qhkAfterEventID, _ := qs.GetOK("after_event_id")
+	if err := o.bindAfterEventID(qAfterEventID, qhkAfterEventID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCategories, qhkCategories, _ := qs.GetOK("categories")
+	if err := o.bindCategories(qCategories, qhkCategories, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
+	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qHostID, qhkHostID, _ := qs.GetOK("host_id")
+	if err := o.bindHostID(qHostID, qhkHostID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLastEventID binds and validates parameter LastEventID from header.
+func (o *StreamEventsParams) bindLastEventID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("Last-Event-ID", "header", "int64", raw)
+	}
+	o.LastEventID = &value
+
+	if err := o.validateLastEventID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLastEventID carries on validations for parameter LastEventID
+func (o *StreamEventsParams) validateLastEventID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("Last-Event-ID", "header", int64(*o.LastEventID), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindAfterEventID binds and validates parameter AfterEventID from query.
+func (o *StreamEventsParams) bindAfterEventID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("after_event_id", "query", "int64", raw)
+	}
+	o.AfterEventID = &value
+
+	if err := o.validateAfterEventID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateAfterEventID carries on validations for parameter AfterEventID
+func (o *StreamEventsParams) validateAfterEventID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("after_event_id", "query", int64(*o.AfterEventID), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindCategories binds and validates array parameter Categories from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *StreamEventsParams) bindCategories(rawData []string, hasKey bool, formats strfmt.Registry) error {
+
+	var qvCategories string
+	if len(rawData) > 0 {
+		qvCategories = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	categoriesIC := swag.SplitByFormat(qvCategories, "")
+	if len(categoriesIC) == 0 {
+		return nil
+	}
+
+	var categoriesIR []string
+	for _, categoriesIV := range categoriesIC {
+		categoriesI := categoriesIV
+
+		categoriesIR = append(categoriesIR, categoriesI)
+	}
+
+	o.Categories = categoriesIR
+
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from path.
+func (o *StreamEventsParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *StreamEventsParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "path", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindHostID binds and validates parameter HostID from query.
+func (o *StreamEventsParams) bindHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("host_id", "query", "strfmt.UUID", raw)
+	}
+	o.HostID = (value.(*strfmt.UUID))
+
+	if err := o.validateHostID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateHostID carries on validations for parameter HostID
+func (o *StreamEventsParams) validateHostID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("host_id", "query", "uuid", o.HostID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
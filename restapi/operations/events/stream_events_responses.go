@@ -0,0 +1,276 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// StreamEventsOKCode is the HTTP code returned for type StreamEventsOK
+const StreamEventsOKCode int = 200
+
+/*StreamEventsOK Success.
+
+swagger:response streamEventsOK
+*/
+type StreamEventsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewStreamEventsOK creates StreamEventsOK with default headers values
+func NewStreamEventsOK() *StreamEventsOK {
+
+	return &StreamEventsOK{}
+}
+
+// WithPayload adds the payload to the stream events o k response
+func (o *StreamEventsOK) WithPayload(payload string) *StreamEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream events o k response
+func (o *StreamEventsOK) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// StreamEventsUnauthorizedCode is the HTTP code returned for type StreamEventsUnauthorized
+const StreamEventsUnauthorizedCode int = 401
+
+/*StreamEventsUnauthorized Unauthorized.
+
+swagger:response streamEventsUnauthorized
+*/
+type StreamEventsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewStreamEventsUnauthorized creates StreamEventsUnauthorized with default headers values
+func NewStreamEventsUnauthorized() *StreamEventsUnauthorized {
+
+	return &StreamEventsUnauthorized{}
+}
+
+// WithPayload adds the payload to the stream events unauthorized response
+func (o *StreamEventsUnauthorized) WithPayload(payload *models.InfraError) *StreamEventsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream events unauthorized response
+func (o *StreamEventsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamEventsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StreamEventsForbiddenCode is the HTTP code returned for type StreamEventsForbidden
+const StreamEventsForbiddenCode int = 403
+
+/*StreamEventsForbidden Forbidden.
+
+swagger:response streamEventsForbidden
+*/
+type StreamEventsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewStreamEventsForbidden creates StreamEventsForbidden with default headers values
+func NewStreamEventsForbidden() *StreamEventsForbidden {
+
+	return &StreamEventsForbidden{}
+}
+
+// WithPayload adds the payload to the stream events forbidden response
+func (o *StreamEventsForbidden) WithPayload(payload *models.InfraError) *StreamEventsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream events forbidden response
+func (o *StreamEventsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamEventsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StreamEventsNotFoundCode is the HTTP code returned for type StreamEventsNotFound
+const StreamEventsNotFoundCode int = 404
+
+/*StreamEventsNotFound Error.
+
+swagger:response streamEventsNotFound
+*/
+type StreamEventsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewStreamEventsNotFound creates StreamEventsNotFound with default headers values
+func NewStreamEventsNotFound() *StreamEventsNotFound {
+
+	return &StreamEventsNotFound{}
+}
+
+// WithPayload adds the payload to the stream events not found response
+func (o *StreamEventsNotFound) WithPayload(payload *models.Error) *StreamEventsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream events not found response
+func (o *StreamEventsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamEventsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StreamEventsMethodNotAllowedCode is the HTTP code returned for type StreamEventsMethodNotAllowed
+const StreamEventsMethodNotAllowedCode int = 405
+
+/*StreamEventsMethodNotAllowed Method Not Allowed.
+
+swagger:response streamEventsMethodNotAllowed
+*/
+type StreamEventsMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewStreamEventsMethodNotAllowed creates StreamEventsMethodNotAllowed with default headers values
+func NewStreamEventsMethodNotAllowed() *StreamEventsMethodNotAllowed {
+
+	return &StreamEventsMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the stream events method not allowed response
+func (o *StreamEventsMethodNotAllowed) WithPayload(payload *models.Error) *StreamEventsMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream events method not allowed response
+func (o *StreamEventsMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamEventsMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StreamEventsInternalServerErrorCode is the HTTP code returned for type StreamEventsInternalServerError
+const StreamEventsInternalServerErrorCode int = 500
+
+/*StreamEventsInternalServerError Error.
+
+swagger:response streamEventsInternalServerError
+*/
+type StreamEventsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewStreamEventsInternalServerError creates StreamEventsInternalServerError with default headers values
+func NewStreamEventsInternalServerError() *StreamEventsInternalServerError {
+
+	return &StreamEventsInternalServerError{}
+}
+
+// WithPayload adds the payload to the stream events internal server error response
+func (o *StreamEventsInternalServerError) WithPayload(payload *models.Error) *StreamEventsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream events internal server error response
+func (o *StreamEventsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamEventsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
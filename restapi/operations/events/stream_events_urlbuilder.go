@@ -0,0 +1,143 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// StreamEventsURL generates an URL for the stream events operation
+type StreamEventsURL struct {
+	ClusterID strfmt.UUID
+
+	AfterEventID *int64
+	Categories   []string
+	HostID       *strfmt.UUID
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *StreamEventsURL) WithBasePath(bp string) *StreamEventsURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *StreamEventsURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *StreamEventsURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/clusters/{cluster_id}/events/stream"
+
+	clusterID := o.ClusterID.String()
+	if clusterID != "" {
+		_path = strings.Replace(_path, "{cluster_id}", clusterID, -1)
+	} else {
+		return nil, errors.New("clusterId is required on StreamEventsURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/assisted-install/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var afterEventIDQ string
+	if o.AfterEventID != nil {
+		afterEventIDQ = swag.FormatInt64(*o.AfterEventID)
+	}
+	if afterEventIDQ != "" {
+		qs.Set("after_event_id", afterEventIDQ)
+	}
+
+	var categoriesIR []string
+	for _, categoriesI := range o.Categories {
+		categoriesIS := categoriesI
+		if categoriesIS != "" {
+			categoriesIR = append(categoriesIR, categoriesIS)
+		}
+	}
+
+	categories := swag.JoinByFormat(categoriesIR, "")
+
+	if len(categories) > 0 {
+		qsv := categories[0]
+		if qsv != "" {
+			qs.Set("categories", qsv)
+		}
+	}
+
+	var hostIDQ string
+	if o.HostID != nil {
+		hostIDQ = o.HostID.String()
+	}
+	if hostIDQ != "" {
+		qs.Set("host_id", hostIDQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *StreamEventsURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *StreamEventsURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *StreamEventsURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on StreamEventsURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on StreamEventsURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *StreamEventsURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package history
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListClusterStatusHistoryHandlerFunc turns a function with the right signature into a list cluster status history handler
+type ListClusterStatusHistoryHandlerFunc func(ListClusterStatusHistoryParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListClusterStatusHistoryHandlerFunc) Handle(params ListClusterStatusHistoryParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListClusterStatusHistoryHandler interface for that can handle valid list cluster status history params
+type ListClusterStatusHistoryHandler interface {
+	Handle(ListClusterStatusHistoryParams, interface{}) middleware.Responder
+}
+
+// NewListClusterStatusHistory creates a new http.Handler for the list cluster status history operation
+func NewListClusterStatusHistory(ctx *middleware.Context, handler ListClusterStatusHistoryHandler) *ListClusterStatusHistory {
+	return &ListClusterStatusHistory{Context: ctx, Handler: handler}
+}
+
+/*ListClusterStatusHistory swagger:route GET /clusters/{cluster_id}/history history listClusterStatusHistory
+
+Retrieves the status changes of a cluster in the order they occurred.
+
+*/
+type ListClusterStatusHistory struct {
+	Context *middleware.Context
+	Handler ListClusterStatusHistoryHandler
+}
+
+func (o *ListClusterStatusHistory) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListClusterStatusHistoryParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
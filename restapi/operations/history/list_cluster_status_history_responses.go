@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package history
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListClusterStatusHistoryOKCode is the HTTP code returned for type ListClusterStatusHistoryOK
+const ListClusterStatusHistoryOKCode int = 200
+
+/*ListClusterStatusHistoryOK Success.
+
+swagger:response listClusterStatusHistoryOK
+*/
+type ListClusterStatusHistoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.StatusHistory `json:"body,omitempty"`
+}
+
+// NewListClusterStatusHistoryOK creates ListClusterStatusHistoryOK with default headers values
+func NewListClusterStatusHistoryOK() *ListClusterStatusHistoryOK {
+
+	return &ListClusterStatusHistoryOK{}
+}
+
+// WithPayload adds the payload to the list cluster status history o k response
+func (o *ListClusterStatusHistoryOK) WithPayload(payload models.StatusHistory) *ListClusterStatusHistoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster status history o k response
+func (o *ListClusterStatusHistoryOK) SetPayload(payload models.StatusHistory) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterStatusHistoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.StatusHistory{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ListClusterStatusHistoryUnauthorizedCode is the HTTP code returned for type ListClusterStatusHistoryUnauthorized
+const ListClusterStatusHistoryUnauthorizedCode int = 401
+
+/*ListClusterStatusHistoryUnauthorized Unauthorized.
+
+swagger:response listClusterStatusHistoryUnauthorized
+*/
+type ListClusterStatusHistoryUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListClusterStatusHistoryUnauthorized creates ListClusterStatusHistoryUnauthorized with default headers values
+func NewListClusterStatusHistoryUnauthorized() *ListClusterStatusHistoryUnauthorized {
+
+	return &ListClusterStatusHistoryUnauthorized{}
+}
+
+// WithPayload adds the payload to the list cluster status history unauthorized response
+func (o *ListClusterStatusHistoryUnauthorized) WithPayload(payload *models.InfraError) *ListClusterStatusHistoryUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster status history unauthorized response
+func (o *ListClusterStatusHistoryUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterStatusHistoryUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListClusterStatusHistoryForbiddenCode is the HTTP code returned for type ListClusterStatusHistoryForbidden
+const ListClusterStatusHistoryForbiddenCode int = 403
+
+/*ListClusterStatusHistoryForbidden Forbidden.
+
+swagger:response listClusterStatusHistoryForbidden
+*/
+type ListClusterStatusHistoryForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListClusterStatusHistoryForbidden creates ListClusterStatusHistoryForbidden with default headers values
+func NewListClusterStatusHistoryForbidden() *ListClusterStatusHistoryForbidden {
+
+	return &ListClusterStatusHistoryForbidden{}
+}
+
+// WithPayload adds the payload to the list cluster status history forbidden response
+func (o *ListClusterStatusHistoryForbidden) WithPayload(payload *models.InfraError) *ListClusterStatusHistoryForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster status history forbidden response
+func (o *ListClusterStatusHistoryForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterStatusHistoryForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListClusterStatusHistoryNotFoundCode is the HTTP code returned for type ListClusterStatusHistoryNotFound
+const ListClusterStatusHistoryNotFoundCode int = 404
+
+/*ListClusterStatusHistoryNotFound Error.
+
+swagger:response listClusterStatusHistoryNotFound
+*/
+type ListClusterStatusHistoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListClusterStatusHistoryNotFound creates ListClusterStatusHistoryNotFound with default headers values
+func NewListClusterStatusHistoryNotFound() *ListClusterStatusHistoryNotFound {
+
+	return &ListClusterStatusHistoryNotFound{}
+}
+
+// WithPayload adds the payload to the list cluster status history not found response
+func (o *ListClusterStatusHistoryNotFound) WithPayload(payload *models.Error) *ListClusterStatusHistoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster status history not found response
+func (o *ListClusterStatusHistoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterStatusHistoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListClusterStatusHistoryMethodNotAllowedCode is the HTTP code returned for type ListClusterStatusHistoryMethodNotAllowed
+const ListClusterStatusHistoryMethodNotAllowedCode int = 405
+
+/*ListClusterStatusHistoryMethodNotAllowed Method Not Allowed.
+
+swagger:response listClusterStatusHistoryMethodNotAllowed
+*/
+type ListClusterStatusHistoryMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListClusterStatusHistoryMethodNotAllowed creates ListClusterStatusHistoryMethodNotAllowed with default headers values
+func NewListClusterStatusHistoryMethodNotAllowed() *ListClusterStatusHistoryMethodNotAllowed {
+
+	return &ListClusterStatusHistoryMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the list cluster status history method not allowed response
+func (o *ListClusterStatusHistoryMethodNotAllowed) WithPayload(payload *models.Error) *ListClusterStatusHistoryMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster status history method not allowed response
+func (o *ListClusterStatusHistoryMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterStatusHistoryMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListClusterStatusHistoryInternalServerErrorCode is the HTTP code returned for type ListClusterStatusHistoryInternalServerError
+const ListClusterStatusHistoryInternalServerErrorCode int = 500
+
+/*ListClusterStatusHistoryInternalServerError Error.
+
+swagger:response listClusterStatusHistoryInternalServerError
+*/
+type ListClusterStatusHistoryInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListClusterStatusHistoryInternalServerError creates ListClusterStatusHistoryInternalServerError with default headers values
+func NewListClusterStatusHistoryInternalServerError() *ListClusterStatusHistoryInternalServerError {
+
+	return &ListClusterStatusHistoryInternalServerError{}
+}
+
+// WithPayload adds the payload to the list cluster status history internal server error response
+func (o *ListClusterStatusHistoryInternalServerError) WithPayload(payload *models.Error) *ListClusterStatusHistoryInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster status history internal server error response
+func (o *ListClusterStatusHistoryInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterStatusHistoryInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
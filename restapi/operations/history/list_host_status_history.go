@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package history
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListHostStatusHistoryHandlerFunc turns a function with the right signature into a list host status history handler
+type ListHostStatusHistoryHandlerFunc func(ListHostStatusHistoryParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListHostStatusHistoryHandlerFunc) Handle(params ListHostStatusHistoryParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListHostStatusHistoryHandler interface for that can handle valid list host status history params
+type ListHostStatusHistoryHandler interface {
+	Handle(ListHostStatusHistoryParams, interface{}) middleware.Responder
+}
+
+// NewListHostStatusHistory creates a new http.Handler for the list host status history operation
+func NewListHostStatusHistory(ctx *middleware.Context, handler ListHostStatusHistoryHandler) *ListHostStatusHistory {
+	return &ListHostStatusHistory{Context: ctx, Handler: handler}
+}
+
+/*ListHostStatusHistory swagger:route GET /clusters/{cluster_id}/hosts/{host_id}/history history listHostStatusHistory
+
+Retrieves the status changes of a host in the order they occurred.
+
+*/
+type ListHostStatusHistory struct {
+	Context *middleware.Context
+	Handler ListHostStatusHistoryHandler
+}
+
+func (o *ListHostStatusHistory) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListHostStatusHistoryParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
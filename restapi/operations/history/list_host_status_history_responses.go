@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package history
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListHostStatusHistoryOKCode is the HTTP code returned for type ListHostStatusHistoryOK
+const ListHostStatusHistoryOKCode int = 200
+
+/*ListHostStatusHistoryOK Success.
+
+swagger:response listHostStatusHistoryOK
+*/
+type ListHostStatusHistoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.StatusHistory `json:"body,omitempty"`
+}
+
+// NewListHostStatusHistoryOK creates ListHostStatusHistoryOK with default headers values
+func NewListHostStatusHistoryOK() *ListHostStatusHistoryOK {
+
+	return &ListHostStatusHistoryOK{}
+}
+
+// WithPayload adds the payload to the list host status history o k response
+func (o *ListHostStatusHistoryOK) WithPayload(payload models.StatusHistory) *ListHostStatusHistoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list host status history o k response
+func (o *ListHostStatusHistoryOK) SetPayload(payload models.StatusHistory) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListHostStatusHistoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.StatusHistory{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ListHostStatusHistoryUnauthorizedCode is the HTTP code returned for type ListHostStatusHistoryUnauthorized
+const ListHostStatusHistoryUnauthorizedCode int = 401
+
+/*ListHostStatusHistoryUnauthorized Unauthorized.
+
+swagger:response listHostStatusHistoryUnauthorized
+*/
+type ListHostStatusHistoryUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListHostStatusHistoryUnauthorized creates ListHostStatusHistoryUnauthorized with default headers values
+func NewListHostStatusHistoryUnauthorized() *ListHostStatusHistoryUnauthorized {
+
+	return &ListHostStatusHistoryUnauthorized{}
+}
+
+// WithPayload adds the payload to the list host status history unauthorized response
+func (o *ListHostStatusHistoryUnauthorized) WithPayload(payload *models.InfraError) *ListHostStatusHistoryUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list host status history unauthorized response
+func (o *ListHostStatusHistoryUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListHostStatusHistoryUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListHostStatusHistoryForbiddenCode is the HTTP code returned for type ListHostStatusHistoryForbidden
+const ListHostStatusHistoryForbiddenCode int = 403
+
+/*ListHostStatusHistoryForbidden Forbidden.
+
+swagger:response listHostStatusHistoryForbidden
+*/
+type ListHostStatusHistoryForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListHostStatusHistoryForbidden creates ListHostStatusHistoryForbidden with default headers values
+func NewListHostStatusHistoryForbidden() *ListHostStatusHistoryForbidden {
+
+	return &ListHostStatusHistoryForbidden{}
+}
+
+// WithPayload adds the payload to the list host status history forbidden response
+func (o *ListHostStatusHistoryForbidden) WithPayload(payload *models.InfraError) *ListHostStatusHistoryForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list host status history forbidden response
+func (o *ListHostStatusHistoryForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListHostStatusHistoryForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListHostStatusHistoryNotFoundCode is the HTTP code returned for type ListHostStatusHistoryNotFound
+const ListHostStatusHistoryNotFoundCode int = 404
+
+/*ListHostStatusHistoryNotFound Error.
+
+swagger:response listHostStatusHistoryNotFound
+*/
+type ListHostStatusHistoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListHostStatusHistoryNotFound creates ListHostStatusHistoryNotFound with default headers values
+func NewListHostStatusHistoryNotFound() *ListHostStatusHistoryNotFound {
+
+	return &ListHostStatusHistoryNotFound{}
+}
+
+// WithPayload adds the payload to the list host status history not found response
+func (o *ListHostStatusHistoryNotFound) WithPayload(payload *models.Error) *ListHostStatusHistoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list host status history not found response
+func (o *ListHostStatusHistoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListHostStatusHistoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListHostStatusHistoryMethodNotAllowedCode is the HTTP code returned for type ListHostStatusHistoryMethodNotAllowed
+const ListHostStatusHistoryMethodNotAllowedCode int = 405
+
+/*ListHostStatusHistoryMethodNotAllowed Method Not Allowed.
+
+swagger:response listHostStatusHistoryMethodNotAllowed
+*/
+type ListHostStatusHistoryMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListHostStatusHistoryMethodNotAllowed creates ListHostStatusHistoryMethodNotAllowed with default headers values
+func NewListHostStatusHistoryMethodNotAllowed() *ListHostStatusHistoryMethodNotAllowed {
+
+	return &ListHostStatusHistoryMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the list host status history method not allowed response
+func (o *ListHostStatusHistoryMethodNotAllowed) WithPayload(payload *models.Error) *ListHostStatusHistoryMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list host status history method not allowed response
+func (o *ListHostStatusHistoryMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListHostStatusHistoryMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListHostStatusHistoryInternalServerErrorCode is the HTTP code returned for type ListHostStatusHistoryInternalServerError
+const ListHostStatusHistoryInternalServerErrorCode int = 500
+
+/*ListHostStatusHistoryInternalServerError Error.
+
+swagger:response listHostStatusHistoryInternalServerError
+*/
+type ListHostStatusHistoryInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListHostStatusHistoryInternalServerError creates ListHostStatusHistoryInternalServerError with default headers values
+func NewListHostStatusHistoryInternalServerError() *ListHostStatusHistoryInternalServerError {
+
+	return &ListHostStatusHistoryInternalServerError{}
+}
+
+// WithPayload adds the payload to the list host status history internal server error response
+func (o *ListHostStatusHistoryInternalServerError) WithPayload(payload *models.Error) *ListHostStatusHistoryInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list host status history internal server error response
+func (o *ListHostStatusHistoryInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListHostStatusHistoryInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
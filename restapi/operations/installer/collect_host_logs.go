@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CollectHostLogsHandlerFunc turns a function with the right signature into a collect host logs handler
+type CollectHostLogsHandlerFunc func(CollectHostLogsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CollectHostLogsHandlerFunc) Handle(params CollectHostLogsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CollectHostLogsHandler interface for that can handle valid collect host logs params
+type CollectHostLogsHandler interface {
+	Handle(CollectHostLogsParams, interface{}) middleware.Responder
+}
+
+// NewCollectHostLogs creates a new http.Handler for the collect host logs operation
+func NewCollectHostLogs(ctx *middleware.Context, handler CollectHostLogsHandler) *CollectHostLogs {
+	return &CollectHostLogs{Context: ctx, Handler: handler}
+}
+
+/*CollectHostLogs swagger:route POST /clusters/{cluster_id}/hosts/{host_id}/actions/collect-logs installer collectHostLogs
+
+Requests the agent of the host to collect and upload its logs, whatever the status of the host is.
+
+*/
+type CollectHostLogs struct {
+	Context *middleware.Context
+	Handler CollectHostLogsHandler
+}
+
+func (o *CollectHostLogs) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCollectHostLogsParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
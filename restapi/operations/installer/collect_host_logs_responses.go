@@ -0,0 +1,322 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// CollectHostLogsAcceptedCode is the HTTP code returned for type CollectHostLogsAccepted
+const CollectHostLogsAcceptedCode int = 202
+
+/*CollectHostLogsAccepted Success.
+
+swagger:response collectHostLogsAccepted
+*/
+type CollectHostLogsAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Host `json:"body,omitempty"`
+}
+
+// NewCollectHostLogsAccepted creates CollectHostLogsAccepted with default headers values
+func NewCollectHostLogsAccepted() *CollectHostLogsAccepted {
+
+	return &CollectHostLogsAccepted{}
+}
+
+// WithPayload adds the payload to the collect host logs accepted response
+func (o *CollectHostLogsAccepted) WithPayload(payload *models.Host) *CollectHostLogsAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the collect host logs accepted response
+func (o *CollectHostLogsAccepted) SetPayload(payload *models.Host) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CollectHostLogsAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CollectHostLogsBadRequestCode is the HTTP code returned for type CollectHostLogsBadRequest
+const CollectHostLogsBadRequestCode int = 400
+
+/*CollectHostLogsBadRequest Error.
+
+swagger:response collectHostLogsBadRequest
+*/
+type CollectHostLogsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCollectHostLogsBadRequest creates CollectHostLogsBadRequest with default headers values
+func NewCollectHostLogsBadRequest() *CollectHostLogsBadRequest {
+
+	return &CollectHostLogsBadRequest{}
+}
+
+// WithPayload adds the payload to the collect host logs bad request response
+func (o *CollectHostLogsBadRequest) WithPayload(payload *models.Error) *CollectHostLogsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the collect host logs bad request response
+func (o *CollectHostLogsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CollectHostLogsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CollectHostLogsUnauthorizedCode is the HTTP code returned for type CollectHostLogsUnauthorized
+const CollectHostLogsUnauthorizedCode int = 401
+
+/*CollectHostLogsUnauthorized Unauthorized.
+
+swagger:response collectHostLogsUnauthorized
+*/
+type CollectHostLogsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewCollectHostLogsUnauthorized creates CollectHostLogsUnauthorized with default headers values
+func NewCollectHostLogsUnauthorized() *CollectHostLogsUnauthorized {
+
+	return &CollectHostLogsUnauthorized{}
+}
+
+// WithPayload adds the payload to the collect host logs unauthorized response
+func (o *CollectHostLogsUnauthorized) WithPayload(payload *models.InfraError) *CollectHostLogsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the collect host logs unauthorized response
+func (o *CollectHostLogsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CollectHostLogsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CollectHostLogsForbiddenCode is the HTTP code returned for type CollectHostLogsForbidden
+const CollectHostLogsForbiddenCode int = 403
+
+/*CollectHostLogsForbidden Forbidden.
+
+swagger:response collectHostLogsForbidden
+*/
+type CollectHostLogsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewCollectHostLogsForbidden creates CollectHostLogsForbidden with default headers values
+func NewCollectHostLogsForbidden() *CollectHostLogsForbidden {
+
+	return &CollectHostLogsForbidden{}
+}
+
+// WithPayload adds the payload to the collect host logs forbidden response
+func (o *CollectHostLogsForbidden) WithPayload(payload *models.InfraError) *CollectHostLogsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the collect host logs forbidden response
+func (o *CollectHostLogsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CollectHostLogsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CollectHostLogsNotFoundCode is the HTTP code returned for type CollectHostLogsNotFound
+const CollectHostLogsNotFoundCode int = 404
+
+/*CollectHostLogsNotFound Error.
+
+swagger:response collectHostLogsNotFound
+*/
+type CollectHostLogsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCollectHostLogsNotFound creates CollectHostLogsNotFound with default headers values
+func NewCollectHostLogsNotFound() *CollectHostLogsNotFound {
+
+	return &CollectHostLogsNotFound{}
+}
+
+// WithPayload adds the payload to the collect host logs not found response
+func (o *CollectHostLogsNotFound) WithPayload(payload *models.Error) *CollectHostLogsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the collect host logs not found response
+func (o *CollectHostLogsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CollectHostLogsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CollectHostLogsConflictCode is the HTTP code returned for type CollectHostLogsConflict
+const CollectHostLogsConflictCode int = 409
+
+/*CollectHostLogsConflict Error.
+
+swagger:response collectHostLogsConflict
+*/
+type CollectHostLogsConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCollectHostLogsConflict creates CollectHostLogsConflict with default headers values
+func NewCollectHostLogsConflict() *CollectHostLogsConflict {
+
+	return &CollectHostLogsConflict{}
+}
+
+// WithPayload adds the payload to the collect host logs conflict response
+func (o *CollectHostLogsConflict) WithPayload(payload *models.Error) *CollectHostLogsConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the collect host logs conflict response
+func (o *CollectHostLogsConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CollectHostLogsConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CollectHostLogsInternalServerErrorCode is the HTTP code returned for type CollectHostLogsInternalServerError
+const CollectHostLogsInternalServerErrorCode int = 500
+
+/*CollectHostLogsInternalServerError Error.
+
+swagger:response collectHostLogsInternalServerError
+*/
+type CollectHostLogsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCollectHostLogsInternalServerError creates CollectHostLogsInternalServerError with default headers values
+func NewCollectHostLogsInternalServerError() *CollectHostLogsInternalServerError {
+
+	return &CollectHostLogsInternalServerError{}
+}
+
+// WithPayload adds the payload to the collect host logs internal server error response
+func (o *CollectHostLogsInternalServerError) WithPayload(payload *models.Error) *CollectHostLogsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the collect host logs internal server error response
+func (o *CollectHostLogsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CollectHostLogsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DryRunClusterHandlerFunc turns a function with the right signature into a dry run cluster handler
+type DryRunClusterHandlerFunc func(DryRunClusterParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DryRunClusterHandlerFunc) Handle(params DryRunClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DryRunClusterHandler interface for that can handle valid dry run cluster params
+type DryRunClusterHandler interface {
+	Handle(DryRunClusterParams, interface{}) middleware.Responder
+}
+
+// NewDryRunCluster creates a new http.Handler for the dry run cluster operation
+func NewDryRunCluster(ctx *middleware.Context, handler DryRunClusterHandler) *DryRunCluster {
+	return &DryRunCluster{Context: ctx, Handler: handler}
+}
+
+/*DryRunCluster swagger:route POST /clusters/{cluster_id}/actions/dry-run installer dryRunCluster
+
+Renders the install config, manifests and ignitions of the cluster without installing it, and returns them as a tar.gz bundle with their secrets redacted. The cluster and its hosts are not modified.
+
+*/
+type DryRunCluster struct {
+	Context *middleware.Context
+	Handler DryRunClusterHandler
+}
+
+func (o *DryRunCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewDryRunClusterParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,321 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// DryRunClusterOKCode is the HTTP code returned for type DryRunClusterOK
+const DryRunClusterOKCode int = 200
+
+/*DryRunClusterOK Success.
+
+swagger:response dryRunClusterOK
+*/
+type DryRunClusterOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewDryRunClusterOK creates DryRunClusterOK with default headers values
+func NewDryRunClusterOK() *DryRunClusterOK {
+
+	return &DryRunClusterOK{}
+}
+
+// WithPayload adds the payload to the dry run cluster o k response
+func (o *DryRunClusterOK) WithPayload(payload io.ReadCloser) *DryRunClusterOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the dry run cluster o k response
+func (o *DryRunClusterOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DryRunClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// DryRunClusterUnauthorizedCode is the HTTP code returned for type DryRunClusterUnauthorized
+const DryRunClusterUnauthorizedCode int = 401
+
+/*DryRunClusterUnauthorized Unauthorized.
+
+swagger:response dryRunClusterUnauthorized
+*/
+type DryRunClusterUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewDryRunClusterUnauthorized creates DryRunClusterUnauthorized with default headers values
+func NewDryRunClusterUnauthorized() *DryRunClusterUnauthorized {
+
+	return &DryRunClusterUnauthorized{}
+}
+
+// WithPayload adds the payload to the dry run cluster unauthorized response
+func (o *DryRunClusterUnauthorized) WithPayload(payload *models.InfraError) *DryRunClusterUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the dry run cluster unauthorized response
+func (o *DryRunClusterUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DryRunClusterUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DryRunClusterForbiddenCode is the HTTP code returned for type DryRunClusterForbidden
+const DryRunClusterForbiddenCode int = 403
+
+/*DryRunClusterForbidden Forbidden.
+
+swagger:response dryRunClusterForbidden
+*/
+type DryRunClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewDryRunClusterForbidden creates DryRunClusterForbidden with default headers values
+func NewDryRunClusterForbidden() *DryRunClusterForbidden {
+
+	return &DryRunClusterForbidden{}
+}
+
+// WithPayload adds the payload to the dry run cluster forbidden response
+func (o *DryRunClusterForbidden) WithPayload(payload *models.InfraError) *DryRunClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the dry run cluster forbidden response
+func (o *DryRunClusterForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DryRunClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DryRunClusterNotFoundCode is the HTTP code returned for type DryRunClusterNotFound
+const DryRunClusterNotFoundCode int = 404
+
+/*DryRunClusterNotFound Error.
+
+swagger:response dryRunClusterNotFound
+*/
+type DryRunClusterNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDryRunClusterNotFound creates DryRunClusterNotFound with default headers values
+func NewDryRunClusterNotFound() *DryRunClusterNotFound {
+
+	return &DryRunClusterNotFound{}
+}
+
+// WithPayload adds the payload to the dry run cluster not found response
+func (o *DryRunClusterNotFound) WithPayload(payload *models.Error) *DryRunClusterNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the dry run cluster not found response
+func (o *DryRunClusterNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DryRunClusterNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DryRunClusterMethodNotAllowedCode is the HTTP code returned for type DryRunClusterMethodNotAllowed
+const DryRunClusterMethodNotAllowedCode int = 405
+
+/*DryRunClusterMethodNotAllowed Method Not Allowed.
+
+swagger:response dryRunClusterMethodNotAllowed
+*/
+type DryRunClusterMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDryRunClusterMethodNotAllowed creates DryRunClusterMethodNotAllowed with default headers values
+func NewDryRunClusterMethodNotAllowed() *DryRunClusterMethodNotAllowed {
+
+	return &DryRunClusterMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the dry run cluster method not allowed response
+func (o *DryRunClusterMethodNotAllowed) WithPayload(payload *models.Error) *DryRunClusterMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the dry run cluster method not allowed response
+func (o *DryRunClusterMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DryRunClusterMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DryRunClusterConflictCode is the HTTP code returned for type DryRunClusterConflict
+const DryRunClusterConflictCode int = 409
+
+/*DryRunClusterConflict Error.
+
+swagger:response dryRunClusterConflict
+*/
+type DryRunClusterConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDryRunClusterConflict creates DryRunClusterConflict with default headers values
+func NewDryRunClusterConflict() *DryRunClusterConflict {
+
+	return &DryRunClusterConflict{}
+}
+
+// WithPayload adds the payload to the dry run cluster conflict response
+func (o *DryRunClusterConflict) WithPayload(payload *models.Error) *DryRunClusterConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the dry run cluster conflict response
+func (o *DryRunClusterConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DryRunClusterConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DryRunClusterInternalServerErrorCode is the HTTP code returned for type DryRunClusterInternalServerError
+const DryRunClusterInternalServerErrorCode int = 500
+
+/*DryRunClusterInternalServerError Error.
+
+swagger:response dryRunClusterInternalServerError
+*/
+type DryRunClusterInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDryRunClusterInternalServerError creates DryRunClusterInternalServerError with default headers values
+func NewDryRunClusterInternalServerError() *DryRunClusterInternalServerError {
+
+	return &DryRunClusterInternalServerError{}
+}
+
+// WithPayload adds the payload to the dry run cluster internal server error response
+func (o *DryRunClusterInternalServerError) WithPayload(payload *models.Error) *DryRunClusterInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the dry run cluster internal server error response
+func (o *DryRunClusterInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DryRunClusterInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
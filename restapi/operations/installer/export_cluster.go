@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ExportClusterHandlerFunc turns a function with the right signature into a export cluster handler
+type ExportClusterHandlerFunc func(ExportClusterParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ExportClusterHandlerFunc) Handle(params ExportClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ExportClusterHandler interface for that can handle valid export cluster params
+type ExportClusterHandler interface {
+	Handle(ExportClusterParams, interface{}) middleware.Responder
+}
+
+// NewExportCluster creates a new http.Handler for the export cluster operation
+func NewExportCluster(ctx *middleware.Context, handler ExportClusterHandler) *ExportCluster {
+	return &ExportCluster{Context: ctx, Handler: handler}
+}
+
+/*ExportCluster swagger:route GET /clusters/{cluster_id}/export installer exportCluster
+
+Exports the definition of the cluster as a portable bundle that can be imported by this or another assisted-service instance. The pull secret of the cluster is not exported.
+
+*/
+type ExportCluster struct {
+	Context *middleware.Context
+	Handler ExportClusterHandler
+}
+
+func (o *ExportCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewExportClusterParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
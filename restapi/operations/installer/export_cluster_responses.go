@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ExportClusterOKCode is the HTTP code returned for type ExportClusterOK
+const ExportClusterOKCode int = 200
+
+/*ExportClusterOK Success.
+
+swagger:response exportClusterOK
+*/
+type ExportClusterOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterExport `json:"body,omitempty"`
+}
+
+// NewExportClusterOK creates ExportClusterOK with default headers values
+func NewExportClusterOK() *ExportClusterOK {
+
+	return &ExportClusterOK{}
+}
+
+// WithPayload adds the payload to the export cluster o k response
+func (o *ExportClusterOK) WithPayload(payload *models.ClusterExport) *ExportClusterOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export cluster o k response
+func (o *ExportClusterOK) SetPayload(payload *models.ClusterExport) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportClusterUnauthorizedCode is the HTTP code returned for type ExportClusterUnauthorized
+const ExportClusterUnauthorizedCode int = 401
+
+/*ExportClusterUnauthorized Unauthorized.
+
+swagger:response exportClusterUnauthorized
+*/
+type ExportClusterUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewExportClusterUnauthorized creates ExportClusterUnauthorized with default headers values
+func NewExportClusterUnauthorized() *ExportClusterUnauthorized {
+
+	return &ExportClusterUnauthorized{}
+}
+
+// WithPayload adds the payload to the export cluster unauthorized response
+func (o *ExportClusterUnauthorized) WithPayload(payload *models.InfraError) *ExportClusterUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export cluster unauthorized response
+func (o *ExportClusterUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportClusterUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportClusterForbiddenCode is the HTTP code returned for type ExportClusterForbidden
+const ExportClusterForbiddenCode int = 403
+
+/*ExportClusterForbidden Forbidden.
+
+swagger:response exportClusterForbidden
+*/
+type ExportClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewExportClusterForbidden creates ExportClusterForbidden with default headers values
+func NewExportClusterForbidden() *ExportClusterForbidden {
+
+	return &ExportClusterForbidden{}
+}
+
+// WithPayload adds the payload to the export cluster forbidden response
+func (o *ExportClusterForbidden) WithPayload(payload *models.InfraError) *ExportClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export cluster forbidden response
+func (o *ExportClusterForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportClusterNotFoundCode is the HTTP code returned for type ExportClusterNotFound
+const ExportClusterNotFoundCode int = 404
+
+/*ExportClusterNotFound Error.
+
+swagger:response exportClusterNotFound
+*/
+type ExportClusterNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewExportClusterNotFound creates ExportClusterNotFound with default headers values
+func NewExportClusterNotFound() *ExportClusterNotFound {
+
+	return &ExportClusterNotFound{}
+}
+
+// WithPayload adds the payload to the export cluster not found response
+func (o *ExportClusterNotFound) WithPayload(payload *models.Error) *ExportClusterNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export cluster not found response
+func (o *ExportClusterNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportClusterNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportClusterMethodNotAllowedCode is the HTTP code returned for type ExportClusterMethodNotAllowed
+const ExportClusterMethodNotAllowedCode int = 405
+
+/*ExportClusterMethodNotAllowed Method Not Allowed.
+
+swagger:response exportClusterMethodNotAllowed
+*/
+type ExportClusterMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewExportClusterMethodNotAllowed creates ExportClusterMethodNotAllowed with default headers values
+func NewExportClusterMethodNotAllowed() *ExportClusterMethodNotAllowed {
+
+	return &ExportClusterMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the export cluster method not allowed response
+func (o *ExportClusterMethodNotAllowed) WithPayload(payload *models.Error) *ExportClusterMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export cluster method not allowed response
+func (o *ExportClusterMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportClusterMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportClusterInternalServerErrorCode is the HTTP code returned for type ExportClusterInternalServerError
+const ExportClusterInternalServerErrorCode int = 500
+
+/*ExportClusterInternalServerError Error.
+
+swagger:response exportClusterInternalServerError
+*/
+type ExportClusterInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewExportClusterInternalServerError creates ExportClusterInternalServerError with default headers values
+func NewExportClusterInternalServerError() *ExportClusterInternalServerError {
+
+	return &ExportClusterInternalServerError{}
+}
+
+// WithPayload adds the payload to the export cluster internal server error response
+func (o *ExportClusterInternalServerError) WithPayload(payload *models.Error) *ExportClusterInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export cluster internal server error response
+func (o *ExportClusterInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportClusterInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
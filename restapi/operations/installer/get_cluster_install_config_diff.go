@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetClusterInstallConfigDiffHandlerFunc turns a function with the right signature into a get cluster install config diff handler
+type GetClusterInstallConfigDiffHandlerFunc func(GetClusterInstallConfigDiffParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetClusterInstallConfigDiffHandlerFunc) Handle(params GetClusterInstallConfigDiffParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetClusterInstallConfigDiffHandler interface for that can handle valid get cluster install config diff params
+type GetClusterInstallConfigDiffHandler interface {
+	Handle(GetClusterInstallConfigDiffParams, interface{}) middleware.Responder
+}
+
+// NewGetClusterInstallConfigDiff creates a new http.Handler for the get cluster install config diff operation
+func NewGetClusterInstallConfigDiff(ctx *middleware.Context, handler GetClusterInstallConfigDiffHandler) *GetClusterInstallConfigDiff {
+	return &GetClusterInstallConfigDiff{Context: ctx, Handler: handler}
+}
+
+/*GetClusterInstallConfigDiff swagger:route GET /clusters/{cluster_id}/install-config/diff installer getClusterInstallConfigDiff
+
+Get the differences between the install config that the service generates for the cluster and the effective install config after the install config overrides of the cluster.
+
+*/
+type GetClusterInstallConfigDiff struct {
+	Context *middleware.Context
+	Handler GetClusterInstallConfigDiffHandler
+}
+
+func (o *GetClusterInstallConfigDiff) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetClusterInstallConfigDiffParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetClusterInstallConfigDiffOKCode is the HTTP code returned for type GetClusterInstallConfigDiffOK
+const GetClusterInstallConfigDiffOKCode int = 200
+
+/*GetClusterInstallConfigDiffOK Success.
+
+swagger:response getClusterInstallConfigDiffOK
+*/
+type GetClusterInstallConfigDiffOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ConfigDiff `json:"body,omitempty"`
+}
+
+// NewGetClusterInstallConfigDiffOK creates GetClusterInstallConfigDiffOK with default headers values
+func NewGetClusterInstallConfigDiffOK() *GetClusterInstallConfigDiffOK {
+
+	return &GetClusterInstallConfigDiffOK{}
+}
+
+// WithPayload adds the payload to the get cluster install config diff o k response
+func (o *GetClusterInstallConfigDiffOK) WithPayload(payload *models.ConfigDiff) *GetClusterInstallConfigDiffOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster install config diff o k response
+func (o *GetClusterInstallConfigDiffOK) SetPayload(payload *models.ConfigDiff) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterInstallConfigDiffOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterInstallConfigDiffUnauthorizedCode is the HTTP code returned for type GetClusterInstallConfigDiffUnauthorized
+const GetClusterInstallConfigDiffUnauthorizedCode int = 401
+
+/*GetClusterInstallConfigDiffUnauthorized Unauthorized.
+
+swagger:response getClusterInstallConfigDiffUnauthorized
+*/
+type GetClusterInstallConfigDiffUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetClusterInstallConfigDiffUnauthorized creates GetClusterInstallConfigDiffUnauthorized with default headers values
+func NewGetClusterInstallConfigDiffUnauthorized() *GetClusterInstallConfigDiffUnauthorized {
+
+	return &GetClusterInstallConfigDiffUnauthorized{}
+}
+
+// WithPayload adds the payload to the get cluster install config diff unauthorized response
+func (o *GetClusterInstallConfigDiffUnauthorized) WithPayload(payload *models.InfraError) *GetClusterInstallConfigDiffUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster install config diff unauthorized response
+func (o *GetClusterInstallConfigDiffUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterInstallConfigDiffUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterInstallConfigDiffForbiddenCode is the HTTP code returned for type GetClusterInstallConfigDiffForbidden
+const GetClusterInstallConfigDiffForbiddenCode int = 403
+
+/*GetClusterInstallConfigDiffForbidden Forbidden.
+
+swagger:response getClusterInstallConfigDiffForbidden
+*/
+type GetClusterInstallConfigDiffForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetClusterInstallConfigDiffForbidden creates GetClusterInstallConfigDiffForbidden with default headers values
+func NewGetClusterInstallConfigDiffForbidden() *GetClusterInstallConfigDiffForbidden {
+
+	return &GetClusterInstallConfigDiffForbidden{}
+}
+
+// WithPayload adds the payload to the get cluster install config diff forbidden response
+func (o *GetClusterInstallConfigDiffForbidden) WithPayload(payload *models.InfraError) *GetClusterInstallConfigDiffForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster install config diff forbidden response
+func (o *GetClusterInstallConfigDiffForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterInstallConfigDiffForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterInstallConfigDiffNotFoundCode is the HTTP code returned for type GetClusterInstallConfigDiffNotFound
+const GetClusterInstallConfigDiffNotFoundCode int = 404
+
+/*GetClusterInstallConfigDiffNotFound Error.
+
+swagger:response getClusterInstallConfigDiffNotFound
+*/
+type GetClusterInstallConfigDiffNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterInstallConfigDiffNotFound creates GetClusterInstallConfigDiffNotFound with default headers values
+func NewGetClusterInstallConfigDiffNotFound() *GetClusterInstallConfigDiffNotFound {
+
+	return &GetClusterInstallConfigDiffNotFound{}
+}
+
+// WithPayload adds the payload to the get cluster install config diff not found response
+func (o *GetClusterInstallConfigDiffNotFound) WithPayload(payload *models.Error) *GetClusterInstallConfigDiffNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster install config diff not found response
+func (o *GetClusterInstallConfigDiffNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterInstallConfigDiffNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterInstallConfigDiffMethodNotAllowedCode is the HTTP code returned for type GetClusterInstallConfigDiffMethodNotAllowed
+const GetClusterInstallConfigDiffMethodNotAllowedCode int = 405
+
+/*GetClusterInstallConfigDiffMethodNotAllowed Method Not Allowed.
+
+swagger:response getClusterInstallConfigDiffMethodNotAllowed
+*/
+type GetClusterInstallConfigDiffMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterInstallConfigDiffMethodNotAllowed creates GetClusterInstallConfigDiffMethodNotAllowed with default headers values
+func NewGetClusterInstallConfigDiffMethodNotAllowed() *GetClusterInstallConfigDiffMethodNotAllowed {
+
+	return &GetClusterInstallConfigDiffMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the get cluster install config diff method not allowed response
+func (o *GetClusterInstallConfigDiffMethodNotAllowed) WithPayload(payload *models.Error) *GetClusterInstallConfigDiffMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster install config diff method not allowed response
+func (o *GetClusterInstallConfigDiffMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterInstallConfigDiffMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterInstallConfigDiffInternalServerErrorCode is the HTTP code returned for type GetClusterInstallConfigDiffInternalServerError
+const GetClusterInstallConfigDiffInternalServerErrorCode int = 500
+
+/*GetClusterInstallConfigDiffInternalServerError Error.
+
+swagger:response getClusterInstallConfigDiffInternalServerError
+*/
+type GetClusterInstallConfigDiffInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterInstallConfigDiffInternalServerError creates GetClusterInstallConfigDiffInternalServerError with default headers values
+func NewGetClusterInstallConfigDiffInternalServerError() *GetClusterInstallConfigDiffInternalServerError {
+
+	return &GetClusterInstallConfigDiffInternalServerError{}
+}
+
+// WithPayload adds the payload to the get cluster install config diff internal server error response
+func (o *GetClusterInstallConfigDiffInternalServerError) WithPayload(payload *models.Error) *GetClusterInstallConfigDiffInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster install config diff internal server error response
+func (o *GetClusterInstallConfigDiffInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterInstallConfigDiffInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
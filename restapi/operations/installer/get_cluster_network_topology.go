@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetClusterNetworkTopologyHandlerFunc turns a function with the right signature into a get cluster network topology handler
+type GetClusterNetworkTopologyHandlerFunc func(GetClusterNetworkTopologyParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetClusterNetworkTopologyHandlerFunc) Handle(params GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetClusterNetworkTopologyHandler interface for that can handle valid get cluster network topology params
+type GetClusterNetworkTopologyHandler interface {
+	Handle(GetClusterNetworkTopologyParams, interface{}) middleware.Responder
+}
+
+// NewGetClusterNetworkTopology creates a new http.Handler for the get cluster network topology operation
+func NewGetClusterNetworkTopology(ctx *middleware.Context, handler GetClusterNetworkTopologyHandler) *GetClusterNetworkTopology {
+	return &GetClusterNetworkTopology{Context: ctx, Handler: handler}
+}
+
+/*GetClusterNetworkTopology swagger:route GET /clusters/{cluster_id}/network-topology installer getClusterNetworkTopology
+
+Retrieves the network topology of the cluster as the connectivity checks of its hosts see it.
+
+*/
+type GetClusterNetworkTopology struct {
+	Context *middleware.Context
+	Handler GetClusterNetworkTopologyHandler
+}
+
+func (o *GetClusterNetworkTopology) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetClusterNetworkTopologyParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
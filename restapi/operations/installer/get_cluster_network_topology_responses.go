@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetClusterNetworkTopologyOKCode is the HTTP code returned for type GetClusterNetworkTopologyOK
+const GetClusterNetworkTopologyOKCode int = 200
+
+/*GetClusterNetworkTopologyOK Success.
+
+swagger:response getClusterNetworkTopologyOK
+*/
+type GetClusterNetworkTopologyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NetworkTopology `json:"body,omitempty"`
+}
+
+// NewGetClusterNetworkTopologyOK creates GetClusterNetworkTopologyOK with default headers values
+func NewGetClusterNetworkTopologyOK() *GetClusterNetworkTopologyOK {
+
+	return &GetClusterNetworkTopologyOK{}
+}
+
+// WithPayload adds the payload to the get cluster network topology o k response
+func (o *GetClusterNetworkTopologyOK) WithPayload(payload *models.NetworkTopology) *GetClusterNetworkTopologyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster network topology o k response
+func (o *GetClusterNetworkTopologyOK) SetPayload(payload *models.NetworkTopology) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterNetworkTopologyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterNetworkTopologyUnauthorizedCode is the HTTP code returned for type GetClusterNetworkTopologyUnauthorized
+const GetClusterNetworkTopologyUnauthorizedCode int = 401
+
+/*GetClusterNetworkTopologyUnauthorized Unauthorized.
+
+swagger:response getClusterNetworkTopologyUnauthorized
+*/
+type GetClusterNetworkTopologyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetClusterNetworkTopologyUnauthorized creates GetClusterNetworkTopologyUnauthorized with default headers values
+func NewGetClusterNetworkTopologyUnauthorized() *GetClusterNetworkTopologyUnauthorized {
+
+	return &GetClusterNetworkTopologyUnauthorized{}
+}
+
+// WithPayload adds the payload to the get cluster network topology unauthorized response
+func (o *GetClusterNetworkTopologyUnauthorized) WithPayload(payload *models.InfraError) *GetClusterNetworkTopologyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster network topology unauthorized response
+func (o *GetClusterNetworkTopologyUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterNetworkTopologyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterNetworkTopologyForbiddenCode is the HTTP code returned for type GetClusterNetworkTopologyForbidden
+const GetClusterNetworkTopologyForbiddenCode int = 403
+
+/*GetClusterNetworkTopologyForbidden Forbidden.
+
+swagger:response getClusterNetworkTopologyForbidden
+*/
+type GetClusterNetworkTopologyForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetClusterNetworkTopologyForbidden creates GetClusterNetworkTopologyForbidden with default headers values
+func NewGetClusterNetworkTopologyForbidden() *GetClusterNetworkTopologyForbidden {
+
+	return &GetClusterNetworkTopologyForbidden{}
+}
+
+// WithPayload adds the payload to the get cluster network topology forbidden response
+func (o *GetClusterNetworkTopologyForbidden) WithPayload(payload *models.InfraError) *GetClusterNetworkTopologyForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster network topology forbidden response
+func (o *GetClusterNetworkTopologyForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterNetworkTopologyForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterNetworkTopologyNotFoundCode is the HTTP code returned for type GetClusterNetworkTopologyNotFound
+const GetClusterNetworkTopologyNotFoundCode int = 404
+
+/*GetClusterNetworkTopologyNotFound Error.
+
+swagger:response getClusterNetworkTopologyNotFound
+*/
+type GetClusterNetworkTopologyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterNetworkTopologyNotFound creates GetClusterNetworkTopologyNotFound with default headers values
+func NewGetClusterNetworkTopologyNotFound() *GetClusterNetworkTopologyNotFound {
+
+	return &GetClusterNetworkTopologyNotFound{}
+}
+
+// WithPayload adds the payload to the get cluster network topology not found response
+func (o *GetClusterNetworkTopologyNotFound) WithPayload(payload *models.Error) *GetClusterNetworkTopologyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster network topology not found response
+func (o *GetClusterNetworkTopologyNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterNetworkTopologyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterNetworkTopologyMethodNotAllowedCode is the HTTP code returned for type GetClusterNetworkTopologyMethodNotAllowed
+const GetClusterNetworkTopologyMethodNotAllowedCode int = 405
+
+/*GetClusterNetworkTopologyMethodNotAllowed Method Not Allowed.
+
+swagger:response getClusterNetworkTopologyMethodNotAllowed
+*/
+type GetClusterNetworkTopologyMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterNetworkTopologyMethodNotAllowed creates GetClusterNetworkTopologyMethodNotAllowed with default headers values
+func NewGetClusterNetworkTopologyMethodNotAllowed() *GetClusterNetworkTopologyMethodNotAllowed {
+
+	return &GetClusterNetworkTopologyMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the get cluster network topology method not allowed response
+func (o *GetClusterNetworkTopologyMethodNotAllowed) WithPayload(payload *models.Error) *GetClusterNetworkTopologyMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster network topology method not allowed response
+func (o *GetClusterNetworkTopologyMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterNetworkTopologyMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterNetworkTopologyInternalServerErrorCode is the HTTP code returned for type GetClusterNetworkTopologyInternalServerError
+const GetClusterNetworkTopologyInternalServerErrorCode int = 500
+
+/*GetClusterNetworkTopologyInternalServerError Error.
+
+swagger:response getClusterNetworkTopologyInternalServerError
+*/
+type GetClusterNetworkTopologyInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterNetworkTopologyInternalServerError creates GetClusterNetworkTopologyInternalServerError with default headers values
+func NewGetClusterNetworkTopologyInternalServerError() *GetClusterNetworkTopologyInternalServerError {
+
+	return &GetClusterNetworkTopologyInternalServerError{}
+}
+
+// WithPayload adds the payload to the get cluster network topology internal server error response
+func (o *GetClusterNetworkTopologyInternalServerError) WithPayload(payload *models.Error) *GetClusterNetworkTopologyInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster network topology internal server error response
+func (o *GetClusterNetworkTopologyInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterNetworkTopologyInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
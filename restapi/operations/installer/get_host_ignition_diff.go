@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetHostIgnitionDiffHandlerFunc turns a function with the right signature into a get host ignition diff handler
+type GetHostIgnitionDiffHandlerFunc func(GetHostIgnitionDiffParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetHostIgnitionDiffHandlerFunc) Handle(params GetHostIgnitionDiffParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetHostIgnitionDiffHandler interface for that can handle valid get host ignition diff params
+type GetHostIgnitionDiffHandler interface {
+	Handle(GetHostIgnitionDiffParams, interface{}) middleware.Responder
+}
+
+// NewGetHostIgnitionDiff creates a new http.Handler for the get host ignition diff operation
+func NewGetHostIgnitionDiff(ctx *middleware.Context, handler GetHostIgnitionDiffHandler) *GetHostIgnitionDiff {
+	return &GetHostIgnitionDiff{Context: ctx, Handler: handler}
+}
+
+/*GetHostIgnitionDiff swagger:route GET /clusters/{cluster_id}/hosts/{host_id}/ignition/diff installer getHostIgnitionDiff
+
+Get the differences between the ignition config that the service generates for the host and the effective ignition config after the ignition config overrides of the host. Until the installation assets of the cluster are generated, the generated ignition config only includes the values that the service sets for the host.
+
+*/
+type GetHostIgnitionDiff struct {
+	Context *middleware.Context
+	Handler GetHostIgnitionDiffHandler
+}
+
+func (o *GetHostIgnitionDiff) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetHostIgnitionDiffParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetHostIgnitionDiffOKCode is the HTTP code returned for type GetHostIgnitionDiffOK
+const GetHostIgnitionDiffOKCode int = 200
+
+/*GetHostIgnitionDiffOK Success.
+
+swagger:response getHostIgnitionDiffOK
+*/
+type GetHostIgnitionDiffOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ConfigDiff `json:"body,omitempty"`
+}
+
+// NewGetHostIgnitionDiffOK creates GetHostIgnitionDiffOK with default headers values
+func NewGetHostIgnitionDiffOK() *GetHostIgnitionDiffOK {
+
+	return &GetHostIgnitionDiffOK{}
+}
+
+// WithPayload adds the payload to the get host ignition diff o k response
+func (o *GetHostIgnitionDiffOK) WithPayload(payload *models.ConfigDiff) *GetHostIgnitionDiffOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get host ignition diff o k response
+func (o *GetHostIgnitionDiffOK) SetPayload(payload *models.ConfigDiff) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHostIgnitionDiffOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetHostIgnitionDiffUnauthorizedCode is the HTTP code returned for type GetHostIgnitionDiffUnauthorized
+const GetHostIgnitionDiffUnauthorizedCode int = 401
+
+/*GetHostIgnitionDiffUnauthorized Unauthorized.
+
+swagger:response getHostIgnitionDiffUnauthorized
+*/
+type GetHostIgnitionDiffUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetHostIgnitionDiffUnauthorized creates GetHostIgnitionDiffUnauthorized with default headers values
+func NewGetHostIgnitionDiffUnauthorized() *GetHostIgnitionDiffUnauthorized {
+
+	return &GetHostIgnitionDiffUnauthorized{}
+}
+
+// WithPayload adds the payload to the get host ignition diff unauthorized response
+func (o *GetHostIgnitionDiffUnauthorized) WithPayload(payload *models.InfraError) *GetHostIgnitionDiffUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get host ignition diff unauthorized response
+func (o *GetHostIgnitionDiffUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHostIgnitionDiffUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetHostIgnitionDiffForbiddenCode is the HTTP code returned for type GetHostIgnitionDiffForbidden
+const GetHostIgnitionDiffForbiddenCode int = 403
+
+/*GetHostIgnitionDiffForbidden Forbidden.
+
+swagger:response getHostIgnitionDiffForbidden
+*/
+type GetHostIgnitionDiffForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetHostIgnitionDiffForbidden creates GetHostIgnitionDiffForbidden with default headers values
+func NewGetHostIgnitionDiffForbidden() *GetHostIgnitionDiffForbidden {
+
+	return &GetHostIgnitionDiffForbidden{}
+}
+
+// WithPayload adds the payload to the get host ignition diff forbidden response
+func (o *GetHostIgnitionDiffForbidden) WithPayload(payload *models.InfraError) *GetHostIgnitionDiffForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get host ignition diff forbidden response
+func (o *GetHostIgnitionDiffForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHostIgnitionDiffForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetHostIgnitionDiffNotFoundCode is the HTTP code returned for type GetHostIgnitionDiffNotFound
+const GetHostIgnitionDiffNotFoundCode int = 404
+
+/*GetHostIgnitionDiffNotFound Error.
+
+swagger:response getHostIgnitionDiffNotFound
+*/
+type GetHostIgnitionDiffNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetHostIgnitionDiffNotFound creates GetHostIgnitionDiffNotFound with default headers values
+func NewGetHostIgnitionDiffNotFound() *GetHostIgnitionDiffNotFound {
+
+	return &GetHostIgnitionDiffNotFound{}
+}
+
+// WithPayload adds the payload to the get host ignition diff not found response
+func (o *GetHostIgnitionDiffNotFound) WithPayload(payload *models.Error) *GetHostIgnitionDiffNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get host ignition diff not found response
+func (o *GetHostIgnitionDiffNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHostIgnitionDiffNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetHostIgnitionDiffMethodNotAllowedCode is the HTTP code returned for type GetHostIgnitionDiffMethodNotAllowed
+const GetHostIgnitionDiffMethodNotAllowedCode int = 405
+
+/*GetHostIgnitionDiffMethodNotAllowed Method Not Allowed.
+
+swagger:response getHostIgnitionDiffMethodNotAllowed
+*/
+type GetHostIgnitionDiffMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetHostIgnitionDiffMethodNotAllowed creates GetHostIgnitionDiffMethodNotAllowed with default headers values
+func NewGetHostIgnitionDiffMethodNotAllowed() *GetHostIgnitionDiffMethodNotAllowed {
+
+	return &GetHostIgnitionDiffMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the get host ignition diff method not allowed response
+func (o *GetHostIgnitionDiffMethodNotAllowed) WithPayload(payload *models.Error) *GetHostIgnitionDiffMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get host ignition diff method not allowed response
+func (o *GetHostIgnitionDiffMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHostIgnitionDiffMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetHostIgnitionDiffInternalServerErrorCode is the HTTP code returned for type GetHostIgnitionDiffInternalServerError
+const GetHostIgnitionDiffInternalServerErrorCode int = 500
+
+/*GetHostIgnitionDiffInternalServerError Error.
+
+swagger:response getHostIgnitionDiffInternalServerError
+*/
+type GetHostIgnitionDiffInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetHostIgnitionDiffInternalServerError creates GetHostIgnitionDiffInternalServerError with default headers values
+func NewGetHostIgnitionDiffInternalServerError() *GetHostIgnitionDiffInternalServerError {
+
+	return &GetHostIgnitionDiffInternalServerError{}
+}
+
+// WithPayload adds the payload to the get host ignition diff internal server error response
+func (o *GetHostIgnitionDiffInternalServerError) WithPayload(payload *models.Error) *GetHostIgnitionDiffInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get host ignition diff internal server error response
+func (o *GetHostIgnitionDiffInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHostIgnitionDiffInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
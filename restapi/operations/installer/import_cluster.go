@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ImportClusterHandlerFunc turns a function with the right signature into a import cluster handler
+type ImportClusterHandlerFunc func(ImportClusterParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ImportClusterHandlerFunc) Handle(params ImportClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ImportClusterHandler interface for that can handle valid import cluster params
+type ImportClusterHandler interface {
+	Handle(ImportClusterParams, interface{}) middleware.Responder
+}
+
+// NewImportCluster creates a new http.Handler for the import cluster operation
+func NewImportCluster(ctx *middleware.Context, handler ImportClusterHandler) *ImportCluster {
+	return &ImportCluster{Context: ctx, Handler: handler}
+}
+
+/*ImportCluster swagger:route POST /clusters/import installer importCluster
+
+Creates a new OpenShift cluster definition from a bundle exported by this or another assisted-service instance. The host assignments of the bundle are applied as hosts with matching MAC addresses or serial numbers register to the new cluster.
+
+*/
+type ImportCluster struct {
+	Context *middleware.Context
+	Handler ImportClusterHandler
+}
+
+func (o *ImportCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewImportClusterParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
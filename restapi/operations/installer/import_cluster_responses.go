@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ImportClusterCreatedCode is the HTTP code returned for type ImportClusterCreated
+const ImportClusterCreatedCode int = 201
+
+/*ImportClusterCreated Success.
+
+swagger:response importClusterCreated
+*/
+type ImportClusterCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Cluster `json:"body,omitempty"`
+}
+
+// NewImportClusterCreated creates ImportClusterCreated with default headers values
+func NewImportClusterCreated() *ImportClusterCreated {
+
+	return &ImportClusterCreated{}
+}
+
+// WithPayload adds the payload to the import cluster created response
+func (o *ImportClusterCreated) WithPayload(payload *models.Cluster) *ImportClusterCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import cluster created response
+func (o *ImportClusterCreated) SetPayload(payload *models.Cluster) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportClusterCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportClusterBadRequestCode is the HTTP code returned for type ImportClusterBadRequest
+const ImportClusterBadRequestCode int = 400
+
+/*ImportClusterBadRequest Error.
+
+swagger:response importClusterBadRequest
+*/
+type ImportClusterBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewImportClusterBadRequest creates ImportClusterBadRequest with default headers values
+func NewImportClusterBadRequest() *ImportClusterBadRequest {
+
+	return &ImportClusterBadRequest{}
+}
+
+// WithPayload adds the payload to the import cluster bad request response
+func (o *ImportClusterBadRequest) WithPayload(payload *models.Error) *ImportClusterBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import cluster bad request response
+func (o *ImportClusterBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportClusterBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportClusterUnauthorizedCode is the HTTP code returned for type ImportClusterUnauthorized
+const ImportClusterUnauthorizedCode int = 401
+
+/*ImportClusterUnauthorized Unauthorized.
+
+swagger:response importClusterUnauthorized
+*/
+type ImportClusterUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewImportClusterUnauthorized creates ImportClusterUnauthorized with default headers values
+func NewImportClusterUnauthorized() *ImportClusterUnauthorized {
+
+	return &ImportClusterUnauthorized{}
+}
+
+// WithPayload adds the payload to the import cluster unauthorized response
+func (o *ImportClusterUnauthorized) WithPayload(payload *models.InfraError) *ImportClusterUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import cluster unauthorized response
+func (o *ImportClusterUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportClusterUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportClusterForbiddenCode is the HTTP code returned for type ImportClusterForbidden
+const ImportClusterForbiddenCode int = 403
+
+/*ImportClusterForbidden Forbidden.
+
+swagger:response importClusterForbidden
+*/
+type ImportClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewImportClusterForbidden creates ImportClusterForbidden with default headers values
+func NewImportClusterForbidden() *ImportClusterForbidden {
+
+	return &ImportClusterForbidden{}
+}
+
+// WithPayload adds the payload to the import cluster forbidden response
+func (o *ImportClusterForbidden) WithPayload(payload *models.InfraError) *ImportClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import cluster forbidden response
+func (o *ImportClusterForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportClusterMethodNotAllowedCode is the HTTP code returned for type ImportClusterMethodNotAllowed
+const ImportClusterMethodNotAllowedCode int = 405
+
+/*ImportClusterMethodNotAllowed Method Not Allowed.
+
+swagger:response importClusterMethodNotAllowed
+*/
+type ImportClusterMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewImportClusterMethodNotAllowed creates ImportClusterMethodNotAllowed with default headers values
+func NewImportClusterMethodNotAllowed() *ImportClusterMethodNotAllowed {
+
+	return &ImportClusterMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the import cluster method not allowed response
+func (o *ImportClusterMethodNotAllowed) WithPayload(payload *models.Error) *ImportClusterMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import cluster method not allowed response
+func (o *ImportClusterMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportClusterMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportClusterInternalServerErrorCode is the HTTP code returned for type ImportClusterInternalServerError
+const ImportClusterInternalServerErrorCode int = 500
+
+/*ImportClusterInternalServerError Error.
+
+swagger:response importClusterInternalServerError
+*/
+type ImportClusterInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewImportClusterInternalServerError creates ImportClusterInternalServerError with default headers values
+func NewImportClusterInternalServerError() *ImportClusterInternalServerError {
+
+	return &ImportClusterInternalServerError{}
+}
+
+// WithPayload adds the payload to the import cluster internal server error response
+func (o *ImportClusterInternalServerError) WithPayload(payload *models.Error) *ImportClusterInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import cluster internal server error response
+func (o *ImportClusterInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportClusterInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -169,6 +169,50 @@ func (o *UploadHostLogsNotFound) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// UploadHostLogsRequestEntityTooLargeCode is the HTTP code returned for type UploadHostLogsRequestEntityTooLarge
+const UploadHostLogsRequestEntityTooLargeCode int = 413
+
+/*UploadHostLogsRequestEntityTooLarge Uploading the logs would exceed the log storage quota.
+
+swagger:response uploadHostLogsRequestEntityTooLarge
+*/
+type UploadHostLogsRequestEntityTooLarge struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUploadHostLogsRequestEntityTooLarge creates UploadHostLogsRequestEntityTooLarge with default headers values
+func NewUploadHostLogsRequestEntityTooLarge() *UploadHostLogsRequestEntityTooLarge {
+
+	return &UploadHostLogsRequestEntityTooLarge{}
+}
+
+// WithPayload adds the payload to the upload host logs request entity too large response
+func (o *UploadHostLogsRequestEntityTooLarge) WithPayload(payload *models.Error) *UploadHostLogsRequestEntityTooLarge {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the upload host logs request entity too large response
+func (o *UploadHostLogsRequestEntityTooLarge) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UploadHostLogsRequestEntityTooLarge) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(413)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // UploadHostLogsInternalServerErrorCode is the HTTP code returned for type UploadHostLogsInternalServerError
 const UploadHostLogsInternalServerErrorCode int = 500
 
@@ -169,6 +169,50 @@ func (o *UploadLogsNotFound) WriteResponse(rw http.ResponseWriter, producer runt
 	}
 }
 
+// UploadLogsRequestEntityTooLargeCode is the HTTP code returned for type UploadLogsRequestEntityTooLarge
+const UploadLogsRequestEntityTooLargeCode int = 413
+
+/*UploadLogsRequestEntityTooLarge Uploading the logs would exceed the log storage quota.
+
+swagger:response uploadLogsRequestEntityTooLarge
+*/
+type UploadLogsRequestEntityTooLarge struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUploadLogsRequestEntityTooLarge creates UploadLogsRequestEntityTooLarge with default headers values
+func NewUploadLogsRequestEntityTooLarge() *UploadLogsRequestEntityTooLarge {
+
+	return &UploadLogsRequestEntityTooLarge{}
+}
+
+// WithPayload adds the payload to the upload logs request entity too large response
+func (o *UploadLogsRequestEntityTooLarge) WithPayload(payload *models.Error) *UploadLogsRequestEntityTooLarge {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the upload logs request entity too large response
+func (o *UploadLogsRequestEntityTooLarge) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UploadLogsRequestEntityTooLarge) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(413)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // UploadLogsInternalServerErrorCode is the HTTP code returned for type UploadLogsInternalServerError
 const UploadLogsInternalServerErrorCode int = 500
 
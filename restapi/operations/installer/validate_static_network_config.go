@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ValidateStaticNetworkConfigHandlerFunc turns a function with the right signature into a validate static network config handler
+type ValidateStaticNetworkConfigHandlerFunc func(ValidateStaticNetworkConfigParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ValidateStaticNetworkConfigHandlerFunc) Handle(params ValidateStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ValidateStaticNetworkConfigHandler interface for that can handle valid validate static network config params
+type ValidateStaticNetworkConfigHandler interface {
+	Handle(ValidateStaticNetworkConfigParams, interface{}) middleware.Responder
+}
+
+// NewValidateStaticNetworkConfig creates a new http.Handler for the validate static network config operation
+func NewValidateStaticNetworkConfig(ctx *middleware.Context, handler ValidateStaticNetworkConfigHandler) *ValidateStaticNetworkConfig {
+	return &ValidateStaticNetworkConfig{Context: ctx, Handler: handler}
+}
+
+/*ValidateStaticNetworkConfig swagger:route POST /static-network-config/validate installer validateStaticNetworkConfig
+
+Validates static network configurations and renders the NetworkManager keyfiles that the discovery image would configure the hosts with.
+
+*/
+type ValidateStaticNetworkConfig struct {
+	Context *middleware.Context
+	Handler ValidateStaticNetworkConfigHandler
+}
+
+func (o *ValidateStaticNetworkConfig) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewValidateStaticNetworkConfigParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
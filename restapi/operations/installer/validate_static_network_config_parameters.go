@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewValidateStaticNetworkConfigParams creates a new ValidateStaticNetworkConfigParams object
+// no default values defined in spec.
+func NewValidateStaticNetworkConfigParams() ValidateStaticNetworkConfigParams {
+
+	return ValidateStaticNetworkConfigParams{}
+}
+
+// ValidateStaticNetworkConfigParams contains all the bound params for the validate static network config operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters ValidateStaticNetworkConfig
+type ValidateStaticNetworkConfigParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The static network configurations of the hosts, as they would be passed to the image creation.
+	  Required: true
+	  In: body
+	*/
+	StaticNetworkConfigValidateParams *models.StaticNetworkConfigValidateParams
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewValidateStaticNetworkConfigParams() beforehand.
+func (o *ValidateStaticNetworkConfigParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.StaticNetworkConfigValidateParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("staticNetworkConfigValidateParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("staticNetworkConfigValidateParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.StaticNetworkConfigValidateParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("staticNetworkConfigValidateParams", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ValidateStaticNetworkConfigOKCode is the HTTP code returned for type ValidateStaticNetworkConfigOK
+const ValidateStaticNetworkConfigOKCode int = 200
+
+/*ValidateStaticNetworkConfigOK Success.
+
+swagger:response validateStaticNetworkConfigOK
+*/
+type ValidateStaticNetworkConfigOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.StaticNetworkConfigValidation `json:"body,omitempty"`
+}
+
+// NewValidateStaticNetworkConfigOK creates ValidateStaticNetworkConfigOK with default headers values
+func NewValidateStaticNetworkConfigOK() *ValidateStaticNetworkConfigOK {
+
+	return &ValidateStaticNetworkConfigOK{}
+}
+
+// WithPayload adds the payload to the validate static network config o k response
+func (o *ValidateStaticNetworkConfigOK) WithPayload(payload *models.StaticNetworkConfigValidation) *ValidateStaticNetworkConfigOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the validate static network config o k response
+func (o *ValidateStaticNetworkConfigOK) SetPayload(payload *models.StaticNetworkConfigValidation) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ValidateStaticNetworkConfigOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ValidateStaticNetworkConfigBadRequestCode is the HTTP code returned for type ValidateStaticNetworkConfigBadRequest
+const ValidateStaticNetworkConfigBadRequestCode int = 400
+
+/*ValidateStaticNetworkConfigBadRequest Error.
+
+swagger:response validateStaticNetworkConfigBadRequest
+*/
+type ValidateStaticNetworkConfigBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewValidateStaticNetworkConfigBadRequest creates ValidateStaticNetworkConfigBadRequest with default headers values
+func NewValidateStaticNetworkConfigBadRequest() *ValidateStaticNetworkConfigBadRequest {
+
+	return &ValidateStaticNetworkConfigBadRequest{}
+}
+
+// WithPayload adds the payload to the validate static network config bad request response
+func (o *ValidateStaticNetworkConfigBadRequest) WithPayload(payload *models.Error) *ValidateStaticNetworkConfigBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the validate static network config bad request response
+func (o *ValidateStaticNetworkConfigBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ValidateStaticNetworkConfigBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ValidateStaticNetworkConfigUnauthorizedCode is the HTTP code returned for type ValidateStaticNetworkConfigUnauthorized
+const ValidateStaticNetworkConfigUnauthorizedCode int = 401
+
+/*ValidateStaticNetworkConfigUnauthorized Unauthorized.
+
+swagger:response validateStaticNetworkConfigUnauthorized
+*/
+type ValidateStaticNetworkConfigUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewValidateStaticNetworkConfigUnauthorized creates ValidateStaticNetworkConfigUnauthorized with default headers values
+func NewValidateStaticNetworkConfigUnauthorized() *ValidateStaticNetworkConfigUnauthorized {
+
+	return &ValidateStaticNetworkConfigUnauthorized{}
+}
+
+// WithPayload adds the payload to the validate static network config unauthorized response
+func (o *ValidateStaticNetworkConfigUnauthorized) WithPayload(payload *models.InfraError) *ValidateStaticNetworkConfigUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the validate static network config unauthorized response
+func (o *ValidateStaticNetworkConfigUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ValidateStaticNetworkConfigUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ValidateStaticNetworkConfigForbiddenCode is the HTTP code returned for type ValidateStaticNetworkConfigForbidden
+const ValidateStaticNetworkConfigForbiddenCode int = 403
+
+/*ValidateStaticNetworkConfigForbidden Forbidden.
+
+swagger:response validateStaticNetworkConfigForbidden
+*/
+type ValidateStaticNetworkConfigForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewValidateStaticNetworkConfigForbidden creates ValidateStaticNetworkConfigForbidden with default headers values
+func NewValidateStaticNetworkConfigForbidden() *ValidateStaticNetworkConfigForbidden {
+
+	return &ValidateStaticNetworkConfigForbidden{}
+}
+
+// WithPayload adds the payload to the validate static network config forbidden response
+func (o *ValidateStaticNetworkConfigForbidden) WithPayload(payload *models.InfraError) *ValidateStaticNetworkConfigForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the validate static network config forbidden response
+func (o *ValidateStaticNetworkConfigForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ValidateStaticNetworkConfigForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ValidateStaticNetworkConfigMethodNotAllowedCode is the HTTP code returned for type ValidateStaticNetworkConfigMethodNotAllowed
+const ValidateStaticNetworkConfigMethodNotAllowedCode int = 405
+
+/*ValidateStaticNetworkConfigMethodNotAllowed Method Not Allowed.
+
+swagger:response validateStaticNetworkConfigMethodNotAllowed
+*/
+type ValidateStaticNetworkConfigMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewValidateStaticNetworkConfigMethodNotAllowed creates ValidateStaticNetworkConfigMethodNotAllowed with default headers values
+func NewValidateStaticNetworkConfigMethodNotAllowed() *ValidateStaticNetworkConfigMethodNotAllowed {
+
+	return &ValidateStaticNetworkConfigMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the validate static network config method not allowed response
+func (o *ValidateStaticNetworkConfigMethodNotAllowed) WithPayload(payload *models.Error) *ValidateStaticNetworkConfigMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the validate static network config method not allowed response
+func (o *ValidateStaticNetworkConfigMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ValidateStaticNetworkConfigMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ValidateStaticNetworkConfigInternalServerErrorCode is the HTTP code returned for type ValidateStaticNetworkConfigInternalServerError
+const ValidateStaticNetworkConfigInternalServerErrorCode int = 500
+
+/*ValidateStaticNetworkConfigInternalServerError Error.
+
+swagger:response validateStaticNetworkConfigInternalServerError
+*/
+type ValidateStaticNetworkConfigInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewValidateStaticNetworkConfigInternalServerError creates ValidateStaticNetworkConfigInternalServerError with default headers values
+func NewValidateStaticNetworkConfigInternalServerError() *ValidateStaticNetworkConfigInternalServerError {
+
+	return &ValidateStaticNetworkConfigInternalServerError{}
+}
+
+// WithPayload adds the payload to the validate static network config internal server error response
+func (o *ValidateStaticNetworkConfigInternalServerError) WithPayload(payload *models.Error) *ValidateStaticNetworkConfigInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the validate static network config internal server error response
+func (o *ValidateStaticNetworkConfigInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ValidateStaticNetworkConfigInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package logs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetClusterLogsAnalysisHandlerFunc turns a function with the right signature into a get cluster logs analysis handler
+type GetClusterLogsAnalysisHandlerFunc func(GetClusterLogsAnalysisParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetClusterLogsAnalysisHandlerFunc) Handle(params GetClusterLogsAnalysisParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetClusterLogsAnalysisHandler interface for that can handle valid get cluster logs analysis params
+type GetClusterLogsAnalysisHandler interface {
+	Handle(GetClusterLogsAnalysisParams, interface{}) middleware.Responder
+}
+
+// NewGetClusterLogsAnalysis creates a new http.Handler for the get cluster logs analysis operation
+func NewGetClusterLogsAnalysis(ctx *middleware.Context, handler GetClusterLogsAnalysisHandler) *GetClusterLogsAnalysis {
+	return &GetClusterLogsAnalysis{Context: ctx, Handler: handler}
+}
+
+/*GetClusterLogsAnalysis swagger:route GET /clusters/{cluster_id}/logs/analysis logs getClusterLogsAnalysis
+
+Retrieves the known failures that were found in the uploaded logs of the cluster and its hosts.
+
+*/
+type GetClusterLogsAnalysis struct {
+	Context *middleware.Context
+	Handler GetClusterLogsAnalysisHandler
+}
+
+func (o *GetClusterLogsAnalysis) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetClusterLogsAnalysisParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,183 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package logs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetClusterLogsAnalysisParams creates a new GetClusterLogsAnalysisParams object
+// no default values defined in spec.
+func NewGetClusterLogsAnalysisParams() GetClusterLogsAnalysisParams {
+
+	return GetClusterLogsAnalysisParams{}
+}
+
+// GetClusterLogsAnalysisParams contains all the bound params for the get cluster logs analysis operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetClusterLogsAnalysis
+type GetClusterLogsAnalysisParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster whose logs analysis should be retrieved.
+	  Required: true
+	  In: path
+	*/
+	ClusterID strfmt.UUID
+	/*A specific host in the cluster whose logs analysis should be retrieved.
+	  In: query
+	*/
+	HostID *strfmt.UUID
+	/*Only findings of these severities are retrieved.
+	  In: query
+	  Collection Format: csv
+	*/
+	Severities []string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetClusterLogsAnalysisParams() beforehand.
+func (o *GetClusterLogsAnalysisParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
+	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qHostID, qhkHostID, _ := qs.GetOK("host_id")
+	if err := o.bindHostID(qHostID, qhkHostID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSeverities, qhkSeverities, _ := qs.GetOK("severities")
+	if err := o.bindSeverities(qSeverities, qhkSeverities, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from path.
+func (o *GetClusterLogsAnalysisParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *GetClusterLogsAnalysisParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "path", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindHostID binds and validates parameter HostID from query.
+func (o *GetClusterLogsAnalysisParams) bindHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("host_id", "query", "strfmt.UUID", raw)
+	}
+	o.HostID = (value.(*strfmt.UUID))
+
+	if err := o.validateHostID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateHostID carries on validations for parameter HostID
+func (o *GetClusterLogsAnalysisParams) validateHostID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("host_id", "query", "uuid", o.HostID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindSeverities binds and validates array parameter Severities from query.
+//
+// Arrays are parsed according to CollectionFormat: "csv" (defaults to "csv" when empty).
+func (o *GetClusterLogsAnalysisParams) bindSeverities(rawData []string, hasKey bool, formats strfmt.Registry) error {
+
+	var qvSeverities string
+	if len(rawData) > 0 {
+		qvSeverities = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat: csv
+	severitiesIC := swag.SplitByFormat(qvSeverities, "csv")
+	if len(severitiesIC) == 0 {
+		return nil
+	}
+
+	var severitiesIR []string
+	for i, severitiesIV := range severitiesIC {
+		severitiesI := severitiesIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "severities", i), "query", severitiesI, []interface{}{"info", "warning", "critical"}, true); err != nil {
+			return err
+		}
+
+		severitiesIR = append(severitiesIR, severitiesI)
+	}
+
+	o.Severities = severitiesIR
+
+	return nil
+}
@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package logs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetClusterLogsAnalysisOKCode is the HTTP code returned for type GetClusterLogsAnalysisOK
+const GetClusterLogsAnalysisOKCode int = 200
+
+/*GetClusterLogsAnalysisOK Success.
+
+swagger:response getClusterLogsAnalysisOK
+*/
+type GetClusterLogsAnalysisOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.LogFindings `json:"body,omitempty"`
+}
+
+// NewGetClusterLogsAnalysisOK creates GetClusterLogsAnalysisOK with default headers values
+func NewGetClusterLogsAnalysisOK() *GetClusterLogsAnalysisOK {
+
+	return &GetClusterLogsAnalysisOK{}
+}
+
+// WithPayload adds the payload to the get cluster logs analysis o k response
+func (o *GetClusterLogsAnalysisOK) WithPayload(payload models.LogFindings) *GetClusterLogsAnalysisOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster logs analysis o k response
+func (o *GetClusterLogsAnalysisOK) SetPayload(payload models.LogFindings) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterLogsAnalysisOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.LogFindings{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetClusterLogsAnalysisUnauthorizedCode is the HTTP code returned for type GetClusterLogsAnalysisUnauthorized
+const GetClusterLogsAnalysisUnauthorizedCode int = 401
+
+/*GetClusterLogsAnalysisUnauthorized Unauthorized.
+
+swagger:response getClusterLogsAnalysisUnauthorized
+*/
+type GetClusterLogsAnalysisUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetClusterLogsAnalysisUnauthorized creates GetClusterLogsAnalysisUnauthorized with default headers values
+func NewGetClusterLogsAnalysisUnauthorized() *GetClusterLogsAnalysisUnauthorized {
+
+	return &GetClusterLogsAnalysisUnauthorized{}
+}
+
+// WithPayload adds the payload to the get cluster logs analysis unauthorized response
+func (o *GetClusterLogsAnalysisUnauthorized) WithPayload(payload *models.InfraError) *GetClusterLogsAnalysisUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster logs analysis unauthorized response
+func (o *GetClusterLogsAnalysisUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterLogsAnalysisUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterLogsAnalysisForbiddenCode is the HTTP code returned for type GetClusterLogsAnalysisForbidden
+const GetClusterLogsAnalysisForbiddenCode int = 403
+
+/*GetClusterLogsAnalysisForbidden Forbidden.
+
+swagger:response getClusterLogsAnalysisForbidden
+*/
+type GetClusterLogsAnalysisForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetClusterLogsAnalysisForbidden creates GetClusterLogsAnalysisForbidden with default headers values
+func NewGetClusterLogsAnalysisForbidden() *GetClusterLogsAnalysisForbidden {
+
+	return &GetClusterLogsAnalysisForbidden{}
+}
+
+// WithPayload adds the payload to the get cluster logs analysis forbidden response
+func (o *GetClusterLogsAnalysisForbidden) WithPayload(payload *models.InfraError) *GetClusterLogsAnalysisForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster logs analysis forbidden response
+func (o *GetClusterLogsAnalysisForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterLogsAnalysisForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterLogsAnalysisNotFoundCode is the HTTP code returned for type GetClusterLogsAnalysisNotFound
+const GetClusterLogsAnalysisNotFoundCode int = 404
+
+/*GetClusterLogsAnalysisNotFound Error.
+
+swagger:response getClusterLogsAnalysisNotFound
+*/
+type GetClusterLogsAnalysisNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterLogsAnalysisNotFound creates GetClusterLogsAnalysisNotFound with default headers values
+func NewGetClusterLogsAnalysisNotFound() *GetClusterLogsAnalysisNotFound {
+
+	return &GetClusterLogsAnalysisNotFound{}
+}
+
+// WithPayload adds the payload to the get cluster logs analysis not found response
+func (o *GetClusterLogsAnalysisNotFound) WithPayload(payload *models.Error) *GetClusterLogsAnalysisNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster logs analysis not found response
+func (o *GetClusterLogsAnalysisNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterLogsAnalysisNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterLogsAnalysisMethodNotAllowedCode is the HTTP code returned for type GetClusterLogsAnalysisMethodNotAllowed
+const GetClusterLogsAnalysisMethodNotAllowedCode int = 405
+
+/*GetClusterLogsAnalysisMethodNotAllowed Method Not Allowed.
+
+swagger:response getClusterLogsAnalysisMethodNotAllowed
+*/
+type GetClusterLogsAnalysisMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterLogsAnalysisMethodNotAllowed creates GetClusterLogsAnalysisMethodNotAllowed with default headers values
+func NewGetClusterLogsAnalysisMethodNotAllowed() *GetClusterLogsAnalysisMethodNotAllowed {
+
+	return &GetClusterLogsAnalysisMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the get cluster logs analysis method not allowed response
+func (o *GetClusterLogsAnalysisMethodNotAllowed) WithPayload(payload *models.Error) *GetClusterLogsAnalysisMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster logs analysis method not allowed response
+func (o *GetClusterLogsAnalysisMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterLogsAnalysisMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetClusterLogsAnalysisInternalServerErrorCode is the HTTP code returned for type GetClusterLogsAnalysisInternalServerError
+const GetClusterLogsAnalysisInternalServerErrorCode int = 500
+
+/*GetClusterLogsAnalysisInternalServerError Error.
+
+swagger:response getClusterLogsAnalysisInternalServerError
+*/
+type GetClusterLogsAnalysisInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterLogsAnalysisInternalServerError creates GetClusterLogsAnalysisInternalServerError with default headers values
+func NewGetClusterLogsAnalysisInternalServerError() *GetClusterLogsAnalysisInternalServerError {
+
+	return &GetClusterLogsAnalysisInternalServerError{}
+}
+
+// WithPayload adds the payload to the get cluster logs analysis internal server error response
+func (o *GetClusterLogsAnalysisInternalServerError) WithPayload(payload *models.Error) *GetClusterLogsAnalysisInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster logs analysis internal server error response
+func (o *GetClusterLogsAnalysisInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterLogsAnalysisInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
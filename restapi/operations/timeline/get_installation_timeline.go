@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetInstallationTimelineHandlerFunc turns a function with the right signature into a get installation timeline handler
+type GetInstallationTimelineHandlerFunc func(GetInstallationTimelineParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetInstallationTimelineHandlerFunc) Handle(params GetInstallationTimelineParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetInstallationTimelineHandler interface for that can handle valid get installation timeline params
+type GetInstallationTimelineHandler interface {
+	Handle(GetInstallationTimelineParams, interface{}) middleware.Responder
+}
+
+// NewGetInstallationTimeline creates a new http.Handler for the get installation timeline operation
+func NewGetInstallationTimeline(ctx *middleware.Context, handler GetInstallationTimelineHandler) *GetInstallationTimeline {
+	return &GetInstallationTimeline{Context: ctx, Handler: handler}
+}
+
+/*GetInstallationTimeline swagger:route GET /clusters/{cluster_id}/installation-timeline timeline getInstallationTimeline
+
+Retrieves the installation of a cluster laid out over time, including the stages of every host, the status changes of the cluster, the validations that changed and the progress of the operators.
+
+*/
+type GetInstallationTimeline struct {
+	Context *middleware.Context
+	Handler GetInstallationTimelineHandler
+}
+
+func (o *GetInstallationTimeline) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetInstallationTimelineParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetInstallationTimelineOKCode is the HTTP code returned for type GetInstallationTimelineOK
+const GetInstallationTimelineOKCode int = 200
+
+/*GetInstallationTimelineOK Success.
+
+swagger:response getInstallationTimelineOK
+*/
+type GetInstallationTimelineOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InstallationTimeline `json:"body,omitempty"`
+}
+
+// NewGetInstallationTimelineOK creates GetInstallationTimelineOK with default headers values
+func NewGetInstallationTimelineOK() *GetInstallationTimelineOK {
+
+	return &GetInstallationTimelineOK{}
+}
+
+// WithPayload adds the payload to the get installation timeline o k response
+func (o *GetInstallationTimelineOK) WithPayload(payload *models.InstallationTimeline) *GetInstallationTimelineOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get installation timeline o k response
+func (o *GetInstallationTimelineOK) SetPayload(payload *models.InstallationTimeline) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetInstallationTimelineOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetInstallationTimelineUnauthorizedCode is the HTTP code returned for type GetInstallationTimelineUnauthorized
+const GetInstallationTimelineUnauthorizedCode int = 401
+
+/*GetInstallationTimelineUnauthorized Unauthorized.
+
+swagger:response getInstallationTimelineUnauthorized
+*/
+type GetInstallationTimelineUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetInstallationTimelineUnauthorized creates GetInstallationTimelineUnauthorized with default headers values
+func NewGetInstallationTimelineUnauthorized() *GetInstallationTimelineUnauthorized {
+
+	return &GetInstallationTimelineUnauthorized{}
+}
+
+// WithPayload adds the payload to the get installation timeline unauthorized response
+func (o *GetInstallationTimelineUnauthorized) WithPayload(payload *models.InfraError) *GetInstallationTimelineUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get installation timeline unauthorized response
+func (o *GetInstallationTimelineUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetInstallationTimelineUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetInstallationTimelineForbiddenCode is the HTTP code returned for type GetInstallationTimelineForbidden
+const GetInstallationTimelineForbiddenCode int = 403
+
+/*GetInstallationTimelineForbidden Forbidden.
+
+swagger:response getInstallationTimelineForbidden
+*/
+type GetInstallationTimelineForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewGetInstallationTimelineForbidden creates GetInstallationTimelineForbidden with default headers values
+func NewGetInstallationTimelineForbidden() *GetInstallationTimelineForbidden {
+
+	return &GetInstallationTimelineForbidden{}
+}
+
+// WithPayload adds the payload to the get installation timeline forbidden response
+func (o *GetInstallationTimelineForbidden) WithPayload(payload *models.InfraError) *GetInstallationTimelineForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get installation timeline forbidden response
+func (o *GetInstallationTimelineForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetInstallationTimelineForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetInstallationTimelineNotFoundCode is the HTTP code returned for type GetInstallationTimelineNotFound
+const GetInstallationTimelineNotFoundCode int = 404
+
+/*GetInstallationTimelineNotFound Error.
+
+swagger:response getInstallationTimelineNotFound
+*/
+type GetInstallationTimelineNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetInstallationTimelineNotFound creates GetInstallationTimelineNotFound with default headers values
+func NewGetInstallationTimelineNotFound() *GetInstallationTimelineNotFound {
+
+	return &GetInstallationTimelineNotFound{}
+}
+
+// WithPayload adds the payload to the get installation timeline not found response
+func (o *GetInstallationTimelineNotFound) WithPayload(payload *models.Error) *GetInstallationTimelineNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get installation timeline not found response
+func (o *GetInstallationTimelineNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetInstallationTimelineNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetInstallationTimelineMethodNotAllowedCode is the HTTP code returned for type GetInstallationTimelineMethodNotAllowed
+const GetInstallationTimelineMethodNotAllowedCode int = 405
+
+/*GetInstallationTimelineMethodNotAllowed Method Not Allowed.
+
+swagger:response getInstallationTimelineMethodNotAllowed
+*/
+type GetInstallationTimelineMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetInstallationTimelineMethodNotAllowed creates GetInstallationTimelineMethodNotAllowed with default headers values
+func NewGetInstallationTimelineMethodNotAllowed() *GetInstallationTimelineMethodNotAllowed {
+
+	return &GetInstallationTimelineMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the get installation timeline method not allowed response
+func (o *GetInstallationTimelineMethodNotAllowed) WithPayload(payload *models.Error) *GetInstallationTimelineMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get installation timeline method not allowed response
+func (o *GetInstallationTimelineMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetInstallationTimelineMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetInstallationTimelineInternalServerErrorCode is the HTTP code returned for type GetInstallationTimelineInternalServerError
+const GetInstallationTimelineInternalServerErrorCode int = 500
+
+/*GetInstallationTimelineInternalServerError Error.
+
+swagger:response getInstallationTimelineInternalServerError
+*/
+type GetInstallationTimelineInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetInstallationTimelineInternalServerError creates GetInstallationTimelineInternalServerError with default headers values
+func NewGetInstallationTimelineInternalServerError() *GetInstallationTimelineInternalServerError {
+
+	return &GetInstallationTimelineInternalServerError{}
+}
+
+// WithPayload adds the payload to the get installation timeline internal server error response
+func (o *GetInstallationTimelineInternalServerError) WithPayload(payload *models.Error) *GetInstallationTimelineInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get installation timeline internal server error response
+func (o *GetInstallationTimelineInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetInstallationTimelineInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListCustomHostValidationsHandlerFunc turns a function with the right signature into a list custom host validations handler
+type ListCustomHostValidationsHandlerFunc func(ListCustomHostValidationsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListCustomHostValidationsHandlerFunc) Handle(params ListCustomHostValidationsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListCustomHostValidationsHandler interface for that can handle valid list custom host validations params
+type ListCustomHostValidationsHandler interface {
+	Handle(ListCustomHostValidationsParams, interface{}) middleware.Responder
+}
+
+// NewListCustomHostValidations creates a new http.Handler for the list custom host validations operation
+func NewListCustomHostValidations(ctx *middleware.Context, handler ListCustomHostValidationsHandler) *ListCustomHostValidations {
+	return &ListCustomHostValidations{Context: ctx, Handler: handler}
+}
+
+/*ListCustomHostValidations swagger:route GET /clusters/{cluster_id}/custom-host-validations validations listCustomHostValidations
+
+Retrieves the custom validations that are evaluated for the hosts of the cluster, which are the service-wide defaults unless the cluster has its own.
+
+*/
+type ListCustomHostValidations struct {
+	Context *middleware.Context
+	Handler ListCustomHostValidationsHandler
+}
+
+func (o *ListCustomHostValidations) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListCustomHostValidationsParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListCustomHostValidationsOKCode is the HTTP code returned for type ListCustomHostValidationsOK
+const ListCustomHostValidationsOKCode int = 200
+
+/*ListCustomHostValidationsOK Success.
+
+swagger:response listCustomHostValidationsOK
+*/
+type ListCustomHostValidationsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.CustomHostValidationList `json:"body,omitempty"`
+}
+
+// NewListCustomHostValidationsOK creates ListCustomHostValidationsOK with default headers values
+func NewListCustomHostValidationsOK() *ListCustomHostValidationsOK {
+
+	return &ListCustomHostValidationsOK{}
+}
+
+// WithPayload adds the payload to the list custom host validations o k response
+func (o *ListCustomHostValidationsOK) WithPayload(payload models.CustomHostValidationList) *ListCustomHostValidationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list custom host validations o k response
+func (o *ListCustomHostValidationsOK) SetPayload(payload models.CustomHostValidationList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListCustomHostValidationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.CustomHostValidationList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ListCustomHostValidationsUnauthorizedCode is the HTTP code returned for type ListCustomHostValidationsUnauthorized
+const ListCustomHostValidationsUnauthorizedCode int = 401
+
+/*ListCustomHostValidationsUnauthorized Unauthorized.
+
+swagger:response listCustomHostValidationsUnauthorized
+*/
+type ListCustomHostValidationsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListCustomHostValidationsUnauthorized creates ListCustomHostValidationsUnauthorized with default headers values
+func NewListCustomHostValidationsUnauthorized() *ListCustomHostValidationsUnauthorized {
+
+	return &ListCustomHostValidationsUnauthorized{}
+}
+
+// WithPayload adds the payload to the list custom host validations unauthorized response
+func (o *ListCustomHostValidationsUnauthorized) WithPayload(payload *models.InfraError) *ListCustomHostValidationsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list custom host validations unauthorized response
+func (o *ListCustomHostValidationsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListCustomHostValidationsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListCustomHostValidationsForbiddenCode is the HTTP code returned for type ListCustomHostValidationsForbidden
+const ListCustomHostValidationsForbiddenCode int = 403
+
+/*ListCustomHostValidationsForbidden Forbidden.
+
+swagger:response listCustomHostValidationsForbidden
+*/
+type ListCustomHostValidationsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListCustomHostValidationsForbidden creates ListCustomHostValidationsForbidden with default headers values
+func NewListCustomHostValidationsForbidden() *ListCustomHostValidationsForbidden {
+
+	return &ListCustomHostValidationsForbidden{}
+}
+
+// WithPayload adds the payload to the list custom host validations forbidden response
+func (o *ListCustomHostValidationsForbidden) WithPayload(payload *models.InfraError) *ListCustomHostValidationsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list custom host validations forbidden response
+func (o *ListCustomHostValidationsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListCustomHostValidationsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListCustomHostValidationsNotFoundCode is the HTTP code returned for type ListCustomHostValidationsNotFound
+const ListCustomHostValidationsNotFoundCode int = 404
+
+/*ListCustomHostValidationsNotFound Error.
+
+swagger:response listCustomHostValidationsNotFound
+*/
+type ListCustomHostValidationsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListCustomHostValidationsNotFound creates ListCustomHostValidationsNotFound with default headers values
+func NewListCustomHostValidationsNotFound() *ListCustomHostValidationsNotFound {
+
+	return &ListCustomHostValidationsNotFound{}
+}
+
+// WithPayload adds the payload to the list custom host validations not found response
+func (o *ListCustomHostValidationsNotFound) WithPayload(payload *models.Error) *ListCustomHostValidationsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list custom host validations not found response
+func (o *ListCustomHostValidationsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListCustomHostValidationsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListCustomHostValidationsMethodNotAllowedCode is the HTTP code returned for type ListCustomHostValidationsMethodNotAllowed
+const ListCustomHostValidationsMethodNotAllowedCode int = 405
+
+/*ListCustomHostValidationsMethodNotAllowed Method Not Allowed.
+
+swagger:response listCustomHostValidationsMethodNotAllowed
+*/
+type ListCustomHostValidationsMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListCustomHostValidationsMethodNotAllowed creates ListCustomHostValidationsMethodNotAllowed with default headers values
+func NewListCustomHostValidationsMethodNotAllowed() *ListCustomHostValidationsMethodNotAllowed {
+
+	return &ListCustomHostValidationsMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the list custom host validations method not allowed response
+func (o *ListCustomHostValidationsMethodNotAllowed) WithPayload(payload *models.Error) *ListCustomHostValidationsMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list custom host validations method not allowed response
+func (o *ListCustomHostValidationsMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListCustomHostValidationsMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListCustomHostValidationsInternalServerErrorCode is the HTTP code returned for type ListCustomHostValidationsInternalServerError
+const ListCustomHostValidationsInternalServerErrorCode int = 500
+
+/*ListCustomHostValidationsInternalServerError Error.
+
+swagger:response listCustomHostValidationsInternalServerError
+*/
+type ListCustomHostValidationsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListCustomHostValidationsInternalServerError creates ListCustomHostValidationsInternalServerError with default headers values
+func NewListCustomHostValidationsInternalServerError() *ListCustomHostValidationsInternalServerError {
+
+	return &ListCustomHostValidationsInternalServerError{}
+}
+
+// WithPayload adds the payload to the list custom host validations internal server error response
+func (o *ListCustomHostValidationsInternalServerError) WithPayload(payload *models.Error) *ListCustomHostValidationsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list custom host validations internal server error response
+func (o *ListCustomHostValidationsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListCustomHostValidationsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
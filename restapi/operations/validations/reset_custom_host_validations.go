@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ResetCustomHostValidationsHandlerFunc turns a function with the right signature into a reset custom host validations handler
+type ResetCustomHostValidationsHandlerFunc func(ResetCustomHostValidationsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ResetCustomHostValidationsHandlerFunc) Handle(params ResetCustomHostValidationsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ResetCustomHostValidationsHandler interface for that can handle valid reset custom host validations params
+type ResetCustomHostValidationsHandler interface {
+	Handle(ResetCustomHostValidationsParams, interface{}) middleware.Responder
+}
+
+// NewResetCustomHostValidations creates a new http.Handler for the reset custom host validations operation
+func NewResetCustomHostValidations(ctx *middleware.Context, handler ResetCustomHostValidationsHandler) *ResetCustomHostValidations {
+	return &ResetCustomHostValidations{Context: ctx, Handler: handler}
+}
+
+/*ResetCustomHostValidations swagger:route DELETE /clusters/{cluster_id}/custom-host-validations validations resetCustomHostValidations
+
+Removes the custom validations of the cluster, so that the service-wide defaults are evaluated for its hosts.
+
+*/
+type ResetCustomHostValidations struct {
+	Context *middleware.Context
+	Handler ResetCustomHostValidationsHandler
+}
+
+func (o *ResetCustomHostValidations) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewResetCustomHostValidationsParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
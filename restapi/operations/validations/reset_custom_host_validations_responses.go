@@ -0,0 +1,258 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ResetCustomHostValidationsNoContentCode is the HTTP code returned for type ResetCustomHostValidationsNoContent
+const ResetCustomHostValidationsNoContentCode int = 204
+
+/*ResetCustomHostValidationsNoContent Success.
+
+swagger:response resetCustomHostValidationsNoContent
+*/
+type ResetCustomHostValidationsNoContent struct {
+}
+
+// NewResetCustomHostValidationsNoContent creates ResetCustomHostValidationsNoContent with default headers values
+func NewResetCustomHostValidationsNoContent() *ResetCustomHostValidationsNoContent {
+
+	return &ResetCustomHostValidationsNoContent{}
+}
+
+// WriteResponse to the client
+func (o *ResetCustomHostValidationsNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// ResetCustomHostValidationsUnauthorizedCode is the HTTP code returned for type ResetCustomHostValidationsUnauthorized
+const ResetCustomHostValidationsUnauthorizedCode int = 401
+
+/*ResetCustomHostValidationsUnauthorized Unauthorized.
+
+swagger:response resetCustomHostValidationsUnauthorized
+*/
+type ResetCustomHostValidationsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewResetCustomHostValidationsUnauthorized creates ResetCustomHostValidationsUnauthorized with default headers values
+func NewResetCustomHostValidationsUnauthorized() *ResetCustomHostValidationsUnauthorized {
+
+	return &ResetCustomHostValidationsUnauthorized{}
+}
+
+// WithPayload adds the payload to the reset custom host validations unauthorized response
+func (o *ResetCustomHostValidationsUnauthorized) WithPayload(payload *models.InfraError) *ResetCustomHostValidationsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset custom host validations unauthorized response
+func (o *ResetCustomHostValidationsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetCustomHostValidationsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResetCustomHostValidationsForbiddenCode is the HTTP code returned for type ResetCustomHostValidationsForbidden
+const ResetCustomHostValidationsForbiddenCode int = 403
+
+/*ResetCustomHostValidationsForbidden Forbidden.
+
+swagger:response resetCustomHostValidationsForbidden
+*/
+type ResetCustomHostValidationsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewResetCustomHostValidationsForbidden creates ResetCustomHostValidationsForbidden with default headers values
+func NewResetCustomHostValidationsForbidden() *ResetCustomHostValidationsForbidden {
+
+	return &ResetCustomHostValidationsForbidden{}
+}
+
+// WithPayload adds the payload to the reset custom host validations forbidden response
+func (o *ResetCustomHostValidationsForbidden) WithPayload(payload *models.InfraError) *ResetCustomHostValidationsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset custom host validations forbidden response
+func (o *ResetCustomHostValidationsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetCustomHostValidationsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResetCustomHostValidationsNotFoundCode is the HTTP code returned for type ResetCustomHostValidationsNotFound
+const ResetCustomHostValidationsNotFoundCode int = 404
+
+/*ResetCustomHostValidationsNotFound Error.
+
+swagger:response resetCustomHostValidationsNotFound
+*/
+type ResetCustomHostValidationsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResetCustomHostValidationsNotFound creates ResetCustomHostValidationsNotFound with default headers values
+func NewResetCustomHostValidationsNotFound() *ResetCustomHostValidationsNotFound {
+
+	return &ResetCustomHostValidationsNotFound{}
+}
+
+// WithPayload adds the payload to the reset custom host validations not found response
+func (o *ResetCustomHostValidationsNotFound) WithPayload(payload *models.Error) *ResetCustomHostValidationsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset custom host validations not found response
+func (o *ResetCustomHostValidationsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetCustomHostValidationsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResetCustomHostValidationsMethodNotAllowedCode is the HTTP code returned for type ResetCustomHostValidationsMethodNotAllowed
+const ResetCustomHostValidationsMethodNotAllowedCode int = 405
+
+/*ResetCustomHostValidationsMethodNotAllowed Method Not Allowed.
+
+swagger:response resetCustomHostValidationsMethodNotAllowed
+*/
+type ResetCustomHostValidationsMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResetCustomHostValidationsMethodNotAllowed creates ResetCustomHostValidationsMethodNotAllowed with default headers values
+func NewResetCustomHostValidationsMethodNotAllowed() *ResetCustomHostValidationsMethodNotAllowed {
+
+	return &ResetCustomHostValidationsMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the reset custom host validations method not allowed response
+func (o *ResetCustomHostValidationsMethodNotAllowed) WithPayload(payload *models.Error) *ResetCustomHostValidationsMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset custom host validations method not allowed response
+func (o *ResetCustomHostValidationsMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetCustomHostValidationsMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResetCustomHostValidationsInternalServerErrorCode is the HTTP code returned for type ResetCustomHostValidationsInternalServerError
+const ResetCustomHostValidationsInternalServerErrorCode int = 500
+
+/*ResetCustomHostValidationsInternalServerError Error.
+
+swagger:response resetCustomHostValidationsInternalServerError
+*/
+type ResetCustomHostValidationsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResetCustomHostValidationsInternalServerError creates ResetCustomHostValidationsInternalServerError with default headers values
+func NewResetCustomHostValidationsInternalServerError() *ResetCustomHostValidationsInternalServerError {
+
+	return &ResetCustomHostValidationsInternalServerError{}
+}
+
+// WithPayload adds the payload to the reset custom host validations internal server error response
+func (o *ResetCustomHostValidationsInternalServerError) WithPayload(payload *models.Error) *ResetCustomHostValidationsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset custom host validations internal server error response
+func (o *ResetCustomHostValidationsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetCustomHostValidationsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// UpdateCustomHostValidationsHandlerFunc turns a function with the right signature into a update custom host validations handler
+type UpdateCustomHostValidationsHandlerFunc func(UpdateCustomHostValidationsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateCustomHostValidationsHandlerFunc) Handle(params UpdateCustomHostValidationsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UpdateCustomHostValidationsHandler interface for that can handle valid update custom host validations params
+type UpdateCustomHostValidationsHandler interface {
+	Handle(UpdateCustomHostValidationsParams, interface{}) middleware.Responder
+}
+
+// NewUpdateCustomHostValidations creates a new http.Handler for the update custom host validations operation
+func NewUpdateCustomHostValidations(ctx *middleware.Context, handler UpdateCustomHostValidationsHandler) *UpdateCustomHostValidations {
+	return &UpdateCustomHostValidations{Context: ctx, Handler: handler}
+}
+
+/*UpdateCustomHostValidations swagger:route PUT /clusters/{cluster_id}/custom-host-validations validations updateCustomHostValidations
+
+Replaces the custom validations that are evaluated for the hosts of the cluster instead of the service-wide defaults.
+
+*/
+type UpdateCustomHostValidations struct {
+	Context *middleware.Context
+	Handler UpdateCustomHostValidationsHandler
+}
+
+func (o *UpdateCustomHostValidations) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUpdateCustomHostValidationsParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,325 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package validations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// UpdateCustomHostValidationsOKCode is the HTTP code returned for type UpdateCustomHostValidationsOK
+const UpdateCustomHostValidationsOKCode int = 200
+
+/*UpdateCustomHostValidationsOK Success.
+
+swagger:response updateCustomHostValidationsOK
+*/
+type UpdateCustomHostValidationsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.CustomHostValidationList `json:"body,omitempty"`
+}
+
+// NewUpdateCustomHostValidationsOK creates UpdateCustomHostValidationsOK with default headers values
+func NewUpdateCustomHostValidationsOK() *UpdateCustomHostValidationsOK {
+
+	return &UpdateCustomHostValidationsOK{}
+}
+
+// WithPayload adds the payload to the update custom host validations o k response
+func (o *UpdateCustomHostValidationsOK) WithPayload(payload models.CustomHostValidationList) *UpdateCustomHostValidationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update custom host validations o k response
+func (o *UpdateCustomHostValidationsOK) SetPayload(payload models.CustomHostValidationList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateCustomHostValidationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.CustomHostValidationList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// UpdateCustomHostValidationsBadRequestCode is the HTTP code returned for type UpdateCustomHostValidationsBadRequest
+const UpdateCustomHostValidationsBadRequestCode int = 400
+
+/*UpdateCustomHostValidationsBadRequest Error.
+
+swagger:response updateCustomHostValidationsBadRequest
+*/
+type UpdateCustomHostValidationsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateCustomHostValidationsBadRequest creates UpdateCustomHostValidationsBadRequest with default headers values
+func NewUpdateCustomHostValidationsBadRequest() *UpdateCustomHostValidationsBadRequest {
+
+	return &UpdateCustomHostValidationsBadRequest{}
+}
+
+// WithPayload adds the payload to the update custom host validations bad request response
+func (o *UpdateCustomHostValidationsBadRequest) WithPayload(payload *models.Error) *UpdateCustomHostValidationsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update custom host validations bad request response
+func (o *UpdateCustomHostValidationsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateCustomHostValidationsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateCustomHostValidationsUnauthorizedCode is the HTTP code returned for type UpdateCustomHostValidationsUnauthorized
+const UpdateCustomHostValidationsUnauthorizedCode int = 401
+
+/*UpdateCustomHostValidationsUnauthorized Unauthorized.
+
+swagger:response updateCustomHostValidationsUnauthorized
+*/
+type UpdateCustomHostValidationsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewUpdateCustomHostValidationsUnauthorized creates UpdateCustomHostValidationsUnauthorized with default headers values
+func NewUpdateCustomHostValidationsUnauthorized() *UpdateCustomHostValidationsUnauthorized {
+
+	return &UpdateCustomHostValidationsUnauthorized{}
+}
+
+// WithPayload adds the payload to the update custom host validations unauthorized response
+func (o *UpdateCustomHostValidationsUnauthorized) WithPayload(payload *models.InfraError) *UpdateCustomHostValidationsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update custom host validations unauthorized response
+func (o *UpdateCustomHostValidationsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateCustomHostValidationsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateCustomHostValidationsForbiddenCode is the HTTP code returned for type UpdateCustomHostValidationsForbidden
+const UpdateCustomHostValidationsForbiddenCode int = 403
+
+/*UpdateCustomHostValidationsForbidden Forbidden.
+
+swagger:response updateCustomHostValidationsForbidden
+*/
+type UpdateCustomHostValidationsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewUpdateCustomHostValidationsForbidden creates UpdateCustomHostValidationsForbidden with default headers values
+func NewUpdateCustomHostValidationsForbidden() *UpdateCustomHostValidationsForbidden {
+
+	return &UpdateCustomHostValidationsForbidden{}
+}
+
+// WithPayload adds the payload to the update custom host validations forbidden response
+func (o *UpdateCustomHostValidationsForbidden) WithPayload(payload *models.InfraError) *UpdateCustomHostValidationsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update custom host validations forbidden response
+func (o *UpdateCustomHostValidationsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateCustomHostValidationsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateCustomHostValidationsNotFoundCode is the HTTP code returned for type UpdateCustomHostValidationsNotFound
+const UpdateCustomHostValidationsNotFoundCode int = 404
+
+/*UpdateCustomHostValidationsNotFound Error.
+
+swagger:response updateCustomHostValidationsNotFound
+*/
+type UpdateCustomHostValidationsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateCustomHostValidationsNotFound creates UpdateCustomHostValidationsNotFound with default headers values
+func NewUpdateCustomHostValidationsNotFound() *UpdateCustomHostValidationsNotFound {
+
+	return &UpdateCustomHostValidationsNotFound{}
+}
+
+// WithPayload adds the payload to the update custom host validations not found response
+func (o *UpdateCustomHostValidationsNotFound) WithPayload(payload *models.Error) *UpdateCustomHostValidationsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update custom host validations not found response
+func (o *UpdateCustomHostValidationsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateCustomHostValidationsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateCustomHostValidationsMethodNotAllowedCode is the HTTP code returned for type UpdateCustomHostValidationsMethodNotAllowed
+const UpdateCustomHostValidationsMethodNotAllowedCode int = 405
+
+/*UpdateCustomHostValidationsMethodNotAllowed Method Not Allowed.
+
+swagger:response updateCustomHostValidationsMethodNotAllowed
+*/
+type UpdateCustomHostValidationsMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateCustomHostValidationsMethodNotAllowed creates UpdateCustomHostValidationsMethodNotAllowed with default headers values
+func NewUpdateCustomHostValidationsMethodNotAllowed() *UpdateCustomHostValidationsMethodNotAllowed {
+
+	return &UpdateCustomHostValidationsMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the update custom host validations method not allowed response
+func (o *UpdateCustomHostValidationsMethodNotAllowed) WithPayload(payload *models.Error) *UpdateCustomHostValidationsMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update custom host validations method not allowed response
+func (o *UpdateCustomHostValidationsMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateCustomHostValidationsMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateCustomHostValidationsInternalServerErrorCode is the HTTP code returned for type UpdateCustomHostValidationsInternalServerError
+const UpdateCustomHostValidationsInternalServerErrorCode int = 500
+
+/*UpdateCustomHostValidationsInternalServerError Error.
+
+swagger:response updateCustomHostValidationsInternalServerError
+*/
+type UpdateCustomHostValidationsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateCustomHostValidationsInternalServerError creates UpdateCustomHostValidationsInternalServerError with default headers values
+func NewUpdateCustomHostValidationsInternalServerError() *UpdateCustomHostValidationsInternalServerError {
+
+	return &UpdateCustomHostValidationsInternalServerError{}
+}
+
+// WithPayload adds the payload to the update custom host validations internal server error response
+func (o *UpdateCustomHostValidationsInternalServerError) WithPayload(payload *models.Error) *UpdateCustomHostValidationsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update custom host validations internal server error response
+func (o *UpdateCustomHostValidationsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateCustomHostValidationsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeregisterWebhookHandlerFunc turns a function with the right signature into a deregister webhook handler
+type DeregisterWebhookHandlerFunc func(DeregisterWebhookParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeregisterWebhookHandlerFunc) Handle(params DeregisterWebhookParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeregisterWebhookHandler interface for that can handle valid deregister webhook params
+type DeregisterWebhookHandler interface {
+	Handle(DeregisterWebhookParams, interface{}) middleware.Responder
+}
+
+// NewDeregisterWebhook creates a new http.Handler for the deregister webhook operation
+func NewDeregisterWebhook(ctx *middleware.Context, handler DeregisterWebhookHandler) *DeregisterWebhook {
+	return &DeregisterWebhook{Context: ctx, Handler: handler}
+}
+
+/*DeregisterWebhook swagger:route DELETE /clusters/{cluster_id}/webhooks/{webhook_id} webhooks deregisterWebhook
+
+Deregisters a webhook of a cluster.
+
+*/
+type DeregisterWebhook struct {
+	Context *middleware.Context
+	Handler DeregisterWebhookHandler
+}
+
+func (o *DeregisterWebhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewDeregisterWebhookParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
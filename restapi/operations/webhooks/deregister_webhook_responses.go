@@ -0,0 +1,258 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// DeregisterWebhookNoContentCode is the HTTP code returned for type DeregisterWebhookNoContent
+const DeregisterWebhookNoContentCode int = 204
+
+/*DeregisterWebhookNoContent Success.
+
+swagger:response deregisterWebhookNoContent
+*/
+type DeregisterWebhookNoContent struct {
+}
+
+// NewDeregisterWebhookNoContent creates DeregisterWebhookNoContent with default headers values
+func NewDeregisterWebhookNoContent() *DeregisterWebhookNoContent {
+
+	return &DeregisterWebhookNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeregisterWebhookNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// DeregisterWebhookUnauthorizedCode is the HTTP code returned for type DeregisterWebhookUnauthorized
+const DeregisterWebhookUnauthorizedCode int = 401
+
+/*DeregisterWebhookUnauthorized Unauthorized.
+
+swagger:response deregisterWebhookUnauthorized
+*/
+type DeregisterWebhookUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewDeregisterWebhookUnauthorized creates DeregisterWebhookUnauthorized with default headers values
+func NewDeregisterWebhookUnauthorized() *DeregisterWebhookUnauthorized {
+
+	return &DeregisterWebhookUnauthorized{}
+}
+
+// WithPayload adds the payload to the deregister webhook unauthorized response
+func (o *DeregisterWebhookUnauthorized) WithPayload(payload *models.InfraError) *DeregisterWebhookUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deregister webhook unauthorized response
+func (o *DeregisterWebhookUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeregisterWebhookUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeregisterWebhookForbiddenCode is the HTTP code returned for type DeregisterWebhookForbidden
+const DeregisterWebhookForbiddenCode int = 403
+
+/*DeregisterWebhookForbidden Forbidden.
+
+swagger:response deregisterWebhookForbidden
+*/
+type DeregisterWebhookForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewDeregisterWebhookForbidden creates DeregisterWebhookForbidden with default headers values
+func NewDeregisterWebhookForbidden() *DeregisterWebhookForbidden {
+
+	return &DeregisterWebhookForbidden{}
+}
+
+// WithPayload adds the payload to the deregister webhook forbidden response
+func (o *DeregisterWebhookForbidden) WithPayload(payload *models.InfraError) *DeregisterWebhookForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deregister webhook forbidden response
+func (o *DeregisterWebhookForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeregisterWebhookForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeregisterWebhookNotFoundCode is the HTTP code returned for type DeregisterWebhookNotFound
+const DeregisterWebhookNotFoundCode int = 404
+
+/*DeregisterWebhookNotFound Error.
+
+swagger:response deregisterWebhookNotFound
+*/
+type DeregisterWebhookNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeregisterWebhookNotFound creates DeregisterWebhookNotFound with default headers values
+func NewDeregisterWebhookNotFound() *DeregisterWebhookNotFound {
+
+	return &DeregisterWebhookNotFound{}
+}
+
+// WithPayload adds the payload to the deregister webhook not found response
+func (o *DeregisterWebhookNotFound) WithPayload(payload *models.Error) *DeregisterWebhookNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deregister webhook not found response
+func (o *DeregisterWebhookNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeregisterWebhookNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeregisterWebhookMethodNotAllowedCode is the HTTP code returned for type DeregisterWebhookMethodNotAllowed
+const DeregisterWebhookMethodNotAllowedCode int = 405
+
+/*DeregisterWebhookMethodNotAllowed Method Not Allowed.
+
+swagger:response deregisterWebhookMethodNotAllowed
+*/
+type DeregisterWebhookMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeregisterWebhookMethodNotAllowed creates DeregisterWebhookMethodNotAllowed with default headers values
+func NewDeregisterWebhookMethodNotAllowed() *DeregisterWebhookMethodNotAllowed {
+
+	return &DeregisterWebhookMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the deregister webhook method not allowed response
+func (o *DeregisterWebhookMethodNotAllowed) WithPayload(payload *models.Error) *DeregisterWebhookMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deregister webhook method not allowed response
+func (o *DeregisterWebhookMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeregisterWebhookMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeregisterWebhookInternalServerErrorCode is the HTTP code returned for type DeregisterWebhookInternalServerError
+const DeregisterWebhookInternalServerErrorCode int = 500
+
+/*DeregisterWebhookInternalServerError Error.
+
+swagger:response deregisterWebhookInternalServerError
+*/
+type DeregisterWebhookInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeregisterWebhookInternalServerError creates DeregisterWebhookInternalServerError with default headers values
+func NewDeregisterWebhookInternalServerError() *DeregisterWebhookInternalServerError {
+
+	return &DeregisterWebhookInternalServerError{}
+}
+
+// WithPayload adds the payload to the deregister webhook internal server error response
+func (o *DeregisterWebhookInternalServerError) WithPayload(payload *models.Error) *DeregisterWebhookInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deregister webhook internal server error response
+func (o *DeregisterWebhookInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeregisterWebhookInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
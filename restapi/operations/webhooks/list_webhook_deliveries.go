@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListWebhookDeliveriesHandlerFunc turns a function with the right signature into a list webhook deliveries handler
+type ListWebhookDeliveriesHandlerFunc func(ListWebhookDeliveriesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListWebhookDeliveriesHandlerFunc) Handle(params ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListWebhookDeliveriesHandler interface for that can handle valid list webhook deliveries params
+type ListWebhookDeliveriesHandler interface {
+	Handle(ListWebhookDeliveriesParams, interface{}) middleware.Responder
+}
+
+// NewListWebhookDeliveries creates a new http.Handler for the list webhook deliveries operation
+func NewListWebhookDeliveries(ctx *middleware.Context, handler ListWebhookDeliveriesHandler) *ListWebhookDeliveries {
+	return &ListWebhookDeliveries{Context: ctx, Handler: handler}
+}
+
+/*ListWebhookDeliveries swagger:route GET /clusters/{cluster_id}/webhooks/{webhook_id}/deliveries webhooks listWebhookDeliveries
+
+Lists the delivery log of a webhook.
+
+*/
+type ListWebhookDeliveries struct {
+	Context *middleware.Context
+	Handler ListWebhookDeliveriesHandler
+}
+
+func (o *ListWebhookDeliveries) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListWebhookDeliveriesParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewListWebhookDeliveriesParams creates a new ListWebhookDeliveriesParams object
+// no default values defined in spec.
+func NewListWebhookDeliveriesParams() ListWebhookDeliveriesParams {
+
+	return ListWebhookDeliveriesParams{}
+}
+
+// ListWebhookDeliveriesParams contains all the bound params for the list webhook deliveries operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters ListWebhookDeliveries
+type ListWebhookDeliveriesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster of the webhook.
+	  Required: true
+	  In: path
+	*/
+	ClusterID strfmt.UUID
+	/*The webhook to return deliveries for.
+	  Required: true
+	  In: path
+	*/
+	WebhookID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListWebhookDeliveriesParams() beforehand.
+func (o *ListWebhookDeliveriesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
+	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rWebhookID, rhkWebhookID, _ := route.Params.GetOK("webhook_id")
+	if err := o.bindWebhookID(rWebhookID, rhkWebhookID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from path.
+func (o *ListWebhookDeliveriesParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *ListWebhookDeliveriesParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "path", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindWebhookID binds and validates parameter WebhookID from path.
+func (o *ListWebhookDeliveriesParams) bindWebhookID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("webhook_id", "path", "strfmt.UUID", raw)
+	}
+	o.WebhookID = *(value.(*strfmt.UUID))
+
+	if err := o.validateWebhookID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateWebhookID carries on validations for parameter WebhookID
+func (o *ListWebhookDeliveriesParams) validateWebhookID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("webhook_id", "path", "uuid", o.WebhookID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
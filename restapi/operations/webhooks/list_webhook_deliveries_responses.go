@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListWebhookDeliveriesOKCode is the HTTP code returned for type ListWebhookDeliveriesOK
+const ListWebhookDeliveriesOKCode int = 200
+
+/*ListWebhookDeliveriesOK Success.
+
+swagger:response listWebhookDeliveriesOK
+*/
+type ListWebhookDeliveriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.WebhookDeliveryList `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesOK creates ListWebhookDeliveriesOK with default headers values
+func NewListWebhookDeliveriesOK() *ListWebhookDeliveriesOK {
+
+	return &ListWebhookDeliveriesOK{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries o k response
+func (o *ListWebhookDeliveriesOK) WithPayload(payload models.WebhookDeliveryList) *ListWebhookDeliveriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries o k response
+func (o *ListWebhookDeliveriesOK) SetPayload(payload models.WebhookDeliveryList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.WebhookDeliveryList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ListWebhookDeliveriesUnauthorizedCode is the HTTP code returned for type ListWebhookDeliveriesUnauthorized
+const ListWebhookDeliveriesUnauthorizedCode int = 401
+
+/*ListWebhookDeliveriesUnauthorized Unauthorized.
+
+swagger:response listWebhookDeliveriesUnauthorized
+*/
+type ListWebhookDeliveriesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesUnauthorized creates ListWebhookDeliveriesUnauthorized with default headers values
+func NewListWebhookDeliveriesUnauthorized() *ListWebhookDeliveriesUnauthorized {
+
+	return &ListWebhookDeliveriesUnauthorized{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries unauthorized response
+func (o *ListWebhookDeliveriesUnauthorized) WithPayload(payload *models.InfraError) *ListWebhookDeliveriesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries unauthorized response
+func (o *ListWebhookDeliveriesUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhookDeliveriesForbiddenCode is the HTTP code returned for type ListWebhookDeliveriesForbidden
+const ListWebhookDeliveriesForbiddenCode int = 403
+
+/*ListWebhookDeliveriesForbidden Forbidden.
+
+swagger:response listWebhookDeliveriesForbidden
+*/
+type ListWebhookDeliveriesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesForbidden creates ListWebhookDeliveriesForbidden with default headers values
+func NewListWebhookDeliveriesForbidden() *ListWebhookDeliveriesForbidden {
+
+	return &ListWebhookDeliveriesForbidden{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries forbidden response
+func (o *ListWebhookDeliveriesForbidden) WithPayload(payload *models.InfraError) *ListWebhookDeliveriesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries forbidden response
+func (o *ListWebhookDeliveriesForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhookDeliveriesNotFoundCode is the HTTP code returned for type ListWebhookDeliveriesNotFound
+const ListWebhookDeliveriesNotFoundCode int = 404
+
+/*ListWebhookDeliveriesNotFound Error.
+
+swagger:response listWebhookDeliveriesNotFound
+*/
+type ListWebhookDeliveriesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesNotFound creates ListWebhookDeliveriesNotFound with default headers values
+func NewListWebhookDeliveriesNotFound() *ListWebhookDeliveriesNotFound {
+
+	return &ListWebhookDeliveriesNotFound{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries not found response
+func (o *ListWebhookDeliveriesNotFound) WithPayload(payload *models.Error) *ListWebhookDeliveriesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries not found response
+func (o *ListWebhookDeliveriesNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhookDeliveriesMethodNotAllowedCode is the HTTP code returned for type ListWebhookDeliveriesMethodNotAllowed
+const ListWebhookDeliveriesMethodNotAllowedCode int = 405
+
+/*ListWebhookDeliveriesMethodNotAllowed Method Not Allowed.
+
+swagger:response listWebhookDeliveriesMethodNotAllowed
+*/
+type ListWebhookDeliveriesMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesMethodNotAllowed creates ListWebhookDeliveriesMethodNotAllowed with default headers values
+func NewListWebhookDeliveriesMethodNotAllowed() *ListWebhookDeliveriesMethodNotAllowed {
+
+	return &ListWebhookDeliveriesMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries method not allowed response
+func (o *ListWebhookDeliveriesMethodNotAllowed) WithPayload(payload *models.Error) *ListWebhookDeliveriesMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries method not allowed response
+func (o *ListWebhookDeliveriesMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhookDeliveriesInternalServerErrorCode is the HTTP code returned for type ListWebhookDeliveriesInternalServerError
+const ListWebhookDeliveriesInternalServerErrorCode int = 500
+
+/*ListWebhookDeliveriesInternalServerError Error.
+
+swagger:response listWebhookDeliveriesInternalServerError
+*/
+type ListWebhookDeliveriesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesInternalServerError creates ListWebhookDeliveriesInternalServerError with default headers values
+func NewListWebhookDeliveriesInternalServerError() *ListWebhookDeliveriesInternalServerError {
+
+	return &ListWebhookDeliveriesInternalServerError{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries internal server error response
+func (o *ListWebhookDeliveriesInternalServerError) WithPayload(payload *models.Error) *ListWebhookDeliveriesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries internal server error response
+func (o *ListWebhookDeliveriesInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// ListWebhooksOKCode is the HTTP code returned for type ListWebhooksOK
+const ListWebhooksOKCode int = 200
+
+/*ListWebhooksOK Success.
+
+swagger:response listWebhooksOK
+*/
+type ListWebhooksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.WebhookList `json:"body,omitempty"`
+}
+
+// NewListWebhooksOK creates ListWebhooksOK with default headers values
+func NewListWebhooksOK() *ListWebhooksOK {
+
+	return &ListWebhooksOK{}
+}
+
+// WithPayload adds the payload to the list webhooks o k response
+func (o *ListWebhooksOK) WithPayload(payload models.WebhookList) *ListWebhooksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks o k response
+func (o *ListWebhooksOK) SetPayload(payload models.WebhookList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.WebhookList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ListWebhooksUnauthorizedCode is the HTTP code returned for type ListWebhooksUnauthorized
+const ListWebhooksUnauthorizedCode int = 401
+
+/*ListWebhooksUnauthorized Unauthorized.
+
+swagger:response listWebhooksUnauthorized
+*/
+type ListWebhooksUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListWebhooksUnauthorized creates ListWebhooksUnauthorized with default headers values
+func NewListWebhooksUnauthorized() *ListWebhooksUnauthorized {
+
+	return &ListWebhooksUnauthorized{}
+}
+
+// WithPayload adds the payload to the list webhooks unauthorized response
+func (o *ListWebhooksUnauthorized) WithPayload(payload *models.InfraError) *ListWebhooksUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks unauthorized response
+func (o *ListWebhooksUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhooksForbiddenCode is the HTTP code returned for type ListWebhooksForbidden
+const ListWebhooksForbiddenCode int = 403
+
+/*ListWebhooksForbidden Forbidden.
+
+swagger:response listWebhooksForbidden
+*/
+type ListWebhooksForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewListWebhooksForbidden creates ListWebhooksForbidden with default headers values
+func NewListWebhooksForbidden() *ListWebhooksForbidden {
+
+	return &ListWebhooksForbidden{}
+}
+
+// WithPayload adds the payload to the list webhooks forbidden response
+func (o *ListWebhooksForbidden) WithPayload(payload *models.InfraError) *ListWebhooksForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks forbidden response
+func (o *ListWebhooksForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhooksNotFoundCode is the HTTP code returned for type ListWebhooksNotFound
+const ListWebhooksNotFoundCode int = 404
+
+/*ListWebhooksNotFound Error.
+
+swagger:response listWebhooksNotFound
+*/
+type ListWebhooksNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhooksNotFound creates ListWebhooksNotFound with default headers values
+func NewListWebhooksNotFound() *ListWebhooksNotFound {
+
+	return &ListWebhooksNotFound{}
+}
+
+// WithPayload adds the payload to the list webhooks not found response
+func (o *ListWebhooksNotFound) WithPayload(payload *models.Error) *ListWebhooksNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks not found response
+func (o *ListWebhooksNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhooksMethodNotAllowedCode is the HTTP code returned for type ListWebhooksMethodNotAllowed
+const ListWebhooksMethodNotAllowedCode int = 405
+
+/*ListWebhooksMethodNotAllowed Method Not Allowed.
+
+swagger:response listWebhooksMethodNotAllowed
+*/
+type ListWebhooksMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhooksMethodNotAllowed creates ListWebhooksMethodNotAllowed with default headers values
+func NewListWebhooksMethodNotAllowed() *ListWebhooksMethodNotAllowed {
+
+	return &ListWebhooksMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the list webhooks method not allowed response
+func (o *ListWebhooksMethodNotAllowed) WithPayload(payload *models.Error) *ListWebhooksMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks method not allowed response
+func (o *ListWebhooksMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhooksInternalServerErrorCode is the HTTP code returned for type ListWebhooksInternalServerError
+const ListWebhooksInternalServerErrorCode int = 500
+
+/*ListWebhooksInternalServerError Error.
+
+swagger:response listWebhooksInternalServerError
+*/
+type ListWebhooksInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhooksInternalServerError creates ListWebhooksInternalServerError with default headers values
+func NewListWebhooksInternalServerError() *ListWebhooksInternalServerError {
+
+	return &ListWebhooksInternalServerError{}
+}
+
+// WithPayload adds the payload to the list webhooks internal server error response
+func (o *ListWebhooksInternalServerError) WithPayload(payload *models.Error) *ListWebhooksInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks internal server error response
+func (o *ListWebhooksInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
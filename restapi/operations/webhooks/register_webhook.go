@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RegisterWebhookHandlerFunc turns a function with the right signature into a register webhook handler
+type RegisterWebhookHandlerFunc func(RegisterWebhookParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RegisterWebhookHandlerFunc) Handle(params RegisterWebhookParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RegisterWebhookHandler interface for that can handle valid register webhook params
+type RegisterWebhookHandler interface {
+	Handle(RegisterWebhookParams, interface{}) middleware.Responder
+}
+
+// NewRegisterWebhook creates a new http.Handler for the register webhook operation
+func NewRegisterWebhook(ctx *middleware.Context, handler RegisterWebhookHandler) *RegisterWebhook {
+	return &RegisterWebhook{Context: ctx, Handler: handler}
+}
+
+/*RegisterWebhook swagger:route POST /clusters/{cluster_id}/webhooks webhooks registerWebhook
+
+Registers a webhook that is notified about the events and status changes of a cluster and its hosts.
+
+*/
+type RegisterWebhook struct {
+	Context *middleware.Context
+	Handler RegisterWebhookHandler
+}
+
+func (o *RegisterWebhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewRegisterWebhookParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,322 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// RegisterWebhookCreatedCode is the HTTP code returned for type RegisterWebhookCreated
+const RegisterWebhookCreatedCode int = 201
+
+/*RegisterWebhookCreated Success.
+
+swagger:response registerWebhookCreated
+*/
+type RegisterWebhookCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Webhook `json:"body,omitempty"`
+}
+
+// NewRegisterWebhookCreated creates RegisterWebhookCreated with default headers values
+func NewRegisterWebhookCreated() *RegisterWebhookCreated {
+
+	return &RegisterWebhookCreated{}
+}
+
+// WithPayload adds the payload to the register webhook created response
+func (o *RegisterWebhookCreated) WithPayload(payload *models.Webhook) *RegisterWebhookCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register webhook created response
+func (o *RegisterWebhookCreated) SetPayload(payload *models.Webhook) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterWebhookCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RegisterWebhookBadRequestCode is the HTTP code returned for type RegisterWebhookBadRequest
+const RegisterWebhookBadRequestCode int = 400
+
+/*RegisterWebhookBadRequest Error.
+
+swagger:response registerWebhookBadRequest
+*/
+type RegisterWebhookBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRegisterWebhookBadRequest creates RegisterWebhookBadRequest with default headers values
+func NewRegisterWebhookBadRequest() *RegisterWebhookBadRequest {
+
+	return &RegisterWebhookBadRequest{}
+}
+
+// WithPayload adds the payload to the register webhook bad request response
+func (o *RegisterWebhookBadRequest) WithPayload(payload *models.Error) *RegisterWebhookBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register webhook bad request response
+func (o *RegisterWebhookBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterWebhookBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RegisterWebhookUnauthorizedCode is the HTTP code returned for type RegisterWebhookUnauthorized
+const RegisterWebhookUnauthorizedCode int = 401
+
+/*RegisterWebhookUnauthorized Unauthorized.
+
+swagger:response registerWebhookUnauthorized
+*/
+type RegisterWebhookUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewRegisterWebhookUnauthorized creates RegisterWebhookUnauthorized with default headers values
+func NewRegisterWebhookUnauthorized() *RegisterWebhookUnauthorized {
+
+	return &RegisterWebhookUnauthorized{}
+}
+
+// WithPayload adds the payload to the register webhook unauthorized response
+func (o *RegisterWebhookUnauthorized) WithPayload(payload *models.InfraError) *RegisterWebhookUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register webhook unauthorized response
+func (o *RegisterWebhookUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterWebhookUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RegisterWebhookForbiddenCode is the HTTP code returned for type RegisterWebhookForbidden
+const RegisterWebhookForbiddenCode int = 403
+
+/*RegisterWebhookForbidden Forbidden.
+
+swagger:response registerWebhookForbidden
+*/
+type RegisterWebhookForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewRegisterWebhookForbidden creates RegisterWebhookForbidden with default headers values
+func NewRegisterWebhookForbidden() *RegisterWebhookForbidden {
+
+	return &RegisterWebhookForbidden{}
+}
+
+// WithPayload adds the payload to the register webhook forbidden response
+func (o *RegisterWebhookForbidden) WithPayload(payload *models.InfraError) *RegisterWebhookForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register webhook forbidden response
+func (o *RegisterWebhookForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterWebhookForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RegisterWebhookNotFoundCode is the HTTP code returned for type RegisterWebhookNotFound
+const RegisterWebhookNotFoundCode int = 404
+
+/*RegisterWebhookNotFound Error.
+
+swagger:response registerWebhookNotFound
+*/
+type RegisterWebhookNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRegisterWebhookNotFound creates RegisterWebhookNotFound with default headers values
+func NewRegisterWebhookNotFound() *RegisterWebhookNotFound {
+
+	return &RegisterWebhookNotFound{}
+}
+
+// WithPayload adds the payload to the register webhook not found response
+func (o *RegisterWebhookNotFound) WithPayload(payload *models.Error) *RegisterWebhookNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register webhook not found response
+func (o *RegisterWebhookNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterWebhookNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RegisterWebhookMethodNotAllowedCode is the HTTP code returned for type RegisterWebhookMethodNotAllowed
+const RegisterWebhookMethodNotAllowedCode int = 405
+
+/*RegisterWebhookMethodNotAllowed Method Not Allowed.
+
+swagger:response registerWebhookMethodNotAllowed
+*/
+type RegisterWebhookMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRegisterWebhookMethodNotAllowed creates RegisterWebhookMethodNotAllowed with default headers values
+func NewRegisterWebhookMethodNotAllowed() *RegisterWebhookMethodNotAllowed {
+
+	return &RegisterWebhookMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the register webhook method not allowed response
+func (o *RegisterWebhookMethodNotAllowed) WithPayload(payload *models.Error) *RegisterWebhookMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register webhook method not allowed response
+func (o *RegisterWebhookMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterWebhookMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RegisterWebhookInternalServerErrorCode is the HTTP code returned for type RegisterWebhookInternalServerError
+const RegisterWebhookInternalServerErrorCode int = 500
+
+/*RegisterWebhookInternalServerError Error.
+
+swagger:response registerWebhookInternalServerError
+*/
+type RegisterWebhookInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRegisterWebhookInternalServerError creates RegisterWebhookInternalServerError with default headers values
+func NewRegisterWebhookInternalServerError() *RegisterWebhookInternalServerError {
+
+	return &RegisterWebhookInternalServerError{}
+}
+
+// WithPayload adds the payload to the register webhook internal server error response
+func (o *RegisterWebhookInternalServerError) WithPayload(payload *models.Error) *RegisterWebhookInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register webhook internal server error response
+func (o *RegisterWebhookInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterWebhookInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/cnv"
 	"github.com/openshift/assisted-service/internal/operators/lso"
 	"github.com/openshift/assisted-service/internal/operators/ocs"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -35,7 +36,7 @@ var _ = Describe("Operators endpoint tests", func() {
 			reply, err := userBMClient.Operators.ListSupportedOperators(context.TODO(), opclient.NewListSupportedOperatorsParams())
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(reply.GetPayload()).To(ConsistOf(ocs.Operator.Name, lso.Operator.Name, cnv.Operator.Name))
+			Expect(reply.GetPayload()).To(ConsistOf(ocs.Operator.Name, lso.Operator.Name, cnv.Operator.Name, sriov.Operator.Name))
 		})
 
 		It("should provide operator properties", func() {